@@ -119,7 +119,18 @@ func (c APIClient) Restore(ops []*admin.Op) (retErr error) {
 
 // RestoreReader restores cluster state from a reader containing marshaled ops.
 // Such as those written by ExtractWriter.
-func (c APIClient) RestoreReader(r io.Reader) (retErr error) {
+func (c APIClient) RestoreReader(r io.Reader) error {
+	return c.restoreReader(r, false)
+}
+
+// RestoreReaderBreakGlass is like RestoreReader, but the restore may rewind
+// branches in WORM repos whose retention hasn't elapsed. Its use is
+// audit-logged.
+func (c APIClient) RestoreReaderBreakGlass(r io.Reader) error {
+	return c.restoreReader(r, true)
+}
+
+func (c APIClient) restoreReader(r io.Reader, breakGlass bool) (retErr error) {
 	restoreClient, err := c.AdminAPIClient.Restore(c.Ctx())
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
@@ -138,7 +149,7 @@ func (c APIClient) RestoreReader(r io.Reader) (retErr error) {
 			}
 			return err
 		}
-		if err := restoreClient.Send(&admin.RestoreRequest{Op: op}); err != nil {
+		if err := restoreClient.Send(&admin.RestoreRequest{Op: op, BreakGlass: breakGlass}); err != nil {
 			return grpcutil.ScrubGRPC(err)
 		}
 	}
@@ -162,7 +173,17 @@ func (c APIClient) RestoreFrom(objects, enterprise, auth bool, otherC *APIClient
 }
 
 // RestoreURL restures cluster state from object storage.
-func (c APIClient) RestoreURL(url string) (retErr error) {
+func (c APIClient) RestoreURL(url string) error {
+	return c.restoreURL(url, false)
+}
+
+// RestoreURLBreakGlass is like RestoreURL, but the restore may rewind branches
+// in WORM repos whose retention hasn't elapsed. Its use is audit-logged.
+func (c APIClient) RestoreURLBreakGlass(url string) error {
+	return c.restoreURL(url, true)
+}
+
+func (c APIClient) restoreURL(url string, breakGlass bool) (retErr error) {
 	restoreClient, err := c.AdminAPIClient.Restore(c.Ctx())
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
@@ -172,5 +193,5 @@ func (c APIClient) RestoreURL(url string) (retErr error) {
 			retErr = grpcutil.ScrubGRPC(err)
 		}
 	}()
-	return grpcutil.ScrubGRPC(restoreClient.Send(&admin.RestoreRequest{URL: url}))
+	return grpcutil.ScrubGRPC(restoreClient.Send(&admin.RestoreRequest{URL: url, BreakGlass: breakGlass}))
 }
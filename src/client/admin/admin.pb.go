@@ -993,7 +993,10 @@ type RestoreRequest struct {
 	Op *Op `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
 	// URL is an object storage URL, if it's not "" data will be restored from
 	// this URL.
-	URL                  string   `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	URL string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	// break_glass restores into WORM repos whose retention hasn't elapsed.
+	// Its use is audit-logged.
+	BreakGlass           bool     `protobuf:"varint,3,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1046,6 +1049,13 @@ func (m *RestoreRequest) GetURL() string {
 	return ""
 }
 
+func (m *RestoreRequest) GetBreakGlass() bool {
+	if m != nil {
+		return m.BreakGlass
+	}
+	return false
+}
+
 type ClusterInfo struct {
 	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	DeploymentID         string   `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
@@ -1119,89 +1129,90 @@ func init() {
 func init() { proto.RegisterFile("client/admin/admin.proto", fileDescriptor_6597bb2f2302afbd) }
 
 var fileDescriptor_6597bb2f2302afbd = []byte{
-	// 1312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x98, 0xdf, 0x72, 0xda, 0x46,
-	0x1b, 0xc6, 0x03, 0x84, 0x3f, 0x5e, 0x03, 0xe1, 0xdb, 0x24, 0x8e, 0x4c, 0x12, 0x3b, 0xe1, 0x6b,
-	0x27, 0x69, 0x9a, 0x22, 0x96, 0x24, 0xb5, 0x94, 0xd6, 0xed, 0x00, 0xf6, 0x01, 0xad, 0x3b, 0xf6,
-	0xa8, 0xe9, 0x49, 0xa7, 0x53, 0x0d, 0x88, 0x05, 0x64, 0x83, 0x56, 0x95, 0x96, 0x4c, 0x7d, 0x47,
-	0xbd, 0x89, 0xf6, 0xb8, 0x87, 0xbd, 0x02, 0xb7, 0xe3, 0xa3, 0x9e, 0xf7, 0x06, 0x3a, 0x5a, 0xed,
-	0x0a, 0x49, 0x20, 0x53, 0x38, 0x80, 0x51, 0xb4, 0xcf, 0xf3, 0xea, 0xdd, 0xe7, 0xa7, 0xdd, 0xac,
-	0x01, 0x92, 0x31, 0x31, 0xb1, 0x45, 0xe5, 0xde, 0x60, 0x6a, 0x5a, 0xfe, 0x77, 0xdd, 0x76, 0x08,
-	0x25, 0x30, 0xcb, 0xfe, 0x51, 0x7d, 0x38, 0x22, 0x64, 0x34, 0xc1, 0x32, 0xbb, 0xd9, 0x9f, 0x0d,
-	0x65, 0x3c, 0xb5, 0xe9, 0xa5, 0xaf, 0xa9, 0xde, 0x1b, 0x91, 0x11, 0x61, 0x97, 0xb2, 0x77, 0xc5,
-	0xef, 0xee, 0x47, 0x6a, 0xbe, 0x47, 0xfa, 0x81, 0x6c, 0x0f, 0x5d, 0xef, 0x73, 0x83, 0xc0, 0x76,
-	0xbd, 0x4f, 0x92, 0x40, 0x59, 0x55, 0x41, 0x59, 0x55, 0x41, 0x5d, 0x55, 0x41, 0x8d, 0x55, 0x78,
-	0x12, 0x17, 0xa0, 0x46, 0xac, 0xc4, 0x52, 0xc5, 0x8a, 0x1a, 0x68, 0x65, 0x0d, 0x14, 0xab, 0x71,
-	0x8f, 0x2b, 0xa2, 0xbe, 0xe0, 0x6e, 0x44, 0xbb, 0x23, 0xaa, 0xcd, 0xe8, 0x98, 0x7d, 0xf1, 0xfb,
-	0x35, 0x7e, 0x1f, 0x5b, 0x14, 0x3b, 0xb6, 0x63, 0xba, 0x38, 0x74, 0xe9, 0x6b, 0x6a, 0x15, 0x50,
-	0xee, 0x8c, 0xb1, 0x71, 0xd1, 0x9a, 0xd1, 0xf1, 0x3b, 0x72, 0x81, 0xad, 0xda, 0x6f, 0x69, 0x90,
-	0x3d, 0xb5, 0x91, 0x7e, 0x00, 0x11, 0xc8, 0x91, 0xfe, 0x39, 0x36, 0xa8, 0x94, 0x7e, 0x92, 0x7a,
-	0xbe, 0xdd, 0xdc, 0xad, 0xdb, 0x43, 0x57, 0x47, 0xfa, 0x41, 0xfd, 0x6c, 0x46, 0x4f, 0xd9, 0x88,
-	0x86, 0x7f, 0x9a, 0x61, 0x97, 0x6a, 0x5c, 0x08, 0x3f, 0x06, 0x19, 0xda, 0x1b, 0x49, 0x99, 0x98,
-	0xfe, 0x5d, 0x6f, 0x14, 0xd5, 0x7b, 0x2a, 0x58, 0x07, 0xb7, 0x1d, 0x6c, 0x13, 0xe9, 0x36, 0x53,
-	0x57, 0x03, 0x75, 0xc7, 0xc1, 0x3d, 0x8a, 0x35, 0x6c, 0x13, 0x21, 0x67, 0x3a, 0xf8, 0x0a, 0xe4,
-	0x0c, 0x32, 0x9d, 0x9a, 0x54, 0xca, 0x32, 0xc7, 0xc3, 0xc0, 0xd1, 0x9e, 0x99, 0x93, 0x41, 0x87,
-	0x8d, 0x05, 0x1d, 0xf9, 0x52, 0xf8, 0x1a, 0xe4, 0xfa, 0x4e, 0xcf, 0x32, 0xc6, 0x52, 0x8e, 0x99,
-	0x1e, 0xc5, 0x1e, 0xd3, 0x66, 0x83, 0x81, 0xcb, 0xd7, 0xc2, 0xb7, 0xa0, 0x60, 0x9b, 0x36, 0x9e,
-	0x98, 0x16, 0x96, 0xf2, 0xcc, 0xb7, 0x57, 0xb7, 0xed, 0xb0, 0xef, 0x8c, 0x0f, 0x0b, 0x67, 0xa0,
-	0x0f, 0x02, 0x54, 0x12, 0x03, 0x54, 0xd6, 0x0c, 0x50, 0x59, 0x2b, 0x40, 0x65, 0xed, 0x00, 0x95,
-	0x4d, 0x02, 0x54, 0x36, 0x0c, 0x50, 0x59, 0x19, 0xe0, 0x55, 0xc6, 0x0f, 0x50, 0x4d, 0x0c, 0x50,
-	0x4d, 0x0e, 0xb0, 0x05, 0x4a, 0x06, 0xab, 0xaf, 0x73, 0xe7, 0x56, 0xa4, 0x6b, 0x95, 0x3f, 0x3d,
-	0x6a, 0x2e, 0x1a, 0xa1, 0x9b, 0xcb, 0x19, 0xa8, 0x89, 0x0c, 0xb2, 0xfd, 0x09, 0x31, 0x2e, 0x24,
-	0xc0, 0xe4, 0x52, 0xb8, 0xc3, 0xb6, 0x37, 0x20, 0xd4, 0xbe, 0x2c, 0x81, 0x99, 0xba, 0x36, 0x33,
-	0x75, 0x13, 0x66, 0xea, 0x86, 0xcc, 0xd4, 0x55, 0xcc, 0xbc, 0xcc, 0xce, 0x49, 0x5f, 0x2a, 0x88,
-	0xcc, 0x22, 0xb6, 0xaf, 0x48, 0x3f, 0xc8, 0xec, 0x9c, 0xf4, 0x6b, 0x7f, 0x67, 0x40, 0xce, 0x03,
-	0x8c, 0x1a, 0xb0, 0x19, 0x23, 0x2c, 0x02, 0x41, 0x8d, 0x64, 0xc4, 0xed, 0xe5, 0x88, 0x1f, 0xcf,
-	0xad, 0xab, 0x19, 0xbf, 0x0c, 0x33, 0x0e, 0x3d, 0x74, 0x39, 0x64, 0x39, 0x0a, 0x79, 0x37, 0xd2,
-	0xe4, 0x32, 0xca, 0x72, 0x84, 0xf2, 0xc3, 0x78, 0x67, 0x8b, 0x98, 0x5f, 0xc7, 0x30, 0x3f, 0x9a,
-	0x5b, 0x6e, 0xe0, 0xfc, 0x26, 0xc6, 0x79, 0x21, 0x82, 0xe5, 0xa0, 0x3f, 0x5b, 0x00, 0xbd, 0xcf,
-	0x89, 0x05, 0xc6, 0x64, 0xd2, 0x2f, 0xc3, 0xa4, 0xab, 0x71, 0x5f, 0x22, 0x6a, 0x94, 0x8c, 0x1a,
-	0x6d, 0x8e, 0x1a, 0x6d, 0x8c, 0x1a, 0xad, 0x89, 0x1a, 0xad, 0x89, 0x1a, 0xad, 0x8f, 0x1a, 0x6d,
-	0x84, 0x1a, 0x6d, 0x8a, 0x1a, 0x6d, 0x88, 0x1a, 0x25, 0xa0, 0xfe, 0x25, 0xcf, 0x51, 0x37, 0xe1,
-	0x27, 0x31, 0xd4, 0xf7, 0xbd, 0x66, 0x93, 0x29, 0x1f, 0x2e, 0xa7, 0xcc, 0xf6, 0xd2, 0xff, 0x00,
-	0xf8, 0x59, 0x18, 0xb0, 0xff, 0xa8, 0xe5, 0x6c, 0x5f, 0x44, 0xd9, 0xde, 0x13, 0x5d, 0x2d, 0xc3,
-	0xfa, 0x22, 0x82, 0x75, 0x27, 0xd4, 0xca, 0x22, 0x51, 0x39, 0x46, 0xf4, 0x01, 0x53, 0xdf, 0x00,
-	0xb3, 0x11, 0x83, 0x19, 0x9e, 0xe9, 0x72, 0x8e, 0x9f, 0x2e, 0x70, 0x64, 0x3c, 0x56, 0x22, 0x7c,
-	0x16, 0x46, 0x78, 0x3f, 0x64, 0x89, 0xd1, 0x83, 0x2f, 0x41, 0xde, 0xc5, 0x54, 0xef, 0x19, 0x13,
-	0x69, 0x9b, 0x89, 0xef, 0xd6, 0xd9, 0x51, 0xf2, 0x5b, 0x4c, 0x5b, 0x9d, 0x93, 0xa0, 0x1d, 0x17,
-	0xd3, 0x96, 0x31, 0x81, 0x3f, 0x02, 0xc9, 0x53, 0x1b, 0x93, 0x99, 0x4b, 0xb1, 0xa3, 0x3b, 0x64,
-	0x82, 0xf5, 0xbe, 0x69, 0x0d, 0x4c, 0x6b, 0x24, 0x15, 0x99, 0xfd, 0x43, 0xdf, 0xfe, 0x0d, 0x19,
-	0x98, 0xc3, 0xcb, 0x8e, 0xaf, 0xd3, 0xc8, 0x04, 0xb7, 0x7d, 0x95, 0x28, 0x78, 0xdf, 0xc5, 0x74,
-	0x71, 0x14, 0x1e, 0x83, 0x3b, 0xac, 0x9b, 0x19, 0x1d, 0xeb, 0x06, 0xb1, 0x86, 0xe6, 0x48, 0x2a,
-	0xf1, 0xd7, 0x5e, 0x74, 0xd5, 0x61, 0xb7, 0x67, 0x4e, 0x8f, 0x9a, 0xc4, 0x12, 0xe5, 0x4a, 0x5e,
-	0x7f, 0x33, 0x3a, 0xf6, 0x07, 0xe1, 0x5b, 0x50, 0xea, 0x19, 0xd4, 0x7c, 0xef, 0xbd, 0x5a, 0x9e,
-	0x4f, 0x2a, 0xf3, 0x1c, 0x58, 0x91, 0x16, 0x1f, 0x0a, 0xde, 0x2a, 0xa1, 0xf5, 0x2a, 0xc0, 0xaf,
-	0x01, 0x74, 0xb0, 0x4b, 0x89, 0xe3, 0x5b, 0x75, 0xea, 0x9d, 0x8e, 0xa5, 0x3b, 0xe1, 0x2e, 0x34,
-	0x7f, 0x3c, 0x38, 0x3b, 0x8b, 0x42, 0x15, 0x27, 0x36, 0x00, 0x4f, 0xc0, 0xdd, 0xa0, 0x91, 0xf9,
-	0x19, 0x5c, 0xaa, 0xf0, 0x3d, 0x23, 0x74, 0x2c, 0x8f, 0x37, 0x05, 0x85, 0xef, 0x38, 0xd0, 0xc0,
-	0x2f, 0x41, 0xc5, 0xf0, 0x0e, 0xed, 0xe1, 0xc6, 0xfe, 0x27, 0x66, 0xc6, 0xfe, 0x98, 0x8b, 0x9e,
-	0xe9, 0xb5, 0xb2, 0x11, 0x3d, 0xe3, 0xff, 0x99, 0x02, 0xe9, 0x53, 0x1b, 0x3e, 0x05, 0x59, 0xe2,
-	0x9d, 0xf4, 0xa5, 0x14, 0x33, 0x17, 0xb9, 0x99, 0x9d, 0xfe, 0xb5, 0xdb, 0xc4, 0x46, 0x07, 0x42,
-	0xa2, 0xf0, 0x85, 0x1c, 0x96, 0x28, 0x4c, 0xa2, 0x08, 0x89, 0xca, 0x17, 0x60, 0x58, 0xa2, 0x32,
-	0x89, 0x0a, 0x3f, 0x00, 0x39, 0xc2, 0xfe, 0xbf, 0xe7, 0xcb, 0xa9, 0x14, 0xd2, 0xa0, 0x86, 0xe6,
-	0xf9, 0x51, 0x23, 0x50, 0x21, 0xbe, 0x8c, 0x22, 0x2a, 0xe4, 0xab, 0x50, 0xa0, 0x6a, 0xf2, 0xb5,
-	0x13, 0x51, 0x35, 0x7d, 0x55, 0xb3, 0xf6, 0x6b, 0x0a, 0x94, 0x8f, 0x7f, 0xa6, 0x4e, 0x2f, 0xd8,
-	0x02, 0x60, 0x05, 0x64, 0xbe, 0xd3, 0x4e, 0xd8, 0x5c, 0xb7, 0x34, 0xef, 0x12, 0x3e, 0x06, 0xc0,
-	0x22, 0x7c, 0xcf, 0x71, 0xd9, 0x0c, 0x0b, 0xda, 0x96, 0x45, 0xfc, 0x9d, 0xc3, 0x85, 0xbb, 0xa0,
-	0x60, 0x11, 0xdd, 0x5b, 0xe1, 0x2e, 0x9b, 0x5b, 0x41, 0xcb, 0x5b, 0xc4, 0x5b, 0xfd, 0x2e, 0x7c,
-	0x0a, 0x8a, 0x16, 0xd1, 0xc5, 0x2a, 0x73, 0xd9, 0xb4, 0x0a, 0xda, 0xb6, 0x45, 0xc4, 0x4a, 0x74,
-	0xe1, 0xff, 0x41, 0xc9, 0x22, 0x61, 0xd8, 0x59, 0xa6, 0x29, 0x5a, 0x24, 0x44, 0xf2, 0x01, 0xc8,
-	0x5b, 0xc4, 0x7f, 0x35, 0x73, 0x6c, 0x38, 0x67, 0x11, 0x0f, 0x53, 0xad, 0x03, 0x76, 0x78, 0xfb,
-	0xb1, 0xb5, 0x0d, 0x3f, 0x0a, 0xed, 0x04, 0x29, 0x9e, 0x80, 0xb7, 0xac, 0x03, 0xdd, 0xfc, 0x20,
-	0x7d, 0x08, 0xca, 0xfc, 0x15, 0x15, 0xe6, 0x5d, 0x90, 0x26, 0x36, 0xb7, 0x6d, 0x05, 0xc1, 0x69,
-	0x69, 0x62, 0x8b, 0x78, 0xd2, 0x41, 0x3c, 0xb5, 0x1f, 0xc0, 0x36, 0x5f, 0x9a, 0x5d, 0x6b, 0x48,
-	0xe0, 0x0e, 0x48, 0x9b, 0x03, 0x3f, 0xbe, 0x76, 0xee, 0xfa, 0x6a, 0x3f, 0xdd, 0x3d, 0xd2, 0xd2,
-	0xe6, 0x00, 0xbe, 0x01, 0xa5, 0x01, 0xb6, 0x27, 0xe4, 0x72, 0x8a, 0x2d, 0xaa, 0x9b, 0x03, 0xbf,
-	0x44, 0xbb, 0x72, 0x7d, 0xb5, 0x5f, 0x3c, 0x0a, 0x06, 0xba, 0x47, 0x5a, 0x71, 0x2e, 0xeb, 0x0e,
-	0x9a, 0xff, 0xa4, 0x40, 0xa6, 0x75, 0xd6, 0x85, 0x32, 0xc8, 0xf3, 0x99, 0x42, 0xf1, 0xf6, 0x46,
-	0xc1, 0x55, 0xe7, 0x8d, 0xd6, 0x6e, 0x35, 0x52, 0xf0, 0x10, 0xdc, 0x89, 0x45, 0x03, 0x1f, 0x47,
-	0x8d, 0xb1, 0xc8, 0x22, 0x05, 0xe0, 0xe7, 0x20, 0xcf, 0x43, 0x09, 0x9e, 0x17, 0x0d, 0xa9, 0xba,
-	0x53, 0xf7, 0x7f, 0xfd, 0xa8, 0x8b, 0x5f, 0x3f, 0xea, 0xc7, 0x53, 0x9b, 0x5e, 0xd6, 0x6e, 0x3d,
-	0x4f, 0xc1, 0x2f, 0x40, 0xb9, 0x6b, 0xb9, 0x36, 0x36, 0xc4, 0xae, 0x05, 0x13, 0xd4, 0x55, 0x28,
-	0x96, 0xe2, 0x3c, 0xc2, 0xda, 0xad, 0xf6, 0xe1, 0xef, 0xd7, 0x7b, 0xa9, 0x3f, 0xae, 0xf7, 0x52,
-	0x7f, 0x5d, 0xef, 0xa5, 0xbe, 0x97, 0x47, 0x26, 0x1d, 0xcf, 0xfa, 0x75, 0x83, 0x4c, 0x65, 0xbb,
-	0x67, 0x8c, 0x2f, 0x07, 0xd8, 0x09, 0x5f, 0xb9, 0x8e, 0x21, 0x87, 0x7f, 0x2a, 0xe8, 0xe7, 0xd8,
-	0x43, 0x5e, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x5f, 0x69, 0x23, 0xc1, 0x11, 0x00, 0x00,
+	// 1326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x98, 0x61, 0x73, 0xdb, 0xb4,
+	0x1f, 0xc7, 0x97, 0x64, 0x49, 0x5a, 0x35, 0xe9, 0xfa, 0xd7, 0xb6, 0xce, 0xcd, 0xb6, 0x76, 0xcb,
+	0x1f, 0x6e, 0x63, 0x8c, 0x38, 0xca, 0x36, 0x6a, 0x0f, 0x06, 0xd7, 0x64, 0x3d, 0xae, 0x30, 0x6e,
+	0x3b, 0x33, 0x9e, 0x70, 0xdc, 0x7c, 0x8e, 0xa3, 0x24, 0x5e, 0x1d, 0xcb, 0xd8, 0xca, 0x8e, 0xbe,
+	0x23, 0xde, 0x04, 0x3c, 0xe6, 0x21, 0xaf, 0x60, 0x70, 0x7d, 0xc4, 0x73, 0xde, 0x00, 0x27, 0x59,
+	0x72, 0x6c, 0x27, 0x6e, 0x48, 0x1e, 0x24, 0xe7, 0x4a, 0xdf, 0xdf, 0x4f, 0x3f, 0x7d, 0x3f, 0x92,
+	0xaa, 0x18, 0x28, 0xb6, 0xeb, 0x60, 0x8f, 0xaa, 0xd6, 0x60, 0xe2, 0x78, 0xd1, 0x77, 0xcb, 0x0f,
+	0x08, 0x25, 0xb0, 0xcc, 0xff, 0x68, 0xdc, 0x1c, 0x11, 0x32, 0x72, 0xb1, 0xca, 0x1b, 0xfb, 0xd3,
+	0xa1, 0x8a, 0x27, 0x3e, 0x3d, 0x8b, 0x34, 0x8d, 0x6b, 0x23, 0x32, 0x22, 0xfc, 0x51, 0x65, 0x4f,
+	0xa2, 0xf5, 0x20, 0x95, 0xf3, 0x1d, 0x32, 0x0f, 0x55, 0x7f, 0x18, 0xb2, 0xcf, 0x05, 0x02, 0x3f,
+	0x64, 0x9f, 0x3c, 0x81, 0xb6, 0x2c, 0x83, 0xb6, 0x2c, 0x83, 0xbe, 0x2c, 0x83, 0x9e, 0xc9, 0x70,
+	0x27, 0x2b, 0x40, 0xed, 0x4c, 0x8a, 0x85, 0x8a, 0x25, 0x39, 0xd0, 0xd2, 0x1c, 0x28, 0x93, 0xe3,
+	0x9a, 0x50, 0xa4, 0xe3, 0xe2, 0xd6, 0x94, 0x76, 0x57, 0x66, 0x9b, 0xd2, 0x31, 0xff, 0x12, 0xed,
+	0x4d, 0xd1, 0x8e, 0x3d, 0x8a, 0x03, 0x3f, 0x70, 0x42, 0x9c, 0x78, 0x8c, 0x34, 0xcd, 0x1d, 0xb0,
+	0xdd, 0x1b, 0x63, 0xfb, 0xf4, 0x68, 0x4a, 0xc7, 0xaf, 0xc9, 0x29, 0xf6, 0x9a, 0xbf, 0x15, 0x41,
+	0xf9, 0xa5, 0x8f, 0xcc, 0x43, 0x88, 0x40, 0x85, 0xf4, 0xdf, 0x62, 0x9b, 0x2a, 0xc5, 0x3b, 0x85,
+	0xfb, 0x5b, 0x9d, 0xbd, 0x96, 0x3f, 0x0c, 0x4d, 0x64, 0x1e, 0xb6, 0x5e, 0x4d, 0xe9, 0x4b, 0xde,
+	0x63, 0xe0, 0x9f, 0xa6, 0x38, 0xa4, 0x86, 0x10, 0xc2, 0x8f, 0x41, 0x89, 0x5a, 0x23, 0xa5, 0x94,
+	0xd1, 0xbf, 0xb6, 0x46, 0x69, 0x3d, 0x53, 0xc1, 0x16, 0xb8, 0x1c, 0x60, 0x9f, 0x28, 0x97, 0xb9,
+	0xba, 0x11, 0xab, 0x7b, 0x01, 0xb6, 0x28, 0x36, 0xb0, 0x4f, 0xa4, 0x9c, 0xeb, 0xe0, 0x23, 0x50,
+	0xb1, 0xc9, 0x64, 0xe2, 0x50, 0xa5, 0xcc, 0x23, 0x6e, 0xc6, 0x11, 0xdd, 0xa9, 0xe3, 0x0e, 0x7a,
+	0xbc, 0x2f, 0xae, 0x28, 0x92, 0xc2, 0xc7, 0xa0, 0xd2, 0x0f, 0x2c, 0xcf, 0x1e, 0x2b, 0x15, 0x1e,
+	0x74, 0x2b, 0x33, 0x4c, 0x97, 0x77, 0xc6, 0x51, 0x91, 0x16, 0x3e, 0x05, 0x1b, 0xbe, 0xe3, 0x63,
+	0xd7, 0xf1, 0xb0, 0x52, 0xe5, 0x71, 0xfb, 0x2d, 0xdf, 0x4f, 0xc6, 0xbd, 0x12, 0xdd, 0x32, 0x32,
+	0xd6, 0xc7, 0x06, 0x6a, 0xb9, 0x06, 0x6a, 0x2b, 0x1a, 0xa8, 0xad, 0x64, 0xa0, 0xb6, 0xb2, 0x81,
+	0xda, 0x3a, 0x06, 0x6a, 0x6b, 0x1a, 0xa8, 0x2d, 0x35, 0xf0, 0x7d, 0x29, 0x32, 0x50, 0xcf, 0x35,
+	0x50, 0xcf, 0x37, 0xf0, 0x08, 0xd4, 0x6d, 0x9e, 0xdf, 0x14, 0x91, 0x9b, 0xa9, 0xaa, 0x75, 0x31,
+	0x7a, 0x3a, 0xb8, 0x66, 0x27, 0x1a, 0x17, 0x33, 0xd0, 0x73, 0x19, 0x94, 0xfb, 0x2e, 0xb1, 0x4f,
+	0x15, 0xc0, 0xe5, 0x4a, 0xb2, 0xc2, 0x2e, 0xeb, 0x90, 0xea, 0x48, 0x96, 0xc3, 0x4c, 0x5f, 0x99,
+	0x99, 0xbe, 0x0e, 0x33, 0x7d, 0x4d, 0x66, 0xfa, 0x32, 0x66, 0xcc, 0xb3, 0xb7, 0xa4, 0xaf, 0x6c,
+	0x48, 0xcf, 0x52, 0x61, 0x5f, 0x93, 0x7e, 0xec, 0xd9, 0x5b, 0xd2, 0x6f, 0xfe, 0x5d, 0x02, 0x15,
+	0x06, 0x18, 0xb5, 0x61, 0x27, 0x43, 0x58, 0x1a, 0x82, 0xda, 0xf9, 0x88, 0xbb, 0x8b, 0x11, 0xdf,
+	0x9e, 0x85, 0x2e, 0x67, 0xfc, 0x30, 0xc9, 0x38, 0x31, 0xe8, 0x62, 0xc8, 0x6a, 0x1a, 0xf2, 0x5e,
+	0xaa, 0xc8, 0x45, 0x94, 0xd5, 0x14, 0xe5, 0x9b, 0xd9, 0xca, 0xe6, 0x31, 0x3f, 0xce, 0x60, 0xbe,
+	0x35, 0x0b, 0xb9, 0x80, 0xf3, 0x93, 0x0c, 0xe7, 0x39, 0x0b, 0x16, 0x83, 0xfe, 0x6c, 0x0e, 0xf4,
+	0x81, 0x20, 0x86, 0xda, 0x4b, 0x49, 0x3f, 0x4c, 0x92, 0x6e, 0x64, 0xe3, 0x72, 0x51, 0xa3, 0x7c,
+	0xd4, 0x68, 0x7d, 0xd4, 0x68, 0x6d, 0xd4, 0x68, 0x45, 0xd4, 0x68, 0x45, 0xd4, 0x68, 0x75, 0xd4,
+	0x68, 0x2d, 0xd4, 0x68, 0x5d, 0xd4, 0x68, 0x4d, 0xd4, 0x28, 0x07, 0xf5, 0x2f, 0x55, 0x81, 0xba,
+	0x03, 0x3f, 0xc9, 0xa0, 0xbe, 0xce, 0x8a, 0xcd, 0xa7, 0xfc, 0x6c, 0x31, 0x65, 0x7e, 0x96, 0xfe,
+	0x07, 0xc0, 0xf7, 0x92, 0x80, 0xa3, 0xa1, 0x16, 0xb3, 0x7d, 0x90, 0x66, 0x7b, 0x4d, 0x56, 0xb5,
+	0x08, 0xeb, 0x83, 0x14, 0xd6, 0xdd, 0x44, 0x29, 0xf3, 0x44, 0xd5, 0x0c, 0xd1, 0x1b, 0x5c, 0x7d,
+	0x01, 0xcc, 0x76, 0x06, 0x66, 0x72, 0xa6, 0x8b, 0x39, 0x7e, 0x3a, 0xc7, 0x91, 0xf3, 0x58, 0x8a,
+	0xf0, 0x5e, 0x12, 0xe1, 0xf5, 0x44, 0x48, 0x86, 0x1e, 0x7c, 0x08, 0xaa, 0x21, 0xa6, 0xa6, 0x65,
+	0xbb, 0xca, 0x16, 0x17, 0x5f, 0x6d, 0xf1, 0xab, 0xe4, 0x77, 0x98, 0x1e, 0xf5, 0x5e, 0xc4, 0xe5,
+	0x84, 0x98, 0x1e, 0xd9, 0x2e, 0x7c, 0x03, 0x14, 0xa6, 0xb6, 0xdd, 0x69, 0x48, 0x71, 0x60, 0x06,
+	0xc4, 0xc5, 0x66, 0xdf, 0xf1, 0x06, 0x8e, 0x37, 0x52, 0x6a, 0x3c, 0xfc, 0xc3, 0x28, 0xfc, 0x5b,
+	0x32, 0x70, 0x86, 0x67, 0xbd, 0x48, 0x67, 0x10, 0x17, 0x77, 0x23, 0x95, 0x4c, 0x78, 0x3d, 0xc4,
+	0x74, 0xbe, 0x17, 0x1e, 0x83, 0x2b, 0xbc, 0x9a, 0x29, 0x1d, 0x9b, 0x36, 0xf1, 0x86, 0xce, 0x48,
+	0xa9, 0x8b, 0x65, 0x2f, 0xab, 0xea, 0xf1, 0xe6, 0x69, 0x60, 0x51, 0x87, 0x78, 0x32, 0x5d, 0x9d,
+	0xd5, 0x37, 0xa5, 0xe3, 0xa8, 0x13, 0x3e, 0x05, 0x75, 0xcb, 0xa6, 0xce, 0x3b, 0xb6, 0xb4, 0x58,
+	0x9c, 0xb2, 0x2d, 0x7c, 0xe0, 0x49, 0x8e, 0x44, 0x57, 0xbc, 0xaa, 0xa4, 0x96, 0x65, 0x80, 0xdf,
+	0x00, 0x18, 0xe0, 0x90, 0x92, 0x20, 0x0a, 0x35, 0x29, 0xbb, 0x1d, 0x2b, 0x57, 0x92, 0x55, 0x18,
+	0x51, 0x7f, 0x7c, 0x77, 0x96, 0x89, 0x76, 0x82, 0x4c, 0x07, 0x7c, 0x01, 0xae, 0xc6, 0x85, 0xcc,
+	0xee, 0xe0, 0xca, 0x8e, 0x38, 0x33, 0x66, 0x4d, 0x73, 0x45, 0x41, 0x19, 0x77, 0x1c, 0x6b, 0xe0,
+	0x97, 0x60, 0xc7, 0x66, 0x97, 0xf6, 0x64, 0x61, 0xff, 0x93, 0x33, 0xe3, 0x3f, 0xe6, 0xd2, 0x77,
+	0x7a, 0x63, 0xdb, 0x4e, 0xdf, 0xf1, 0xff, 0x2c, 0x80, 0xe2, 0x4b, 0x1f, 0xde, 0x05, 0x65, 0xc2,
+	0x6e, 0xfa, 0x4a, 0x81, 0x07, 0xd7, 0x44, 0x30, 0xbf, 0xfd, 0x1b, 0x97, 0x89, 0x8f, 0x0e, 0xa5,
+	0x44, 0x53, 0x8a, 0x73, 0x12, 0x8d, 0x4b, 0x34, 0x29, 0xd1, 0x95, 0xd2, 0x9c, 0x44, 0xe7, 0x12,
+	0x1d, 0x7e, 0x00, 0x2a, 0x84, 0xff, 0xbf, 0x17, 0xdb, 0xa9, 0x9e, 0xd0, 0xa0, 0xb6, 0xc1, 0xe2,
+	0x51, 0x3b, 0x56, 0x21, 0xa5, 0x3c, 0xaf, 0x42, 0x91, 0x0a, 0xc5, 0xaa, 0x8e, 0x52, 0x99, 0x57,
+	0x75, 0x22, 0x55, 0xa7, 0xf9, 0x6b, 0x01, 0x6c, 0x1f, 0xff, 0x4c, 0x03, 0x2b, 0x3e, 0x02, 0xe0,
+	0x0e, 0x28, 0x7d, 0x6f, 0xbc, 0xe0, 0x73, 0xdd, 0x34, 0xd8, 0x23, 0xbc, 0x0d, 0x80, 0x47, 0xc4,
+	0x99, 0x13, 0xf2, 0x19, 0x6e, 0x18, 0x9b, 0x1e, 0x89, 0x4e, 0x8e, 0x10, 0xee, 0x81, 0x0d, 0x8f,
+	0x98, 0x6c, 0x87, 0x87, 0x7c, 0x6e, 0x1b, 0x46, 0xd5, 0x23, 0x6c, 0xf7, 0x87, 0xf0, 0x2e, 0xa8,
+	0x79, 0xc4, 0x94, 0xbb, 0x2c, 0xe4, 0xd3, 0xda, 0x30, 0xb6, 0x3c, 0x22, 0x77, 0x62, 0x08, 0xff,
+	0x0f, 0xea, 0x1e, 0x49, 0xc2, 0x2e, 0x73, 0x4d, 0xcd, 0x23, 0x09, 0x92, 0x37, 0x40, 0xd5, 0x23,
+	0xd1, 0xd2, 0xac, 0xf0, 0xee, 0x8a, 0x47, 0x18, 0xa6, 0x66, 0x0f, 0xec, 0x8a, 0xf2, 0x33, 0x7b,
+	0x1b, 0x7e, 0x94, 0x38, 0x09, 0x0a, 0xc2, 0x01, 0xb6, 0xad, 0x63, 0xdd, 0xec, 0x22, 0xfd, 0x06,
+	0x6c, 0x8b, 0x25, 0x2a, 0x83, 0xf7, 0x40, 0x91, 0xf8, 0x22, 0x6c, 0x33, 0x36, 0xce, 0x28, 0x12,
+	0x5f, 0xda, 0x53, 0x9c, 0xd9, 0x73, 0x00, 0xb6, 0xfa, 0x01, 0xb6, 0x4e, 0xcd, 0x91, 0x6b, 0x85,
+	0xd2, 0x02, 0xc0, 0x9b, 0xbe, 0x62, 0x2d, 0xcd, 0x1f, 0xc1, 0x96, 0xd8, 0xbb, 0x27, 0xde, 0x90,
+	0xc0, 0x5d, 0x50, 0x74, 0x06, 0x91, 0xbf, 0xdd, 0xca, 0xf9, 0xfb, 0x83, 0xe2, 0xc9, 0x73, 0xa3,
+	0xe8, 0x0c, 0xe0, 0x13, 0x50, 0x1f, 0x60, 0xdf, 0x25, 0x67, 0x13, 0xec, 0x51, 0xd3, 0x19, 0x44,
+	0x63, 0x74, 0x77, 0xce, 0xdf, 0x1f, 0xd4, 0x9e, 0xc7, 0x1d, 0x27, 0xcf, 0x8d, 0xda, 0x4c, 0x76,
+	0x32, 0xe8, 0xfc, 0x53, 0x00, 0xa5, 0xa3, 0x57, 0x27, 0x50, 0x05, 0x55, 0x61, 0x05, 0x94, 0xcb,
+	0x3b, 0x4d, 0xb6, 0x31, 0x9b, 0x49, 0xf3, 0x52, 0xbb, 0x00, 0x9f, 0x81, 0x2b, 0x19, 0xef, 0xe0,
+	0xed, 0x74, 0x60, 0xc6, 0xd3, 0x54, 0x02, 0xf8, 0x39, 0xa8, 0x0a, 0xd7, 0xe2, 0xf1, 0xd2, 0x2e,
+	0x36, 0x76, 0x5b, 0xd1, 0xeb, 0x91, 0x96, 0x7c, 0x3d, 0xd2, 0x3a, 0x66, 0xaf, 0x47, 0x9a, 0x97,
+	0xee, 0x17, 0xe0, 0x17, 0x60, 0xfb, 0xc4, 0x0b, 0x7d, 0x6c, 0xcb, 0x63, 0x0d, 0xe6, 0xa8, 0x1b,
+	0x50, 0xee, 0xd5, 0x99, 0x85, 0xcd, 0x4b, 0xdd, 0x67, 0xbf, 0x9f, 0xef, 0x17, 0xfe, 0x38, 0xdf,
+	0x2f, 0xfc, 0x75, 0xbe, 0x5f, 0xf8, 0x41, 0x1d, 0x39, 0x74, 0x3c, 0xed, 0xb7, 0x6c, 0x32, 0x51,
+	0x7d, 0xcb, 0x1e, 0x9f, 0x0d, 0x70, 0x90, 0x7c, 0x0a, 0x03, 0x5b, 0x4d, 0xbe, 0x4b, 0xe8, 0x57,
+	0xf8, 0x20, 0x8f, 0xfe, 0x1d, 0x00, 0x58, 0x22, 0x3f, 0x38, 0xe2, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2553,6 +2564,16 @@ func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.BreakGlass {
+		i--
+		if m.BreakGlass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.URL) > 0 {
 		i -= len(m.URL)
 		copy(dAtA[i:], m.URL)
@@ -3028,6 +3049,9 @@ func (m *RestoreRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAdmin(uint64(l))
 	}
+	if m.BreakGlass {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6061,6 +6085,26 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlass", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BreakGlass = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
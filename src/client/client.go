@@ -611,7 +611,7 @@ func (c APIClient) DeleteAll() error {
 	}
 	if _, err := c.PfsAPIClient.DeleteAll(
 		c.Ctx(),
-		&types.Empty{},
+		&pfs.DeleteAllRequest{},
 	); err != nil {
 		return grpcutil.ScrubGRPC(err)
 	}
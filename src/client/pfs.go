@@ -157,6 +157,19 @@ func (c APIClient) DeleteRepo(repoName string, force bool, splitTransaction ...b
 	return grpcutil.ScrubGRPC(err)
 }
 
+// RenameRepo renames a repo, along with its commits, branches and ACL, and
+// updates the pipelines that read from it.
+func (c APIClient) RenameRepo(repoName string, newName string) error {
+	_, err := c.PfsAPIClient.RenameRepo(
+		c.Ctx(),
+		&pfs.RenameRepoRequest{
+			Repo:    NewRepo(repoName),
+			NewName: newName,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // StartCommit begins the process of committing data to a Repo. Once started
 // you can write to the Commit with PutFile and when all the data has been
 // written you must finish the Commit with FinishCommit. NOTE, data is not
@@ -317,6 +330,13 @@ func (c APIClient) ListCommitF(repoName string, to string, from string, number u
 	if to != "" {
 		req.To = NewCommit(repoName, to)
 	}
+	return c.ListCommitRequestF(req, f)
+}
+
+// ListCommitRequestF is like ListCommitF, but takes a full ListCommitRequest,
+// which allows the caller to filter the commits returned, e.g. by time range,
+// origin or description.
+func (c APIClient) ListCommitRequestF(req *pfs.ListCommitRequest, f func(*pfs.CommitInfo) error) error {
 	stream, err := c.PfsAPIClient.ListCommitStream(c.Ctx(), req)
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
@@ -424,6 +444,19 @@ func (c APIClient) DeleteBranch(repoName string, branch string, force bool) erro
 	return grpcutil.ScrubGRPC(err)
 }
 
+// RenameBranch renames a branch within its repo, and updates the pipelines
+// that read from it.
+func (c APIClient) RenameBranch(repoName string, branch string, newName string) error {
+	_, err := c.PfsAPIClient.RenameBranch(
+		c.Ctx(),
+		&pfs.RenameBranchRequest{
+			Branch:  NewBranch(repoName, branch),
+			NewName: newName,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // DeleteCommit deletes a commit.
 func (c APIClient) DeleteCommit(repoName string, commitID string) error {
 	_, err := c.PfsAPIClient.DeleteCommit(
@@ -959,6 +992,15 @@ type PutFileClient interface {
 	// overwrite the entire file, specify an index of 0.
 	PutFileOverwrite(repoName string, commitID string, path string, reader io.Reader, overwriteIndex int64) (_ int, retErr error)
 
+	// PutFileMode is like PutFile (or PutFileOverwrite with an overwriteIndex
+	// of 0, if 'overwrite' is set), but it also sets the POSIX permission bits
+	// of the file to 'mode'.
+	PutFileMode(repoName string, commitID string, path string, reader io.Reader, overwrite bool, mode uint32) (_ int, retErr error)
+
+	// PutSymlink creates a symlink at 'path' that points to 'target'. 'target'
+	// must be a relative path that stays inside of the repo.
+	PutSymlink(repoName string, commitID string, path string, target string) error
+
 	// PutFileSplit writes a file to PFS from a reader.
 	// delimiter is used to tell PFS how to break the input into blocks.
 	PutFileSplit(repoName string, commitID string, path string, delimiter pfs.Delimiter, targetFileDatums int64, targetFileBytes int64, headerRecords int64, overwrite bool, reader io.Reader) (_ int, retErr error)
@@ -998,6 +1040,30 @@ func (c APIClient) NewPutFileClient() (PutFileClient, error) {
 	return &putFileClient{c: pfc}, nil
 }
 
+// NewPutFileClientBreakGlass is like NewPutFileClient, but the files it puts
+// may delete or overwrite data in WORM repos whose retention hasn't elapsed.
+// Its use is audit-logged.
+func (c APIClient) NewPutFileClientBreakGlass() (PutFileClient, error) {
+	if c.storageV2 {
+		return nil, errors.Errorf("break glass is not supported by the V2 put file client")
+	}
+	pfc, err := c.PfsAPIClient.PutFile(c.Ctx())
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return &putFileClient{c: breakGlassPutFileClient{pfc}}, nil
+}
+
+// breakGlassPutFileClient sets break_glass in every request it sends.
+type breakGlassPutFileClient struct {
+	pfs.API_PutFileClient
+}
+
+func (c breakGlassPutFileClient) Send(request *pfs.PutFileRequest) error {
+	request.BreakGlass = true
+	return c.API_PutFileClient.Send(request)
+}
+
 func (c APIClient) newOneoffPutFileClient() (PutFileClient, error) {
 	if c.storageV2 {
 		return c.newPutFileClientV2(), nil
@@ -1053,6 +1119,48 @@ func (c *putFileClient) PutFileOverwrite(repoName string, commitID string, path
 	return int(written), grpcutil.ScrubGRPC(err)
 }
 
+// PutFileMode is like PutFile (or PutFileOverwrite with an overwriteIndex of
+// 0, if 'overwrite' is set), but it also sets the POSIX permission bits of the
+// file to 'mode'.
+func (c *putFileClient) PutFileMode(repoName string, commitID string, path string, reader io.Reader, overwrite bool, mode uint32) (_ int, retErr error) {
+	var overwriteIndex *pfs.OverwriteIndex
+	if overwrite {
+		overwriteIndex = &pfs.OverwriteIndex{}
+	}
+	writer, err := c.newPutFileWriteCloser(repoName, commitID, path, pfs.Delimiter_NONE, 0, 0, 0, overwriteIndex)
+	if err != nil {
+		return 0, grpcutil.ScrubGRPC(err)
+	}
+	writer.request.Mode = mode
+	defer func() {
+		if err := writer.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	written, err := io.Copy(writer, reader)
+	return int(written), grpcutil.ScrubGRPC(err)
+}
+
+// PutSymlink creates a symlink at 'path' that points to 'target'.
+func (c *putFileClient) PutSymlink(repoName string, commitID string, path string, target string) (retErr error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.oneoff {
+		defer func() {
+			if err := grpcutil.ScrubGRPC(c.Close()); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+	}
+	if err := c.c.Send(&pfs.PutFileRequest{
+		File:          NewFile(repoName, commitID, path),
+		SymlinkTarget: target,
+	}); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	return nil
+}
+
 //PutFileSplit writes a file to PFS from a reader
 // delimiter is used to tell PFS how to break the input into blocks
 func (c *putFileClient) PutFileSplit(repoName string, commitID string, path string, delimiter pfs.Delimiter, targetFileDatums int64, targetFileBytes int64, headerRecords int64, overwrite bool, reader io.Reader) (_ int, retErr error) {
@@ -1170,6 +1278,27 @@ func (c APIClient) PutFileOverwrite(repoName string, commitID string, path strin
 	return pfc.PutFileOverwrite(repoName, commitID, path, reader, overwriteIndex)
 }
 
+// PutFileMode is like PutFile (or PutFileOverwrite with an overwriteIndex of
+// 0, if 'overwrite' is set), but it also sets the POSIX permission bits of the
+// file to 'mode'.
+func (c APIClient) PutFileMode(repoName string, commitID string, path string, reader io.Reader, overwrite bool, mode uint32) (_ int, retErr error) {
+	pfc, err := c.newOneoffPutFileClient()
+	if err != nil {
+		return 0, err
+	}
+	return pfc.PutFileMode(repoName, commitID, path, reader, overwrite, mode)
+}
+
+// PutSymlink creates a symlink at 'path' that points to 'target'. 'target'
+// must be a relative path that stays inside of the repo.
+func (c APIClient) PutSymlink(repoName string, commitID string, path string, target string) error {
+	pfc, err := c.newOneoffPutFileClient()
+	if err != nil {
+		return err
+	}
+	return pfc.PutSymlink(repoName, commitID, path, target)
+}
+
 //PutFileSplit writes a file to PFS from a reader
 // delimiter is used to tell PFS how to break the input into blocks
 func (c APIClient) PutFileSplit(repoName string, commitID string, path string, delimiter pfs.Delimiter, targetFileDatums int64, targetFileBytes int64, headerRecords int64, overwrite bool, reader io.Reader) (_ int, retErr error) {
@@ -1192,6 +1321,68 @@ func (c APIClient) PutFileURL(repoName string, commitID string, path string, url
 	return pfc.PutFileURL(repoName, commitID, path, url, recursive, overwrite)
 }
 
+// BeginUpload starts a resumable upload session for a file. The file's data
+// is then sent in numbered parts with PutUploadPart, and added to the commit
+// by CompleteUpload.
+func (c APIClient) BeginUpload(repoName string, commitID string, path string, overwrite bool) (*pfs.UploadInfo, error) {
+	uploadInfo, err := c.PfsAPIClient.BeginUpload(
+		c.Ctx(),
+		&pfs.BeginUploadRequest{
+			File:      NewFile(repoName, commitID, path),
+			Overwrite: overwrite,
+		},
+	)
+	return uploadInfo, grpcutil.ScrubGRPC(err)
+}
+
+// PutUploadPart uploads part 'number' of an upload session from 'r'. Parts
+// may be uploaded in parallel, and re-uploading a part replaces it.
+func (c APIClient) PutUploadPart(id string, number int64, r io.Reader) (*pfs.UploadPart, error) {
+	client, err := c.PfsAPIClient.PutUploadPart(c.Ctx())
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	request := &pfs.PutUploadPartRequest{ID: id, Number: number}
+	if _, err := grpcutil.ChunkReader(r, func(data []byte) error {
+		request.Value = data
+		if err := client.Send(request); err != nil {
+			return err
+		}
+		request = &pfs.PutUploadPartRequest{}
+		return nil
+	}); err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	// An empty part still needs to identify itself
+	if request.ID != "" {
+		if err := client.Send(request); err != nil {
+			return nil, grpcutil.ScrubGRPC(err)
+		}
+	}
+	part, err := client.CloseAndRecv()
+	return part, grpcutil.ScrubGRPC(err)
+}
+
+// InspectUpload returns an upload session, including the parts it has
+// received so far.
+func (c APIClient) InspectUpload(id string) (*pfs.UploadInfo, error) {
+	uploadInfo, err := c.PfsAPIClient.InspectUpload(
+		c.Ctx(),
+		&pfs.InspectUploadRequest{ID: id},
+	)
+	return uploadInfo, grpcutil.ScrubGRPC(err)
+}
+
+// CompleteUpload adds the data of an upload session to its file, provided
+// that it has the hex-encoded SHA-256 'sum'.
+func (c APIClient) CompleteUpload(id string, sum string) error {
+	_, err := c.PfsAPIClient.CompleteUpload(
+		c.Ctx(),
+		&pfs.CompleteUploadRequest{ID: id, Sha256: sum},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // CopyFile copys a file from one pfs location to another. It can be used on
 // directories or regular files.
 func (c APIClient) CopyFile(srcRepo, srcCommit, srcPath, dstRepo, dstCommit, dstPath string, overwrite bool) error {
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"hash"
+	"os"
 )
 
 var (
@@ -15,6 +16,20 @@ var (
 	EmptyStr = "(empty)"
 )
 
+// DefaultFileMode is the mode of files that PFS doesn't record a mode for.
+// Clients only send the modes of files that differ from it, so that the hashes
+// of ordinary files don't change.
+const DefaultFileMode = 0644
+
+// FileModeToRecord returns the mode to record for a file with the permission
+// bits 'perm', which is 0 (i.e. none) if 'perm' is DefaultFileMode.
+func FileModeToRecord(perm os.FileMode) uint32 {
+	if perm == DefaultFileMode {
+		return 0
+	}
+	return uint32(perm)
+}
+
 // FullID prints repoName/CommitID
 func (c *Commit) FullID() string {
 	return fmt.Sprintf("%s/%s", c.Repo.Name, c.ID)
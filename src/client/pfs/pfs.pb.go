@@ -64,18 +64,21 @@ const (
 	FileType_RESERVED FileType = 0
 	FileType_FILE     FileType = 1
 	FileType_DIR      FileType = 2
+	FileType_SYMLINK  FileType = 3
 )
 
 var FileType_name = map[int32]string{
 	0: "RESERVED",
 	1: "FILE",
 	2: "DIR",
+	3: "SYMLINK",
 }
 
 var FileType_value = map[string]int32{
 	"RESERVED": 0,
 	"FILE":     1,
 	"DIR":      2,
+	"SYMLINK":  3,
 }
 
 func (x FileType) String() string {
@@ -465,10 +468,12 @@ type RepoInfo struct {
 	// that DeleteRepo has started deleting branches and commits in the repo, but
 	// not all of its commits have been deleted and not all upstream commits'
 	// subvenance have been updated.
-	Tombstone            bool     `protobuf:"varint,8,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Tombstone bool `protobuf:"varint,8,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+	// worm is set if the repo is write-once-read-many (see WORMPolicy).
+	Worm                 *WORMPolicy `protobuf:"bytes,9,opt,name=worm,proto3" json:"worm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *RepoInfo) Reset()         { *m = RepoInfo{} }
@@ -553,6 +558,66 @@ func (m *RepoInfo) GetTombstone() bool {
 	return false
 }
 
+func (m *RepoInfo) GetWorm() *WORMPolicy {
+	if m != nil {
+		return m.Worm
+	}
+	return nil
+}
+
+// WORMPolicy makes a repo write-once-read-many. Data committed to such a repo
+// can't be deleted or overwritten (by DeleteFile, overwriting PutFile,
+// DeleteCommit, DeleteRepo, rewinding a branch or restoring into the repo)
+// until 'retention' has elapsed since the commit containing it finished. Once
+// set, a repo's WORM policy can't be removed and its retention can't be
+// shortened.
+type WORMPolicy struct {
+	Retention            *types.Duration `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *WORMPolicy) Reset()         { *m = WORMPolicy{} }
+func (m *WORMPolicy) String() string { return proto.CompactTextString(m) }
+func (*WORMPolicy) ProtoMessage()    {}
+func (*WORMPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{7}
+}
+func (m *WORMPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WORMPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WORMPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WORMPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WORMPolicy.Merge(m, src)
+}
+func (m *WORMPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *WORMPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_WORMPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WORMPolicy proto.InternalMessageInfo
+
+func (m *WORMPolicy) GetRetention() *types.Duration {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
 // RepoAuthInfo includes the caller's access scope for a repo, and is returned
 // by ListRepo and InspectRepo but not persisted in etcd. It's used by the
 // Pachyderm dashboard to render repo access appropriately. To set a user's auth
@@ -570,7 +635,7 @@ func (m *RepoAuthInfo) Reset()         { *m = RepoAuthInfo{} }
 func (m *RepoAuthInfo) String() string { return proto.CompactTextString(m) }
 func (*RepoAuthInfo) ProtoMessage()    {}
 func (*RepoAuthInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{7}
+	return fileDescriptor_b48f014707f6595c, []int{8}
 }
 func (m *RepoAuthInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,7 +689,7 @@ func (m *BranchInfo) Reset()         { *m = BranchInfo{} }
 func (m *BranchInfo) String() string { return proto.CompactTextString(m) }
 func (*BranchInfo) ProtoMessage()    {}
 func (*BranchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{8}
+	return fileDescriptor_b48f014707f6595c, []int{9}
 }
 func (m *BranchInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -713,7 +778,7 @@ func (m *BranchInfos) Reset()         { *m = BranchInfos{} }
 func (m *BranchInfos) String() string { return proto.CompactTextString(m) }
 func (*BranchInfos) ProtoMessage()    {}
 func (*BranchInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{9}
+	return fileDescriptor_b48f014707f6595c, []int{10}
 }
 func (m *BranchInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,7 +838,7 @@ func (m *Trigger) Reset()         { *m = Trigger{} }
 func (m *Trigger) String() string { return proto.CompactTextString(m) }
 func (*Trigger) ProtoMessage()    {}
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{10}
+	return fileDescriptor_b48f014707f6595c, []int{11}
 }
 func (m *Trigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -848,7 +913,7 @@ func (m *CommitOrigin) Reset()         { *m = CommitOrigin{} }
 func (m *CommitOrigin) String() string { return proto.CompactTextString(m) }
 func (*CommitOrigin) ProtoMessage()    {}
 func (*CommitOrigin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{11}
+	return fileDescriptor_b48f014707f6595c, []int{12}
 }
 func (m *CommitOrigin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -899,7 +964,7 @@ func (m *Commit) Reset()         { *m = Commit{} }
 func (m *Commit) String() string { return proto.CompactTextString(m) }
 func (*Commit) ProtoMessage()    {}
 func (*Commit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{12}
+	return fileDescriptor_b48f014707f6595c, []int{13}
 }
 func (m *Commit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -956,7 +1021,7 @@ func (m *CommitRange) Reset()         { *m = CommitRange{} }
 func (m *CommitRange) String() string { return proto.CompactTextString(m) }
 func (*CommitRange) ProtoMessage()    {}
 func (*CommitRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{13}
+	return fileDescriptor_b48f014707f6595c, []int{14}
 }
 func (m *CommitRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1014,7 +1079,7 @@ func (m *CommitProvenance) Reset()         { *m = CommitProvenance{} }
 func (m *CommitProvenance) String() string { return proto.CompactTextString(m) }
 func (*CommitProvenance) ProtoMessage()    {}
 func (*CommitProvenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{14}
+	return fileDescriptor_b48f014707f6595c, []int{15}
 }
 func (m *CommitProvenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1096,7 +1161,7 @@ func (m *CommitInfo) Reset()         { *m = CommitInfo{} }
 func (m *CommitInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitInfo) ProtoMessage()    {}
 func (*CommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{15}
+	return fileDescriptor_b48f014707f6595c, []int{16}
 }
 func (m *CommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1258,20 +1323,26 @@ type FileInfo struct {
 	Committed *types.Timestamp `protobuf:"bytes,10,opt,name=committed,proto3" json:"committed,omitempty"`
 	// the base names (i.e. just the filenames, not the full paths) of
 	// the children
-	Children             []string    `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
-	Objects              []*Object   `protobuf:"bytes,8,rep,name=objects,proto3" json:"objects,omitempty"`
-	BlockRefs            []*BlockRef `protobuf:"bytes,9,rep,name=blockRefs,proto3" json:"blockRefs,omitempty"`
-	Hash                 []byte      `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Children  []string    `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
+	Objects   []*Object   `protobuf:"bytes,8,rep,name=objects,proto3" json:"objects,omitempty"`
+	BlockRefs []*BlockRef `protobuf:"bytes,9,rep,name=blockRefs,proto3" json:"blockRefs,omitempty"`
+	Hash      []byte      `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+	// mode holds the POSIX permission bits of the file. It's 0 if they were
+	// never set, in which case the file gets the default permissions.
+	Mode uint32 `protobuf:"varint,11,opt,name=mode,proto3" json:"mode,omitempty"`
+	// symlink_target is the target of a SYMLINK, relative to the directory that
+	// contains it.
+	SymlinkTarget        string   `protobuf:"bytes,12,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{16}
+	return fileDescriptor_b48f014707f6595c, []int{17}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1356,6 +1427,20 @@ func (m *FileInfo) GetHash() []byte {
 	return nil
 }
 
+func (m *FileInfo) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *FileInfo) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
 type ByteRange struct {
 	Lower                uint64   `protobuf:"varint,1,opt,name=lower,proto3" json:"lower,omitempty"`
 	Upper                uint64   `protobuf:"varint,2,opt,name=upper,proto3" json:"upper,omitempty"`
@@ -1368,7 +1453,7 @@ func (m *ByteRange) Reset()         { *m = ByteRange{} }
 func (m *ByteRange) String() string { return proto.CompactTextString(m) }
 func (*ByteRange) ProtoMessage()    {}
 func (*ByteRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{17}
+	return fileDescriptor_b48f014707f6595c, []int{18}
 }
 func (m *ByteRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,7 +1508,7 @@ func (m *BlockRef) Reset()         { *m = BlockRef{} }
 func (m *BlockRef) String() string { return proto.CompactTextString(m) }
 func (*BlockRef) ProtoMessage()    {}
 func (*BlockRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{18}
+	return fileDescriptor_b48f014707f6595c, []int{19}
 }
 func (m *BlockRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1478,7 +1563,7 @@ func (m *ObjectInfo) Reset()         { *m = ObjectInfo{} }
 func (m *ObjectInfo) String() string { return proto.CompactTextString(m) }
 func (*ObjectInfo) ProtoMessage()    {}
 func (*ObjectInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{19}
+	return fileDescriptor_b48f014707f6595c, []int{20}
 }
 func (m *ObjectInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1532,7 +1617,7 @@ func (m *Compaction) Reset()         { *m = Compaction{} }
 func (m *Compaction) String() string { return proto.CompactTextString(m) }
 func (*Compaction) ProtoMessage()    {}
 func (*Compaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{20}
+	return fileDescriptor_b48f014707f6595c, []int{21}
 }
 func (m *Compaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1581,7 +1666,7 @@ func (m *Shard) Reset()         { *m = Shard{} }
 func (m *Shard) String() string { return proto.CompactTextString(m) }
 func (*Shard) ProtoMessage()    {}
 func (*Shard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{21}
+	return fileDescriptor_b48f014707f6595c, []int{22}
 }
 func (m *Shard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1643,7 +1728,7 @@ func (m *PathRange) Reset()         { *m = PathRange{} }
 func (m *PathRange) String() string { return proto.CompactTextString(m) }
 func (*PathRange) ProtoMessage()    {}
 func (*PathRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{22}
+	return fileDescriptor_b48f014707f6595c, []int{23}
 }
 func (m *PathRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1687,19 +1772,21 @@ func (m *PathRange) GetUpper() string {
 }
 
 type CreateRepoRequest struct {
-	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Update               bool     `protobuf:"varint,4,opt,name=update,proto3" json:"update,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Repo        *Repo  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Update      bool   `protobuf:"varint,4,opt,name=update,proto3" json:"update,omitempty"`
+	// worm, if set, makes the repo write-once-read-many.
+	Worm                 *WORMPolicy `protobuf:"bytes,5,opt,name=worm,proto3" json:"worm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *CreateRepoRequest) Reset()         { *m = CreateRepoRequest{} }
 func (m *CreateRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRepoRequest) ProtoMessage()    {}
 func (*CreateRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{23}
+	return fileDescriptor_b48f014707f6595c, []int{24}
 }
 func (m *CreateRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1749,6 +1836,13 @@ func (m *CreateRepoRequest) GetUpdate() bool {
 	return false
 }
 
+func (m *CreateRepoRequest) GetWorm() *WORMPolicy {
+	if m != nil {
+		return m.Worm
+	}
+	return nil
+}
+
 type InspectRepoRequest struct {
 	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1760,7 +1854,7 @@ func (m *InspectRepoRequest) Reset()         { *m = InspectRepoRequest{} }
 func (m *InspectRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectRepoRequest) ProtoMessage()    {}
 func (*InspectRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{24}
+	return fileDescriptor_b48f014707f6595c, []int{25}
 }
 func (m *InspectRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1806,7 +1900,7 @@ func (m *ListRepoRequest) Reset()         { *m = ListRepoRequest{} }
 func (m *ListRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRepoRequest) ProtoMessage()    {}
 func (*ListRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{25}
+	return fileDescriptor_b48f014707f6595c, []int{26}
 }
 func (m *ListRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1846,7 +1940,7 @@ func (m *ListRepoResponse) Reset()         { *m = ListRepoResponse{} }
 func (m *ListRepoResponse) String() string { return proto.CompactTextString(m) }
 func (*ListRepoResponse) ProtoMessage()    {}
 func (*ListRepoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{26}
+	return fileDescriptor_b48f014707f6595c, []int{27}
 }
 func (m *ListRepoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1883,10 +1977,13 @@ func (m *ListRepoResponse) GetRepoInfo() []*RepoInfo {
 }
 
 type DeleteRepoRequest struct {
-	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-	All                  bool     `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
-	SplitTransaction     bool     `protobuf:"varint,4,opt,name=split_transaction,json=splitTransaction,proto3" json:"split_transaction,omitempty"`
+	Repo             *Repo `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Force            bool  `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	All              bool  `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	SplitTransaction bool  `protobuf:"varint,4,opt,name=split_transaction,json=splitTransaction,proto3" json:"split_transaction,omitempty"`
+	// break_glass deletes WORM repos whose retention hasn't elapsed. Its use is
+	// audit-logged.
+	BreakGlass           bool     `protobuf:"varint,5,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1896,7 +1993,7 @@ func (m *DeleteRepoRequest) Reset()         { *m = DeleteRepoRequest{} }
 func (m *DeleteRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRepoRequest) ProtoMessage()    {}
 func (*DeleteRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{27}
+	return fileDescriptor_b48f014707f6595c, []int{28}
 }
 func (m *DeleteRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1953,6 +2050,72 @@ func (m *DeleteRepoRequest) GetSplitTransaction() bool {
 	return false
 }
 
+func (m *DeleteRepoRequest) GetBreakGlass() bool {
+	if m != nil {
+		return m.BreakGlass
+	}
+	return false
+}
+
+// RenameRepoRequest renames 'repo' to 'new_name'. The repo's commits,
+// branches and ACL, the provenance of downstream commits and branches, and the
+// specs of pipelines that read from the repo all refer to the new name
+// afterwards.
+type RenameRepoRequest struct {
+	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenameRepoRequest) Reset()         { *m = RenameRepoRequest{} }
+func (m *RenameRepoRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameRepoRequest) ProtoMessage()    {}
+func (*RenameRepoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{29}
+}
+func (m *RenameRepoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenameRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenameRepoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RenameRepoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameRepoRequest.Merge(m, src)
+}
+func (m *RenameRepoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenameRepoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameRepoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameRepoRequest proto.InternalMessageInfo
+
+func (m *RenameRepoRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *RenameRepoRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
 type StartCommitRequest struct {
 	// Parent.ID may be empty in which case the commit that Branch points to will be used as the parent.
 	// If branch is empty, or if branch does not exist, the commit will have no parent.
@@ -1970,7 +2133,7 @@ func (m *StartCommitRequest) Reset()         { *m = StartCommitRequest{} }
 func (m *StartCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*StartCommitRequest) ProtoMessage()    {}
 func (*StartCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{28}
+	return fileDescriptor_b48f014707f6595c, []int{30}
 }
 func (m *StartCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2052,7 +2215,7 @@ func (m *BuildCommitRequest) Reset()         { *m = BuildCommitRequest{} }
 func (m *BuildCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*BuildCommitRequest) ProtoMessage()    {}
 func (*BuildCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{29}
+	return fileDescriptor_b48f014707f6595c, []int{31}
 }
 func (m *BuildCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2179,7 +2342,7 @@ func (m *FinishCommitRequest) Reset()         { *m = FinishCommitRequest{} }
 func (m *FinishCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*FinishCommitRequest) ProtoMessage()    {}
 func (*FinishCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{30}
+	return fileDescriptor_b48f014707f6595c, []int{32}
 }
 func (m *FinishCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2270,7 +2433,7 @@ func (m *InspectCommitRequest) Reset()         { *m = InspectCommitRequest{} }
 func (m *InspectCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectCommitRequest) ProtoMessage()    {}
 func (*InspectCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{31}
+	return fileDescriptor_b48f014707f6595c, []int{33}
 }
 func (m *InspectCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2314,11 +2477,24 @@ func (m *InspectCommitRequest) GetBlockState() CommitState {
 }
 
 type ListCommitRequest struct {
-	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	From                 *Commit  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To                   *Commit  `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Number               uint64   `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
-	Reverse              bool     `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Repo    *Repo   `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	From    *Commit `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To      *Commit `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Number  uint64  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	Reverse bool    `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// The following fields filter the commits that are returned. Commits that
+	// don't match a filter are skipped, and don't count towards 'number'.
+	StartedAfter   *types.Timestamp `protobuf:"bytes,6,opt,name=started_after,json=startedAfter,proto3" json:"started_after,omitempty"`
+	StartedBefore  *types.Timestamp `protobuf:"bytes,7,opt,name=started_before,json=startedBefore,proto3" json:"started_before,omitempty"`
+	FinishedAfter  *types.Timestamp `protobuf:"bytes,8,opt,name=finished_after,json=finishedAfter,proto3" json:"finished_after,omitempty"`
+	FinishedBefore *types.Timestamp `protobuf:"bytes,9,opt,name=finished_before,json=finishedBefore,proto3" json:"finished_before,omitempty"`
+	// If set, only commits with this origin are returned
+	Origin *CommitOrigin `protobuf:"bytes,10,opt,name=origin,proto3" json:"origin,omitempty"`
+	// If set, only commits whose description matches this regex are returned
+	Description  string `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	MinSizeBytes uint64 `protobuf:"varint,12,opt,name=min_size_bytes,json=minSizeBytes,proto3" json:"min_size_bytes,omitempty"`
+	// If set, only commits on this branch are returned
+	Branch               string   `protobuf:"bytes,13,opt,name=branch,proto3" json:"branch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2328,7 +2504,7 @@ func (m *ListCommitRequest) Reset()         { *m = ListCommitRequest{} }
 func (m *ListCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCommitRequest) ProtoMessage()    {}
 func (*ListCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{32}
+	return fileDescriptor_b48f014707f6595c, []int{34}
 }
 func (m *ListCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2392,6 +2568,62 @@ func (m *ListCommitRequest) GetReverse() bool {
 	return false
 }
 
+func (m *ListCommitRequest) GetStartedAfter() *types.Timestamp {
+	if m != nil {
+		return m.StartedAfter
+	}
+	return nil
+}
+
+func (m *ListCommitRequest) GetStartedBefore() *types.Timestamp {
+	if m != nil {
+		return m.StartedBefore
+	}
+	return nil
+}
+
+func (m *ListCommitRequest) GetFinishedAfter() *types.Timestamp {
+	if m != nil {
+		return m.FinishedAfter
+	}
+	return nil
+}
+
+func (m *ListCommitRequest) GetFinishedBefore() *types.Timestamp {
+	if m != nil {
+		return m.FinishedBefore
+	}
+	return nil
+}
+
+func (m *ListCommitRequest) GetOrigin() *CommitOrigin {
+	if m != nil {
+		return m.Origin
+	}
+	return nil
+}
+
+func (m *ListCommitRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ListCommitRequest) GetMinSizeBytes() uint64 {
+	if m != nil {
+		return m.MinSizeBytes
+	}
+	return 0
+}
+
+func (m *ListCommitRequest) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
 type CommitInfos struct {
 	CommitInfo           []*CommitInfo `protobuf:"bytes,1,rep,name=commit_info,json=commitInfo,proto3" json:"commit_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -2403,7 +2635,7 @@ func (m *CommitInfos) Reset()         { *m = CommitInfos{} }
 func (m *CommitInfos) String() string { return proto.CompactTextString(m) }
 func (*CommitInfos) ProtoMessage()    {}
 func (*CommitInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{33}
+	return fileDescriptor_b48f014707f6595c, []int{35}
 }
 func (m *CommitInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2444,20 +2676,23 @@ type CreateBranchRequest struct {
 	// s_branch matches the field number and type of SetBranchRequest.Branch in
 	// Pachyderm 1.6--so that operations (generated by pachyderm 1.6's
 	// Admin.Export) can be deserialized by pachyderm 1.7 correctly
-	SBranch              string    `protobuf:"bytes,2,opt,name=s_branch,json=sBranch,proto3" json:"s_branch,omitempty"`
-	Branch               *Branch   `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
-	Provenance           []*Branch `protobuf:"bytes,4,rep,name=provenance,proto3" json:"provenance,omitempty"`
-	Trigger              *Trigger  `protobuf:"bytes,5,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	SBranch    string    `protobuf:"bytes,2,opt,name=s_branch,json=sBranch,proto3" json:"s_branch,omitempty"`
+	Branch     *Branch   `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	Provenance []*Branch `protobuf:"bytes,4,rep,name=provenance,proto3" json:"provenance,omitempty"`
+	Trigger    *Trigger  `protobuf:"bytes,5,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// break_glass moves the branch back past commits in a WORM repo whose
+	// retention hasn't elapsed. Its use is audit-logged.
+	BreakGlass           bool     `protobuf:"varint,6,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateBranchRequest) Reset()         { *m = CreateBranchRequest{} }
 func (m *CreateBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateBranchRequest) ProtoMessage()    {}
 func (*CreateBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{34}
+	return fileDescriptor_b48f014707f6595c, []int{36}
 }
 func (m *CreateBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2521,6 +2756,13 @@ func (m *CreateBranchRequest) GetTrigger() *Trigger {
 	return nil
 }
 
+func (m *CreateBranchRequest) GetBreakGlass() bool {
+	if m != nil {
+		return m.BreakGlass
+	}
+	return false
+}
+
 type InspectBranchRequest struct {
 	Branch               *Branch  `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2532,7 +2774,7 @@ func (m *InspectBranchRequest) Reset()         { *m = InspectBranchRequest{} }
 func (m *InspectBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectBranchRequest) ProtoMessage()    {}
 func (*InspectBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{35}
+	return fileDescriptor_b48f014707f6595c, []int{37}
 }
 func (m *InspectBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2580,7 +2822,7 @@ func (m *ListBranchRequest) Reset()         { *m = ListBranchRequest{} }
 func (m *ListBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*ListBranchRequest) ProtoMessage()    {}
 func (*ListBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{36}
+	return fileDescriptor_b48f014707f6595c, []int{38}
 }
 func (m *ListBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2635,7 +2877,7 @@ func (m *DeleteBranchRequest) Reset()         { *m = DeleteBranchRequest{} }
 func (m *DeleteBranchRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteBranchRequest) ProtoMessage()    {}
 func (*DeleteBranchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{37}
+	return fileDescriptor_b48f014707f6595c, []int{39}
 }
 func (m *DeleteBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2678,25 +2920,28 @@ func (m *DeleteBranchRequest) GetForce() bool {
 	return false
 }
 
-type DeleteCommitRequest struct {
-	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+// RenameBranchRequest renames 'branch' to 'new_name' within its repo. Like
+// RenameRepoRequest, it updates everything that refers to the branch.
+type RenameBranchRequest struct {
+	Branch               *Branch  `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteCommitRequest) Reset()         { *m = DeleteCommitRequest{} }
-func (m *DeleteCommitRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteCommitRequest) ProtoMessage()    {}
-func (*DeleteCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{38}
+func (m *RenameBranchRequest) Reset()         { *m = RenameBranchRequest{} }
+func (m *RenameBranchRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameBranchRequest) ProtoMessage()    {}
+func (*RenameBranchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{40}
 }
-func (m *DeleteCommitRequest) XXX_Unmarshal(b []byte) error {
+func (m *RenameBranchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RenameBranchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteCommitRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RenameBranchRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2706,30 +2951,94 @@ func (m *DeleteCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DeleteCommitRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteCommitRequest.Merge(m, src)
+func (m *RenameBranchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameBranchRequest.Merge(m, src)
 }
-func (m *DeleteCommitRequest) XXX_Size() int {
+func (m *RenameBranchRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteCommitRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteCommitRequest.DiscardUnknown(m)
+func (m *RenameBranchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameBranchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteCommitRequest proto.InternalMessageInfo
+var xxx_messageInfo_RenameBranchRequest proto.InternalMessageInfo
 
-func (m *DeleteCommitRequest) GetCommit() *Commit {
+func (m *RenameBranchRequest) GetBranch() *Branch {
 	if m != nil {
-		return m.Commit
+		return m.Branch
 	}
 	return nil
 }
 
-type FlushCommitRequest struct {
-	Commits              []*Commit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
-	ToRepos              []*Repo   `protobuf:"bytes,2,rep,name=to_repos,json=toRepos,proto3" json:"to_repos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
+func (m *RenameBranchRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+type DeleteCommitRequest struct {
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// break_glass deletes commits in WORM repos whose retention hasn't elapsed.
+	// Its use is audit-logged.
+	BreakGlass           bool     `protobuf:"varint,2,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCommitRequest) Reset()         { *m = DeleteCommitRequest{} }
+func (m *DeleteCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCommitRequest) ProtoMessage()    {}
+func (*DeleteCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{41}
+}
+func (m *DeleteCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCommitRequest.Merge(m, src)
+}
+func (m *DeleteCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCommitRequest proto.InternalMessageInfo
+
+func (m *DeleteCommitRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *DeleteCommitRequest) GetBreakGlass() bool {
+	if m != nil {
+		return m.BreakGlass
+	}
+	return false
+}
+
+type FlushCommitRequest struct {
+	Commits              []*Commit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	ToRepos              []*Repo   `protobuf:"bytes,2,rep,name=to_repos,json=toRepos,proto3" json:"to_repos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
 }
 
@@ -2737,7 +3046,7 @@ func (m *FlushCommitRequest) Reset()         { *m = FlushCommitRequest{} }
 func (m *FlushCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushCommitRequest) ProtoMessage()    {}
 func (*FlushCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{39}
+	return fileDescriptor_b48f014707f6595c, []int{42}
 }
 func (m *FlushCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2797,7 +3106,7 @@ func (m *SubscribeCommitRequest) Reset()         { *m = SubscribeCommitRequest{}
 func (m *SubscribeCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeCommitRequest) ProtoMessage()    {}
 func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{40}
+	return fileDescriptor_b48f014707f6595c, []int{43}
 }
 func (m *SubscribeCommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2874,7 +3183,7 @@ func (m *GetFileRequest) Reset()         { *m = GetFileRequest{} }
 func (m *GetFileRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFileRequest) ProtoMessage()    {}
 func (*GetFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{41}
+	return fileDescriptor_b48f014707f6595c, []int{44}
 }
 func (m *GetFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2939,7 +3248,7 @@ func (m *OverwriteIndex) Reset()         { *m = OverwriteIndex{} }
 func (m *OverwriteIndex) String() string { return proto.CompactTextString(m) }
 func (*OverwriteIndex) ProtoMessage()    {}
 func (*OverwriteIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{42}
+	return fileDescriptor_b48f014707f6595c, []int{45}
 }
 func (m *OverwriteIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3014,7 +3323,17 @@ type PutFileRequest struct {
 	// delete indicates that the file should be deleted, this is redundant with
 	// DeleteFile, but is necessary because it allows you to send file deletes
 	// atomically with other PutFile operations.
-	Delete               bool     `protobuf:"varint,12,opt,name=delete,proto3" json:"delete,omitempty"`
+	Delete bool `protobuf:"varint,12,opt,name=delete,proto3" json:"delete,omitempty"`
+	// mode, if set, sets the POSIX permission bits of the file.
+	Mode uint32 `protobuf:"varint,13,opt,name=mode,proto3" json:"mode,omitempty"`
+	// symlink_target, if set, makes the file a symbolic link to this target
+	// (which must resolve to a path inside the repo) rather than a regular
+	// file. No data may be sent with it.
+	SymlinkTarget string `protobuf:"bytes,14,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	// break_glass deletes or overwrites files in a WORM repo whose retention
+	// hasn't elapsed. It applies to the whole PutFile stream if it's set in any
+	// of its requests. Its use is audit-logged.
+	BreakGlass           bool     `protobuf:"varint,15,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3024,7 +3343,7 @@ func (m *PutFileRequest) Reset()         { *m = PutFileRequest{} }
 func (m *PutFileRequest) String() string { return proto.CompactTextString(m) }
 func (*PutFileRequest) ProtoMessage()    {}
 func (*PutFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{43}
+	return fileDescriptor_b48f014707f6595c, []int{46}
 }
 func (m *PutFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3123,6 +3442,27 @@ func (m *PutFileRequest) GetDelete() bool {
 	return false
 }
 
+func (m *PutFileRequest) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *PutFileRequest) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
+func (m *PutFileRequest) GetBreakGlass() bool {
+	if m != nil {
+		return m.BreakGlass
+	}
+	return false
+}
+
 // PutFileRecord is used to record PutFile requests in etcd temporarily.
 type PutFileRecord struct {
 	SizeBytes            int64           `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
@@ -3138,7 +3478,7 @@ func (m *PutFileRecord) Reset()         { *m = PutFileRecord{} }
 func (m *PutFileRecord) String() string { return proto.CompactTextString(m) }
 func (*PutFileRecord) ProtoMessage()    {}
 func (*PutFileRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{44}
+	return fileDescriptor_b48f014707f6595c, []int{47}
 }
 func (m *PutFileRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3201,6 +3541,8 @@ type PutFileRecords struct {
 	Tombstone            bool             `protobuf:"varint,3,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
 	Header               *PutFileRecord   `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
 	Footer               *PutFileRecord   `protobuf:"bytes,5,opt,name=footer,proto3" json:"footer,omitempty"`
+	Mode                 uint32           `protobuf:"varint,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	SymlinkTarget        string           `protobuf:"bytes,7,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -3210,7 +3552,7 @@ func (m *PutFileRecords) Reset()         { *m = PutFileRecords{} }
 func (m *PutFileRecords) String() string { return proto.CompactTextString(m) }
 func (*PutFileRecords) ProtoMessage()    {}
 func (*PutFileRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{45}
+	return fileDescriptor_b48f014707f6595c, []int{48}
 }
 func (m *PutFileRecords) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3274,27 +3616,45 @@ func (m *PutFileRecords) GetFooter() *PutFileRecord {
 	return nil
 }
 
-type CopyFileRequest struct {
-	Src                  *File    `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
-	Dst                  *File    `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
-	Overwrite            bool     `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PutFileRecords) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
 }
 
-func (m *CopyFileRequest) Reset()         { *m = CopyFileRequest{} }
-func (m *CopyFileRequest) String() string { return proto.CompactTextString(m) }
-func (*CopyFileRequest) ProtoMessage()    {}
-func (*CopyFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{46}
+func (m *PutFileRecords) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
 }
-func (m *CopyFileRequest) XXX_Unmarshal(b []byte) error {
+
+// An upload session lets a large file be uploaded in numbered parts, which
+// may be sent in parallel and retried individually, and then added to a
+// commit once its SHA-256 has been verified.
+type UploadPart struct {
+	Number    int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// records are the objects holding the part's data.
+	Records              []*PutFileRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UploadPart) Reset()         { *m = UploadPart{} }
+func (m *UploadPart) String() string { return proto.CompactTextString(m) }
+func (*UploadPart) ProtoMessage()    {}
+func (*UploadPart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{49}
+}
+func (m *UploadPart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CopyFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UploadPart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CopyFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UploadPart.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3304,58 +3664,73 @@ func (m *CopyFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *CopyFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CopyFileRequest.Merge(m, src)
+func (m *UploadPart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadPart.Merge(m, src)
 }
-func (m *CopyFileRequest) XXX_Size() int {
+func (m *UploadPart) XXX_Size() int {
 	return m.Size()
 }
-func (m *CopyFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CopyFileRequest.DiscardUnknown(m)
+func (m *UploadPart) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadPart.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CopyFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_UploadPart proto.InternalMessageInfo
 
-func (m *CopyFileRequest) GetSrc() *File {
+func (m *UploadPart) GetNumber() int64 {
 	if m != nil {
-		return m.Src
+		return m.Number
 	}
-	return nil
+	return 0
 }
 
-func (m *CopyFileRequest) GetDst() *File {
+func (m *UploadPart) GetSizeBytes() int64 {
 	if m != nil {
-		return m.Dst
+		return m.SizeBytes
 	}
-	return nil
+	return 0
 }
 
-func (m *CopyFileRequest) GetOverwrite() bool {
+func (m *UploadPart) GetRecords() []*PutFileRecord {
 	if m != nil {
-		return m.Overwrite
+		return m.Records
 	}
-	return false
+	return nil
 }
 
-type InspectFileRequest struct {
-	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+type UploadInfo struct {
+	ID   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	File *File  `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	// overwrite, if set, replaces the file's existing content when the upload
+	// completes rather than appending to it.
+	Overwrite bool             `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	Started   *types.Timestamp `protobuf:"bytes,4,opt,name=started,proto3" json:"started,omitempty"`
+	// parts are the parts received so far, ordered by number.
+	Parts []*UploadPart `protobuf:"bytes,5,rep,name=parts,proto3" json:"parts,omitempty"`
+	// updated is when the session last received a part (or started). Sessions
+	// expire once they haven't been updated for pachd's upload TTL, after which
+	// garbage collection removes the data of their parts.
+	Updated *types.Timestamp `protobuf:"bytes,6,opt,name=updated,proto3" json:"updated,omitempty"`
+	// completed is set once the upload's data has been added to its file.
+	// Completed sessions are kept until they expire, so that completing them
+	// again does nothing.
+	Completed            bool     `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InspectFileRequest) Reset()         { *m = InspectFileRequest{} }
-func (m *InspectFileRequest) String() string { return proto.CompactTextString(m) }
-func (*InspectFileRequest) ProtoMessage()    {}
-func (*InspectFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{47}
+func (m *UploadInfo) Reset()         { *m = UploadInfo{} }
+func (m *UploadInfo) String() string { return proto.CompactTextString(m) }
+func (*UploadInfo) ProtoMessage()    {}
+func (*UploadInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{50}
 }
-func (m *InspectFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *UploadInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InspectFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UploadInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InspectFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UploadInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3365,64 +3740,87 @@ func (m *InspectFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *InspectFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InspectFileRequest.Merge(m, src)
+func (m *UploadInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadInfo.Merge(m, src)
 }
-func (m *InspectFileRequest) XXX_Size() int {
+func (m *UploadInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *InspectFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InspectFileRequest.DiscardUnknown(m)
+func (m *UploadInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InspectFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_UploadInfo proto.InternalMessageInfo
 
-func (m *InspectFileRequest) GetFile() *File {
+func (m *UploadInfo) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *UploadInfo) GetFile() *File {
 	if m != nil {
 		return m.File
 	}
 	return nil
 }
 
-type ListFileRequest struct {
-	// File is the parent directory of the files we want to list. This sets the
-	// repo, the commit/branch, and path prefix of files we're interested in
-	// If the "path" field is omitted, a list of files at the top level of the repo
-	// is returned
-	File *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	// Full indicates whether the result should include file contents, which may
-	// be large (i.e. the list of children for directories, and the list of object
-	// references for regular files)
-	Full bool `protobuf:"varint,2,opt,name=full,proto3" json:"full,omitempty"`
-	// History indicates how many historical versions you want returned. Its
-	// semantics are:
-	// 0: Return the files as they are at the commit in `file`. FileInfo.File
-	//    will equal File in this request.
-	// 1: Return the files as they are in the last commit they were modified in.
-	//    (This will have the same hash as if you'd passed 0, but
-	//    FileInfo.File.Commit will be different.
-	// 2: Return the above and the files as they are in the next-last commit they
-	//    were modified in.
-	// 3: etc.
-	//-1: Return all historical versions.
-	History              int64    `protobuf:"varint,3,opt,name=history,proto3" json:"history,omitempty"`
+func (m *UploadInfo) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
+
+func (m *UploadInfo) GetStarted() *types.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *UploadInfo) GetParts() []*UploadPart {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+func (m *UploadInfo) GetUpdated() *types.Timestamp {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
+func (m *UploadInfo) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+type BeginUploadRequest struct {
+	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Overwrite            bool     `protobuf:"varint,2,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListFileRequest) Reset()         { *m = ListFileRequest{} }
-func (m *ListFileRequest) String() string { return proto.CompactTextString(m) }
-func (*ListFileRequest) ProtoMessage()    {}
-func (*ListFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{48}
+func (m *BeginUploadRequest) Reset()         { *m = BeginUploadRequest{} }
+func (m *BeginUploadRequest) String() string { return proto.CompactTextString(m) }
+func (*BeginUploadRequest) ProtoMessage()    {}
+func (*BeginUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{51}
 }
-func (m *ListFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *BeginUploadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BeginUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BeginUploadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3432,58 +3830,54 @@ func (m *ListFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ListFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListFileRequest.Merge(m, src)
+func (m *BeginUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginUploadRequest.Merge(m, src)
 }
-func (m *ListFileRequest) XXX_Size() int {
+func (m *BeginUploadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListFileRequest.DiscardUnknown(m)
+func (m *BeginUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginUploadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_BeginUploadRequest proto.InternalMessageInfo
 
-func (m *ListFileRequest) GetFile() *File {
+func (m *BeginUploadRequest) GetFile() *File {
 	if m != nil {
 		return m.File
 	}
 	return nil
 }
 
-func (m *ListFileRequest) GetFull() bool {
+func (m *BeginUploadRequest) GetOverwrite() bool {
 	if m != nil {
-		return m.Full
+		return m.Overwrite
 	}
 	return false
 }
 
-func (m *ListFileRequest) GetHistory() int64 {
-	if m != nil {
-		return m.History
-	}
-	return 0
-}
-
-type WalkFileRequest struct {
-	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+type PutUploadPartRequest struct {
+	// id and number only need to be set in the first message of the stream.
+	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Number               int64    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Value                []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WalkFileRequest) Reset()         { *m = WalkFileRequest{} }
-func (m *WalkFileRequest) String() string { return proto.CompactTextString(m) }
-func (*WalkFileRequest) ProtoMessage()    {}
-func (*WalkFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{49}
+func (m *PutUploadPartRequest) Reset()         { *m = PutUploadPartRequest{} }
+func (m *PutUploadPartRequest) String() string { return proto.CompactTextString(m) }
+func (*PutUploadPartRequest) ProtoMessage()    {}
+func (*PutUploadPartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{52}
 }
-func (m *WalkFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *PutUploadPartRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WalkFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutUploadPartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WalkFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutUploadPartRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3493,100 +3887,58 @@ func (m *WalkFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *WalkFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalkFileRequest.Merge(m, src)
+func (m *PutUploadPartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutUploadPartRequest.Merge(m, src)
 }
-func (m *WalkFileRequest) XXX_Size() int {
+func (m *PutUploadPartRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *WalkFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalkFileRequest.DiscardUnknown(m)
+func (m *PutUploadPartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutUploadPartRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WalkFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_PutUploadPartRequest proto.InternalMessageInfo
 
-func (m *WalkFileRequest) GetFile() *File {
+func (m *PutUploadPartRequest) GetID() string {
 	if m != nil {
-		return m.File
-	}
-	return nil
-}
-
-type GlobFileRequest struct {
-	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
-	Pattern              string   `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GlobFileRequest) Reset()         { *m = GlobFileRequest{} }
-func (m *GlobFileRequest) String() string { return proto.CompactTextString(m) }
-func (*GlobFileRequest) ProtoMessage()    {}
-func (*GlobFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{50}
-}
-func (m *GlobFileRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GlobFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GlobFileRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+		return m.ID
 	}
+	return ""
 }
-func (m *GlobFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GlobFileRequest.Merge(m, src)
-}
-func (m *GlobFileRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *GlobFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GlobFileRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GlobFileRequest proto.InternalMessageInfo
 
-func (m *GlobFileRequest) GetCommit() *Commit {
+func (m *PutUploadPartRequest) GetNumber() int64 {
 	if m != nil {
-		return m.Commit
+		return m.Number
 	}
-	return nil
+	return 0
 }
 
-func (m *GlobFileRequest) GetPattern() string {
+func (m *PutUploadPartRequest) GetValue() []byte {
 	if m != nil {
-		return m.Pattern
+		return m.Value
 	}
-	return ""
+	return nil
 }
 
-// FileInfos is the result of both ListFile and GlobFile
-type FileInfos struct {
-	FileInfo             []*FileInfo `protobuf:"bytes,1,rep,name=file_info,json=fileInfo,proto3" json:"file_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type InspectUploadRequest struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FileInfos) Reset()         { *m = FileInfos{} }
-func (m *FileInfos) String() string { return proto.CompactTextString(m) }
-func (*FileInfos) ProtoMessage()    {}
-func (*FileInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{51}
+func (m *InspectUploadRequest) Reset()         { *m = InspectUploadRequest{} }
+func (m *InspectUploadRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectUploadRequest) ProtoMessage()    {}
+func (*InspectUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{53}
 }
-func (m *FileInfos) XXX_Unmarshal(b []byte) error {
+func (m *InspectUploadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FileInfos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InspectUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FileInfos.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InspectUploadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3596,48 +3948,50 @@ func (m *FileInfos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *FileInfos) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FileInfos.Merge(m, src)
+func (m *InspectUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectUploadRequest.Merge(m, src)
 }
-func (m *FileInfos) XXX_Size() int {
+func (m *InspectUploadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *FileInfos) XXX_DiscardUnknown() {
-	xxx_messageInfo_FileInfos.DiscardUnknown(m)
+func (m *InspectUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectUploadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FileInfos proto.InternalMessageInfo
+var xxx_messageInfo_InspectUploadRequest proto.InternalMessageInfo
 
-func (m *FileInfos) GetFileInfo() []*FileInfo {
+func (m *InspectUploadRequest) GetID() string {
 	if m != nil {
-		return m.FileInfo
+		return m.ID
 	}
-	return nil
+	return ""
 }
 
-type DiffFileRequest struct {
-	NewFile *File `protobuf:"bytes,1,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
-	// OldFile may be left nil in which case the same path in the parent of
-	// NewFile's commit will be used.
-	OldFile              *File    `protobuf:"bytes,2,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
-	Shallow              bool     `protobuf:"varint,3,opt,name=shallow,proto3" json:"shallow,omitempty"`
+type CompleteUploadRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sha256 is the hex-encoded SHA-256 of the whole file, i.e. of its parts
+	// concatenated in order, which must be numbered from 0 with no gaps.
+	Sha256 string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// break_glass overwrites files in a WORM repo whose retention hasn't
+	// elapsed. Its use is audit-logged.
+	BreakGlass           bool     `protobuf:"varint,3,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DiffFileRequest) Reset()         { *m = DiffFileRequest{} }
-func (m *DiffFileRequest) String() string { return proto.CompactTextString(m) }
-func (*DiffFileRequest) ProtoMessage()    {}
-func (*DiffFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{52}
+func (m *CompleteUploadRequest) Reset()         { *m = CompleteUploadRequest{} }
+func (m *CompleteUploadRequest) String() string { return proto.CompactTextString(m) }
+func (*CompleteUploadRequest) ProtoMessage()    {}
+func (*CompleteUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{54}
 }
-func (m *DiffFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *CompleteUploadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DiffFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CompleteUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DiffFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CompleteUploadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3647,59 +4001,63 @@ func (m *DiffFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *DiffFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DiffFileRequest.Merge(m, src)
+func (m *CompleteUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteUploadRequest.Merge(m, src)
 }
-func (m *DiffFileRequest) XXX_Size() int {
+func (m *CompleteUploadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DiffFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DiffFileRequest.DiscardUnknown(m)
+func (m *CompleteUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteUploadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DiffFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_CompleteUploadRequest proto.InternalMessageInfo
 
-func (m *DiffFileRequest) GetNewFile() *File {
+func (m *CompleteUploadRequest) GetID() string {
 	if m != nil {
-		return m.NewFile
+		return m.ID
 	}
-	return nil
+	return ""
 }
 
-func (m *DiffFileRequest) GetOldFile() *File {
+func (m *CompleteUploadRequest) GetSha256() string {
 	if m != nil {
-		return m.OldFile
+		return m.Sha256
 	}
-	return nil
+	return ""
 }
 
-func (m *DiffFileRequest) GetShallow() bool {
+func (m *CompleteUploadRequest) GetBreakGlass() bool {
 	if m != nil {
-		return m.Shallow
+		return m.BreakGlass
 	}
 	return false
 }
 
-type DiffFileResponse struct {
-	NewFiles             []*FileInfo `protobuf:"bytes,1,rep,name=new_files,json=newFiles,proto3" json:"new_files,omitempty"`
-	OldFiles             []*FileInfo `protobuf:"bytes,2,rep,name=old_files,json=oldFiles,proto3" json:"old_files,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type CopyFileRequest struct {
+	Src       *File `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dst       *File `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
+	Overwrite bool  `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	// break_glass overwrites files in a WORM repo whose retention hasn't
+	// elapsed. Its use is audit-logged.
+	BreakGlass           bool     `protobuf:"varint,4,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DiffFileResponse) Reset()         { *m = DiffFileResponse{} }
-func (m *DiffFileResponse) String() string { return proto.CompactTextString(m) }
-func (*DiffFileResponse) ProtoMessage()    {}
-func (*DiffFileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{53}
+func (m *CopyFileRequest) Reset()         { *m = CopyFileRequest{} }
+func (m *CopyFileRequest) String() string { return proto.CompactTextString(m) }
+func (*CopyFileRequest) ProtoMessage()    {}
+func (*CopyFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{55}
 }
-func (m *DiffFileResponse) XXX_Unmarshal(b []byte) error {
+func (m *CopyFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DiffFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CopyFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DiffFileResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CopyFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3709,51 +4067,65 @@ func (m *DiffFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DiffFileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DiffFileResponse.Merge(m, src)
+func (m *CopyFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyFileRequest.Merge(m, src)
 }
-func (m *DiffFileResponse) XXX_Size() int {
+func (m *CopyFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DiffFileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DiffFileResponse.DiscardUnknown(m)
+func (m *CopyFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DiffFileResponse proto.InternalMessageInfo
+var xxx_messageInfo_CopyFileRequest proto.InternalMessageInfo
 
-func (m *DiffFileResponse) GetNewFiles() []*FileInfo {
+func (m *CopyFileRequest) GetSrc() *File {
 	if m != nil {
-		return m.NewFiles
+		return m.Src
 	}
 	return nil
 }
 
-func (m *DiffFileResponse) GetOldFiles() []*FileInfo {
+func (m *CopyFileRequest) GetDst() *File {
 	if m != nil {
-		return m.OldFiles
+		return m.Dst
 	}
 	return nil
 }
 
-type DeleteFileRequest struct {
+func (m *CopyFileRequest) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
+
+func (m *CopyFileRequest) GetBreakGlass() bool {
+	if m != nil {
+		return m.BreakGlass
+	}
+	return false
+}
+
+type InspectFileRequest struct {
 	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteFileRequest) Reset()         { *m = DeleteFileRequest{} }
-func (m *DeleteFileRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteFileRequest) ProtoMessage()    {}
-func (*DeleteFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{54}
+func (m *InspectFileRequest) Reset()         { *m = InspectFileRequest{} }
+func (m *InspectFileRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectFileRequest) ProtoMessage()    {}
+func (*InspectFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{56}
 }
-func (m *DeleteFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *InspectFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InspectFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InspectFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3763,44 +4135,64 @@ func (m *DeleteFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DeleteFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteFileRequest.Merge(m, src)
+func (m *InspectFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectFileRequest.Merge(m, src)
 }
-func (m *DeleteFileRequest) XXX_Size() int {
+func (m *InspectFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteFileRequest.DiscardUnknown(m)
+func (m *InspectFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_InspectFileRequest proto.InternalMessageInfo
 
-func (m *DeleteFileRequest) GetFile() *File {
+func (m *InspectFileRequest) GetFile() *File {
 	if m != nil {
 		return m.File
 	}
 	return nil
 }
 
-type FsckRequest struct {
-	Fix                  bool     `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
+type ListFileRequest struct {
+	// File is the parent directory of the files we want to list. This sets the
+	// repo, the commit/branch, and path prefix of files we're interested in
+	// If the "path" field is omitted, a list of files at the top level of the repo
+	// is returned
+	File *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Full indicates whether the result should include file contents, which may
+	// be large (i.e. the list of children for directories, and the list of object
+	// references for regular files)
+	Full bool `protobuf:"varint,2,opt,name=full,proto3" json:"full,omitempty"`
+	// History indicates how many historical versions you want returned. Its
+	// semantics are:
+	// 0: Return the files as they are at the commit in `file`. FileInfo.File
+	//    will equal File in this request.
+	// 1: Return the files as they are in the last commit they were modified in.
+	//    (This will have the same hash as if you'd passed 0, but
+	//    FileInfo.File.Commit will be different.
+	// 2: Return the above and the files as they are in the next-last commit they
+	//    were modified in.
+	// 3: etc.
+	//-1: Return all historical versions.
+	History              int64    `protobuf:"varint,3,opt,name=history,proto3" json:"history,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FsckRequest) Reset()         { *m = FsckRequest{} }
-func (m *FsckRequest) String() string { return proto.CompactTextString(m) }
-func (*FsckRequest) ProtoMessage()    {}
-func (*FsckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{55}
+func (m *ListFileRequest) Reset()         { *m = ListFileRequest{} }
+func (m *ListFileRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFileRequest) ProtoMessage()    {}
+func (*ListFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{57}
 }
-func (m *FsckRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FsckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FsckRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3810,45 +4202,58 @@ func (m *FsckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *FsckRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FsckRequest.Merge(m, src)
+func (m *ListFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFileRequest.Merge(m, src)
 }
-func (m *FsckRequest) XXX_Size() int {
+func (m *ListFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *FsckRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FsckRequest.DiscardUnknown(m)
+func (m *ListFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FsckRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListFileRequest proto.InternalMessageInfo
 
-func (m *FsckRequest) GetFix() bool {
+func (m *ListFileRequest) GetFile() *File {
 	if m != nil {
-		return m.Fix
+		return m.File
+	}
+	return nil
+}
+
+func (m *ListFileRequest) GetFull() bool {
+	if m != nil {
+		return m.Full
 	}
 	return false
 }
 
-type FsckResponse struct {
-	Fix                  string   `protobuf:"bytes,1,opt,name=fix,proto3" json:"fix,omitempty"`
-	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+func (m *ListFileRequest) GetHistory() int64 {
+	if m != nil {
+		return m.History
+	}
+	return 0
+}
+
+type WalkFileRequest struct {
+	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FsckResponse) Reset()         { *m = FsckResponse{} }
-func (m *FsckResponse) String() string { return proto.CompactTextString(m) }
-func (*FsckResponse) ProtoMessage()    {}
-func (*FsckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{56}
+func (m *WalkFileRequest) Reset()         { *m = WalkFileRequest{} }
+func (m *WalkFileRequest) String() string { return proto.CompactTextString(m) }
+func (*WalkFileRequest) ProtoMessage()    {}
+func (*WalkFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{58}
 }
-func (m *FsckResponse) XXX_Unmarshal(b []byte) error {
+func (m *WalkFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FsckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WalkFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FsckResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WalkFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3858,55 +4263,45 @@ func (m *FsckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *FsckResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FsckResponse.Merge(m, src)
+func (m *WalkFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalkFileRequest.Merge(m, src)
 }
-func (m *FsckResponse) XXX_Size() int {
+func (m *WalkFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *FsckResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FsckResponse.DiscardUnknown(m)
+func (m *WalkFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalkFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FsckResponse proto.InternalMessageInfo
-
-func (m *FsckResponse) GetFix() string {
-	if m != nil {
-		return m.Fix
-	}
-	return ""
-}
+var xxx_messageInfo_WalkFileRequest proto.InternalMessageInfo
 
-func (m *FsckResponse) GetError() string {
+func (m *WalkFileRequest) GetFile() *File {
 	if m != nil {
-		return m.Error
+		return m.File
 	}
-	return ""
+	return nil
 }
 
-type FileOperationRequestV2 struct {
-	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
-	// Types that are valid to be assigned to Operation:
-	//	*FileOperationRequestV2_PutTar
-	//	*FileOperationRequestV2_DeleteFiles
-	Operation            isFileOperationRequestV2_Operation `protobuf_oneof:"operation"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
-	XXX_unrecognized     []byte                             `json:"-"`
-	XXX_sizecache        int32                              `json:"-"`
+type GlobFileRequest struct {
+	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Pattern              string   `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FileOperationRequestV2) Reset()         { *m = FileOperationRequestV2{} }
-func (m *FileOperationRequestV2) String() string { return proto.CompactTextString(m) }
-func (*FileOperationRequestV2) ProtoMessage()    {}
-func (*FileOperationRequestV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{57}
+func (m *GlobFileRequest) Reset()         { *m = GlobFileRequest{} }
+func (m *GlobFileRequest) String() string { return proto.CompactTextString(m) }
+func (*GlobFileRequest) ProtoMessage()    {}
+func (*GlobFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{59}
 }
-func (m *FileOperationRequestV2) XXX_Unmarshal(b []byte) error {
+func (m *GlobFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FileOperationRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GlobFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FileOperationRequestV2.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GlobFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3916,91 +4311,52 @@ func (m *FileOperationRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *FileOperationRequestV2) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FileOperationRequestV2.Merge(m, src)
+func (m *GlobFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobFileRequest.Merge(m, src)
 }
-func (m *FileOperationRequestV2) XXX_Size() int {
+func (m *GlobFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *FileOperationRequestV2) XXX_DiscardUnknown() {
-	xxx_messageInfo_FileOperationRequestV2.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_FileOperationRequestV2 proto.InternalMessageInfo
-
-type isFileOperationRequestV2_Operation interface {
-	isFileOperationRequestV2_Operation()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type FileOperationRequestV2_PutTar struct {
-	PutTar *PutTarRequestV2 `protobuf:"bytes,2,opt,name=put_tar,json=putTar,proto3,oneof" json:"put_tar,omitempty"`
-}
-type FileOperationRequestV2_DeleteFiles struct {
-	DeleteFiles *DeleteFilesRequestV2 `protobuf:"bytes,3,opt,name=delete_files,json=deleteFiles,proto3,oneof" json:"delete_files,omitempty"`
+func (m *GlobFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobFileRequest.DiscardUnknown(m)
 }
 
-func (*FileOperationRequestV2_PutTar) isFileOperationRequestV2_Operation()      {}
-func (*FileOperationRequestV2_DeleteFiles) isFileOperationRequestV2_Operation() {}
-
-func (m *FileOperationRequestV2) GetOperation() isFileOperationRequestV2_Operation {
-	if m != nil {
-		return m.Operation
-	}
-	return nil
-}
+var xxx_messageInfo_GlobFileRequest proto.InternalMessageInfo
 
-func (m *FileOperationRequestV2) GetCommit() *Commit {
+func (m *GlobFileRequest) GetCommit() *Commit {
 	if m != nil {
 		return m.Commit
 	}
 	return nil
 }
 
-func (m *FileOperationRequestV2) GetPutTar() *PutTarRequestV2 {
-	if x, ok := m.GetOperation().(*FileOperationRequestV2_PutTar); ok {
-		return x.PutTar
-	}
-	return nil
-}
-
-func (m *FileOperationRequestV2) GetDeleteFiles() *DeleteFilesRequestV2 {
-	if x, ok := m.GetOperation().(*FileOperationRequestV2_DeleteFiles); ok {
-		return x.DeleteFiles
-	}
-	return nil
-}
-
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*FileOperationRequestV2) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*FileOperationRequestV2_PutTar)(nil),
-		(*FileOperationRequestV2_DeleteFiles)(nil),
+func (m *GlobFileRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
 	}
+	return ""
 }
 
-type PutTarRequestV2 struct {
-	Overwrite            bool     `protobuf:"varint,1,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
-	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// FileInfos is the result of both ListFile and GlobFile
+type FileInfos struct {
+	FileInfo             []*FileInfo `protobuf:"bytes,1,rep,name=file_info,json=fileInfo,proto3" json:"file_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *PutTarRequestV2) Reset()         { *m = PutTarRequestV2{} }
-func (m *PutTarRequestV2) String() string { return proto.CompactTextString(m) }
-func (*PutTarRequestV2) ProtoMessage()    {}
-func (*PutTarRequestV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{58}
+func (m *FileInfos) Reset()         { *m = FileInfos{} }
+func (m *FileInfos) String() string { return proto.CompactTextString(m) }
+func (*FileInfos) ProtoMessage()    {}
+func (*FileInfos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{60}
 }
-func (m *PutTarRequestV2) XXX_Unmarshal(b []byte) error {
+func (m *FileInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PutTarRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FileInfos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PutTarRequestV2.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FileInfos.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4010,59 +4366,48 @@ func (m *PutTarRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *PutTarRequestV2) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutTarRequestV2.Merge(m, src)
+func (m *FileInfos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileInfos.Merge(m, src)
 }
-func (m *PutTarRequestV2) XXX_Size() int {
+func (m *FileInfos) XXX_Size() int {
 	return m.Size()
 }
-func (m *PutTarRequestV2) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutTarRequestV2.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PutTarRequestV2 proto.InternalMessageInfo
-
-func (m *PutTarRequestV2) GetOverwrite() bool {
-	if m != nil {
-		return m.Overwrite
-	}
-	return false
+func (m *FileInfos) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileInfos.DiscardUnknown(m)
 }
 
-func (m *PutTarRequestV2) GetTag() string {
-	if m != nil {
-		return m.Tag
-	}
-	return ""
-}
+var xxx_messageInfo_FileInfos proto.InternalMessageInfo
 
-func (m *PutTarRequestV2) GetData() []byte {
+func (m *FileInfos) GetFileInfo() []*FileInfo {
 	if m != nil {
-		return m.Data
+		return m.FileInfo
 	}
 	return nil
 }
 
-type DeleteFilesRequestV2 struct {
-	Files                []string `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
-	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+type DiffFileRequest struct {
+	NewFile *File `protobuf:"bytes,1,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
+	// OldFile may be left nil in which case the same path in the parent of
+	// NewFile's commit will be used.
+	OldFile              *File    `protobuf:"bytes,2,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
+	Shallow              bool     `protobuf:"varint,3,opt,name=shallow,proto3" json:"shallow,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteFilesRequestV2) Reset()         { *m = DeleteFilesRequestV2{} }
-func (m *DeleteFilesRequestV2) String() string { return proto.CompactTextString(m) }
-func (*DeleteFilesRequestV2) ProtoMessage()    {}
-func (*DeleteFilesRequestV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{59}
+func (m *DiffFileRequest) Reset()         { *m = DiffFileRequest{} }
+func (m *DiffFileRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffFileRequest) ProtoMessage()    {}
+func (*DiffFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{61}
 }
-func (m *DeleteFilesRequestV2) XXX_Unmarshal(b []byte) error {
+func (m *DiffFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteFilesRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DiffFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteFilesRequestV2.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DiffFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4072,51 +4417,59 @@ func (m *DeleteFilesRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *DeleteFilesRequestV2) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteFilesRequestV2.Merge(m, src)
+func (m *DiffFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffFileRequest.Merge(m, src)
 }
-func (m *DeleteFilesRequestV2) XXX_Size() int {
+func (m *DiffFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteFilesRequestV2) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteFilesRequestV2.DiscardUnknown(m)
+func (m *DiffFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteFilesRequestV2 proto.InternalMessageInfo
+var xxx_messageInfo_DiffFileRequest proto.InternalMessageInfo
 
-func (m *DeleteFilesRequestV2) GetFiles() []string {
+func (m *DiffFileRequest) GetNewFile() *File {
 	if m != nil {
-		return m.Files
+		return m.NewFile
 	}
 	return nil
 }
 
-func (m *DeleteFilesRequestV2) GetTag() string {
+func (m *DiffFileRequest) GetOldFile() *File {
 	if m != nil {
-		return m.Tag
+		return m.OldFile
 	}
-	return ""
+	return nil
 }
 
-type GetTarRequestV2 struct {
-	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DiffFileRequest) GetShallow() bool {
+	if m != nil {
+		return m.Shallow
+	}
+	return false
 }
 
-func (m *GetTarRequestV2) Reset()         { *m = GetTarRequestV2{} }
-func (m *GetTarRequestV2) String() string { return proto.CompactTextString(m) }
-func (*GetTarRequestV2) ProtoMessage()    {}
-func (*GetTarRequestV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{60}
+type DiffFileResponse struct {
+	NewFiles             []*FileInfo `protobuf:"bytes,1,rep,name=new_files,json=newFiles,proto3" json:"new_files,omitempty"`
+	OldFiles             []*FileInfo `protobuf:"bytes,2,rep,name=old_files,json=oldFiles,proto3" json:"old_files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (m *GetTarRequestV2) XXX_Unmarshal(b []byte) error {
+
+func (m *DiffFileResponse) Reset()         { *m = DiffFileResponse{} }
+func (m *DiffFileResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffFileResponse) ProtoMessage()    {}
+func (*DiffFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{62}
+}
+func (m *DiffFileResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTarRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DiffFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTarRequestV2.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DiffFileResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4126,45 +4479,54 @@ func (m *GetTarRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *GetTarRequestV2) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTarRequestV2.Merge(m, src)
+func (m *DiffFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffFileResponse.Merge(m, src)
 }
-func (m *GetTarRequestV2) XXX_Size() int {
+func (m *DiffFileResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTarRequestV2) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTarRequestV2.DiscardUnknown(m)
+func (m *DiffFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffFileResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTarRequestV2 proto.InternalMessageInfo
+var xxx_messageInfo_DiffFileResponse proto.InternalMessageInfo
 
-func (m *GetTarRequestV2) GetFile() *File {
+func (m *DiffFileResponse) GetNewFiles() []*FileInfo {
 	if m != nil {
-		return m.File
+		return m.NewFiles
 	}
 	return nil
 }
 
-type DiffFileResponseV2 struct {
-	OldFile              *FileInfo `protobuf:"bytes,1,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
-	NewFile              *FileInfo `protobuf:"bytes,2,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *DiffFileResponse) GetOldFiles() []*FileInfo {
+	if m != nil {
+		return m.OldFiles
+	}
+	return nil
 }
 
-func (m *DiffFileResponseV2) Reset()         { *m = DiffFileResponseV2{} }
-func (m *DiffFileResponseV2) String() string { return proto.CompactTextString(m) }
-func (*DiffFileResponseV2) ProtoMessage()    {}
-func (*DiffFileResponseV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{61}
+type DeleteFileRequest struct {
+	File *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// break_glass deletes files in a WORM repo whose retention hasn't elapsed.
+	// Its use is audit-logged.
+	BreakGlass           bool     `protobuf:"varint,2,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *DiffFileResponseV2) XXX_Unmarshal(b []byte) error {
+
+func (m *DeleteFileRequest) Reset()         { *m = DeleteFileRequest{} }
+func (m *DeleteFileRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteFileRequest) ProtoMessage()    {}
+func (*DeleteFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{63}
+}
+func (m *DeleteFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DiffFileResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DiffFileResponseV2.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4174,51 +4536,51 @@ func (m *DiffFileResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DiffFileResponseV2) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DiffFileResponseV2.Merge(m, src)
+func (m *DeleteFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteFileRequest.Merge(m, src)
 }
-func (m *DiffFileResponseV2) XXX_Size() int {
+func (m *DeleteFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DiffFileResponseV2) XXX_DiscardUnknown() {
-	xxx_messageInfo_DiffFileResponseV2.DiscardUnknown(m)
+func (m *DeleteFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DiffFileResponseV2 proto.InternalMessageInfo
+var xxx_messageInfo_DeleteFileRequest proto.InternalMessageInfo
 
-func (m *DiffFileResponseV2) GetOldFile() *FileInfo {
+func (m *DeleteFileRequest) GetFile() *File {
 	if m != nil {
-		return m.OldFile
+		return m.File
 	}
 	return nil
 }
 
-func (m *DiffFileResponseV2) GetNewFile() *FileInfo {
+func (m *DeleteFileRequest) GetBreakGlass() bool {
 	if m != nil {
-		return m.NewFile
+		return m.BreakGlass
 	}
-	return nil
+	return false
 }
 
-type CreateTmpFileSetResponse struct {
-	FilesetId            string   `protobuf:"bytes,1,opt,name=fileset_id,json=filesetId,proto3" json:"fileset_id,omitempty"`
+type FsckRequest struct {
+	Fix                  bool     `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateTmpFileSetResponse) Reset()         { *m = CreateTmpFileSetResponse{} }
-func (m *CreateTmpFileSetResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateTmpFileSetResponse) ProtoMessage()    {}
-func (*CreateTmpFileSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{62}
+func (m *FsckRequest) Reset()         { *m = FsckRequest{} }
+func (m *FsckRequest) String() string { return proto.CompactTextString(m) }
+func (*FsckRequest) ProtoMessage()    {}
+func (*FsckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{64}
 }
-func (m *CreateTmpFileSetResponse) XXX_Unmarshal(b []byte) error {
+func (m *FsckRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateTmpFileSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FsckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateTmpFileSetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FsckRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4228,45 +4590,45 @@ func (m *CreateTmpFileSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *CreateTmpFileSetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTmpFileSetResponse.Merge(m, src)
+func (m *FsckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FsckRequest.Merge(m, src)
 }
-func (m *CreateTmpFileSetResponse) XXX_Size() int {
+func (m *FsckRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateTmpFileSetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTmpFileSetResponse.DiscardUnknown(m)
+func (m *FsckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FsckRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTmpFileSetResponse proto.InternalMessageInfo
+var xxx_messageInfo_FsckRequest proto.InternalMessageInfo
 
-func (m *CreateTmpFileSetResponse) GetFilesetId() string {
+func (m *FsckRequest) GetFix() bool {
 	if m != nil {
-		return m.FilesetId
+		return m.Fix
 	}
-	return ""
+	return false
 }
 
-type RenewTmpFileSetRequest struct {
-	FilesetId            string   `protobuf:"bytes,1,opt,name=fileset_id,json=filesetId,proto3" json:"fileset_id,omitempty"`
-	TtlSeconds           int64    `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+type FsckResponse struct {
+	Fix                  string   `protobuf:"bytes,1,opt,name=fix,proto3" json:"fix,omitempty"`
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RenewTmpFileSetRequest) Reset()         { *m = RenewTmpFileSetRequest{} }
-func (m *RenewTmpFileSetRequest) String() string { return proto.CompactTextString(m) }
-func (*RenewTmpFileSetRequest) ProtoMessage()    {}
-func (*RenewTmpFileSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{63}
+func (m *FsckResponse) Reset()         { *m = FsckResponse{} }
+func (m *FsckResponse) String() string { return proto.CompactTextString(m) }
+func (*FsckResponse) ProtoMessage()    {}
+func (*FsckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{65}
 }
-func (m *RenewTmpFileSetRequest) XXX_Unmarshal(b []byte) error {
+func (m *FsckResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RenewTmpFileSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FsckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RenewTmpFileSetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FsckResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4276,51 +4638,58 @@ func (m *RenewTmpFileSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *RenewTmpFileSetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RenewTmpFileSetRequest.Merge(m, src)
+func (m *FsckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FsckResponse.Merge(m, src)
 }
-func (m *RenewTmpFileSetRequest) XXX_Size() int {
+func (m *FsckResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RenewTmpFileSetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RenewTmpFileSetRequest.DiscardUnknown(m)
+func (m *FsckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FsckResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RenewTmpFileSetRequest proto.InternalMessageInfo
+var xxx_messageInfo_FsckResponse proto.InternalMessageInfo
 
-func (m *RenewTmpFileSetRequest) GetFilesetId() string {
+func (m *FsckResponse) GetFix() string {
 	if m != nil {
-		return m.FilesetId
+		return m.Fix
 	}
 	return ""
 }
 
-func (m *RenewTmpFileSetRequest) GetTtlSeconds() int64 {
+func (m *FsckResponse) GetError() string {
 	if m != nil {
-		return m.TtlSeconds
+		return m.Error
 	}
-	return 0
+	return ""
 }
 
-type ClearCommitRequestV2 struct {
-	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+type FileOperationRequestV2 struct {
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Types that are valid to be assigned to Operation:
+	//	*FileOperationRequestV2_PutTar
+	//	*FileOperationRequestV2_DeleteFiles
+	Operation isFileOperationRequestV2_Operation `protobuf_oneof:"operation"`
+	// break_glass deletes or overwrites files in a WORM repo whose retention
+	// hasn't elapsed. Its use is audit-logged.
+	BreakGlass           bool     `protobuf:"varint,4,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ClearCommitRequestV2) Reset()         { *m = ClearCommitRequestV2{} }
-func (m *ClearCommitRequestV2) String() string { return proto.CompactTextString(m) }
-func (*ClearCommitRequestV2) ProtoMessage()    {}
-func (*ClearCommitRequestV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{64}
+func (m *FileOperationRequestV2) Reset()         { *m = FileOperationRequestV2{} }
+func (m *FileOperationRequestV2) String() string { return proto.CompactTextString(m) }
+func (*FileOperationRequestV2) ProtoMessage()    {}
+func (*FileOperationRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{66}
 }
-func (m *ClearCommitRequestV2) XXX_Unmarshal(b []byte) error {
+func (m *FileOperationRequestV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClearCommitRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FileOperationRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClearCommitRequestV2.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FileOperationRequestV2.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4330,46 +4699,98 @@ func (m *ClearCommitRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ClearCommitRequestV2) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClearCommitRequestV2.Merge(m, src)
+func (m *FileOperationRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileOperationRequestV2.Merge(m, src)
 }
-func (m *ClearCommitRequestV2) XXX_Size() int {
+func (m *FileOperationRequestV2) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClearCommitRequestV2) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClearCommitRequestV2.DiscardUnknown(m)
+func (m *FileOperationRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileOperationRequestV2.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClearCommitRequestV2 proto.InternalMessageInfo
+var xxx_messageInfo_FileOperationRequestV2 proto.InternalMessageInfo
 
-func (m *ClearCommitRequestV2) GetCommit() *Commit {
+type isFileOperationRequestV2_Operation interface {
+	isFileOperationRequestV2_Operation()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type FileOperationRequestV2_PutTar struct {
+	PutTar *PutTarRequestV2 `protobuf:"bytes,2,opt,name=put_tar,json=putTar,proto3,oneof" json:"put_tar,omitempty"`
+}
+type FileOperationRequestV2_DeleteFiles struct {
+	DeleteFiles *DeleteFilesRequestV2 `protobuf:"bytes,3,opt,name=delete_files,json=deleteFiles,proto3,oneof" json:"delete_files,omitempty"`
+}
+
+func (*FileOperationRequestV2_PutTar) isFileOperationRequestV2_Operation()      {}
+func (*FileOperationRequestV2_DeleteFiles) isFileOperationRequestV2_Operation() {}
+
+func (m *FileOperationRequestV2) GetOperation() isFileOperationRequestV2_Operation {
 	if m != nil {
-		return m.Commit
+		return m.Operation
 	}
 	return nil
 }
 
-type PutObjectRequest struct {
-	Value                []byte   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Tags                 []*Tag   `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
-	Block                *Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *FileOperationRequestV2) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
 }
 
-func (m *PutObjectRequest) Reset()         { *m = PutObjectRequest{} }
-func (m *PutObjectRequest) String() string { return proto.CompactTextString(m) }
-func (*PutObjectRequest) ProtoMessage()    {}
-func (*PutObjectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{65}
+func (m *FileOperationRequestV2) GetPutTar() *PutTarRequestV2 {
+	if x, ok := m.GetOperation().(*FileOperationRequestV2_PutTar); ok {
+		return x.PutTar
+	}
+	return nil
 }
-func (m *PutObjectRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *FileOperationRequestV2) GetDeleteFiles() *DeleteFilesRequestV2 {
+	if x, ok := m.GetOperation().(*FileOperationRequestV2_DeleteFiles); ok {
+		return x.DeleteFiles
+	}
+	return nil
+}
+
+func (m *FileOperationRequestV2) GetBreakGlass() bool {
+	if m != nil {
+		return m.BreakGlass
+	}
+	return false
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*FileOperationRequestV2) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*FileOperationRequestV2_PutTar)(nil),
+		(*FileOperationRequestV2_DeleteFiles)(nil),
+	}
+}
+
+type PutTarRequestV2 struct {
+	Overwrite            bool     `protobuf:"varint,1,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PutTarRequestV2) Reset()         { *m = PutTarRequestV2{} }
+func (m *PutTarRequestV2) String() string { return proto.CompactTextString(m) }
+func (*PutTarRequestV2) ProtoMessage()    {}
+func (*PutTarRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{67}
+}
+func (m *PutTarRequestV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PutObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutTarRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PutObjectRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutTarRequestV2.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4379,59 +4800,59 @@ func (m *PutObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *PutObjectRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutObjectRequest.Merge(m, src)
+func (m *PutTarRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutTarRequestV2.Merge(m, src)
 }
-func (m *PutObjectRequest) XXX_Size() int {
+func (m *PutTarRequestV2) XXX_Size() int {
 	return m.Size()
 }
-func (m *PutObjectRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutObjectRequest.DiscardUnknown(m)
+func (m *PutTarRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutTarRequestV2.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutObjectRequest proto.InternalMessageInfo
+var xxx_messageInfo_PutTarRequestV2 proto.InternalMessageInfo
 
-func (m *PutObjectRequest) GetValue() []byte {
+func (m *PutTarRequestV2) GetOverwrite() bool {
 	if m != nil {
-		return m.Value
+		return m.Overwrite
 	}
-	return nil
+	return false
 }
 
-func (m *PutObjectRequest) GetTags() []*Tag {
+func (m *PutTarRequestV2) GetTag() string {
 	if m != nil {
-		return m.Tags
+		return m.Tag
 	}
-	return nil
+	return ""
 }
 
-func (m *PutObjectRequest) GetBlock() *Block {
+func (m *PutTarRequestV2) GetData() []byte {
 	if m != nil {
-		return m.Block
+		return m.Data
 	}
 	return nil
 }
 
-type CreateObjectRequest struct {
-	Object               *Object   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	BlockRef             *BlockRef `protobuf:"bytes,2,opt,name=block_ref,json=blockRef,proto3" json:"block_ref,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type DeleteFilesRequestV2 struct {
+	Files                []string `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Tag                  string   `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateObjectRequest) Reset()         { *m = CreateObjectRequest{} }
-func (m *CreateObjectRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateObjectRequest) ProtoMessage()    {}
-func (*CreateObjectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{66}
+func (m *DeleteFilesRequestV2) Reset()         { *m = DeleteFilesRequestV2{} }
+func (m *DeleteFilesRequestV2) String() string { return proto.CompactTextString(m) }
+func (*DeleteFilesRequestV2) ProtoMessage()    {}
+func (*DeleteFilesRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{68}
 }
-func (m *CreateObjectRequest) XXX_Unmarshal(b []byte) error {
+func (m *DeleteFilesRequestV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteFilesRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateObjectRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteFilesRequestV2.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4441,58 +4862,51 @@ func (m *CreateObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CreateObjectRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateObjectRequest.Merge(m, src)
+func (m *DeleteFilesRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteFilesRequestV2.Merge(m, src)
 }
-func (m *CreateObjectRequest) XXX_Size() int {
+func (m *DeleteFilesRequestV2) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateObjectRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateObjectRequest.DiscardUnknown(m)
+func (m *DeleteFilesRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteFilesRequestV2.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateObjectRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteFilesRequestV2 proto.InternalMessageInfo
 
-func (m *CreateObjectRequest) GetObject() *Object {
+func (m *DeleteFilesRequestV2) GetFiles() []string {
 	if m != nil {
-		return m.Object
+		return m.Files
 	}
 	return nil
 }
 
-func (m *CreateObjectRequest) GetBlockRef() *BlockRef {
+func (m *DeleteFilesRequestV2) GetTag() string {
 	if m != nil {
-		return m.BlockRef
+		return m.Tag
 	}
-	return nil
+	return ""
 }
 
-type GetObjectsRequest struct {
-	Objects     []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
-	OffsetBytes uint64    `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
-	// The number of bytes requested.
-	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	// The total amount of bytes in these objects.  It's OK if it's not
-	// entirely accurate or if it's unknown (in which case it'd be set to 0).
-	// It's used primarily as a hint for cache eviction.
-	TotalSize            uint64   `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+type GetTarRequestV2 struct {
+	File                 *File    `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetObjectsRequest) Reset()         { *m = GetObjectsRequest{} }
-func (m *GetObjectsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetObjectsRequest) ProtoMessage()    {}
-func (*GetObjectsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{67}
+func (m *GetTarRequestV2) Reset()         { *m = GetTarRequestV2{} }
+func (m *GetTarRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetTarRequestV2) ProtoMessage()    {}
+func (*GetTarRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{69}
 }
-func (m *GetObjectsRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetTarRequestV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetTarRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetObjectsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetTarRequestV2.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4502,66 +4916,45 @@ func (m *GetObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *GetObjectsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetObjectsRequest.Merge(m, src)
+func (m *GetTarRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTarRequestV2.Merge(m, src)
 }
-func (m *GetObjectsRequest) XXX_Size() int {
+func (m *GetTarRequestV2) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetObjectsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetObjectsRequest.DiscardUnknown(m)
+func (m *GetTarRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTarRequestV2.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetObjectsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetTarRequestV2 proto.InternalMessageInfo
 
-func (m *GetObjectsRequest) GetObjects() []*Object {
+func (m *GetTarRequestV2) GetFile() *File {
 	if m != nil {
-		return m.Objects
+		return m.File
 	}
 	return nil
 }
 
-func (m *GetObjectsRequest) GetOffsetBytes() uint64 {
-	if m != nil {
-		return m.OffsetBytes
-	}
-	return 0
-}
-
-func (m *GetObjectsRequest) GetSizeBytes() uint64 {
-	if m != nil {
-		return m.SizeBytes
-	}
-	return 0
-}
-
-func (m *GetObjectsRequest) GetTotalSize() uint64 {
-	if m != nil {
-		return m.TotalSize
-	}
-	return 0
-}
-
-type PutBlockRequest struct {
-	Block                *Block   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
-	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type DiffFileResponseV2 struct {
+	OldFile              *FileInfo `protobuf:"bytes,1,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
+	NewFile              *FileInfo `protobuf:"bytes,2,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *PutBlockRequest) Reset()         { *m = PutBlockRequest{} }
-func (m *PutBlockRequest) String() string { return proto.CompactTextString(m) }
-func (*PutBlockRequest) ProtoMessage()    {}
-func (*PutBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{68}
+func (m *DiffFileResponseV2) Reset()         { *m = DiffFileResponseV2{} }
+func (m *DiffFileResponseV2) String() string { return proto.CompactTextString(m) }
+func (*DiffFileResponseV2) ProtoMessage()    {}
+func (*DiffFileResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{70}
 }
-func (m *PutBlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *DiffFileResponseV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PutBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DiffFileResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PutBlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DiffFileResponseV2.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4571,51 +4964,51 @@ func (m *PutBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *PutBlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutBlockRequest.Merge(m, src)
+func (m *DiffFileResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffFileResponseV2.Merge(m, src)
 }
-func (m *PutBlockRequest) XXX_Size() int {
+func (m *DiffFileResponseV2) XXX_Size() int {
 	return m.Size()
 }
-func (m *PutBlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutBlockRequest.DiscardUnknown(m)
+func (m *DiffFileResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffFileResponseV2.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutBlockRequest proto.InternalMessageInfo
+var xxx_messageInfo_DiffFileResponseV2 proto.InternalMessageInfo
 
-func (m *PutBlockRequest) GetBlock() *Block {
+func (m *DiffFileResponseV2) GetOldFile() *FileInfo {
 	if m != nil {
-		return m.Block
+		return m.OldFile
 	}
 	return nil
 }
 
-func (m *PutBlockRequest) GetValue() []byte {
+func (m *DiffFileResponseV2) GetNewFile() *FileInfo {
 	if m != nil {
-		return m.Value
+		return m.NewFile
 	}
 	return nil
 }
 
-type GetBlockRequest struct {
-	Block                *Block   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+type CreateTmpFileSetResponse struct {
+	FilesetId            string   `protobuf:"bytes,1,opt,name=fileset_id,json=filesetId,proto3" json:"fileset_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetBlockRequest) Reset()         { *m = GetBlockRequest{} }
-func (m *GetBlockRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBlockRequest) ProtoMessage()    {}
-func (*GetBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{69}
+func (m *CreateTmpFileSetResponse) Reset()         { *m = CreateTmpFileSetResponse{} }
+func (m *CreateTmpFileSetResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateTmpFileSetResponse) ProtoMessage()    {}
+func (*CreateTmpFileSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{71}
 }
-func (m *GetBlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *CreateTmpFileSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateTmpFileSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetBlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateTmpFileSetResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4625,51 +5018,45 @@ func (m *GetBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *GetBlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlockRequest.Merge(m, src)
+func (m *CreateTmpFileSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTmpFileSetResponse.Merge(m, src)
 }
-func (m *GetBlockRequest) XXX_Size() int {
+func (m *CreateTmpFileSetResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetBlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBlockRequest.DiscardUnknown(m)
+func (m *CreateTmpFileSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTmpFileSetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBlockRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateTmpFileSetResponse proto.InternalMessageInfo
 
-func (m *GetBlockRequest) GetBlock() *Block {
+func (m *CreateTmpFileSetResponse) GetFilesetId() string {
 	if m != nil {
-		return m.Block
+		return m.FilesetId
 	}
-	return nil
+	return ""
 }
 
-type GetBlocksRequest struct {
-	BlockRefs   []*BlockRef `protobuf:"bytes,1,rep,name=blockRefs,proto3" json:"blockRefs,omitempty"`
-	OffsetBytes uint64      `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
-	// The number of bytes requested.
-	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	// The total amount of bytes in these blocks.  It's OK if it's not
-	// entirely accurate or if it's unknown (in which case it'd be set to 0).
-	// It's used primarily as a hint for cache eviction.
-	TotalSize            uint64   `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+type RenewTmpFileSetRequest struct {
+	FilesetId            string   `protobuf:"bytes,1,opt,name=fileset_id,json=filesetId,proto3" json:"fileset_id,omitempty"`
+	TtlSeconds           int64    `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetBlocksRequest) Reset()         { *m = GetBlocksRequest{} }
-func (m *GetBlocksRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBlocksRequest) ProtoMessage()    {}
-func (*GetBlocksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{70}
+func (m *RenewTmpFileSetRequest) Reset()         { *m = RenewTmpFileSetRequest{} }
+func (m *RenewTmpFileSetRequest) String() string { return proto.CompactTextString(m) }
+func (*RenewTmpFileSetRequest) ProtoMessage()    {}
+func (*RenewTmpFileSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{72}
 }
-func (m *GetBlocksRequest) XXX_Unmarshal(b []byte) error {
+func (m *RenewTmpFileSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RenewTmpFileSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetBlocksRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RenewTmpFileSetRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4679,64 +5066,51 @@ func (m *GetBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GetBlocksRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlocksRequest.Merge(m, src)
+func (m *RenewTmpFileSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewTmpFileSetRequest.Merge(m, src)
 }
-func (m *GetBlocksRequest) XXX_Size() int {
+func (m *RenewTmpFileSetRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetBlocksRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBlocksRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetBlocksRequest proto.InternalMessageInfo
-
-func (m *GetBlocksRequest) GetBlockRefs() []*BlockRef {
-	if m != nil {
-		return m.BlockRefs
-	}
-	return nil
+func (m *RenewTmpFileSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewTmpFileSetRequest.DiscardUnknown(m)
 }
 
-func (m *GetBlocksRequest) GetOffsetBytes() uint64 {
-	if m != nil {
-		return m.OffsetBytes
-	}
-	return 0
-}
+var xxx_messageInfo_RenewTmpFileSetRequest proto.InternalMessageInfo
 
-func (m *GetBlocksRequest) GetSizeBytes() uint64 {
+func (m *RenewTmpFileSetRequest) GetFilesetId() string {
 	if m != nil {
-		return m.SizeBytes
+		return m.FilesetId
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBlocksRequest) GetTotalSize() uint64 {
+func (m *RenewTmpFileSetRequest) GetTtlSeconds() int64 {
 	if m != nil {
-		return m.TotalSize
+		return m.TtlSeconds
 	}
 	return 0
 }
 
-type ListBlockRequest struct {
+type ClearCommitRequestV2 struct {
+	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListBlockRequest) Reset()         { *m = ListBlockRequest{} }
-func (m *ListBlockRequest) String() string { return proto.CompactTextString(m) }
-func (*ListBlockRequest) ProtoMessage()    {}
-func (*ListBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{71}
+func (m *ClearCommitRequestV2) Reset()         { *m = ClearCommitRequestV2{} }
+func (m *ClearCommitRequestV2) String() string { return proto.CompactTextString(m) }
+func (*ClearCommitRequestV2) ProtoMessage()    {}
+func (*ClearCommitRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{73}
 }
-func (m *ListBlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *ClearCommitRequestV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClearCommitRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListBlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClearCommitRequestV2.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4746,38 +5120,46 @@ func (m *ListBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *ListBlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListBlockRequest.Merge(m, src)
+func (m *ClearCommitRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearCommitRequestV2.Merge(m, src)
 }
-func (m *ListBlockRequest) XXX_Size() int {
+func (m *ClearCommitRequestV2) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListBlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListBlockRequest.DiscardUnknown(m)
+func (m *ClearCommitRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearCommitRequestV2.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListBlockRequest proto.InternalMessageInfo
+var xxx_messageInfo_ClearCommitRequestV2 proto.InternalMessageInfo
 
-type TagObjectRequest struct {
-	Object               *Object  `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+func (m *ClearCommitRequestV2) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+type PutObjectRequest struct {
+	Value                []byte   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	Tags                 []*Tag   `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Block                *Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TagObjectRequest) Reset()         { *m = TagObjectRequest{} }
-func (m *TagObjectRequest) String() string { return proto.CompactTextString(m) }
-func (*TagObjectRequest) ProtoMessage()    {}
-func (*TagObjectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{72}
+func (m *PutObjectRequest) Reset()         { *m = PutObjectRequest{} }
+func (m *PutObjectRequest) String() string { return proto.CompactTextString(m) }
+func (*PutObjectRequest) ProtoMessage()    {}
+func (*PutObjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{74}
 }
-func (m *TagObjectRequest) XXX_Unmarshal(b []byte) error {
+func (m *PutObjectRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TagObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TagObjectRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutObjectRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4787,50 +5169,59 @@ func (m *TagObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *TagObjectRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TagObjectRequest.Merge(m, src)
+func (m *PutObjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutObjectRequest.Merge(m, src)
 }
-func (m *TagObjectRequest) XXX_Size() int {
+func (m *PutObjectRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *TagObjectRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TagObjectRequest.DiscardUnknown(m)
+func (m *PutObjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutObjectRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TagObjectRequest proto.InternalMessageInfo
+var xxx_messageInfo_PutObjectRequest proto.InternalMessageInfo
 
-func (m *TagObjectRequest) GetObject() *Object {
+func (m *PutObjectRequest) GetValue() []byte {
 	if m != nil {
-		return m.Object
+		return m.Value
 	}
 	return nil
 }
 
-func (m *TagObjectRequest) GetTags() []*Tag {
+func (m *PutObjectRequest) GetTags() []*Tag {
 	if m != nil {
 		return m.Tags
 	}
 	return nil
 }
 
-type ListObjectsRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PutObjectRequest) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
 }
 
-func (m *ListObjectsRequest) Reset()         { *m = ListObjectsRequest{} }
-func (m *ListObjectsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListObjectsRequest) ProtoMessage()    {}
-func (*ListObjectsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{73}
+type CreateObjectRequest struct {
+	Object               *Object   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	BlockRef             *BlockRef `protobuf:"bytes,2,opt,name=block_ref,json=blockRef,proto3" json:"block_ref,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
-func (m *ListObjectsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *CreateObjectRequest) Reset()         { *m = CreateObjectRequest{} }
+func (m *CreateObjectRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateObjectRequest) ProtoMessage()    {}
+func (*CreateObjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{75}
+}
+func (m *CreateObjectRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListObjectsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateObjectRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4840,38 +5231,58 @@ func (m *ListObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ListObjectsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListObjectsRequest.Merge(m, src)
+func (m *CreateObjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateObjectRequest.Merge(m, src)
 }
-func (m *ListObjectsRequest) XXX_Size() int {
+func (m *CreateObjectRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListObjectsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListObjectsRequest.DiscardUnknown(m)
+func (m *CreateObjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateObjectRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListObjectsRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateObjectRequest proto.InternalMessageInfo
 
-type ListTagsRequest struct {
-	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	IncludeObject        bool     `protobuf:"varint,2,opt,name=include_object,json=includeObject,proto3" json:"include_object,omitempty"`
+func (m *CreateObjectRequest) GetObject() *Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *CreateObjectRequest) GetBlockRef() *BlockRef {
+	if m != nil {
+		return m.BlockRef
+	}
+	return nil
+}
+
+type GetObjectsRequest struct {
+	Objects     []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	OffsetBytes uint64    `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
+	// The number of bytes requested.
+	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// The total amount of bytes in these objects.  It's OK if it's not
+	// entirely accurate or if it's unknown (in which case it'd be set to 0).
+	// It's used primarily as a hint for cache eviction.
+	TotalSize            uint64   `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListTagsRequest) Reset()         { *m = ListTagsRequest{} }
-func (m *ListTagsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListTagsRequest) ProtoMessage()    {}
-func (*ListTagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{74}
+func (m *GetObjectsRequest) Reset()         { *m = GetObjectsRequest{} }
+func (m *GetObjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetObjectsRequest) ProtoMessage()    {}
+func (*GetObjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{76}
 }
-func (m *ListTagsRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetObjectsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListTagsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetObjectsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4881,52 +5292,66 @@ func (m *ListTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ListTagsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListTagsRequest.Merge(m, src)
+func (m *GetObjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetObjectsRequest.Merge(m, src)
 }
-func (m *ListTagsRequest) XXX_Size() int {
+func (m *GetObjectsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListTagsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListTagsRequest.DiscardUnknown(m)
+func (m *GetObjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetObjectsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListTagsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetObjectsRequest proto.InternalMessageInfo
 
-func (m *ListTagsRequest) GetPrefix() string {
+func (m *GetObjectsRequest) GetObjects() []*Object {
 	if m != nil {
-		return m.Prefix
+		return m.Objects
 	}
-	return ""
+	return nil
 }
 
-func (m *ListTagsRequest) GetIncludeObject() bool {
+func (m *GetObjectsRequest) GetOffsetBytes() uint64 {
 	if m != nil {
-		return m.IncludeObject
+		return m.OffsetBytes
 	}
-	return false
+	return 0
 }
 
-type ListTagsResponse struct {
-	Tag                  *Tag     `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Object               *Object  `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+func (m *GetObjectsRequest) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *GetObjectsRequest) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type PutBlockRequest struct {
+	Block                *Block   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListTagsResponse) Reset()         { *m = ListTagsResponse{} }
-func (m *ListTagsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListTagsResponse) ProtoMessage()    {}
-func (*ListTagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{75}
+func (m *PutBlockRequest) Reset()         { *m = PutBlockRequest{} }
+func (m *PutBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*PutBlockRequest) ProtoMessage()    {}
+func (*PutBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{77}
 }
-func (m *ListTagsResponse) XXX_Unmarshal(b []byte) error {
+func (m *PutBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PutBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListTagsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PutBlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4936,51 +5361,51 @@ func (m *ListTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *ListTagsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListTagsResponse.Merge(m, src)
+func (m *PutBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutBlockRequest.Merge(m, src)
 }
-func (m *ListTagsResponse) XXX_Size() int {
+func (m *PutBlockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListTagsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListTagsResponse.DiscardUnknown(m)
+func (m *PutBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutBlockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListTagsResponse proto.InternalMessageInfo
+var xxx_messageInfo_PutBlockRequest proto.InternalMessageInfo
 
-func (m *ListTagsResponse) GetTag() *Tag {
+func (m *PutBlockRequest) GetBlock() *Block {
 	if m != nil {
-		return m.Tag
+		return m.Block
 	}
 	return nil
 }
 
-func (m *ListTagsResponse) GetObject() *Object {
+func (m *PutBlockRequest) GetValue() []byte {
 	if m != nil {
-		return m.Object
+		return m.Value
 	}
 	return nil
 }
 
-type DeleteObjectsRequest struct {
-	Objects              []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type GetBlockRequest struct {
+	Block                *Block   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteObjectsRequest) Reset()         { *m = DeleteObjectsRequest{} }
-func (m *DeleteObjectsRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteObjectsRequest) ProtoMessage()    {}
-func (*DeleteObjectsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{76}
+func (m *GetBlockRequest) Reset()         { *m = GetBlockRequest{} }
+func (m *GetBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockRequest) ProtoMessage()    {}
+func (*GetBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{78}
 }
-func (m *DeleteObjectsRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteObjectsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetBlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4990,43 +5415,51 @@ func (m *DeleteObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *DeleteObjectsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteObjectsRequest.Merge(m, src)
+func (m *GetBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockRequest.Merge(m, src)
 }
-func (m *DeleteObjectsRequest) XXX_Size() int {
+func (m *GetBlockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteObjectsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteObjectsRequest.DiscardUnknown(m)
+func (m *GetBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteObjectsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetBlockRequest proto.InternalMessageInfo
 
-func (m *DeleteObjectsRequest) GetObjects() []*Object {
+func (m *GetBlockRequest) GetBlock() *Block {
 	if m != nil {
-		return m.Objects
+		return m.Block
 	}
 	return nil
 }
 
-type DeleteObjectsResponse struct {
+type GetBlocksRequest struct {
+	BlockRefs   []*BlockRef `protobuf:"bytes,1,rep,name=blockRefs,proto3" json:"blockRefs,omitempty"`
+	OffsetBytes uint64      `protobuf:"varint,2,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
+	// The number of bytes requested.
+	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// The total amount of bytes in these blocks.  It's OK if it's not
+	// entirely accurate or if it's unknown (in which case it'd be set to 0).
+	// It's used primarily as a hint for cache eviction.
+	TotalSize            uint64   `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteObjectsResponse) Reset()         { *m = DeleteObjectsResponse{} }
-func (m *DeleteObjectsResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteObjectsResponse) ProtoMessage()    {}
-func (*DeleteObjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{77}
+func (m *GetBlocksRequest) Reset()         { *m = GetBlocksRequest{} }
+func (m *GetBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlocksRequest) ProtoMessage()    {}
+func (*GetBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{79}
 }
-func (m *DeleteObjectsResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetBlocksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteObjectsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetBlocksRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5036,37 +5469,64 @@ func (m *DeleteObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *DeleteObjectsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteObjectsResponse.Merge(m, src)
+func (m *GetBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlocksRequest.Merge(m, src)
 }
-func (m *DeleteObjectsResponse) XXX_Size() int {
+func (m *GetBlocksRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteObjectsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteObjectsResponse.DiscardUnknown(m)
+func (m *GetBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlocksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteObjectsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetBlocksRequest proto.InternalMessageInfo
 
-type DeleteTagsRequest struct {
-	Tags                 []*Tag   `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+func (m *GetBlocksRequest) GetBlockRefs() []*BlockRef {
+	if m != nil {
+		return m.BlockRefs
+	}
+	return nil
+}
+
+func (m *GetBlocksRequest) GetOffsetBytes() uint64 {
+	if m != nil {
+		return m.OffsetBytes
+	}
+	return 0
+}
+
+func (m *GetBlocksRequest) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *GetBlocksRequest) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type ListBlockRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteTagsRequest) Reset()         { *m = DeleteTagsRequest{} }
-func (m *DeleteTagsRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteTagsRequest) ProtoMessage()    {}
-func (*DeleteTagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{78}
+func (m *ListBlockRequest) Reset()         { *m = ListBlockRequest{} }
+func (m *ListBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBlockRequest) ProtoMessage()    {}
+func (*ListBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{80}
 }
-func (m *DeleteTagsRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteTagsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListBlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5076,43 +5536,38 @@ func (m *DeleteTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DeleteTagsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteTagsRequest.Merge(m, src)
+func (m *ListBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlockRequest.Merge(m, src)
 }
-func (m *DeleteTagsRequest) XXX_Size() int {
+func (m *ListBlockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteTagsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteTagsRequest.DiscardUnknown(m)
+func (m *ListBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteTagsRequest proto.InternalMessageInfo
-
-func (m *DeleteTagsRequest) GetTags() []*Tag {
-	if m != nil {
-		return m.Tags
-	}
-	return nil
-}
+var xxx_messageInfo_ListBlockRequest proto.InternalMessageInfo
 
-type DeleteTagsResponse struct {
+type TagObjectRequest struct {
+	Object               *Object  `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Tags                 []*Tag   `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteTagsResponse) Reset()         { *m = DeleteTagsResponse{} }
-func (m *DeleteTagsResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteTagsResponse) ProtoMessage()    {}
-func (*DeleteTagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{79}
+func (m *TagObjectRequest) Reset()         { *m = TagObjectRequest{} }
+func (m *TagObjectRequest) String() string { return proto.CompactTextString(m) }
+func (*TagObjectRequest) ProtoMessage()    {}
+func (*TagObjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{81}
 }
-func (m *DeleteTagsResponse) XXX_Unmarshal(b []byte) error {
+func (m *TagObjectRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TagObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteTagsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TagObjectRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5122,84 +5577,50 @@ func (m *DeleteTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DeleteTagsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteTagsResponse.Merge(m, src)
+func (m *TagObjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagObjectRequest.Merge(m, src)
 }
-func (m *DeleteTagsResponse) XXX_Size() int {
+func (m *TagObjectRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteTagsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteTagsResponse.DiscardUnknown(m)
+func (m *TagObjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagObjectRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteTagsResponse proto.InternalMessageInfo
+var xxx_messageInfo_TagObjectRequest proto.InternalMessageInfo
 
-type CheckObjectRequest struct {
-	Object               *Object  `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *CheckObjectRequest) Reset()         { *m = CheckObjectRequest{} }
-func (m *CheckObjectRequest) String() string { return proto.CompactTextString(m) }
-func (*CheckObjectRequest) ProtoMessage()    {}
-func (*CheckObjectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{80}
-}
-func (m *CheckObjectRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *CheckObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_CheckObjectRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *TagObjectRequest) GetObject() *Object {
+	if m != nil {
+		return m.Object
 	}
+	return nil
 }
-func (m *CheckObjectRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckObjectRequest.Merge(m, src)
-}
-func (m *CheckObjectRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *CheckObjectRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CheckObjectRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CheckObjectRequest proto.InternalMessageInfo
 
-func (m *CheckObjectRequest) GetObject() *Object {
+func (m *TagObjectRequest) GetTags() []*Tag {
 	if m != nil {
-		return m.Object
+		return m.Tags
 	}
 	return nil
 }
 
-type CheckObjectResponse struct {
-	Exists               bool     `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+type ListObjectsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CheckObjectResponse) Reset()         { *m = CheckObjectResponse{} }
-func (m *CheckObjectResponse) String() string { return proto.CompactTextString(m) }
-func (*CheckObjectResponse) ProtoMessage()    {}
-func (*CheckObjectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{81}
+func (m *ListObjectsRequest) Reset()         { *m = ListObjectsRequest{} }
+func (m *ListObjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListObjectsRequest) ProtoMessage()    {}
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{82}
 }
-func (m *CheckObjectResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListObjectsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CheckObjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CheckObjectResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListObjectsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5209,44 +5630,38 @@ func (m *CheckObjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CheckObjectResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckObjectResponse.Merge(m, src)
+func (m *ListObjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListObjectsRequest.Merge(m, src)
 }
-func (m *CheckObjectResponse) XXX_Size() int {
+func (m *ListObjectsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CheckObjectResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CheckObjectResponse.DiscardUnknown(m)
+func (m *ListObjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListObjectsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CheckObjectResponse proto.InternalMessageInfo
-
-func (m *CheckObjectResponse) GetExists() bool {
-	if m != nil {
-		return m.Exists
-	}
-	return false
-}
+var xxx_messageInfo_ListObjectsRequest proto.InternalMessageInfo
 
-type Objects struct {
-	Objects              []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type ListTagsRequest struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	IncludeObject        bool     `protobuf:"varint,2,opt,name=include_object,json=includeObject,proto3" json:"include_object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Objects) Reset()         { *m = Objects{} }
-func (m *Objects) String() string { return proto.CompactTextString(m) }
-func (*Objects) ProtoMessage()    {}
-func (*Objects) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{82}
+func (m *ListTagsRequest) Reset()         { *m = ListTagsRequest{} }
+func (m *ListTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTagsRequest) ProtoMessage()    {}
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{83}
 }
-func (m *Objects) XXX_Unmarshal(b []byte) error {
+func (m *ListTagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Objects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Objects.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListTagsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5256,45 +5671,52 @@ func (m *Objects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Objects) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Objects.Merge(m, src)
+func (m *ListTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTagsRequest.Merge(m, src)
 }
-func (m *Objects) XXX_Size() int {
+func (m *ListTagsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Objects) XXX_DiscardUnknown() {
-	xxx_messageInfo_Objects.DiscardUnknown(m)
+func (m *ListTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTagsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Objects proto.InternalMessageInfo
+var xxx_messageInfo_ListTagsRequest proto.InternalMessageInfo
 
-func (m *Objects) GetObjects() []*Object {
+func (m *ListTagsRequest) GetPrefix() string {
 	if m != nil {
-		return m.Objects
+		return m.Prefix
 	}
-	return nil
+	return ""
 }
 
-type PutObjDirectRequest struct {
-	Obj                  string   `protobuf:"bytes,1,opt,name=obj,proto3" json:"obj,omitempty"`
-	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (m *ListTagsRequest) GetIncludeObject() bool {
+	if m != nil {
+		return m.IncludeObject
+	}
+	return false
+}
+
+type ListTagsResponse struct {
+	Tag                  *Tag     `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Object               *Object  `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PutObjDirectRequest) Reset()         { *m = PutObjDirectRequest{} }
-func (m *PutObjDirectRequest) String() string { return proto.CompactTextString(m) }
-func (*PutObjDirectRequest) ProtoMessage()    {}
-func (*PutObjDirectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{83}
+func (m *ListTagsResponse) Reset()         { *m = ListTagsResponse{} }
+func (m *ListTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTagsResponse) ProtoMessage()    {}
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{84}
 }
-func (m *PutObjDirectRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListTagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PutObjDirectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PutObjDirectRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListTagsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5304,51 +5726,51 @@ func (m *PutObjDirectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *PutObjDirectRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PutObjDirectRequest.Merge(m, src)
+func (m *ListTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTagsResponse.Merge(m, src)
 }
-func (m *PutObjDirectRequest) XXX_Size() int {
+func (m *ListTagsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *PutObjDirectRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PutObjDirectRequest.DiscardUnknown(m)
+func (m *ListTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTagsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PutObjDirectRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListTagsResponse proto.InternalMessageInfo
 
-func (m *PutObjDirectRequest) GetObj() string {
+func (m *ListTagsResponse) GetTag() *Tag {
 	if m != nil {
-		return m.Obj
+		return m.Tag
 	}
-	return ""
+	return nil
 }
 
-func (m *PutObjDirectRequest) GetValue() []byte {
+func (m *ListTagsResponse) GetObject() *Object {
 	if m != nil {
-		return m.Value
+		return m.Object
 	}
 	return nil
 }
 
-type GetObjDirectRequest struct {
-	Obj                  string   `protobuf:"bytes,1,opt,name=obj,proto3" json:"obj,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type DeleteObjectsRequest struct {
+	Objects              []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *GetObjDirectRequest) Reset()         { *m = GetObjDirectRequest{} }
-func (m *GetObjDirectRequest) String() string { return proto.CompactTextString(m) }
-func (*GetObjDirectRequest) ProtoMessage()    {}
-func (*GetObjDirectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{84}
+func (m *DeleteObjectsRequest) Reset()         { *m = DeleteObjectsRequest{} }
+func (m *DeleteObjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteObjectsRequest) ProtoMessage()    {}
+func (*DeleteObjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{85}
 }
-func (m *GetObjDirectRequest) XXX_Unmarshal(b []byte) error {
+func (m *DeleteObjectsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetObjDirectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetObjDirectRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteObjectsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5358,50 +5780,43 @@ func (m *GetObjDirectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetObjDirectRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetObjDirectRequest.Merge(m, src)
+func (m *DeleteObjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteObjectsRequest.Merge(m, src)
 }
-func (m *GetObjDirectRequest) XXX_Size() int {
+func (m *DeleteObjectsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetObjDirectRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetObjDirectRequest.DiscardUnknown(m)
+func (m *DeleteObjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteObjectsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetObjDirectRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteObjectsRequest proto.InternalMessageInfo
 
-func (m *GetObjDirectRequest) GetObj() string {
+func (m *DeleteObjectsRequest) GetObjects() []*Object {
 	if m != nil {
-		return m.Obj
+		return m.Objects
 	}
-	return ""
+	return nil
 }
 
-type DeleteObjDirectRequest struct {
-	// Delete a single object by its path.
-	Object string `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	// Delete all objects with paths matching this prefix, cannot be applied to
-	// the core storage layer paths, as those are generally not safe to delete
-	// (use garbage collection for that). This is for deleting objects generated
-	// vi `PutObjDirect`.
-	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+type DeleteObjectsResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteObjDirectRequest) Reset()         { *m = DeleteObjDirectRequest{} }
-func (m *DeleteObjDirectRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteObjDirectRequest) ProtoMessage()    {}
-func (*DeleteObjDirectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{85}
+func (m *DeleteObjectsResponse) Reset()         { *m = DeleteObjectsResponse{} }
+func (m *DeleteObjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteObjectsResponse) ProtoMessage()    {}
+func (*DeleteObjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{86}
 }
-func (m *DeleteObjDirectRequest) XXX_Unmarshal(b []byte) error {
+func (m *DeleteObjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteObjDirectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteObjDirectRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteObjectsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5411,52 +5826,83 @@ func (m *DeleteObjDirectRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *DeleteObjDirectRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteObjDirectRequest.Merge(m, src)
+func (m *DeleteObjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteObjectsResponse.Merge(m, src)
 }
-func (m *DeleteObjDirectRequest) XXX_Size() int {
+func (m *DeleteObjectsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteObjDirectRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteObjDirectRequest.DiscardUnknown(m)
+func (m *DeleteObjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteObjectsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteObjDirectRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteObjectsResponse proto.InternalMessageInfo
 
-func (m *DeleteObjDirectRequest) GetObject() string {
-	if m != nil {
-		return m.Object
+type DeleteTagsRequest struct {
+	Tags                 []*Tag   `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTagsRequest) Reset()         { *m = DeleteTagsRequest{} }
+func (m *DeleteTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTagsRequest) ProtoMessage()    {}
+func (*DeleteTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{87}
+}
+func (m *DeleteTagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *DeleteTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTagsRequest.Merge(m, src)
+}
+func (m *DeleteTagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTagsRequest.DiscardUnknown(m)
 }
 
-func (m *DeleteObjDirectRequest) GetPrefix() string {
+var xxx_messageInfo_DeleteTagsRequest proto.InternalMessageInfo
+
+func (m *DeleteTagsRequest) GetTags() []*Tag {
 	if m != nil {
-		return m.Prefix
+		return m.Tags
 	}
-	return ""
+	return nil
 }
 
-type ObjectIndex struct {
-	Objects              map[string]*BlockRef `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Tags                 map[string]*Object   `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type DeleteTagsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ObjectIndex) Reset()         { *m = ObjectIndex{} }
-func (m *ObjectIndex) String() string { return proto.CompactTextString(m) }
-func (*ObjectIndex) ProtoMessage()    {}
-func (*ObjectIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b48f014707f6595c, []int{86}
+func (m *DeleteTagsResponse) Reset()         { *m = DeleteTagsResponse{} }
+func (m *DeleteTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTagsResponse) ProtoMessage()    {}
+func (*DeleteTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{88}
 }
-func (m *ObjectIndex) XXX_Unmarshal(b []byte) error {
+func (m *DeleteTagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ObjectIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ObjectIndex.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteTagsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5466,844 +5912,1030 @@ func (m *ObjectIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ObjectIndex) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ObjectIndex.Merge(m, src)
+func (m *DeleteTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTagsResponse.Merge(m, src)
 }
-func (m *ObjectIndex) XXX_Size() int {
+func (m *DeleteTagsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ObjectIndex) XXX_DiscardUnknown() {
-	xxx_messageInfo_ObjectIndex.DiscardUnknown(m)
+func (m *DeleteTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTagsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ObjectIndex proto.InternalMessageInfo
+var xxx_messageInfo_DeleteTagsResponse proto.InternalMessageInfo
 
-func (m *ObjectIndex) GetObjects() map[string]*BlockRef {
-	if m != nil {
-		return m.Objects
+type CheckObjectRequest struct {
+	Object               *Object  `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckObjectRequest) Reset()         { *m = CheckObjectRequest{} }
+func (m *CheckObjectRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckObjectRequest) ProtoMessage()    {}
+func (*CheckObjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{89}
+}
+func (m *CheckObjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckObjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *CheckObjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckObjectRequest.Merge(m, src)
+}
+func (m *CheckObjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckObjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckObjectRequest.DiscardUnknown(m)
 }
 
-func (m *ObjectIndex) GetTags() map[string]*Object {
+var xxx_messageInfo_CheckObjectRequest proto.InternalMessageInfo
+
+func (m *CheckObjectRequest) GetObject() *Object {
 	if m != nil {
-		return m.Tags
+		return m.Object
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("pfs.OriginKind", OriginKind_name, OriginKind_value)
-	proto.RegisterEnum("pfs.FileType", FileType_name, FileType_value)
-	proto.RegisterEnum("pfs.CommitState", CommitState_name, CommitState_value)
-	proto.RegisterEnum("pfs.Delimiter", Delimiter_name, Delimiter_value)
-	proto.RegisterType((*Repo)(nil), "pfs.Repo")
-	proto.RegisterType((*Branch)(nil), "pfs.Branch")
-	proto.RegisterType((*File)(nil), "pfs.File")
-	proto.RegisterType((*Block)(nil), "pfs.Block")
-	proto.RegisterType((*Object)(nil), "pfs.Object")
-	proto.RegisterType((*Tag)(nil), "pfs.Tag")
-	proto.RegisterType((*RepoInfo)(nil), "pfs.RepoInfo")
-	proto.RegisterType((*RepoAuthInfo)(nil), "pfs.RepoAuthInfo")
-	proto.RegisterType((*BranchInfo)(nil), "pfs.BranchInfo")
-	proto.RegisterType((*BranchInfos)(nil), "pfs.BranchInfos")
-	proto.RegisterType((*Trigger)(nil), "pfs.Trigger")
-	proto.RegisterType((*CommitOrigin)(nil), "pfs.CommitOrigin")
-	proto.RegisterType((*Commit)(nil), "pfs.Commit")
-	proto.RegisterType((*CommitRange)(nil), "pfs.CommitRange")
-	proto.RegisterType((*CommitProvenance)(nil), "pfs.CommitProvenance")
-	proto.RegisterType((*CommitInfo)(nil), "pfs.CommitInfo")
-	proto.RegisterType((*FileInfo)(nil), "pfs.FileInfo")
-	proto.RegisterType((*ByteRange)(nil), "pfs.ByteRange")
-	proto.RegisterType((*BlockRef)(nil), "pfs.BlockRef")
-	proto.RegisterType((*ObjectInfo)(nil), "pfs.ObjectInfo")
-	proto.RegisterType((*Compaction)(nil), "pfs.Compaction")
-	proto.RegisterType((*Shard)(nil), "pfs.Shard")
-	proto.RegisterType((*PathRange)(nil), "pfs.PathRange")
-	proto.RegisterType((*CreateRepoRequest)(nil), "pfs.CreateRepoRequest")
-	proto.RegisterType((*InspectRepoRequest)(nil), "pfs.InspectRepoRequest")
-	proto.RegisterType((*ListRepoRequest)(nil), "pfs.ListRepoRequest")
-	proto.RegisterType((*ListRepoResponse)(nil), "pfs.ListRepoResponse")
-	proto.RegisterType((*DeleteRepoRequest)(nil), "pfs.DeleteRepoRequest")
-	proto.RegisterType((*StartCommitRequest)(nil), "pfs.StartCommitRequest")
-	proto.RegisterType((*BuildCommitRequest)(nil), "pfs.BuildCommitRequest")
-	proto.RegisterType((*FinishCommitRequest)(nil), "pfs.FinishCommitRequest")
-	proto.RegisterType((*InspectCommitRequest)(nil), "pfs.InspectCommitRequest")
-	proto.RegisterType((*ListCommitRequest)(nil), "pfs.ListCommitRequest")
-	proto.RegisterType((*CommitInfos)(nil), "pfs.CommitInfos")
-	proto.RegisterType((*CreateBranchRequest)(nil), "pfs.CreateBranchRequest")
-	proto.RegisterType((*InspectBranchRequest)(nil), "pfs.InspectBranchRequest")
-	proto.RegisterType((*ListBranchRequest)(nil), "pfs.ListBranchRequest")
-	proto.RegisterType((*DeleteBranchRequest)(nil), "pfs.DeleteBranchRequest")
-	proto.RegisterType((*DeleteCommitRequest)(nil), "pfs.DeleteCommitRequest")
-	proto.RegisterType((*FlushCommitRequest)(nil), "pfs.FlushCommitRequest")
-	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs.SubscribeCommitRequest")
-	proto.RegisterType((*GetFileRequest)(nil), "pfs.GetFileRequest")
-	proto.RegisterType((*OverwriteIndex)(nil), "pfs.OverwriteIndex")
-	proto.RegisterType((*PutFileRequest)(nil), "pfs.PutFileRequest")
-	proto.RegisterType((*PutFileRecord)(nil), "pfs.PutFileRecord")
-	proto.RegisterType((*PutFileRecords)(nil), "pfs.PutFileRecords")
-	proto.RegisterType((*CopyFileRequest)(nil), "pfs.CopyFileRequest")
-	proto.RegisterType((*InspectFileRequest)(nil), "pfs.InspectFileRequest")
-	proto.RegisterType((*ListFileRequest)(nil), "pfs.ListFileRequest")
-	proto.RegisterType((*WalkFileRequest)(nil), "pfs.WalkFileRequest")
-	proto.RegisterType((*GlobFileRequest)(nil), "pfs.GlobFileRequest")
-	proto.RegisterType((*FileInfos)(nil), "pfs.FileInfos")
-	proto.RegisterType((*DiffFileRequest)(nil), "pfs.DiffFileRequest")
-	proto.RegisterType((*DiffFileResponse)(nil), "pfs.DiffFileResponse")
-	proto.RegisterType((*DeleteFileRequest)(nil), "pfs.DeleteFileRequest")
-	proto.RegisterType((*FsckRequest)(nil), "pfs.FsckRequest")
-	proto.RegisterType((*FsckResponse)(nil), "pfs.FsckResponse")
-	proto.RegisterType((*FileOperationRequestV2)(nil), "pfs.FileOperationRequestV2")
-	proto.RegisterType((*PutTarRequestV2)(nil), "pfs.PutTarRequestV2")
-	proto.RegisterType((*DeleteFilesRequestV2)(nil), "pfs.DeleteFilesRequestV2")
-	proto.RegisterType((*GetTarRequestV2)(nil), "pfs.GetTarRequestV2")
-	proto.RegisterType((*DiffFileResponseV2)(nil), "pfs.DiffFileResponseV2")
-	proto.RegisterType((*CreateTmpFileSetResponse)(nil), "pfs.CreateTmpFileSetResponse")
-	proto.RegisterType((*RenewTmpFileSetRequest)(nil), "pfs.RenewTmpFileSetRequest")
-	proto.RegisterType((*ClearCommitRequestV2)(nil), "pfs.ClearCommitRequestV2")
-	proto.RegisterType((*PutObjectRequest)(nil), "pfs.PutObjectRequest")
-	proto.RegisterType((*CreateObjectRequest)(nil), "pfs.CreateObjectRequest")
-	proto.RegisterType((*GetObjectsRequest)(nil), "pfs.GetObjectsRequest")
-	proto.RegisterType((*PutBlockRequest)(nil), "pfs.PutBlockRequest")
-	proto.RegisterType((*GetBlockRequest)(nil), "pfs.GetBlockRequest")
-	proto.RegisterType((*GetBlocksRequest)(nil), "pfs.GetBlocksRequest")
-	proto.RegisterType((*ListBlockRequest)(nil), "pfs.ListBlockRequest")
-	proto.RegisterType((*TagObjectRequest)(nil), "pfs.TagObjectRequest")
-	proto.RegisterType((*ListObjectsRequest)(nil), "pfs.ListObjectsRequest")
-	proto.RegisterType((*ListTagsRequest)(nil), "pfs.ListTagsRequest")
-	proto.RegisterType((*ListTagsResponse)(nil), "pfs.ListTagsResponse")
-	proto.RegisterType((*DeleteObjectsRequest)(nil), "pfs.DeleteObjectsRequest")
-	proto.RegisterType((*DeleteObjectsResponse)(nil), "pfs.DeleteObjectsResponse")
-	proto.RegisterType((*DeleteTagsRequest)(nil), "pfs.DeleteTagsRequest")
-	proto.RegisterType((*DeleteTagsResponse)(nil), "pfs.DeleteTagsResponse")
-	proto.RegisterType((*CheckObjectRequest)(nil), "pfs.CheckObjectRequest")
-	proto.RegisterType((*CheckObjectResponse)(nil), "pfs.CheckObjectResponse")
-	proto.RegisterType((*Objects)(nil), "pfs.Objects")
-	proto.RegisterType((*PutObjDirectRequest)(nil), "pfs.PutObjDirectRequest")
-	proto.RegisterType((*GetObjDirectRequest)(nil), "pfs.GetObjDirectRequest")
-	proto.RegisterType((*DeleteObjDirectRequest)(nil), "pfs.DeleteObjDirectRequest")
-	proto.RegisterType((*ObjectIndex)(nil), "pfs.ObjectIndex")
-	proto.RegisterMapType((map[string]*BlockRef)(nil), "pfs.ObjectIndex.ObjectsEntry")
-	proto.RegisterMapType((map[string]*Object)(nil), "pfs.ObjectIndex.TagsEntry")
+type CheckObjectResponse struct {
+	Exists               bool     `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("client/pfs/pfs.proto", fileDescriptor_b48f014707f6595c) }
-
-var fileDescriptor_b48f014707f6595c = []byte{
-	// 4021 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0x4b, 0x73, 0xdb, 0x58,
-	0x76, 0x16, 0xf8, 0x04, 0x0e, 0x29, 0x11, 0xba, 0x92, 0x69, 0x9a, 0x6e, 0xb7, 0xdd, 0x70, 0x77,
-	0x8f, 0xdb, 0xdd, 0x23, 0x69, 0xa8, 0xf4, 0xc3, 0xf6, 0xb4, 0x5d, 0xd6, 0xab, 0x4d, 0x8f, 0xcb,
-	0x56, 0x40, 0xb5, 0xf2, 0xa8, 0x24, 0x2c, 0x90, 0xbc, 0x24, 0xd1, 0x86, 0x08, 0x0e, 0x00, 0x5a,
-	0xad, 0x59, 0x24, 0xbb, 0xcc, 0x8f, 0x48, 0xa5, 0x2a, 0x35, 0xeb, 0x2c, 0x52, 0xd9, 0xa5, 0xb2,
-	0xc8, 0x22, 0x9b, 0x54, 0x52, 0xa9, 0x9a, 0x5f, 0x90, 0x4a, 0xf5, 0xcf, 0xc8, 0x2a, 0x75, 0x5f,
-	0xc0, 0xc5, 0x83, 0x0f, 0xb9, 0x92, 0x45, 0xb7, 0x80, 0x7b, 0xcf, 0xb9, 0xf7, 0xdc, 0x73, 0xce,
-	0x3d, 0x8f, 0x0f, 0x34, 0x6c, 0xf7, 0x1d, 0x1b, 0x4f, 0x82, 0xdd, 0xe9, 0xd0, 0x27, 0xff, 0xed,
-	0x4c, 0x3d, 0x37, 0x70, 0x51, 0x7e, 0x3a, 0xf4, 0x9b, 0xb7, 0x47, 0xae, 0x3b, 0x72, 0xf0, 0x2e,
-	0x1d, 0xea, 0xcd, 0x86, 0xbb, 0xf8, 0x62, 0x1a, 0x5c, 0x31, 0x8a, 0xe6, 0xdd, 0xe4, 0x64, 0x60,
-	0x5f, 0x60, 0x3f, 0xb0, 0x2e, 0xa6, 0x9c, 0xe0, 0xc3, 0x24, 0xc1, 0xa5, 0x67, 0x4d, 0xa7, 0xd8,
-	0xe3, 0x5b, 0x34, 0xb7, 0x47, 0xee, 0xc8, 0xa5, 0x8f, 0xbb, 0xe4, 0x89, 0x8f, 0xd6, 0xb9, 0x38,
-	0xd6, 0x2c, 0x18, 0xd3, 0xff, 0xb1, 0x71, 0xa3, 0x09, 0x05, 0x13, 0x4f, 0x5d, 0x84, 0xa0, 0x30,
-	0xb1, 0x2e, 0x70, 0x43, 0xb9, 0xa7, 0x3c, 0xd0, 0x4c, 0xfa, 0x6c, 0x3c, 0x81, 0xd2, 0x81, 0x67,
-	0x4d, 0xfa, 0x63, 0x74, 0x07, 0x0a, 0x1e, 0x9e, 0xba, 0x74, 0xb6, 0xd2, 0xd2, 0x76, 0xc8, 0x81,
-	0x08, 0x9b, 0x49, 0x87, 0x43, 0xe6, 0x9c, 0xc4, 0xfc, 0x0c, 0x0a, 0x27, 0xb6, 0x83, 0xd1, 0x7d,
-	0x28, 0xf5, 0xdd, 0x8b, 0x0b, 0x3b, 0xe0, 0xcc, 0x15, 0xca, 0x7c, 0x48, 0x87, 0x4c, 0x3e, 0x45,
-	0x16, 0x98, 0x5a, 0xc1, 0x58, 0x2c, 0x40, 0x9e, 0x8d, 0xdb, 0x50, 0x3c, 0x70, 0xdc, 0xfe, 0x5b,
-	0x32, 0x39, 0xb6, 0xfc, 0xb1, 0x10, 0x8d, 0x3c, 0x1b, 0x1f, 0x40, 0xe9, 0x4d, 0xef, 0x07, 0xdc,
-	0x0f, 0x32, 0x67, 0x6f, 0x41, 0xfe, 0xcc, 0x1a, 0x65, 0x9e, 0xe9, 0x6f, 0x73, 0xa0, 0x12, 0xc9,
-	0xdb, 0x93, 0xa1, 0xbb, 0xec, 0x58, 0x7f, 0x00, 0xe5, 0xbe, 0x87, 0xad, 0x00, 0x0f, 0xa8, 0x60,
-	0x95, 0x56, 0x73, 0x87, 0xe9, 0x7e, 0x47, 0xe8, 0x7e, 0xe7, 0x4c, 0x18, 0xc7, 0x14, 0xa4, 0xe8,
-	0x0e, 0x80, 0x6f, 0xff, 0x06, 0x77, 0x7b, 0x57, 0x01, 0xf6, 0x1b, 0xf9, 0x7b, 0xca, 0x83, 0x82,
-	0xa9, 0x91, 0x91, 0x03, 0x32, 0x80, 0xee, 0x41, 0x65, 0x80, 0xfd, 0xbe, 0x67, 0x4f, 0x03, 0xdb,
-	0x9d, 0x34, 0x8a, 0x54, 0x36, 0x79, 0x08, 0xfd, 0x0c, 0xd4, 0x1e, 0x55, 0x3b, 0xf6, 0x1b, 0xe5,
-	0x7b, 0xf9, 0x50, 0x67, 0xcc, 0x16, 0x66, 0x38, 0x89, 0x76, 0x40, 0x23, 0x96, 0xec, 0xda, 0x93,
-	0xa1, 0xdb, 0x28, 0x51, 0x09, 0x37, 0xc3, 0x33, 0x3c, 0x9f, 0x05, 0x63, 0x72, 0x48, 0x53, 0xb5,
-	0xf8, 0x13, 0xfa, 0x00, 0xb4, 0xc0, 0xbd, 0xe8, 0xf9, 0x81, 0x3b, 0xc1, 0x0d, 0xf5, 0x9e, 0xf2,
-	0x40, 0x35, 0xa3, 0x81, 0x97, 0x05, 0xb5, 0xa0, 0x17, 0x8d, 0xa7, 0x50, 0x95, 0xb9, 0xd1, 0x0e,
-	0x54, 0xad, 0x7e, 0x1f, 0xfb, 0x7e, 0xd7, 0xc1, 0xef, 0xb0, 0x43, 0x55, 0xb5, 0xd1, 0xaa, 0xec,
-	0x50, 0x17, 0xea, 0xf4, 0xdd, 0x29, 0x36, 0x2b, 0x8c, 0xe0, 0x15, 0x99, 0x37, 0x7e, 0x97, 0x03,
-	0x60, 0x82, 0x52, 0xf6, 0xfb, 0x50, 0x62, 0xe2, 0x36, 0x0a, 0x92, 0xf5, 0xf9, 0x49, 0xf8, 0x14,
-	0xba, 0x0b, 0x85, 0x31, 0xb6, 0x84, 0x92, 0x63, 0x0e, 0x42, 0x27, 0xd0, 0xe7, 0x00, 0x53, 0xcf,
-	0x7d, 0x87, 0x27, 0xd6, 0xa4, 0x8f, 0x1b, 0xf9, 0xb4, 0x4e, 0xa4, 0x69, 0x42, 0xec, 0xcf, 0x7a,
-	0x82, 0xb8, 0x98, 0x41, 0x1c, 0x4d, 0xa3, 0x6f, 0x60, 0x73, 0x60, 0x7b, 0xb8, 0x1f, 0x74, 0xa5,
-	0x0d, 0x4a, 0x69, 0x1e, 0x9d, 0x51, 0x9d, 0x46, 0xdb, 0x7c, 0x0a, 0xe5, 0xc0, 0xb3, 0x47, 0x23,
-	0xec, 0x35, 0xca, 0x54, 0xee, 0x2a, 0xa5, 0x3f, 0x63, 0x63, 0xa6, 0x98, 0xcc, 0x74, 0xc2, 0x67,
-	0x50, 0x89, 0x74, 0xe4, 0xa3, 0x3d, 0xa8, 0x30, 0x4d, 0x30, 0x4b, 0x2a, 0x74, 0xfb, 0x9a, 0xb4,
-	0x3d, 0xb5, 0x23, 0xf4, 0xc2, 0x67, 0xe3, 0x2f, 0xa1, 0xcc, 0x37, 0x42, 0xf5, 0x50, 0xc3, 0x6c,
-	0x07, 0xa1, 0x54, 0x1d, 0xf2, 0x96, 0xe3, 0x50, 0x9d, 0xaa, 0x26, 0x79, 0x44, 0xb7, 0x41, 0xeb,
-	0x7b, 0xee, 0xa4, 0xeb, 0x4f, 0x71, 0x9f, 0xfa, 0xa5, 0x66, 0xaa, 0x64, 0xa0, 0x33, 0xc5, 0x7d,
-	0x22, 0x26, 0xf1, 0x51, 0x6a, 0x26, 0xcd, 0xa4, 0xcf, 0xa8, 0x01, 0x65, 0x76, 0x3f, 0x7d, 0xea,
-	0xa6, 0x79, 0x53, 0xbc, 0x1a, 0xfb, 0x50, 0x65, 0x06, 0x7a, 0xe3, 0xd9, 0x23, 0x7b, 0x82, 0xee,
-	0x43, 0xe1, 0xad, 0x3d, 0x19, 0x70, 0xef, 0x60, 0xa2, 0xb3, 0xa9, 0x5f, 0xd9, 0x93, 0x81, 0x49,
-	0x27, 0x8d, 0x67, 0x50, 0x62, 0x4c, 0xcb, 0xee, 0x5d, 0x1d, 0x72, 0x36, 0xf3, 0x06, 0xed, 0xa0,
-	0xf4, 0xd3, 0x7f, 0xdd, 0xcd, 0xb5, 0x8f, 0xcc, 0x9c, 0x3d, 0x30, 0x3a, 0x50, 0xe1, 0x6e, 0x61,
-	0x4d, 0x46, 0x18, 0x7d, 0x04, 0x45, 0xc7, 0xbd, 0xc4, 0x5e, 0x56, 0x60, 0x61, 0x33, 0x84, 0x64,
-	0x46, 0x62, 0x63, 0x96, 0x6b, 0xb1, 0x19, 0xe3, 0xcf, 0x40, 0x67, 0x03, 0x92, 0x6d, 0x57, 0x8a,
-	0x59, 0x91, 0x6b, 0xe7, 0xe6, 0xba, 0xb6, 0xf1, 0x9f, 0x25, 0x00, 0xc6, 0x27, 0xae, 0xc3, 0x75,
-	0x16, 0xae, 0xcd, 0xbf, 0x33, 0x9f, 0x41, 0xc9, 0xa5, 0x0a, 0x6e, 0x6c, 0x4a, 0x17, 0x5f, 0x36,
-	0x8a, 0xc9, 0x09, 0x92, 0x11, 0x47, 0x4d, 0x47, 0x9c, 0x3d, 0x58, 0x9f, 0x5a, 0x1e, 0x9e, 0x04,
-	0x5d, 0x2e, 0x5d, 0x86, 0xba, 0xaa, 0x8c, 0x82, 0x5b, 0x70, 0x0f, 0xd6, 0xfb, 0x63, 0xdb, 0x19,
-	0x74, 0x85, 0x83, 0x54, 0xa4, 0x3b, 0x23, 0x38, 0x28, 0x05, 0x7b, 0xf1, 0x49, 0x30, 0xf5, 0x03,
-	0xcb, 0x23, 0xc1, 0x34, 0xbf, 0x3c, 0x98, 0x72, 0x52, 0xf4, 0x15, 0xa8, 0x43, 0x7b, 0x62, 0xfb,
-	0x63, 0x3c, 0xe0, 0x11, 0x64, 0x11, 0x5b, 0x48, 0x9b, 0x08, 0xc2, 0xc5, 0x64, 0x10, 0xfe, 0x32,
-	0x16, 0x50, 0x74, 0x2a, 0xfb, 0x0d, 0x49, 0xf6, 0xc8, 0x17, 0x62, 0xa1, 0xe5, 0x33, 0xd0, 0x3d,
-	0x6c, 0x0d, 0xae, 0xe4, 0x60, 0x51, 0xa5, 0x37, 0xa3, 0x46, 0xc7, 0x25, 0x17, 0xda, 0x8b, 0x45,
-	0x21, 0x8d, 0xee, 0xa0, 0xcb, 0xda, 0x21, 0x2e, 0x1c, 0x0b, 0x45, 0x77, 0xa1, 0x10, 0x78, 0x18,
-	0xf3, 0x68, 0xc2, 0x34, 0xc9, 0x72, 0x9c, 0x49, 0x27, 0x88, 0x33, 0x93, 0xbf, 0x7e, 0x63, 0x5d,
-	0xd2, 0x35, 0xa7, 0x60, 0x33, 0xc4, 0x75, 0x06, 0x56, 0x30, 0xbb, 0xf0, 0x1b, 0x1b, 0xe9, 0x55,
-	0xf8, 0x14, 0x7a, 0x0c, 0xb7, 0xc4, 0xb6, 0xc2, 0xe0, 0x7e, 0xd7, 0x9f, 0xd1, 0x20, 0xde, 0x40,
-	0xf4, 0x38, 0x37, 0x43, 0x02, 0x6e, 0xbe, 0x0e, 0x9b, 0xce, 0xe6, 0x1d, 0x5a, 0xb6, 0x33, 0xf3,
-	0x70, 0x63, 0x2b, 0x9b, 0xf7, 0x84, 0x4d, 0xa3, 0xaf, 0xe0, 0x66, 0x9a, 0x37, 0x70, 0x03, 0xcb,
-	0x69, 0x6c, 0x53, 0xce, 0x1b, 0x49, 0xce, 0x33, 0x32, 0xf9, 0xb2, 0xa0, 0x96, 0xf4, 0xf2, 0xcb,
-	0x82, 0x0a, 0x7a, 0xc5, 0xf8, 0xc7, 0x1c, 0xa8, 0xa4, 0xac, 0x10, 0xe9, 0x7b, 0x68, 0x3b, 0x38,
-	0x16, 0x46, 0xc8, 0xa4, 0x49, 0x87, 0xd1, 0x43, 0xd0, 0xc8, 0xdf, 0x6e, 0x70, 0x35, 0x65, 0xa5,
-	0xc9, 0x46, 0x6b, 0x3d, 0xa4, 0x39, 0xbb, 0x9a, 0x62, 0xe2, 0x2f, 0xec, 0x69, 0x59, 0xd2, 0xfe,
-	0x06, 0x34, 0x26, 0x30, 0x71, 0x5f, 0x58, 0xea, 0x87, 0x11, 0x31, 0x6a, 0x82, 0x4a, 0xaf, 0x81,
-	0x87, 0x27, 0x34, 0xaf, 0x90, 0x98, 0xcb, 0xdf, 0xd1, 0x27, 0x50, 0x76, 0xa9, 0x69, 0xfc, 0x86,
-	0x9a, 0x36, 0xa9, 0x98, 0x43, 0x9f, 0x83, 0xd6, 0x23, 0x85, 0x90, 0x89, 0x87, 0x3e, 0xf7, 0x24,
-	0x76, 0x8e, 0x03, 0x3e, 0x6a, 0x46, 0xf3, 0x61, 0x39, 0x44, 0xbc, 0xa8, 0xca, 0xcb, 0xa1, 0xaf,
-	0x41, 0x23, 0xc7, 0x60, 0x51, 0x73, 0x5b, 0x8e, 0x9a, 0x05, 0x11, 0x28, 0xb7, 0xe5, 0x40, 0x59,
-	0x10, 0xb1, 0xd1, 0x04, 0x55, 0xec, 0x81, 0xee, 0x41, 0x91, 0xee, 0xc2, 0xb5, 0x0d, 0x92, 0x04,
-	0x6c, 0x02, 0x7d, 0x0c, 0x45, 0x8f, 0x6c, 0xc1, 0xa3, 0xc7, 0x06, 0xa3, 0x10, 0x1b, 0x9b, 0x6c,
-	0xd2, 0xf8, 0x73, 0x00, 0x76, 0x40, 0x11, 0x10, 0xd9, 0x31, 0x63, 0x01, 0x51, 0x38, 0x2c, 0x9b,
-	0x22, 0x86, 0xa4, 0x3b, 0x74, 0x3d, 0x3c, 0xe4, 0x8b, 0x27, 0x14, 0xa0, 0x0a, 0x05, 0x18, 0xfb,
-	0x34, 0xde, 0x4e, 0xad, 0x3e, 0x0d, 0x6c, 0x9f, 0xc0, 0x86, 0x3d, 0x99, 0xce, 0x48, 0x76, 0xc7,
-	0x43, 0xfb, 0x47, 0xec, 0x37, 0x72, 0xd4, 0x06, 0xeb, 0x74, 0xf4, 0x94, 0x0f, 0x1a, 0x7f, 0x05,
-	0xc5, 0xce, 0xd8, 0xf2, 0x06, 0x68, 0x17, 0xa0, 0x1f, 0x72, 0x73, 0x91, 0x6a, 0xe2, 0xd6, 0xf2,
-	0x61, 0x53, 0x22, 0xc9, 0x3e, 0xf3, 0xa9, 0x15, 0x8c, 0xe5, 0x33, 0xa3, 0xbb, 0x50, 0x71, 0x67,
-	0x01, 0x95, 0x83, 0x54, 0xb9, 0x2c, 0xf7, 0x02, 0x1b, 0x22, 0xc4, 0xc4, 0x42, 0x21, 0x53, 0xdc,
-	0x42, 0x5a, 0xa6, 0x85, 0x34, 0x61, 0x21, 0x0f, 0x36, 0x0f, 0x69, 0xdd, 0x49, 0xd3, 0x27, 0xfe,
-	0xf5, 0x0c, 0xfb, 0x4b, 0xd3, 0x6b, 0x22, 0x1f, 0xe4, 0xd3, 0xf9, 0xa0, 0x0e, 0xa5, 0xd9, 0x74,
-	0x60, 0x05, 0xac, 0x1c, 0x50, 0x4d, 0xfe, 0xf6, 0xb2, 0xa0, 0xe6, 0xf4, 0xbc, 0xb1, 0x0f, 0xa8,
-	0x3d, 0x21, 0x45, 0x44, 0xb0, 0xfa, 0xa6, 0xc6, 0x4d, 0xa8, 0xbd, 0xb2, 0x7d, 0x99, 0xe3, 0x65,
-	0x41, 0x55, 0xf4, 0x9c, 0xf1, 0x14, 0xf4, 0x68, 0xc2, 0x9f, 0xba, 0x13, 0x9f, 0xde, 0x5c, 0xc2,
-	0x24, 0x97, 0x43, 0xeb, 0xe1, 0x82, 0xac, 0xa8, 0xf5, 0xf8, 0x93, 0xf1, 0x5b, 0x05, 0x36, 0x8f,
-	0xb0, 0x83, 0xaf, 0xa5, 0x82, 0x6d, 0x28, 0x0e, 0x5d, 0xaf, 0x8f, 0x79, 0x79, 0xc4, 0x5e, 0x44,
-	0xc9, 0x94, 0x8f, 0x4a, 0xa6, 0xcf, 0x61, 0xd3, 0x9f, 0x3a, 0x76, 0xd0, 0x0d, 0x3c, 0x6b, 0xe2,
-	0x73, 0xb7, 0x60, 0x3a, 0xd1, 0xe9, 0xc4, 0x59, 0x34, 0x6e, 0xfc, 0x83, 0x02, 0xa8, 0x43, 0xf2,
-	0x16, 0x8f, 0xf0, 0x5c, 0x94, 0xfb, 0x50, 0x62, 0xa9, 0x33, 0x33, 0xe7, 0xb3, 0xa9, 0xa4, 0x4d,
-	0x0a, 0x99, 0x36, 0xe1, 0x55, 0x41, 0x3e, 0x56, 0xe7, 0xc5, 0x53, 0x59, 0x71, 0xc5, 0x54, 0xc6,
-	0x4d, 0xf9, 0x2f, 0x79, 0x40, 0x07, 0xb3, 0x30, 0x4b, 0x5f, 0x4b, 0xe4, 0x7a, 0xac, 0xb4, 0xd7,
-	0x32, 0x2a, 0x93, 0xea, 0xb2, 0xca, 0x24, 0x2e, 0x7b, 0x69, 0xd5, 0x34, 0x2c, 0x32, 0x65, 0x7e,
-	0x69, 0xa6, 0x2c, 0xaf, 0x90, 0x29, 0xd5, 0xf9, 0x99, 0x72, 0x03, 0x72, 0xed, 0x23, 0xde, 0xa2,
-	0xe5, 0xda, 0x47, 0x89, 0x2c, 0xa1, 0x25, 0xb3, 0x84, 0x54, 0xe2, 0xc0, 0xfb, 0x95, 0x38, 0x95,
-	0xd5, 0x4b, 0x1c, 0x6e, 0xc1, 0xff, 0x51, 0x60, 0xeb, 0x84, 0x0e, 0xa5, 0x4c, 0xb8, 0xbc, 0xd2,
-	0x4c, 0x78, 0x5d, 0x2e, 0xed, 0x75, 0xab, 0xab, 0xba, 0xb8, 0x82, 0xaa, 0xcb, 0xf3, 0x55, 0x1d,
-	0x57, 0x6d, 0x29, 0xa9, 0xda, 0x6d, 0x28, 0x52, 0x90, 0x84, 0x5f, 0x3e, 0xf6, 0x62, 0x4c, 0x60,
-	0x9b, 0x47, 0xa2, 0xf7, 0x38, 0xfc, 0x2f, 0xa0, 0xc2, 0xb2, 0x8a, 0x1f, 0x90, 0x48, 0xc7, 0x0a,
-	0x04, 0xb9, 0x44, 0xeb, 0x90, 0x71, 0x13, 0x28, 0x11, 0x7d, 0x36, 0x7e, 0xa7, 0xc0, 0x26, 0x09,
-	0x56, 0xf1, 0xdd, 0x96, 0xc4, 0x9a, 0xbb, 0x50, 0x18, 0x7a, 0xee, 0x45, 0x66, 0x77, 0x4b, 0x26,
-	0xd0, 0x6d, 0xc8, 0x05, 0x6e, 0x4c, 0xc3, 0x7c, 0x3a, 0x17, 0x90, 0x5e, 0xa8, 0x34, 0x99, 0x5d,
-	0xf4, 0xb0, 0x47, 0x4f, 0x5e, 0x30, 0xf9, 0x1b, 0xe9, 0xcd, 0x3c, 0xfc, 0x0e, 0x7b, 0x3e, 0xa6,
-	0xfe, 0xa9, 0x9a, 0xe2, 0x95, 0x34, 0x97, 0x51, 0xc7, 0x41, 0x9b, 0x4b, 0x76, 0xe0, 0x74, 0x73,
-	0x19, 0x91, 0xd1, 0x9c, 0xc6, 0x9f, 0x8d, 0xff, 0x50, 0x60, 0x8b, 0x25, 0x15, 0xde, 0x73, 0xf0,
-	0x73, 0x8a, 0x36, 0x5d, 0x99, 0xd7, 0xa6, 0xdf, 0x02, 0xd5, 0xef, 0x4a, 0x3d, 0x91, 0x66, 0x96,
-	0x7d, 0x0e, 0x20, 0xdd, 0x8f, 0x45, 0xaf, 0x39, 0x3d, 0x4d, 0xbc, 0xcd, 0x2f, 0x2c, 0x6e, 0xf3,
-	0xa5, 0xfe, 0xbb, 0xb8, 0xa0, 0xff, 0x36, 0x9e, 0x84, 0x3e, 0x12, 0x3f, 0xcd, 0xfd, 0x58, 0xdf,
-	0x3c, 0xa7, 0x7d, 0x7b, 0xc5, 0xec, 0x1d, 0xe7, 0x5c, 0x62, 0x6f, 0xc9, 0x32, 0xb9, 0xb8, 0x65,
-	0x4e, 0x61, 0x8b, 0x65, 0xaa, 0xeb, 0x4b, 0x92, 0x9d, 0xb1, 0x8c, 0xc7, 0x62, 0xc5, 0xeb, 0xfb,
-	0xbf, 0x61, 0x01, 0x3a, 0x71, 0x66, 0xc9, 0xb8, 0xf1, 0x49, 0xd4, 0xf3, 0x2b, 0xe9, 0x96, 0x4e,
-	0xcc, 0xa1, 0x8f, 0x41, 0x0d, 0xdc, 0x2e, 0x39, 0x2f, 0x2b, 0xa9, 0x62, 0x7a, 0x28, 0x07, 0x2e,
-	0xf9, 0xeb, 0x1b, 0xff, 0xaa, 0x40, 0xbd, 0x33, 0xeb, 0x91, 0x70, 0xd2, 0xc3, 0xd7, 0xba, 0x34,
-	0xf5, 0x58, 0x73, 0x2d, 0x27, 0x97, 0x02, 0xf1, 0x01, 0x6e, 0xf2, 0x39, 0xb9, 0x82, 0x92, 0x84,
-	0xf7, 0x2e, 0x3f, 0xef, 0xde, 0x7d, 0x0a, 0x45, 0x76, 0xf5, 0x0b, 0x73, 0xae, 0x3e, 0x9b, 0x36,
-	0x7e, 0x0d, 0x1b, 0xdf, 0xe1, 0x80, 0x36, 0x16, 0x91, 0xf0, 0x8b, 0x1a, 0x8f, 0x8f, 0xa0, 0xea,
-	0x0e, 0x87, 0x3e, 0x0e, 0x78, 0x34, 0xcb, 0xd1, 0xee, 0xa6, 0xc2, 0xc6, 0x58, 0x3c, 0x4b, 0xf7,
-	0x1b, 0x79, 0x29, 0xdc, 0x19, 0x9f, 0xc2, 0xc6, 0x9b, 0x77, 0xd8, 0xbb, 0xf4, 0xec, 0x00, 0xb7,
-	0x27, 0x03, 0xfc, 0x23, 0xb1, 0xbf, 0x4d, 0x1e, 0xe8, 0x9e, 0x79, 0x93, 0xbd, 0x18, 0x7f, 0x9d,
-	0x87, 0x8d, 0xd3, 0xd9, 0x75, 0x64, 0xdb, 0x86, 0xe2, 0x3b, 0xcb, 0x99, 0xb1, 0x88, 0x5e, 0x35,
-	0xd9, 0x0b, 0xa9, 0x7c, 0x66, 0x9e, 0xc3, 0x33, 0x1d, 0x79, 0x44, 0x1f, 0x90, 0x12, 0xac, 0x3f,
-	0xf3, 0x7c, 0xfb, 0x1d, 0xa6, 0xe1, 0x58, 0x35, 0xa3, 0x01, 0xf4, 0x05, 0x68, 0x03, 0xec, 0xd8,
-	0x17, 0x76, 0xc0, 0xe1, 0xaf, 0x0d, 0x5e, 0xfa, 0x1e, 0x89, 0x51, 0x33, 0x22, 0x40, 0x5f, 0x00,
-	0x0a, 0x2c, 0x6f, 0x84, 0x83, 0x2e, 0xed, 0xc7, 0xa4, 0xbc, 0x9b, 0x37, 0x75, 0x36, 0x43, 0x24,
-	0x3c, 0x62, 0x99, 0xe0, 0x21, 0x6c, 0xca, 0xd4, 0x51, 0xae, 0xcd, 0x9b, 0xb5, 0x88, 0x98, 0xa9,
-	0xf1, 0x13, 0xd8, 0x20, 0x91, 0x07, 0x7b, 0x5d, 0x0f, 0xf7, 0x5d, 0x6f, 0xe0, 0xd3, 0x0c, 0x9a,
-	0x37, 0xd7, 0xd9, 0xa8, 0xc9, 0x06, 0xd1, 0x2f, 0xa1, 0xe6, 0x0a, 0x75, 0x76, 0x99, 0x1a, 0x59,
-	0x82, 0xde, 0x62, 0xa9, 0x28, 0xa6, 0x6a, 0x73, 0xc3, 0x8d, 0xab, 0xbe, 0x0e, 0xa5, 0x01, 0xbd,
-	0x64, 0xb4, 0xa0, 0x51, 0x4d, 0xfe, 0xc6, 0x12, 0x30, 0x87, 0x4d, 0xff, 0x49, 0x81, 0xf5, 0xd0,
-	0x10, 0x64, 0xd3, 0x84, 0x85, 0x95, 0x84, 0x85, 0x69, 0x4b, 0x40, 0x33, 0x60, 0x97, 0xb6, 0x6b,
-	0x39, 0xde, 0x12, 0xd0, 0xa1, 0x17, 0x96, 0x3f, 0xce, 0x92, 0x39, 0xbf, 0xba, 0xcc, 0xb1, 0x96,
-	0xa9, 0xb0, 0xb8, 0x65, 0xfa, 0x77, 0x45, 0x72, 0x22, 0xa6, 0xb0, 0x6d, 0x28, 0xd2, 0xf2, 0x96,
-	0xca, 0xad, 0x9a, 0xec, 0x05, 0x7d, 0x41, 0x22, 0x1b, 0x53, 0x33, 0xbb, 0xf3, 0x88, 0xb5, 0x3b,
-	0x32, 0xaf, 0x29, 0x48, 0xe2, 0x68, 0x73, 0x3e, 0x81, 0x36, 0xa3, 0x87, 0x50, 0x62, 0x36, 0xe2,
-	0xd2, 0x65, 0x2d, 0xc5, 0x29, 0x08, 0xed, 0xd0, 0x75, 0x83, 0x30, 0xd2, 0x67, 0xd2, 0x32, 0x0a,
-	0xc3, 0x86, 0xda, 0xa1, 0x3b, 0xbd, 0x92, 0x6f, 0xc4, 0x6d, 0xc8, 0xfb, 0x5e, 0x3f, 0x7d, 0x21,
-	0xc8, 0x28, 0x99, 0x1c, 0xf8, 0x02, 0xf0, 0x92, 0x27, 0x07, 0x7e, 0x40, 0x8e, 0x10, 0xea, 0x55,
-	0x1c, 0x21, 0x1c, 0x90, 0xfa, 0xa0, 0xd5, 0xef, 0x9f, 0xf1, 0x17, 0xac, 0x0f, 0xba, 0xc6, 0x8d,
-	0x45, 0x50, 0x18, 0xce, 0x42, 0x24, 0x97, 0x3e, 0x93, 0x1c, 0x33, 0xb6, 0xfd, 0xc0, 0xf5, 0xae,
-	0x78, 0xec, 0x10, 0xaf, 0xc6, 0x1e, 0xd4, 0xfe, 0xc8, 0x72, 0xde, 0x5e, 0x43, 0xa2, 0x53, 0xa8,
-	0x7d, 0xe7, 0xb8, 0x3d, 0x99, 0x63, 0xa5, 0xfa, 0xa9, 0x01, 0xe5, 0xa9, 0x15, 0x04, 0xd8, 0x13,
-	0x85, 0xa3, 0x78, 0x25, 0xdd, 0xac, 0xc0, 0x68, 0xfc, 0x10, 0x85, 0x49, 0xf5, 0x72, 0x82, 0x84,
-	0xa1, 0x30, 0xb4, 0xf2, 0xb8, 0x84, 0xda, 0x91, 0x3d, 0x1c, 0xca, 0xa2, 0x7c, 0x0c, 0xea, 0x04,
-	0x5f, 0x76, 0xb3, 0x0f, 0x50, 0x9e, 0xe0, 0x4b, 0xfa, 0x91, 0xe9, 0x63, 0x50, 0x5d, 0x67, 0xc0,
-	0xa8, 0x52, 0xa6, 0x2c, 0xbb, 0xce, 0x80, 0x52, 0x35, 0xa0, 0xec, 0x8f, 0x2d, 0xc7, 0x71, 0x2f,
-	0xb9, 0x31, 0xc5, 0xab, 0xf1, 0x03, 0xe8, 0xd1, 0xc6, 0x51, 0x13, 0x2a, 0x76, 0xf6, 0xe7, 0x08,
-	0xce, 0xb7, 0xa7, 0x87, 0x14, 0xfb, 0x8b, 0xbb, 0x91, 0xa4, 0xe5, 0x42, 0xf8, 0x46, 0x4b, 0xf4,
-	0xab, 0xd7, 0xb0, 0xd1, 0x5d, 0xa8, 0x9c, 0xf8, 0xe4, 0xb6, 0x32, 0x6a, 0x1d, 0xf2, 0x43, 0xfb,
-	0x47, 0x7e, 0x39, 0xc9, 0xa3, 0xf1, 0x15, 0x54, 0x19, 0x01, 0x17, 0x5e, 0xa2, 0xd0, 0x28, 0x05,
-	0xad, 0xa0, 0x3d, 0xcf, 0x0d, 0xf1, 0x03, 0xfa, 0x62, 0xfc, 0xb3, 0x02, 0x75, 0xb2, 0xcf, 0x9b,
-	0x29, 0xf6, 0x2c, 0x8a, 0x6e, 0xb0, 0x2d, 0xce, 0x5b, 0xab, 0x39, 0xc1, 0x2e, 0x94, 0xa7, 0xb3,
-	0xa0, 0x1b, 0x58, 0x02, 0x62, 0xdf, 0x16, 0x77, 0xf3, 0xcc, 0xf2, 0xc2, 0xb5, 0x5e, 0xac, 0x99,
-	0xa5, 0x29, 0x1d, 0x42, 0x4f, 0xa1, 0xca, 0xc2, 0x27, 0x57, 0x16, 0x8b, 0x69, 0xb7, 0x44, 0xf2,
-	0xe0, 0x6a, 0xf1, 0x65, 0xd6, 0xca, 0x20, 0x1a, 0x3f, 0xa8, 0x80, 0xe6, 0x0a, 0x59, 0x8d, 0xef,
-	0xa1, 0x96, 0xd8, 0x29, 0x7e, 0x65, 0x95, 0xc4, 0x95, 0x25, 0x6a, 0x09, 0xac, 0x11, 0x57, 0x01,
-	0x79, 0x24, 0xb7, 0x6b, 0x60, 0x05, 0x16, 0x4f, 0x87, 0xf4, 0xd9, 0x78, 0x0a, 0xdb, 0x59, 0xa2,
-	0xd0, 0x1a, 0x2c, 0xf4, 0x06, 0xcd, 0x64, 0x2f, 0xe9, 0x35, 0xc9, 0x1d, 0xfc, 0x0e, 0xc7, 0xc5,
-	0x5a, 0x62, 0xdf, 0x31, 0xa0, 0xa4, 0xff, 0x9d, 0xb7, 0xd0, 0x03, 0xc9, 0xab, 0x15, 0x29, 0x86,
-	0x87, 0x4e, 0x15, 0x7a, 0xf6, 0x03, 0xe9, 0x96, 0xe4, 0x32, 0x29, 0xb9, 0xab, 0x1a, 0x8f, 0xa0,
-	0xc1, 0x6a, 0xfb, 0xb3, 0x8b, 0x29, 0x19, 0xe8, 0xe0, 0x20, 0x74, 0x9a, 0x3b, 0x00, 0xf4, 0x48,
-	0x38, 0xe8, 0xda, 0x03, 0xee, 0x3b, 0x1a, 0x1f, 0x69, 0x0f, 0x8c, 0x3f, 0x86, 0xba, 0x89, 0x27,
-	0xf8, 0x52, 0xe6, 0x14, 0xde, 0xbb, 0x88, 0x91, 0xe4, 0xba, 0x20, 0x70, 0xba, 0x3e, 0xee, 0xbb,
-	0x93, 0x81, 0x28, 0x87, 0x20, 0x08, 0x9c, 0x0e, 0x1b, 0x21, 0x35, 0xfa, 0xa1, 0x83, 0x2d, 0x2f,
-	0x56, 0x22, 0xae, 0xe8, 0x82, 0xc6, 0x18, 0xf4, 0xd3, 0x59, 0xc0, 0xdb, 0x49, 0x2e, 0x50, 0x58,
-	0xe5, 0x28, 0x72, 0x95, 0xf3, 0x01, 0x14, 0x02, 0x6b, 0x24, 0x2e, 0xa8, 0xca, 0xfa, 0x05, 0x6b,
-	0x64, 0xd2, 0xd1, 0x08, 0xe0, 0xcc, 0xcf, 0x01, 0x38, 0x8d, 0xa1, 0xe8, 0x8b, 0xe2, 0x9b, 0xfd,
-	0x9f, 0x63, 0x98, 0x7f, 0xa3, 0xc0, 0xe6, 0x77, 0x98, 0x1f, 0xc9, 0x97, 0x2a, 0x73, 0x81, 0x16,
-	0x2b, 0x0b, 0xd0, 0xe2, 0xac, 0xe2, 0xb3, 0xb0, 0xac, 0xf8, 0x8c, 0xf5, 0xda, 0x77, 0x00, 0x28,
-	0x2a, 0xdf, 0x0d, 0x3f, 0x08, 0x16, 0x48, 0xe6, 0x0e, 0x2c, 0xa7, 0x63, 0xff, 0x06, 0x1b, 0x6d,
-	0x7a, 0xe9, 0xb8, 0xd8, 0x4c, 0xb4, 0xe5, 0xd8, 0x70, 0x68, 0x90, 0x9c, 0x64, 0x10, 0x63, 0x9f,
-	0x5e, 0x94, 0xeb, 0x2d, 0x65, 0xfc, 0x9d, 0x02, 0xba, 0xe0, 0x0a, 0x95, 0x13, 0xc3, 0xc8, 0x95,
-	0x25, 0x18, 0xf9, 0xff, 0xbb, 0x8a, 0x10, 0xc3, 0x34, 0xe5, 0x83, 0x19, 0xdf, 0x83, 0x7e, 0x66,
-	0x8d, 0xde, 0xc3, 0x73, 0x16, 0x7a, 0xad, 0xb1, 0x0d, 0x88, 0x6c, 0x15, 0xf7, 0x15, 0x92, 0xd3,
-	0xc9, 0xe8, 0x99, 0x35, 0x0a, 0x35, 0x54, 0x87, 0x12, 0x03, 0xc1, 0xc5, 0x77, 0x62, 0xf6, 0xc6,
-	0x20, 0xf2, 0xbe, 0x33, 0x1b, 0xe0, 0x2e, 0x97, 0x85, 0x15, 0x1a, 0xeb, 0x7c, 0x94, 0xad, 0x6c,
-	0x74, 0xd8, 0x91, 0xd8, 0x8a, 0x3c, 0x5e, 0x34, 0x59, 0xe4, 0x63, 0xb2, 0x47, 0x82, 0xd1, 0xb8,
-	0x1a, 0x1d, 0x2d, 0x37, 0xf7, 0x68, 0xc6, 0xb7, 0x22, 0xd0, 0xbe, 0x97, 0xab, 0x1b, 0x37, 0xe1,
-	0x46, 0x82, 0x9d, 0x09, 0x66, 0xfc, 0x42, 0xa4, 0x58, 0x59, 0x01, 0x42, 0x8f, 0xca, 0x3c, 0x3d,
-	0xca, 0x2c, 0x7c, 0xa1, 0x47, 0x80, 0x0e, 0xc7, 0xb8, 0xff, 0xf6, 0xfa, 0x66, 0x33, 0x7e, 0x0e,
-	0x5b, 0x31, 0x56, 0xae, 0xb3, 0x3a, 0x94, 0xf0, 0x8f, 0xb6, 0x1f, 0xf8, 0x3c, 0x39, 0xf1, 0x37,
-	0x63, 0x0f, 0xca, 0xfc, 0x14, 0xab, 0x9e, 0xfe, 0x5b, 0xd8, 0x62, 0x71, 0xef, 0x88, 0xfe, 0x34,
-	0x41, 0xaa, 0x0d, 0xdc, 0xde, 0x0f, 0x22, 0xf3, 0xbb, 0xbd, 0x1f, 0xe6, 0xdc, 0xbd, 0x9f, 0xc1,
-	0x16, 0x8b, 0x31, 0x4b, 0xd8, 0x8d, 0x17, 0x50, 0x0f, 0xb5, 0x1c, 0xa7, 0xad, 0xc7, 0xf4, 0xa0,
-	0x85, 0x1e, 0x1b, 0xb9, 0x5a, 0x4e, 0x76, 0x35, 0xe3, 0xb7, 0x39, 0xa8, 0x88, 0x6f, 0x3f, 0xa4,
-	0x49, 0xf9, 0x3a, 0x79, 0xd0, 0x3b, 0xd2, 0x41, 0x29, 0x09, 0x7f, 0xf6, 0x8f, 0x27, 0x81, 0x77,
-	0x15, 0xc5, 0xb8, 0x9d, 0xd8, 0x95, 0x68, 0xa6, 0xb8, 0x88, 0x0d, 0x19, 0x0b, 0xa5, 0x6b, 0xb6,
-	0xa1, 0x2a, 0x2f, 0x44, 0x0e, 0xf9, 0x16, 0x5f, 0x89, 0x43, 0xbe, 0xc5, 0x57, 0xe8, 0xbe, 0xac,
-	0xa3, 0x54, 0xec, 0x60, 0x73, 0x8f, 0x73, 0xdf, 0x28, 0xcd, 0x23, 0xd0, 0xc2, 0xd5, 0x33, 0xd6,
-	0xf9, 0x28, 0xbe, 0x4e, 0x1c, 0x0e, 0x0d, 0x57, 0x79, 0xf8, 0x10, 0x20, 0xfa, 0x79, 0x04, 0x52,
-	0xa1, 0xf0, 0x7d, 0xe7, 0xd8, 0xd4, 0xd7, 0xc8, 0xd3, 0xf3, 0xef, 0xcf, 0xde, 0xe8, 0x0a, 0x79,
-	0x3a, 0xe9, 0x1c, 0xfe, 0x4a, 0xcf, 0x3d, 0xfc, 0x9c, 0x7d, 0xf1, 0xa4, 0x9f, 0x29, 0xab, 0xa0,
-	0x9a, 0xc7, 0x9d, 0x63, 0xf3, 0xfc, 0xf8, 0x88, 0x51, 0x9f, 0xb4, 0x5f, 0x1d, 0xeb, 0x0a, 0x2a,
-	0x43, 0xfe, 0xa8, 0x6d, 0xea, 0xb9, 0x87, 0xfb, 0x02, 0xfc, 0xa3, 0x08, 0x06, 0xaa, 0x40, 0xb9,
-	0x73, 0xf6, 0xdc, 0x3c, 0xa3, 0xe4, 0x1a, 0x14, 0xcd, 0xe3, 0xe7, 0x47, 0x7f, 0xa2, 0x2b, 0x64,
-	0x9d, 0x93, 0xf6, 0xeb, 0x76, 0xe7, 0xc5, 0xf1, 0x91, 0x9e, 0x7b, 0xf8, 0x04, 0xb4, 0xb0, 0x6f,
-	0x27, 0x8b, 0xbe, 0x7e, 0xf3, 0xfa, 0x98, 0x2d, 0xff, 0xb2, 0xf3, 0xe6, 0x35, 0x13, 0xe6, 0x55,
-	0xfb, 0xf5, 0xb1, 0x9e, 0x23, 0x1b, 0x75, 0xfe, 0xf0, 0x95, 0x9e, 0x27, 0x0f, 0x87, 0x9d, 0x73,
-	0xbd, 0xd0, 0xfa, 0xfd, 0x26, 0xe4, 0x9f, 0x9f, 0xb6, 0xd1, 0x53, 0x80, 0xe8, 0x4b, 0x14, 0xaa,
-	0xb3, 0x4c, 0x9d, 0xfc, 0x34, 0xd5, 0xac, 0xa7, 0xa0, 0xed, 0x63, 0x8a, 0xe4, 0xae, 0xa1, 0xaf,
-	0xa1, 0x22, 0x7d, 0x55, 0x42, 0x37, 0xe9, 0x02, 0xe9, 0xef, 0x4c, 0xcd, 0xf8, 0x87, 0x20, 0x63,
-	0x0d, 0x3d, 0x02, 0x55, 0x7c, 0x40, 0x42, 0xac, 0xfa, 0x4c, 0x7c, 0x68, 0x6a, 0xde, 0x48, 0x8c,
-	0xf2, 0xcb, 0xbd, 0x46, 0x64, 0x8e, 0x3e, 0x1d, 0x71, 0x99, 0x53, 0xdf, 0x92, 0x16, 0xc8, 0xfc,
-	0x25, 0x54, 0xa4, 0x0f, 0x3e, 0x5c, 0xe6, 0xf4, 0x27, 0xa0, 0xa6, 0x5c, 0xb7, 0x18, 0x6b, 0xe8,
-	0x00, 0xaa, 0x32, 0x64, 0x8f, 0x1a, 0xbc, 0x56, 0x4b, 0xa1, 0xf8, 0x0b, 0xb6, 0xfe, 0x16, 0xd6,
-	0x63, 0xd0, 0x37, 0xba, 0x25, 0x2b, 0x2c, 0xbe, 0x4a, 0x12, 0xed, 0x35, 0xd6, 0xd0, 0x37, 0x00,
-	0x11, 0x90, 0xcd, 0x4f, 0x9e, 0x42, 0xb6, 0x9b, 0x7a, 0x82, 0xd1, 0x37, 0xd6, 0xd0, 0x33, 0x96,
-	0x08, 0x84, 0x97, 0x79, 0xd8, 0xba, 0x98, 0xcb, 0x9f, 0xde, 0x78, 0x4f, 0x21, 0xa7, 0x97, 0x31,
-	0x4b, 0x7e, 0xfa, 0x0c, 0x18, 0x73, 0xc1, 0xe9, 0x9f, 0x40, 0x45, 0xc2, 0x2e, 0xb9, 0xe2, 0xd3,
-	0x68, 0x66, 0xb6, 0x00, 0x87, 0x50, 0x4b, 0x80, 0x92, 0xe8, 0x36, 0xb3, 0x5c, 0x26, 0x54, 0x99,
-	0xbd, 0xc8, 0x97, 0x50, 0x91, 0x3e, 0x9c, 0x71, 0x09, 0xd2, 0x9f, 0xd2, 0x32, 0x4c, 0x2f, 0x43,
-	0xeb, 0xfc, 0xf0, 0x19, 0x68, 0xfb, 0x4a, 0xa6, 0xe7, 0x8b, 0xc4, 0x4c, 0x1f, 0x5f, 0x25, 0xf9,
-	0x2b, 0xb2, 0xc8, 0xf4, 0x9c, 0x37, 0x32, 0x5d, 0x9c, 0x51, 0x4f, 0x30, 0xfa, 0x4c, 0x78, 0x19,
-	0xbf, 0x8e, 0x59, 0x6e, 0x55, 0xe1, 0x1f, 0x43, 0x99, 0x03, 0x37, 0x68, 0x2b, 0x0e, 0xe3, 0x2c,
-	0xe1, 0x7c, 0xa0, 0xa0, 0xc7, 0xa0, 0x0a, 0x6c, 0x87, 0xdf, 0xf4, 0x04, 0xd4, 0xb3, 0x60, 0xdf,
-	0x67, 0x50, 0xe6, 0x20, 0x2e, 0xdf, 0x37, 0x0e, 0xe9, 0x36, 0x6f, 0xa7, 0x38, 0x69, 0xa5, 0x77,
-	0x4e, 0x73, 0x25, 0x31, 0x78, 0x14, 0x9f, 0xe8, 0x22, 0xb1, 0xf8, 0x24, 0x2f, 0x14, 0x6f, 0xbc,
-	0x8c, 0x35, 0xd4, 0x62, 0xf1, 0x49, 0x92, 0x3a, 0x01, 0x00, 0x35, 0x37, 0x62, 0x2c, 0x3e, 0x8d,
-	0x69, 0x1b, 0x82, 0x88, 0x5f, 0xb1, 0x6c, 0xce, 0xe4, 0x66, 0x7b, 0x0a, 0xda, 0x07, 0x55, 0x00,
-	0x40, 0x9c, 0x29, 0x81, 0x07, 0x65, 0x31, 0xb5, 0x40, 0x15, 0x18, 0x10, 0x67, 0x4a, 0x40, 0x42,
-	0xd9, 0x32, 0x0a, 0xa2, 0x98, 0x8c, 0x49, 0xce, 0x8c, 0xed, 0x1e, 0x81, 0x2a, 0xda, 0x5d, 0xce,
-	0x94, 0x80, 0x7d, 0x78, 0xc8, 0x4e, 0xf6, 0xc4, 0x72, 0xc8, 0xa6, 0xcc, 0xf5, 0x04, 0x6e, 0xb0,
-	0xca, 0xe5, 0xd1, 0x18, 0xf9, 0x73, 0xc7, 0x41, 0x73, 0xc8, 0x16, 0xb0, 0xef, 0x42, 0xe1, 0xc4,
-	0xef, 0xbf, 0x45, 0xec, 0x7a, 0x48, 0x98, 0x4c, 0x73, 0x53, 0x1a, 0x11, 0xd2, 0xee, 0x29, 0xe8,
-	0x25, 0xd4, 0x62, 0xf8, 0xca, 0x79, 0x8b, 0x07, 0x9b, 0x6c, 0xd4, 0x65, 0xa1, 0xff, 0x3f, 0x07,
-	0x95, 0xe1, 0x0a, 0xe7, 0x2d, 0xa1, 0xeb, 0x38, 0xcc, 0xb0, 0xdc, 0x8b, 0x9f, 0x01, 0x08, 0xa5,
-	0x86, 0x8b, 0x24, 0x75, 0x7f, 0x33, 0x53, 0xf7, 0xe7, 0x2d, 0xba, 0x80, 0x09, 0x7a, 0x12, 0x3f,
-	0x58, 0x7c, 0xa0, 0x3b, 0x52, 0x84, 0x4b, 0x63, 0x0e, 0xf4, 0x5c, 0x2f, 0xa0, 0x96, 0x00, 0x16,
-	0xf8, 0x92, 0xd9, 0x70, 0xc3, 0x02, 0xf3, 0x1c, 0xc1, 0xba, 0x04, 0x24, 0x9c, 0xb7, 0x78, 0x68,
-	0xcc, 0x02, 0x17, 0xe6, 0xaf, 0xd2, 0xfa, 0xfb, 0x0a, 0x68, 0xac, 0x66, 0x23, 0x85, 0xcd, 0x3e,
-	0x68, 0x21, 0xbe, 0x80, 0x6e, 0x88, 0x98, 0x15, 0xeb, 0x08, 0x9a, 0x72, 0x9d, 0x47, 0x8f, 0xf4,
-	0x88, 0x42, 0xea, 0x6c, 0xa0, 0x43, 0xc1, 0xf3, 0x39, 0x9c, 0x55, 0x89, 0xd3, 0xa7, 0xac, 0xcf,
-	0x00, 0x42, 0x2a, 0x7f, 0x1e, 0xdb, 0x22, 0x37, 0x09, 0x73, 0x0c, 0x97, 0x59, 0xce, 0x31, 0x2b,
-	0xae, 0x82, 0x1e, 0x81, 0x16, 0x22, 0x10, 0x48, 0x3e, 0xdd, 0x72, 0x17, 0x3b, 0x06, 0x88, 0xc0,
-	0x0b, 0x7e, 0x43, 0x53, 0x68, 0xc6, 0xf2, 0x65, 0x7e, 0x09, 0xaa, 0x80, 0x19, 0x50, 0x08, 0x2a,
-	0xca, 0x1d, 0xf5, 0x0a, 0x57, 0x45, 0xe6, 0x4e, 0x00, 0x0d, 0xcb, 0x05, 0x38, 0xa4, 0x2a, 0x60,
-	0x30, 0x03, 0x37, 0x43, 0x12, 0x76, 0x58, 0xbe, 0x48, 0x0b, 0xb4, 0x10, 0x09, 0x40, 0x51, 0x1d,
-	0x1a, 0x93, 0x44, 0xc2, 0x38, 0xf8, 0xc9, 0xb5, 0x10, 0x29, 0xe0, 0x3c, 0x49, 0xe4, 0x60, 0x61,
-	0x84, 0x12, 0xd5, 0x41, 0x96, 0xf5, 0x6a, 0xb1, 0x5e, 0x89, 0xe6, 0xa7, 0x03, 0xa8, 0x48, 0x8d,
-	0x2a, 0x4f, 0x6c, 0xe9, 0xae, 0xb7, 0xd9, 0x48, 0x4f, 0x84, 0x51, 0xf9, 0x09, 0x54, 0x24, 0x14,
-	0x82, 0xaf, 0x91, 0xc6, 0x25, 0x32, 0xb6, 0xdf, 0x23, 0xd7, 0x7f, 0x3d, 0xd6, 0xc6, 0x23, 0x19,
-	0x0d, 0x4e, 0x2c, 0xd0, 0xcc, 0x9a, 0x0a, 0xc5, 0xd8, 0x87, 0x12, 0x8d, 0x88, 0x23, 0x14, 0xb6,
-	0xf7, 0xcb, 0x4d, 0xf4, 0x19, 0x00, 0x57, 0x58, 0x9c, 0x31, 0x43, 0x55, 0x4f, 0x58, 0x2a, 0x27,
-	0x0d, 0xa0, 0x94, 0x90, 0x25, 0x90, 0x41, 0x6a, 0x35, 0x62, 0x38, 0x82, 0x08, 0xbd, 0x21, 0xc2,
-	0x10, 0xcb, 0x5c, 0xf2, 0x02, 0x37, 0x53, 0xe3, 0x92, 0x92, 0xcb, 0xfc, 0x57, 0x88, 0xef, 0x91,
-	0xb8, 0x8e, 0xa0, 0x2a, 0xa3, 0x05, 0x3c, 0x28, 0x64, 0x00, 0x08, 0x0b, 0xaf, 0x55, 0x1b, 0xaa,
-	0x32, 0x68, 0xc0, 0x57, 0xc9, 0xc0, 0x11, 0x96, 0xab, 0xfd, 0x05, 0xd4, 0x12, 0xb0, 0x02, 0x0f,
-	0xfa, 0xd9, 0x60, 0xc3, 0x7c, 0xb1, 0x0e, 0x9e, 0xfc, 0xdb, 0x4f, 0x1f, 0x2a, 0xbf, 0xff, 0xe9,
-	0x43, 0xe5, 0xbf, 0x7f, 0xfa, 0x50, 0xf9, 0xd3, 0x9f, 0x8f, 0xec, 0x60, 0x3c, 0xeb, 0xed, 0xf4,
-	0xdd, 0x8b, 0xdd, 0xa9, 0xd5, 0x1f, 0x5f, 0x0d, 0xb0, 0x27, 0x3f, 0xf9, 0x5e, 0x7f, 0x37, 0xfa,
-	0xa7, 0x59, 0xbd, 0x12, 0x5d, 0x6e, 0xff, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x25, 0xbb, 0x66,
-	0x2a, 0xaf, 0x35, 0x00, 0x00,
+func (m *CheckObjectResponse) Reset()         { *m = CheckObjectResponse{} }
+func (m *CheckObjectResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckObjectResponse) ProtoMessage()    {}
+func (*CheckObjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{90}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// APIClient is the client API for API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIClient interface {
-	// Repo rpcs
-	// CreateRepo creates a new repo.
-	// An error is returned if the repo already exists.
-	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// InspectRepo returns info about a repo.
-	InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error)
-	// ListRepo returns info about all repos.
-	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error)
-	// DeleteRepo deletes a repo.
-	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// Commit rpcs
-	// StartCommit creates a new write commit from a parent commit.
-	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
-	// FinishCommit turns a write commit into a read commit.
-	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// InspectCommit returns the info about a commit.
-	InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error)
-	// ListCommit returns info about all commits. This is deprecated in favor of
-	// ListCommitStream.
-	ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (*CommitInfos, error)
-	// ListCommitStream is like ListCommit, but returns its results in a GRPC stream
-	ListCommitStream(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitStreamClient, error)
-	// DeleteCommit deletes a commit.
-	DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// FlushCommit waits for downstream commits to finish
-	FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error)
-	// SubscribeCommit subscribes for new commits on a given branch
-	SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error)
-	// BuildCommit builds a commit that's backed by the given tree
-	BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error)
-	// CreateBranch creates a new branch
-	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// InspectBranch returns info about a branch.
-	InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error)
-	// ListBranch returns info about the heads of branches.
-	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error)
-	// DeleteBranch deletes a branch; note that the commits still exist.
-	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// File rpcs
-	// PutFile writes the specified file to pfs.
-	PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error)
-	// CopyFile copies the contents of one file to another.
-	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// GetFile returns a byte stream of the contents of the file.
-	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
-	// InspectFile returns info about a file.
-	InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error)
-	// ListFile returns info about all files. This is deprecated in favor of
-	// ListFileStream
-	ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
-	// ListFileStream is a streaming version of ListFile
-	// TODO(msteffen): When the dash has been updated to use ListFileStream,
-	// replace ListFile with this RPC (https://github.com/pachyderm/dash/issues/201)
-	ListFileStream(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileStreamClient, error)
-	// WalkFile walks over all the files under a directory, including children of children.
-	WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error)
-	// GlobFile returns info about all files. This is deprecated in favor of
-	// GlobFileStream
-	GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
-	// GlobFileStream is a streaming version of GlobFile
-	// TODO(msteffen): When the dash has been updated to use GlobFileStream,
-	// replace GlobFile with this RPC (https://github.com/pachyderm/dash/issues/201)
-	GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error)
-	// DiffFile returns the differences between 2 paths at 2 commits.
-	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error)
-	// DeleteFile deletes a file.
-	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// DeleteAll deletes everything
-	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
-	// Fsck does a file system consistency check for pfs
-	Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error)
-	// RPCs specific to Pachyderm 2.
-	FileOperationV2(ctx context.Context, opts ...grpc.CallOption) (API_FileOperationV2Client, error)
-	GetTarV2(ctx context.Context, in *GetTarRequestV2, opts ...grpc.CallOption) (API_GetTarV2Client, error)
-	// DiffFileV2 returns the differences between 2 paths at 2 commits.
-	// it streams back one file at a time which is either from the new path, or the old path
-	DiffFileV2(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileV2Client, error)
-	// CreateTmpFileSet creates a new temp fileset
-	CreateTmpFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateTmpFileSetClient, error)
-	// RenewTmpFileSet prevents the temporary fileset from being deleted for a set amount of time
-	RenewTmpFileSet(ctx context.Context, in *RenewTmpFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// ClearCommitV2 removes all data from the commit.
-	ClearCommitV2(ctx context.Context, in *ClearCommitRequestV2, opts ...grpc.CallOption) (*types.Empty, error)
+func (m *CheckObjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type aPIClient struct {
-	cc *grpc.ClientConn
+func (m *CheckObjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckObjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
+func (m *CheckObjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckObjectResponse.Merge(m, src)
+}
+func (m *CheckObjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckObjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckObjectResponse.DiscardUnknown(m)
 }
 
-func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/CreateRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CheckObjectResponse proto.InternalMessageInfo
+
+func (m *CheckObjectResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
 	}
-	return out, nil
+	return false
 }
 
-func (c *aPIClient) InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error) {
-	out := new(RepoInfo)
-	err := c.cc.Invoke(ctx, "/pfs.API/InspectRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Objects struct {
+	Objects              []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (c *aPIClient) ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error) {
-	out := new(ListRepoResponse)
-	err := c.cc.Invoke(ctx, "/pfs.API/ListRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Objects) Reset()         { *m = Objects{} }
+func (m *Objects) String() string { return proto.CompactTextString(m) }
+func (*Objects) ProtoMessage()    {}
+func (*Objects) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{91}
+}
+func (m *Objects) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Objects) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Objects.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *Objects) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Objects.Merge(m, src)
+}
+func (m *Objects) XXX_Size() int {
+	return m.Size()
+}
+func (m *Objects) XXX_DiscardUnknown() {
+	xxx_messageInfo_Objects.DiscardUnknown(m)
 }
 
-func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/DeleteRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Objects proto.InternalMessageInfo
+
+func (m *Objects) GetObjects() []*Object {
+	if m != nil {
+		return m.Objects
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
-	out := new(Commit)
-	err := c.cc.Invoke(ctx, "/pfs.API/StartCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type PutObjDirectRequest struct {
+	Obj                  string   `protobuf:"bytes,1,opt,name=obj,proto3" json:"obj,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/FinishCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PutObjDirectRequest) Reset()         { *m = PutObjDirectRequest{} }
+func (m *PutObjDirectRequest) String() string { return proto.CompactTextString(m) }
+func (*PutObjDirectRequest) ProtoMessage()    {}
+func (*PutObjDirectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{92}
+}
+func (m *PutObjDirectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PutObjDirectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PutObjDirectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PutObjDirectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutObjDirectRequest.Merge(m, src)
+}
+func (m *PutObjDirectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PutObjDirectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutObjDirectRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error) {
-	out := new(CommitInfo)
-	err := c.cc.Invoke(ctx, "/pfs.API/InspectCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PutObjDirectRequest proto.InternalMessageInfo
+
+func (m *PutObjDirectRequest) GetObj() string {
+	if m != nil {
+		return m.Obj
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (*CommitInfos, error) {
-	out := new(CommitInfos)
-	err := c.cc.Invoke(ctx, "/pfs.API/ListCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PutObjDirectRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) ListCommitStream(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/pfs.API/ListCommitStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListCommitStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type GetObjDirectRequest struct {
+	Obj                  string   `protobuf:"bytes,1,opt,name=obj,proto3" json:"obj,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type API_ListCommitStreamClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+func (m *GetObjDirectRequest) Reset()         { *m = GetObjDirectRequest{} }
+func (m *GetObjDirectRequest) String() string { return proto.CompactTextString(m) }
+func (*GetObjDirectRequest) ProtoMessage()    {}
+func (*GetObjDirectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{93}
 }
-
-type aPIListCommitStreamClient struct {
-	grpc.ClientStream
+func (m *GetObjDirectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *aPIListCommitStreamClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetObjDirectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetObjDirectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-func (c *aPIClient) DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/DeleteCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetObjDirectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetObjDirectRequest.Merge(m, src)
 }
-
-func (c *aPIClient) FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pfs.API/FlushCommit", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIFlushCommitClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *GetObjDirectRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type API_FlushCommitClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+func (m *GetObjDirectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetObjDirectRequest.DiscardUnknown(m)
 }
 
-type aPIFlushCommitClient struct {
-	grpc.ClientStream
-}
+var xxx_messageInfo_GetObjDirectRequest proto.InternalMessageInfo
 
-func (x *aPIFlushCommitClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetObjDirectRequest) GetObj() string {
+	if m != nil {
+		return m.Obj
 	}
-	return m, nil
+	return ""
 }
 
-func (c *aPIClient) SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pfs.API/SubscribeCommit", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPISubscribeCommitClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type DeleteObjDirectRequest struct {
+	// Delete a single object by its path.
+	Object string `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	// Delete all objects with paths matching this prefix, cannot be applied to
+	// the core storage layer paths, as those are generally not safe to delete
+	// (use garbage collection for that). This is for deleting objects generated
+	// vi `PutObjDirect`.
+	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type API_SubscribeCommitClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+func (m *DeleteObjDirectRequest) Reset()         { *m = DeleteObjDirectRequest{} }
+func (m *DeleteObjDirectRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteObjDirectRequest) ProtoMessage()    {}
+func (*DeleteObjDirectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{94}
 }
-
-type aPISubscribeCommitClient struct {
-	grpc.ClientStream
+func (m *DeleteObjDirectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *aPISubscribeCommitClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *DeleteObjDirectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteObjDirectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *DeleteObjDirectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteObjDirectRequest.Merge(m, src)
+}
+func (m *DeleteObjDirectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteObjDirectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteObjDirectRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
-	out := new(Commit)
-	err := c.cc.Invoke(ctx, "/pfs.API/BuildCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteObjDirectRequest proto.InternalMessageInfo
+
+func (m *DeleteObjDirectRequest) GetObject() string {
+	if m != nil {
+		return m.Object
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/CreateBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeleteObjDirectRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error) {
-	out := new(BranchInfo)
-	err := c.cc.Invoke(ctx, "/pfs.API/InspectBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ObjectIndex struct {
+	Objects              map[string]*BlockRef `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Tags                 map[string]*Object   `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (c *aPIClient) ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error) {
-	out := new(BranchInfos)
-	err := c.cc.Invoke(ctx, "/pfs.API/ListBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ObjectIndex) Reset()         { *m = ObjectIndex{} }
+func (m *ObjectIndex) String() string { return proto.CompactTextString(m) }
+func (*ObjectIndex) ProtoMessage()    {}
+func (*ObjectIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{95}
+}
+func (m *ObjectIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ObjectIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectIndex.Merge(m, src)
+}
+func (m *ObjectIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectIndex.DiscardUnknown(m)
 }
 
-func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/DeleteBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ObjectIndex proto.InternalMessageInfo
+
+func (m *ObjectIndex) GetObjects() map[string]*BlockRef {
+	if m != nil {
+		return m.Objects
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pfs.API/PutFile", opts...)
-	if err != nil {
-		return nil, err
+func (m *ObjectIndex) GetTags() map[string]*Object {
+	if m != nil {
+		return m.Tags
 	}
-	x := &aPIPutFileClient{stream}
-	return x, nil
+	return nil
 }
 
-type API_PutFileClient interface {
-	Send(*PutFileRequest) error
-	CloseAndRecv() (*types.Empty, error)
-	grpc.ClientStream
+type DeleteAllRequest struct {
+	// break_glass deletes WORM repos whose retention hasn't elapsed. Its use is
+	// audit-logged.
+	BreakGlass           bool     `protobuf:"varint,1,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIPutFileClient struct {
-	grpc.ClientStream
+func (m *DeleteAllRequest) Reset()         { *m = DeleteAllRequest{} }
+func (m *DeleteAllRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAllRequest) ProtoMessage()    {}
+func (*DeleteAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{96}
 }
-
-func (x *aPIPutFileClient) Send(m *PutFileRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *DeleteAllRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *aPIPutFileClient) CloseAndRecv() (*types.Empty, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(types.Empty)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *DeleteAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteAllRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-func (c *aPIClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/CopyFile", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DeleteAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAllRequest.Merge(m, src)
+}
+func (m *DeleteAllRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAllRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pfs.API/GetFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGetFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteAllRequest proto.InternalMessageInfo
+
+func (m *DeleteAllRequest) GetBreakGlass() bool {
+	if m != nil {
+		return m.BreakGlass
 	}
-	return x, nil
+	return false
 }
 
-type API_GetFileClient interface {
-	Recv() (*types.BytesValue, error)
-	grpc.ClientStream
+func init() {
+	proto.RegisterEnum("pfs.OriginKind", OriginKind_name, OriginKind_value)
+	proto.RegisterEnum("pfs.FileType", FileType_name, FileType_value)
+	proto.RegisterEnum("pfs.CommitState", CommitState_name, CommitState_value)
+	proto.RegisterEnum("pfs.Delimiter", Delimiter_name, Delimiter_value)
+	proto.RegisterType((*Repo)(nil), "pfs.Repo")
+	proto.RegisterType((*Branch)(nil), "pfs.Branch")
+	proto.RegisterType((*File)(nil), "pfs.File")
+	proto.RegisterType((*Block)(nil), "pfs.Block")
+	proto.RegisterType((*Object)(nil), "pfs.Object")
+	proto.RegisterType((*Tag)(nil), "pfs.Tag")
+	proto.RegisterType((*RepoInfo)(nil), "pfs.RepoInfo")
+	proto.RegisterType((*WORMPolicy)(nil), "pfs.WORMPolicy")
+	proto.RegisterType((*RepoAuthInfo)(nil), "pfs.RepoAuthInfo")
+	proto.RegisterType((*BranchInfo)(nil), "pfs.BranchInfo")
+	proto.RegisterType((*BranchInfos)(nil), "pfs.BranchInfos")
+	proto.RegisterType((*Trigger)(nil), "pfs.Trigger")
+	proto.RegisterType((*CommitOrigin)(nil), "pfs.CommitOrigin")
+	proto.RegisterType((*Commit)(nil), "pfs.Commit")
+	proto.RegisterType((*CommitRange)(nil), "pfs.CommitRange")
+	proto.RegisterType((*CommitProvenance)(nil), "pfs.CommitProvenance")
+	proto.RegisterType((*CommitInfo)(nil), "pfs.CommitInfo")
+	proto.RegisterType((*FileInfo)(nil), "pfs.FileInfo")
+	proto.RegisterType((*ByteRange)(nil), "pfs.ByteRange")
+	proto.RegisterType((*BlockRef)(nil), "pfs.BlockRef")
+	proto.RegisterType((*ObjectInfo)(nil), "pfs.ObjectInfo")
+	proto.RegisterType((*Compaction)(nil), "pfs.Compaction")
+	proto.RegisterType((*Shard)(nil), "pfs.Shard")
+	proto.RegisterType((*PathRange)(nil), "pfs.PathRange")
+	proto.RegisterType((*CreateRepoRequest)(nil), "pfs.CreateRepoRequest")
+	proto.RegisterType((*InspectRepoRequest)(nil), "pfs.InspectRepoRequest")
+	proto.RegisterType((*ListRepoRequest)(nil), "pfs.ListRepoRequest")
+	proto.RegisterType((*ListRepoResponse)(nil), "pfs.ListRepoResponse")
+	proto.RegisterType((*DeleteRepoRequest)(nil), "pfs.DeleteRepoRequest")
+	proto.RegisterType((*RenameRepoRequest)(nil), "pfs.RenameRepoRequest")
+	proto.RegisterType((*StartCommitRequest)(nil), "pfs.StartCommitRequest")
+	proto.RegisterType((*BuildCommitRequest)(nil), "pfs.BuildCommitRequest")
+	proto.RegisterType((*FinishCommitRequest)(nil), "pfs.FinishCommitRequest")
+	proto.RegisterType((*InspectCommitRequest)(nil), "pfs.InspectCommitRequest")
+	proto.RegisterType((*ListCommitRequest)(nil), "pfs.ListCommitRequest")
+	proto.RegisterType((*CommitInfos)(nil), "pfs.CommitInfos")
+	proto.RegisterType((*CreateBranchRequest)(nil), "pfs.CreateBranchRequest")
+	proto.RegisterType((*InspectBranchRequest)(nil), "pfs.InspectBranchRequest")
+	proto.RegisterType((*ListBranchRequest)(nil), "pfs.ListBranchRequest")
+	proto.RegisterType((*DeleteBranchRequest)(nil), "pfs.DeleteBranchRequest")
+	proto.RegisterType((*RenameBranchRequest)(nil), "pfs.RenameBranchRequest")
+	proto.RegisterType((*DeleteCommitRequest)(nil), "pfs.DeleteCommitRequest")
+	proto.RegisterType((*FlushCommitRequest)(nil), "pfs.FlushCommitRequest")
+	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs.SubscribeCommitRequest")
+	proto.RegisterType((*GetFileRequest)(nil), "pfs.GetFileRequest")
+	proto.RegisterType((*OverwriteIndex)(nil), "pfs.OverwriteIndex")
+	proto.RegisterType((*PutFileRequest)(nil), "pfs.PutFileRequest")
+	proto.RegisterType((*PutFileRecord)(nil), "pfs.PutFileRecord")
+	proto.RegisterType((*PutFileRecords)(nil), "pfs.PutFileRecords")
+	proto.RegisterType((*UploadPart)(nil), "pfs.UploadPart")
+	proto.RegisterType((*UploadInfo)(nil), "pfs.UploadInfo")
+	proto.RegisterType((*BeginUploadRequest)(nil), "pfs.BeginUploadRequest")
+	proto.RegisterType((*PutUploadPartRequest)(nil), "pfs.PutUploadPartRequest")
+	proto.RegisterType((*InspectUploadRequest)(nil), "pfs.InspectUploadRequest")
+	proto.RegisterType((*CompleteUploadRequest)(nil), "pfs.CompleteUploadRequest")
+	proto.RegisterType((*CopyFileRequest)(nil), "pfs.CopyFileRequest")
+	proto.RegisterType((*InspectFileRequest)(nil), "pfs.InspectFileRequest")
+	proto.RegisterType((*ListFileRequest)(nil), "pfs.ListFileRequest")
+	proto.RegisterType((*WalkFileRequest)(nil), "pfs.WalkFileRequest")
+	proto.RegisterType((*GlobFileRequest)(nil), "pfs.GlobFileRequest")
+	proto.RegisterType((*FileInfos)(nil), "pfs.FileInfos")
+	proto.RegisterType((*DiffFileRequest)(nil), "pfs.DiffFileRequest")
+	proto.RegisterType((*DiffFileResponse)(nil), "pfs.DiffFileResponse")
+	proto.RegisterType((*DeleteFileRequest)(nil), "pfs.DeleteFileRequest")
+	proto.RegisterType((*FsckRequest)(nil), "pfs.FsckRequest")
+	proto.RegisterType((*FsckResponse)(nil), "pfs.FsckResponse")
+	proto.RegisterType((*FileOperationRequestV2)(nil), "pfs.FileOperationRequestV2")
+	proto.RegisterType((*PutTarRequestV2)(nil), "pfs.PutTarRequestV2")
+	proto.RegisterType((*DeleteFilesRequestV2)(nil), "pfs.DeleteFilesRequestV2")
+	proto.RegisterType((*GetTarRequestV2)(nil), "pfs.GetTarRequestV2")
+	proto.RegisterType((*DiffFileResponseV2)(nil), "pfs.DiffFileResponseV2")
+	proto.RegisterType((*CreateTmpFileSetResponse)(nil), "pfs.CreateTmpFileSetResponse")
+	proto.RegisterType((*RenewTmpFileSetRequest)(nil), "pfs.RenewTmpFileSetRequest")
+	proto.RegisterType((*ClearCommitRequestV2)(nil), "pfs.ClearCommitRequestV2")
+	proto.RegisterType((*PutObjectRequest)(nil), "pfs.PutObjectRequest")
+	proto.RegisterType((*CreateObjectRequest)(nil), "pfs.CreateObjectRequest")
+	proto.RegisterType((*GetObjectsRequest)(nil), "pfs.GetObjectsRequest")
+	proto.RegisterType((*PutBlockRequest)(nil), "pfs.PutBlockRequest")
+	proto.RegisterType((*GetBlockRequest)(nil), "pfs.GetBlockRequest")
+	proto.RegisterType((*GetBlocksRequest)(nil), "pfs.GetBlocksRequest")
+	proto.RegisterType((*ListBlockRequest)(nil), "pfs.ListBlockRequest")
+	proto.RegisterType((*TagObjectRequest)(nil), "pfs.TagObjectRequest")
+	proto.RegisterType((*ListObjectsRequest)(nil), "pfs.ListObjectsRequest")
+	proto.RegisterType((*ListTagsRequest)(nil), "pfs.ListTagsRequest")
+	proto.RegisterType((*ListTagsResponse)(nil), "pfs.ListTagsResponse")
+	proto.RegisterType((*DeleteObjectsRequest)(nil), "pfs.DeleteObjectsRequest")
+	proto.RegisterType((*DeleteObjectsResponse)(nil), "pfs.DeleteObjectsResponse")
+	proto.RegisterType((*DeleteTagsRequest)(nil), "pfs.DeleteTagsRequest")
+	proto.RegisterType((*DeleteTagsResponse)(nil), "pfs.DeleteTagsResponse")
+	proto.RegisterType((*CheckObjectRequest)(nil), "pfs.CheckObjectRequest")
+	proto.RegisterType((*CheckObjectResponse)(nil), "pfs.CheckObjectResponse")
+	proto.RegisterType((*Objects)(nil), "pfs.Objects")
+	proto.RegisterType((*PutObjDirectRequest)(nil), "pfs.PutObjDirectRequest")
+	proto.RegisterType((*GetObjDirectRequest)(nil), "pfs.GetObjDirectRequest")
+	proto.RegisterType((*DeleteObjDirectRequest)(nil), "pfs.DeleteObjDirectRequest")
+	proto.RegisterType((*ObjectIndex)(nil), "pfs.ObjectIndex")
+	proto.RegisterMapType((map[string]*BlockRef)(nil), "pfs.ObjectIndex.ObjectsEntry")
+	proto.RegisterMapType((map[string]*Object)(nil), "pfs.ObjectIndex.TagsEntry")
+	proto.RegisterType((*DeleteAllRequest)(nil), "pfs.DeleteAllRequest")
 }
 
-type aPIGetFileClient struct {
-	grpc.ClientStream
+func init() { proto.RegisterFile("client/pfs/pfs.proto", fileDescriptor_b48f014707f6595c) }
+
+var fileDescriptor_b48f014707f6595c = []byte{
+	// 4590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0xcd, 0x93, 0xdb, 0xc6,
+	0x72, 0x17, 0x08, 0x7e, 0x00, 0x4d, 0x2e, 0x89, 0x9d, 0x5d, 0xad, 0x28, 0xca, 0xb2, 0x64, 0xc8,
+	0xf2, 0x93, 0x65, 0xbf, 0x95, 0xde, 0x6e, 0x6c, 0x4b, 0x96, 0xad, 0x2d, 0xed, 0x87, 0xa4, 0x95,
+	0x65, 0x69, 0x1f, 0xb8, 0xd2, 0xcb, 0x4b, 0x5e, 0xc2, 0xc2, 0x92, 0x43, 0x12, 0x5e, 0x90, 0xa0,
+	0x01, 0x50, 0xf2, 0xe6, 0x90, 0x9c, 0x52, 0xa9, 0xca, 0x31, 0x97, 0x1c, 0x72, 0x49, 0xe5, 0x94,
+	0x43, 0x0e, 0xb9, 0xbe, 0x53, 0xaa, 0x92, 0x4b, 0x2e, 0xa9, 0xca, 0x29, 0xa7, 0x54, 0x2a, 0xa5,
+	0x73, 0xf2, 0x0f, 0xe4, 0x94, 0x9a, 0x2f, 0x60, 0xf0, 0xc1, 0x8f, 0x55, 0x25, 0x07, 0x89, 0xc0,
+	0xcc, 0x74, 0x4f, 0x4f, 0x77, 0x4f, 0x77, 0xcf, 0x6f, 0xb0, 0xb0, 0xde, 0x75, 0x1d, 0x3c, 0x0e,
+	0xef, 0x4c, 0xfa, 0x01, 0xf9, 0xb7, 0x39, 0xf1, 0xbd, 0xd0, 0x43, 0xea, 0xa4, 0x1f, 0xb4, 0x3e,
+	0x1c, 0x78, 0xde, 0xc0, 0xc5, 0x77, 0x68, 0xd3, 0xc9, 0xb4, 0x7f, 0xa7, 0x37, 0xf5, 0xed, 0xd0,
+	0xf1, 0xc6, 0x6c, 0x50, 0xeb, 0x4a, 0xba, 0x1f, 0x8f, 0x26, 0xe1, 0x19, 0xef, 0xbc, 0x96, 0xee,
+	0x0c, 0x9d, 0x11, 0x0e, 0x42, 0x7b, 0x34, 0xe1, 0x03, 0x32, 0xdc, 0xdf, 0xfa, 0xf6, 0x64, 0x82,
+	0x7d, 0x2e, 0x42, 0x6b, 0x7d, 0xe0, 0x0d, 0x3c, 0xfa, 0x78, 0x87, 0x3c, 0xf1, 0xd6, 0x0d, 0x2e,
+	0xae, 0x3d, 0x0d, 0x87, 0xf4, 0x3f, 0xd6, 0x6e, 0xb6, 0xa0, 0x68, 0xe1, 0x89, 0x87, 0x10, 0x14,
+	0xc7, 0xf6, 0x08, 0x37, 0x95, 0xeb, 0xca, 0x2d, 0xdd, 0xa2, 0xcf, 0xe6, 0x03, 0x28, 0xef, 0xfa,
+	0xf6, 0xb8, 0x3b, 0x44, 0x57, 0xa1, 0xe8, 0xe3, 0x89, 0x47, 0x7b, 0xab, 0x5b, 0xfa, 0x26, 0x59,
+	0x30, 0x21, 0xb3, 0x8a, 0xbe, 0x4c, 0x5c, 0x90, 0x88, 0x77, 0xa0, 0xf8, 0xd8, 0x71, 0x31, 0xba,
+	0x01, 0xe5, 0xae, 0x37, 0x1a, 0x39, 0x21, 0x27, 0xae, 0x52, 0xe2, 0x3d, 0xda, 0x64, 0xf1, 0x2e,
+	0xc2, 0x60, 0x62, 0x87, 0x43, 0xc1, 0x80, 0x3c, 0x9b, 0x57, 0xa0, 0xb4, 0xeb, 0x7a, 0xdd, 0x53,
+	0xd2, 0x39, 0xb4, 0x83, 0xa1, 0x10, 0x8d, 0x3c, 0x9b, 0x1f, 0x40, 0xf9, 0xe5, 0xc9, 0x0f, 0xb8,
+	0x1b, 0xe6, 0xf6, 0x5e, 0x06, 0xf5, 0xd8, 0x1e, 0xe4, 0xae, 0xe9, 0x1f, 0x0b, 0xa0, 0x11, 0xc9,
+	0x0f, 0xc7, 0x7d, 0x6f, 0xd1, 0xb2, 0x7e, 0x07, 0x2a, 0x5d, 0x1f, 0xdb, 0x21, 0xee, 0x51, 0xc1,
+	0xaa, 0x5b, 0xad, 0x4d, 0xa6, 0xfb, 0x4d, 0xa1, 0xfb, 0xcd, 0x63, 0x61, 0x1c, 0x4b, 0x0c, 0x45,
+	0x57, 0x01, 0x02, 0xe7, 0x8f, 0x70, 0xe7, 0xe4, 0x2c, 0xc4, 0x41, 0x53, 0xbd, 0xae, 0xdc, 0x2a,
+	0x5a, 0x3a, 0x69, 0xd9, 0x25, 0x0d, 0xe8, 0x3a, 0x54, 0x7b, 0x38, 0xe8, 0xfa, 0xce, 0x84, 0x78,
+	0x44, 0xb3, 0x44, 0x65, 0x93, 0x9b, 0xd0, 0xcf, 0x40, 0x3b, 0xa1, 0x6a, 0xc7, 0x41, 0xb3, 0x72,
+	0x5d, 0x8d, 0x74, 0xc6, 0x6c, 0x61, 0x45, 0x9d, 0x68, 0x13, 0x74, 0x62, 0xc9, 0x8e, 0x33, 0xee,
+	0x7b, 0xcd, 0x32, 0x95, 0x70, 0x35, 0x5a, 0xc3, 0xa3, 0x69, 0x38, 0x24, 0x8b, 0xb4, 0x34, 0x9b,
+	0x3f, 0xa1, 0x0f, 0x40, 0x0f, 0xbd, 0xd1, 0x49, 0x10, 0x7a, 0x63, 0xdc, 0xd4, 0xae, 0x2b, 0xb7,
+	0x34, 0x2b, 0x6e, 0x40, 0x37, 0xa0, 0xf8, 0xd6, 0xf3, 0x47, 0x4d, 0x9d, 0x32, 0x6a, 0x50, 0x46,
+	0xbf, 0x7a, 0x69, 0x7d, 0x7f, 0xe4, 0xb9, 0x4e, 0xf7, 0xcc, 0xa2, 0x9d, 0xcf, 0x8a, 0x5a, 0xd1,
+	0x28, 0x99, 0x07, 0x00, 0x71, 0x0f, 0xfa, 0x0a, 0x74, 0x1f, 0x87, 0x78, 0x4c, 0xd7, 0xc3, 0x54,
+	0x79, 0x39, 0xa3, 0xa8, 0x7d, 0xbe, 0x05, 0xac, 0x78, 0xac, 0xf9, 0x10, 0x6a, 0xb2, 0xa4, 0x68,
+	0x13, 0x6a, 0x76, 0xb7, 0x8b, 0x83, 0xa0, 0xe3, 0xe2, 0x37, 0xd8, 0xa5, 0xbc, 0xea, 0x5b, 0xd5,
+	0x4d, 0xea, 0xae, 0xed, 0xae, 0x37, 0xc1, 0x56, 0x95, 0x0d, 0x78, 0x4e, 0xfa, 0xcd, 0xbf, 0x29,
+	0x00, 0x30, 0xa5, 0x50, 0xf2, 0x1b, 0x50, 0x66, 0xaa, 0x69, 0x16, 0x25, 0x4f, 0xe3, 0x5a, 0xe3,
+	0x5d, 0xe8, 0x1a, 0x14, 0x87, 0xd8, 0x16, 0x06, 0x4d, 0x38, 0x23, 0xed, 0x40, 0x9f, 0x01, 0x4c,
+	0x7c, 0xef, 0x0d, 0x1e, 0xdb, 0xe3, 0x2e, 0x6e, 0xaa, 0x59, 0xfd, 0x4b, 0xdd, 0x64, 0x70, 0x30,
+	0x3d, 0x11, 0x83, 0x4b, 0x39, 0x83, 0xe3, 0x6e, 0x74, 0x0f, 0x56, 0x7b, 0x8e, 0x8f, 0xbb, 0x61,
+	0x47, 0x9a, 0xa0, 0x9c, 0xa5, 0x31, 0xd8, 0xa8, 0xa3, 0x78, 0x9a, 0x4f, 0xa0, 0x12, 0xfa, 0xce,
+	0x60, 0x80, 0xfd, 0x66, 0x85, 0xca, 0x5d, 0xa3, 0xe3, 0x8f, 0x59, 0x9b, 0x25, 0x3a, 0x73, 0x1d,
+	0x7e, 0x07, 0xaa, 0xb1, 0x8e, 0x02, 0x74, 0x17, 0xaa, 0x4c, 0x13, 0xcc, 0x6b, 0x94, 0xeb, 0x6a,
+	0x64, 0xec, 0x78, 0x98, 0x05, 0x27, 0xd1, 0xb3, 0xf9, 0xc7, 0x50, 0xe1, 0x13, 0xa1, 0x8d, 0x48,
+	0xc3, 0x6c, 0x06, 0xfe, 0x86, 0x0c, 0x50, 0x6d, 0xd7, 0xa5, 0x3a, 0xd5, 0x2c, 0xf2, 0x88, 0xae,
+	0x80, 0xde, 0xf5, 0xbd, 0x71, 0x27, 0x98, 0xe0, 0x2e, 0xdd, 0x03, 0xba, 0xa5, 0x91, 0x86, 0xf6,
+	0x04, 0x77, 0x89, 0x98, 0x64, 0x3f, 0x50, 0x33, 0xe9, 0x16, 0x7d, 0x46, 0x4d, 0xa8, 0xb0, 0x58,
+	0x10, 0xd0, 0x2d, 0xa1, 0x5a, 0xe2, 0xd5, 0xdc, 0x86, 0x1a, 0x33, 0xd0, 0x4b, 0xdf, 0x19, 0x38,
+	0x63, 0xe2, 0xa7, 0xa7, 0xce, 0xb8, 0xc7, 0xbd, 0x83, 0x89, 0xce, 0xba, 0xbe, 0x73, 0xc6, 0x3d,
+	0x8b, 0x76, 0x9a, 0x3b, 0x50, 0x66, 0x44, 0x8b, 0xf6, 0xf8, 0x06, 0x14, 0x1c, 0xe6, 0x0d, 0xfa,
+	0x6e, 0xf9, 0xdd, 0x7f, 0x5c, 0x2b, 0x1c, 0xee, 0x5b, 0x05, 0xa7, 0x67, 0xb6, 0xa1, 0xca, 0xdd,
+	0xc2, 0x1e, 0x0f, 0x30, 0xfa, 0x08, 0x4a, 0xae, 0xf7, 0x16, 0xfb, 0x79, 0x41, 0x8c, 0xf5, 0x90,
+	0x21, 0x53, 0x12, 0x87, 0xf3, 0x5c, 0x8b, 0xf5, 0x98, 0xbf, 0x01, 0x83, 0x35, 0x48, 0xb6, 0x5d,
+	0x2a, 0x3e, 0xc6, 0xae, 0x5d, 0x98, 0xe9, 0xda, 0xe6, 0xbf, 0x94, 0x01, 0x18, 0x9d, 0xd8, 0x0e,
+	0xe7, 0x61, 0xdc, 0x98, 0xbd, 0x67, 0x3e, 0x85, 0xb2, 0x47, 0x15, 0xdc, 0x5c, 0x95, 0x82, 0x8c,
+	0x6c, 0x14, 0x8b, 0x0f, 0x48, 0x47, 0x37, 0x2d, 0x1b, 0xdd, 0xee, 0xc2, 0xca, 0xc4, 0xf6, 0xf1,
+	0x38, 0xec, 0x70, 0xe9, 0x72, 0xd4, 0x55, 0x63, 0x23, 0xd8, 0x1b, 0xa1, 0xe8, 0x0e, 0x1d, 0xb7,
+	0xd7, 0x11, 0x0e, 0x52, 0x95, 0xf6, 0x8c, 0xa0, 0xa0, 0x23, 0xd8, 0x4b, 0x40, 0x02, 0x77, 0x10,
+	0xda, 0x3e, 0x09, 0xdc, 0xea, 0xe2, 0xc0, 0xcd, 0x87, 0xa2, 0x2f, 0x41, 0xeb, 0x3b, 0x63, 0x27,
+	0x18, 0xe2, 0x5e, 0xb3, 0xb8, 0x90, 0x2c, 0x1a, 0x9b, 0x0a, 0xf8, 0xa5, 0x74, 0xc0, 0xff, 0x22,
+	0x11, 0x50, 0x0c, 0x2a, 0xfb, 0x45, 0x49, 0xf6, 0xd8, 0x17, 0x12, 0xa1, 0xe5, 0x53, 0x30, 0x7c,
+	0x6c, 0xf7, 0xce, 0xe4, 0x60, 0x51, 0xa3, 0x3b, 0xa3, 0x41, 0xdb, 0x63, 0x32, 0x74, 0x37, 0x11,
+	0x85, 0x74, 0x3a, 0x83, 0x21, 0x6b, 0x87, 0xb8, 0x70, 0x22, 0x14, 0x5d, 0x83, 0x62, 0xe8, 0x63,
+	0xcc, 0xa3, 0x09, 0xd3, 0x24, 0xcb, 0xa7, 0x16, 0xed, 0x20, 0xce, 0x4c, 0x7e, 0x83, 0xe6, 0xca,
+	0x75, 0x35, 0x3d, 0x82, 0xf5, 0x10, 0xd7, 0xe9, 0xd9, 0xe1, 0x74, 0x14, 0x34, 0xeb, 0x59, 0x2e,
+	0xbc, 0x0b, 0x7d, 0x0d, 0x97, 0xc5, 0xb4, 0xc2, 0xe0, 0x41, 0x27, 0x98, 0xd2, 0x20, 0xde, 0x44,
+	0x74, 0x39, 0x97, 0xa2, 0x01, 0xdc, 0x7c, 0x6d, 0xd6, 0x9d, 0x4f, 0xdb, 0xb7, 0x1d, 0x77, 0xea,
+	0xe3, 0xe6, 0x5a, 0x3e, 0xed, 0x63, 0xd6, 0x8d, 0xbe, 0x84, 0x4b, 0x59, 0xda, 0xd0, 0x0b, 0x6d,
+	0xb7, 0xb9, 0x4e, 0x29, 0x2f, 0xa6, 0x29, 0x8f, 0x49, 0xe7, 0xb3, 0xa2, 0x56, 0x36, 0x2a, 0xcf,
+	0x8a, 0x1a, 0x18, 0x55, 0xf3, 0xbf, 0x0b, 0xa0, 0x91, 0x12, 0x46, 0x94, 0x0a, 0x7d, 0xc7, 0xc5,
+	0x89, 0x30, 0x42, 0x3a, 0x2d, 0xda, 0x8c, 0x6e, 0x83, 0x4e, 0x7e, 0x3b, 0xe1, 0xd9, 0x84, 0x95,
+	0x41, 0xf5, 0xad, 0x95, 0x68, 0xcc, 0xf1, 0xd9, 0x04, 0x13, 0x7f, 0x61, 0x4f, 0x8b, 0x0a, 0x84,
+	0x7b, 0xa0, 0x33, 0x81, 0x89, 0xfb, 0xc2, 0x42, 0x3f, 0x8c, 0x07, 0xa3, 0x16, 0x68, 0x74, 0x1b,
+	0xf8, 0x78, 0x4c, 0xf3, 0x8a, 0x6e, 0x45, 0xef, 0xe8, 0x26, 0x54, 0x3c, 0x6a, 0x9a, 0xa0, 0xa9,
+	0x65, 0x4d, 0x2a, 0xfa, 0xd0, 0x67, 0xa0, 0x9f, 0x90, 0xa2, 0xcb, 0xc2, 0xfd, 0x80, 0x7b, 0x12,
+	0x5b, 0xc7, 0x2e, 0x6f, 0xb5, 0xe2, 0xfe, 0xa8, 0xf4, 0x22, 0x5e, 0x54, 0x63, 0xa5, 0x17, 0x69,
+	0x1b, 0x79, 0x3d, 0xdc, 0xac, 0x5e, 0x57, 0x6e, 0xad, 0x58, 0xf4, 0x19, 0xdd, 0x84, 0x7a, 0x70,
+	0x36, 0x72, 0x9d, 0xf1, 0x69, 0x27, 0xb4, 0xfd, 0x01, 0x0e, 0xa9, 0x23, 0xeb, 0xd6, 0x0a, 0x6f,
+	0x3d, 0xa6, 0x8d, 0xe6, 0x57, 0xa0, 0x13, 0x0d, 0xb0, 0x80, 0xbb, 0x2e, 0x07, 0xdc, 0xa2, 0x88,
+	0xb1, 0xeb, 0x72, 0x8c, 0x2d, 0x8a, 0xb0, 0x6a, 0x81, 0x26, 0xc4, 0x43, 0xd7, 0xa1, 0x44, 0x05,
+	0xe4, 0x86, 0x02, 0x49, 0x78, 0xd6, 0x81, 0x3e, 0x86, 0x92, 0x4f, 0xa6, 0xe0, 0x81, 0xa7, 0xce,
+	0x46, 0x88, 0x89, 0x2d, 0xd6, 0x69, 0xfe, 0x01, 0x00, 0xd3, 0x8d, 0x88, 0xa5, 0x4c, 0x43, 0x89,
+	0x58, 0x2a, 0x7c, 0x9d, 0x75, 0x11, 0x1f, 0xa0, 0x33, 0x74, 0x7c, 0xdc, 0xe7, 0xcc, 0x53, 0xba,
+	0xd3, 0x84, 0xee, 0xcc, 0x6d, 0x1a, 0xaa, 0x27, 0x76, 0x97, 0xc6, 0xc4, 0x9b, 0x50, 0x77, 0xc6,
+	0x93, 0x29, 0x29, 0x0c, 0x70, 0xdf, 0xf9, 0x09, 0x07, 0xcd, 0x02, 0x35, 0xdf, 0x0a, 0x6d, 0x3d,
+	0xe2, 0x8d, 0xe6, 0x9f, 0x40, 0xa9, 0x3d, 0xb4, 0xfd, 0x1e, 0xba, 0x03, 0xd0, 0x8d, 0xa8, 0xb9,
+	0x48, 0x0d, 0xb1, 0xe1, 0x79, 0xb3, 0x25, 0x0d, 0xc9, 0x5f, 0xf3, 0x91, 0x1d, 0x0e, 0xe5, 0x35,
+	0xa3, 0x6b, 0x50, 0xf5, 0xa6, 0x21, 0x95, 0x83, 0x14, 0xe3, 0x2c, 0x6d, 0x03, 0x6b, 0x22, 0x83,
+	0x89, 0x85, 0x22, 0xa2, 0xa4, 0x85, 0xf4, 0x5c, 0x0b, 0xe9, 0xc2, 0x42, 0x7f, 0xa9, 0xc0, 0xea,
+	0x1e, 0xad, 0x8f, 0x69, 0xea, 0xc5, 0x3f, 0x4e, 0x71, 0xb0, 0x30, 0x35, 0xa7, 0x72, 0x89, 0x9a,
+	0xcd, 0x25, 0x1b, 0x50, 0x9e, 0x4e, 0x7a, 0x76, 0xc8, 0x4a, 0x09, 0xcd, 0xe2, 0x6f, 0x51, 0x29,
+	0x5b, 0x9a, 0x5f, 0xca, 0x16, 0x0c, 0xd5, 0xdc, 0x06, 0x74, 0x38, 0x26, 0x55, 0x4a, 0xb8, 0xbc,
+	0x64, 0xe6, 0x25, 0x68, 0x3c, 0x77, 0x02, 0x99, 0xe2, 0x59, 0x51, 0x53, 0x8c, 0x82, 0xf9, 0x10,
+	0x8c, 0xb8, 0x23, 0x98, 0x78, 0xe3, 0x80, 0x86, 0x06, 0x42, 0x24, 0xd7, 0x5b, 0x2b, 0x11, 0x43,
+	0x56, 0xa1, 0xfb, 0xfc, 0xc9, 0xfc, 0x5b, 0x05, 0x56, 0xf7, 0xb1, 0x8b, 0xcf, 0xa5, 0xa7, 0x75,
+	0x28, 0xf5, 0x3d, 0xbf, 0x8b, 0x79, 0xfd, 0xc5, 0x5e, 0x44, 0x4d, 0xa6, 0xc6, 0x35, 0xd9, 0x67,
+	0xb0, 0x1a, 0x4c, 0x5c, 0x27, 0xec, 0x84, 0xbe, 0x3d, 0x0e, 0xb8, 0xf3, 0x30, 0xc5, 0x19, 0xb4,
+	0xe3, 0x38, 0x6e, 0x27, 0xbe, 0x70, 0xe2, 0x63, 0xfb, 0xb4, 0x33, 0x70, 0xed, 0x80, 0x65, 0x35,
+	0x8d, 0x94, 0x85, 0xd8, 0x3e, 0x7d, 0x42, 0x5a, 0xcc, 0xef, 0x61, 0xd5, 0xc2, 0xa4, 0xc2, 0x3c,
+	0x87, 0xa4, 0x97, 0x41, 0x1b, 0xe3, 0xb7, 0x1d, 0xe9, 0xac, 0x58, 0x19, 0xe3, 0xb7, 0x2f, 0x48,
+	0x99, 0xfa, 0xf7, 0x0a, 0xa0, 0x36, 0x49, 0xc4, 0x3c, 0x65, 0x71, 0x86, 0x37, 0xa0, 0xcc, 0x6a,
+	0x81, 0xdc, 0x22, 0x86, 0x75, 0xa5, 0x1d, 0xa5, 0x98, 0xeb, 0x28, 0xbc, 0xcc, 0x51, 0x13, 0x85,
+	0x6b, 0x32, 0x37, 0x97, 0x96, 0xcc, 0xcd, 0xdc, 0x75, 0xfe, 0x41, 0x05, 0xb4, 0x3b, 0x8d, 0xca,
+	0x8e, 0x73, 0x89, 0xbc, 0x91, 0x38, 0xab, 0xe8, 0x39, 0xa5, 0x56, 0x6d, 0x51, 0xa9, 0x95, 0x94,
+	0xbd, 0xbc, 0x6c, 0x5d, 0x21, 0x52, 0xbf, 0xba, 0x30, 0xf5, 0x57, 0x96, 0x48, 0xfd, 0xda, 0xec,
+	0xd4, 0x5f, 0x87, 0xc2, 0xe1, 0x3e, 0x3f, 0xdf, 0x16, 0x0e, 0xf7, 0x53, 0x69, 0x4f, 0x4f, 0xa7,
+	0x3d, 0xa9, 0x66, 0x83, 0xf7, 0xab, 0xd9, 0xaa, 0xcb, 0xd7, 0x6c, 0xdc, 0x82, 0xff, 0xa3, 0xc0,
+	0xda, 0x63, 0xda, 0x94, 0x31, 0xe1, 0xe2, 0xd2, 0x39, 0xe5, 0x75, 0x85, 0xac, 0xd7, 0x2d, 0xaf,
+	0xea, 0xd2, 0x12, 0xaa, 0xae, 0xcc, 0x56, 0x75, 0x52, 0xb5, 0xe5, 0xb4, 0x6a, 0xd7, 0xa1, 0x44,
+	0x11, 0x26, 0xbe, 0xd9, 0xd9, 0x8b, 0x39, 0x86, 0x75, 0x1e, 0xf9, 0xde, 0x63, 0xf1, 0xbf, 0x80,
+	0x2a, 0xcb, 0x75, 0x41, 0x48, 0xc2, 0x2f, 0xab, 0x78, 0xe4, 0x9a, 0xb3, 0x4d, 0xda, 0x2d, 0xa0,
+	0x83, 0xe8, 0xb3, 0xf9, 0xdb, 0x22, 0xac, 0x92, 0xe0, 0x98, 0x9c, 0x6d, 0x41, 0xc4, 0xb8, 0x06,
+	0xc5, 0xbe, 0xef, 0x8d, 0x72, 0x8f, 0xeb, 0xa4, 0x03, 0x5d, 0x81, 0x42, 0xe8, 0x35, 0xd5, 0x6c,
+	0x77, 0x21, 0x24, 0x87, 0xbb, 0xf2, 0x78, 0x3a, 0x3a, 0xc1, 0x3e, 0x5d, 0x79, 0xd1, 0xe2, 0x6f,
+	0xe4, 0xb0, 0xe9, 0xe3, 0x37, 0xd8, 0x0f, 0x30, 0x0f, 0x6c, 0xe2, 0x15, 0xed, 0xc0, 0x0a, 0x77,
+	0xad, 0x8e, 0xdd, 0x0f, 0xb1, 0xdf, 0x2c, 0x2f, 0x74, 0xaa, 0x1a, 0x27, 0x78, 0x44, 0xc6, 0xa3,
+	0x47, 0x50, 0x17, 0x0c, 0x4e, 0x70, 0xdf, 0xf3, 0x45, 0x8d, 0x3d, 0x8f, 0x83, 0x98, 0x72, 0x97,
+	0x12, 0x10, 0x16, 0xc2, 0x4f, 0xb9, 0x10, 0xda, 0x62, 0x16, 0x82, 0x82, 0x49, 0xb1, 0x07, 0x8d,
+	0x88, 0x05, 0x17, 0x43, 0x5f, 0xc8, 0x23, 0x9a, 0x95, 0xcb, 0x11, 0xc7, 0x22, 0x38, 0xe7, 0xb1,
+	0xaf, 0x9a, 0xdd, 0x0b, 0x1f, 0x43, 0x7d, 0xe4, 0x8c, 0x3b, 0x92, 0x9b, 0xd6, 0xa8, 0x49, 0x6a,
+	0x23, 0x67, 0xdc, 0x8e, 0x3c, 0x35, 0x0e, 0x8b, 0x2b, 0x72, 0x58, 0x24, 0x20, 0x46, 0x7c, 0xb2,
+	0xa5, 0x20, 0x06, 0xf3, 0xc3, 0x2c, 0x88, 0x11, 0x0f, 0xa3, 0x05, 0x10, 0x7f, 0x36, 0xff, 0x4b,
+	0x81, 0x35, 0x56, 0x80, 0xf0, 0xb3, 0x2d, 0x77, 0x3f, 0x01, 0x07, 0x29, 0xb3, 0xe0, 0xa0, 0xcb,
+	0xa0, 0x05, 0x1d, 0xe9, 0xec, 0xad, 0x5b, 0x95, 0x80, 0xb1, 0x90, 0xce, 0xce, 0xea, 0xec, 0xb3,
+	0x73, 0x12, 0x4e, 0x2a, 0xce, 0x87, 0x93, 0x24, 0x9c, 0xa7, 0x34, 0x0f, 0xe7, 0x49, 0x25, 0xe7,
+	0x72, 0x26, 0x39, 0x3f, 0x88, 0xf6, 0x76, 0x72, 0xb9, 0x37, 0x12, 0x00, 0xce, 0x0c, 0x1c, 0xe1,
+	0x39, 0xdb, 0xa7, 0x49, 0xca, 0x05, 0xfb, 0x54, 0xda, 0x51, 0x85, 0xc4, 0x8e, 0x32, 0x8f, 0x60,
+	0x8d, 0x55, 0x34, 0xe7, 0x97, 0x24, 0xbf, 0xb2, 0x31, 0x5f, 0xc1, 0x1a, 0xab, 0x3c, 0xde, 0x83,
+	0xe3, 0x9c, 0x0a, 0xe4, 0xf7, 0x85, 0xa0, 0xef, 0x11, 0x0e, 0x53, 0x06, 0x29, 0x64, 0x0c, 0x62,
+	0x03, 0x7a, 0xec, 0x4e, 0xd3, 0x79, 0xe6, 0x66, 0x0c, 0x7a, 0x29, 0x59, 0x4c, 0x43, 0xf4, 0xa1,
+	0x8f, 0x41, 0x0b, 0xbd, 0x0e, 0xd1, 0x33, 0x3b, 0x18, 0x24, 0xf4, 0x5f, 0x09, 0x3d, 0xf2, 0x1b,
+	0x98, 0xff, 0xa4, 0xc0, 0x46, 0x7b, 0x7a, 0x42, 0xb6, 0xdc, 0x09, 0x3e, 0x57, 0x90, 0xdd, 0x48,
+	0xa0, 0x4b, 0x72, 0x31, 0x52, 0x24, 0xce, 0xc9, 0x7d, 0x71, 0x46, 0x6d, 0x41, 0x87, 0x44, 0x71,
+	0x5a, 0x9d, 0x15, 0xa7, 0x3f, 0x81, 0x12, 0x4b, 0x15, 0xc5, 0x19, 0xa9, 0x82, 0x75, 0x9b, 0x3f,
+	0x42, 0xfd, 0x09, 0x0e, 0xe9, 0xc9, 0x3a, 0x16, 0x7e, 0xde, 0xc9, 0xfb, 0x23, 0xa8, 0x79, 0xfd,
+	0x7e, 0x80, 0x43, 0x1e, 0x56, 0x0a, 0xf4, 0x78, 0x5f, 0x65, 0x6d, 0x2c, 0xaa, 0x64, 0x0f, 0xdc,
+	0xaa, 0x94, 0x1e, 0xcd, 0x4f, 0xa0, 0xfe, 0xf2, 0x0d, 0xf6, 0xdf, 0xfa, 0x4e, 0x88, 0x0f, 0xc7,
+	0x3d, 0xfc, 0x13, 0xf1, 0x3b, 0x87, 0x3c, 0xd0, 0x39, 0x55, 0x8b, 0xbd, 0x98, 0xef, 0x54, 0xa8,
+	0x1f, 0x4d, 0xcf, 0x23, 0xdb, 0x3a, 0x94, 0xde, 0xd8, 0xee, 0x94, 0x55, 0x00, 0x35, 0x8b, 0xbd,
+	0x90, 0xca, 0x7c, 0xea, 0xbb, 0xbc, 0x32, 0x22, 0x8f, 0x04, 0x98, 0xf7, 0x71, 0x77, 0xea, 0x07,
+	0xce, 0x1b, 0xcc, 0x77, 0x73, 0xdc, 0x80, 0x3e, 0x07, 0xbd, 0x87, 0x5d, 0x67, 0xe4, 0x84, 0x1c,
+	0xff, 0xad, 0xf3, 0x03, 0xdc, 0xbe, 0x68, 0xb5, 0xe2, 0x01, 0xe8, 0x73, 0x40, 0xec, 0x90, 0xdd,
+	0xa1, 0x80, 0x84, 0x54, 0xa7, 0xa9, 0x96, 0xc1, 0x7a, 0x88, 0x84, 0xfb, 0xb4, 0x1d, 0xdd, 0x86,
+	0x55, 0x79, 0x74, 0x5c, 0x9b, 0xa9, 0x56, 0x23, 0x1e, 0xcc, 0xd4, 0x78, 0x13, 0xea, 0x24, 0x24,
+	0x62, 0xbf, 0xe3, 0xe3, 0xae, 0xe7, 0xf7, 0x02, 0x1a, 0xe7, 0x55, 0x6b, 0x85, 0xb5, 0x5a, 0xac,
+	0x11, 0x7d, 0x03, 0x0d, 0x4f, 0xa8, 0xb3, 0xc3, 0xd4, 0xc8, 0xf2, 0xc7, 0x1a, 0x2b, 0x5d, 0x12,
+	0xaa, 0xb6, 0xea, 0x5e, 0x52, 0xf5, 0x1b, 0x50, 0xee, 0xd1, 0x5d, 0x48, 0xf3, 0x83, 0x66, 0xf1,
+	0xb7, 0x08, 0x57, 0x58, 0x99, 0x8b, 0x2b, 0xd4, 0x73, 0x70, 0x85, 0xf4, 0xe6, 0x6c, 0xa4, 0x37,
+	0x27, 0x2b, 0x06, 0xf9, 0xd5, 0xc6, 0x6f, 0x15, 0x58, 0x89, 0x8c, 0x4c, 0x16, 0x94, 0xf2, 0x1e,
+	0x25, 0xe5, 0x3d, 0x84, 0x3b, 0x3b, 0xff, 0x77, 0x28, 0x16, 0x52, 0xe0, 0x87, 0x66, 0xda, 0xf4,
+	0x94, 0x20, 0x22, 0x39, 0xfa, 0x50, 0x97, 0xd7, 0x47, 0x02, 0x54, 0x28, 0xce, 0x07, 0x15, 0xfe,
+	0xb4, 0x00, 0xf5, 0x84, 0xec, 0xb4, 0xf4, 0xa3, 0x47, 0x3b, 0x2a, 0xb7, 0x66, 0xb1, 0x17, 0xf4,
+	0x39, 0x89, 0xd6, 0xcc, 0x84, 0x2c, 0x9e, 0x20, 0x06, 0x08, 0xc8, 0xb4, 0x96, 0x18, 0x92, 0xbc,
+	0x36, 0x52, 0xd3, 0xd7, 0x46, 0xb7, 0xa1, 0xcc, 0xec, 0xcf, 0xa5, 0xcb, 0x63, 0xc5, 0x47, 0x90,
+	0xb1, 0x7d, 0xcf, 0x0b, 0xa3, 0xf4, 0x96, 0x3b, 0x96, 0x8d, 0x88, 0x0c, 0x5e, 0x9e, 0x6b, 0xf0,
+	0x4a, 0x1e, 0x90, 0xf4, 0x23, 0xc0, 0xab, 0x89, 0xeb, 0xd9, 0xbd, 0x23, 0xdb, 0x0f, 0xa5, 0x22,
+	0x90, 0xd9, 0x8e, 0xbf, 0xa5, 0xec, 0x5a, 0x48, 0xdb, 0x55, 0xd2, 0x91, 0xba, 0x50, 0x47, 0xe6,
+	0x5f, 0x14, 0xc4, 0x9c, 0x14, 0x2f, 0x62, 0xb7, 0x0a, 0x4a, 0xfa, 0x56, 0x21, 0x8a, 0x17, 0x85,
+	0xfc, 0x78, 0xf1, 0x01, 0xe8, 0x91, 0xf9, 0x85, 0xa6, 0xa3, 0x06, 0xf9, 0x84, 0x54, 0x5c, 0xfe,
+	0x84, 0x74, 0x13, 0x4a, 0x13, 0xdb, 0x0f, 0xc5, 0x19, 0x83, 0x55, 0x49, 0xb1, 0x7a, 0x2c, 0xd6,
+	0x4b, 0x98, 0x33, 0xf0, 0xa4, 0xb7, 0x44, 0xc9, 0x2b, 0x86, 0x12, 0x81, 0x09, 0xca, 0x44, 0x76,
+	0x68, 0x8f, 0xda, 0x42, 0xb3, 0xe2, 0x06, 0xf3, 0x97, 0x80, 0x76, 0xf1, 0xc0, 0x19, 0xb3, 0xd9,
+	0x96, 0x8c, 0x99, 0x09, 0x1d, 0x14, 0x52, 0x3a, 0x30, 0x7f, 0x03, 0xeb, 0x47, 0xd3, 0x50, 0x12,
+	0x9f, 0x33, 0x9d, 0xa5, 0xf0, 0xd8, 0xf8, 0x85, 0x84, 0xf1, 0x73, 0x23, 0xb3, 0xb9, 0x19, 0x95,
+	0x4d, 0x49, 0x91, 0x67, 0x70, 0x37, 0x87, 0x70, 0x71, 0x8f, 0xaf, 0x76, 0x29, 0x02, 0x22, 0x4e,
+	0x30, 0xb4, 0xb7, 0xbe, 0xf8, 0x52, 0x64, 0x5a, 0xf6, 0x96, 0x0e, 0x51, 0x6a, 0xa6, 0x7e, 0xf8,
+	0x73, 0x05, 0x1a, 0x7b, 0xde, 0xe4, 0x4c, 0x4e, 0x3e, 0x57, 0x40, 0x0d, 0xfc, 0x6e, 0x56, 0x8f,
+	0xa4, 0x95, 0x74, 0xf6, 0x82, 0x30, 0xeb, 0x68, 0xa4, 0x75, 0x81, 0x9f, 0xa5, 0x84, 0x29, 0x66,
+	0x84, 0x89, 0x31, 0xb3, 0xe5, 0x73, 0xa1, 0xf9, 0x87, 0x0c, 0x33, 0x5b, 0x9e, 0x82, 0x44, 0x80,
+	0xfe, 0x34, 0xba, 0x56, 0xa4, 0xcf, 0xa4, 0xce, 0x1c, 0x3a, 0x41, 0xe8, 0xf9, 0x67, 0x3c, 0x8f,
+	0x8b, 0x57, 0xf3, 0x2e, 0x34, 0x7e, 0x65, 0xbb, 0xa7, 0xe7, 0x90, 0xe8, 0x08, 0x1a, 0x4f, 0x5c,
+	0xef, 0x44, 0xa6, 0x58, 0xaa, 0xd8, 0x6b, 0x42, 0x65, 0x62, 0x87, 0x21, 0xf6, 0xc5, 0xa1, 0x5f,
+	0xbc, 0x12, 0x7c, 0x54, 0x5c, 0x18, 0x04, 0xd1, 0x95, 0x40, 0x06, 0xf7, 0x13, 0x43, 0xd8, 0x95,
+	0x00, 0x79, 0x32, 0xdf, 0x42, 0x63, 0xdf, 0xe9, 0xf7, 0x65, 0x51, 0x3e, 0x66, 0x95, 0x6a, 0xfe,
+	0x02, 0x48, 0xd1, 0x4a, 0x1e, 0xc8, 0x28, 0xcf, 0xed, 0x75, 0xf2, 0x83, 0x4a, 0xc5, 0x73, 0x7b,
+	0x74, 0x54, 0x13, 0x2a, 0xc1, 0xd0, 0x76, 0x5d, 0xef, 0x2d, 0xb7, 0xb6, 0x78, 0x35, 0x7f, 0x00,
+	0x23, 0x9e, 0x38, 0x06, 0x2c, 0xc5, 0xcc, 0xc1, 0x0c, 0xc1, 0xf9, 0xf4, 0x74, 0x91, 0x62, 0x7e,
+	0x91, 0x4b, 0xd2, 0x63, 0xb9, 0x10, 0x81, 0xd9, 0x16, 0xd8, 0xe6, 0x39, 0x7c, 0x60, 0x61, 0x61,
+	0x7d, 0x0d, 0xaa, 0x8f, 0x83, 0xee, 0xa9, 0x60, 0x67, 0x80, 0xda, 0x77, 0x7e, 0xe2, 0xd9, 0x8e,
+	0x3c, 0x9a, 0x5f, 0x42, 0x8d, 0x0d, 0xe0, 0xab, 0x93, 0x46, 0xe8, 0x74, 0x04, 0x85, 0x47, 0x7c,
+	0xdf, 0x8b, 0x20, 0x6b, 0xfa, 0x62, 0xfe, 0xbb, 0x02, 0x1b, 0x44, 0x90, 0x97, 0x13, 0xcc, 0xbf,
+	0x5c, 0x60, 0x53, 0xbc, 0xde, 0x5a, 0xce, 0x4b, 0xee, 0x40, 0x85, 0x20, 0xe9, 0xa1, 0x2d, 0x2e,
+	0x84, 0xd7, 0x45, 0xfe, 0x38, 0xb6, 0xfd, 0x88, 0xd7, 0xd3, 0x0b, 0x56, 0x79, 0x42, 0x9b, 0xd0,
+	0x43, 0xa8, 0xb1, 0x5a, 0x87, 0x6b, 0x53, 0xe5, 0x5f, 0x52, 0xf0, 0x4a, 0x8f, 0xeb, 0x2d, 0x90,
+	0x49, 0xab, 0xbd, 0xb8, 0x7d, 0xe1, 0xb6, 0xdd, 0xad, 0x82, 0xee, 0x89, 0xc5, 0x98, 0xaf, 0xa0,
+	0x91, 0x12, 0x25, 0x19, 0x15, 0x94, 0x74, 0x54, 0x30, 0x40, 0x0d, 0xed, 0x01, 0xd7, 0x11, 0x79,
+	0x24, 0xfb, 0xb3, 0x67, 0x87, 0x36, 0x0f, 0xa1, 0xf4, 0xd9, 0x7c, 0x08, 0xeb, 0x79, 0xb2, 0xd2,
+	0x93, 0x5c, 0xe4, 0x4f, 0xba, 0xc5, 0x5e, 0xb2, 0x3c, 0xc9, 0x2e, 0x7e, 0x82, 0x93, 0x62, 0x2d,
+	0xd8, 0xc5, 0x43, 0x40, 0x69, 0x0f, 0x7e, 0xbd, 0x85, 0x6e, 0x49, 0xfb, 0x42, 0x91, 0xaa, 0xa6,
+	0xc8, 0x2d, 0xa3, 0xbd, 0x71, 0x4b, 0xda, 0x67, 0x85, 0xdc, 0x91, 0xdc, 0xd9, 0xcd, 0xfb, 0xd0,
+	0x64, 0x10, 0xc2, 0xf1, 0x68, 0x42, 0x1a, 0xda, 0x38, 0x8c, 0xbc, 0xea, 0x2a, 0x00, 0x5d, 0x12,
+	0x0e, 0x3b, 0x22, 0xf0, 0x5b, 0x3a, 0x6f, 0x39, 0xec, 0x99, 0xbf, 0x0b, 0x1b, 0x16, 0x1e, 0xe3,
+	0xb7, 0x32, 0xa5, 0xf0, 0xff, 0x79, 0x84, 0xc4, 0xa8, 0x61, 0xe8, 0x76, 0x02, 0xdc, 0xf5, 0xc6,
+	0x3d, 0x51, 0xa5, 0x40, 0x18, 0xba, 0x6d, 0xd6, 0x42, 0x4e, 0xfa, 0x7b, 0x2e, 0xb6, 0xfd, 0xc4,
+	0x81, 0x6f, 0x49, 0x1f, 0x35, 0x87, 0x60, 0x1c, 0x4d, 0x43, 0x0e, 0x26, 0x72, 0x81, 0xa2, 0xcc,
+	0xa8, 0xc8, 0x67, 0x96, 0x0f, 0xa0, 0x18, 0xda, 0x03, 0xb1, 0xc5, 0x35, 0x06, 0x4b, 0xd8, 0x03,
+	0x8b, 0xb6, 0xc6, 0x97, 0x6e, 0xea, 0x8c, 0x4b, 0x37, 0xb3, 0x2f, 0xe0, 0x97, 0xe4, 0x64, 0xff,
+	0xe7, 0xf7, 0x6a, 0x7f, 0xa5, 0xc0, 0xea, 0x13, 0xcc, 0x97, 0x14, 0x48, 0xe7, 0x6c, 0x71, 0xf9,
+	0xa9, 0xcc, 0xb9, 0xfc, 0xcc, 0x3b, 0x4a, 0x16, 0x17, 0x1d, 0x25, 0x13, 0x48, 0xeb, 0x55, 0x00,
+	0x7a, 0xc9, 0xdc, 0x89, 0xbe, 0x6f, 0x29, 0x92, 0x5a, 0x39, 0xb4, 0x5d, 0x82, 0x71, 0x99, 0x87,
+	0x74, 0xd3, 0x71, 0xb1, 0x99, 0x68, 0x8b, 0xef, 0x2b, 0x23, 0x83, 0x14, 0xe4, 0x52, 0x65, 0x9b,
+	0x6e, 0x94, 0xf3, 0xb1, 0x32, 0xff, 0x5a, 0x01, 0x43, 0x50, 0x45, 0xca, 0x49, 0x5c, 0xf9, 0x2a,
+	0x0b, 0xae, 0x7c, 0xff, 0xdf, 0x55, 0x84, 0xd8, 0x0d, 0x9a, 0xbc, 0x30, 0xf3, 0x15, 0x18, 0xc7,
+	0xf6, 0xe0, 0x3d, 0x3c, 0x67, 0xae, 0xd7, 0x9a, 0xeb, 0x80, 0xc8, 0x54, 0x49, 0x5f, 0x21, 0x55,
+	0x01, 0x69, 0x3d, 0xb6, 0x07, 0x41, 0x5c, 0xcd, 0x95, 0xd9, 0xc5, 0xac, 0xf8, 0xec, 0x89, 0xbd,
+	0xb1, 0x6b, 0xdb, 0xae, 0x3b, 0xed, 0xe1, 0x0e, 0x97, 0x85, 0xe5, 0xa7, 0x15, 0xde, 0xca, 0x38,
+	0x9b, 0x6d, 0x30, 0x62, 0x8e, 0x3c, 0x5e, 0xb4, 0x58, 0xe4, 0x63, 0xb2, 0xc7, 0x82, 0x91, 0x46,
+	0x69, 0x69, 0x85, 0x99, 0x4b, 0x33, 0xbf, 0x15, 0x81, 0xf6, 0xbd, 0x5c, 0xdd, 0xbc, 0x04, 0x17,
+	0x53, 0xe4, 0x4c, 0x30, 0xf3, 0x17, 0x22, 0x49, 0xcb, 0x0a, 0x10, 0x7a, 0x54, 0x66, 0xe9, 0x51,
+	0x26, 0xe1, 0x8c, 0xee, 0x03, 0xda, 0x1b, 0xe2, 0xee, 0xe9, 0xf9, 0xcd, 0x66, 0xfe, 0x1c, 0xd6,
+	0x12, 0xa4, 0x5c, 0x67, 0x1b, 0x50, 0xc6, 0x3f, 0x39, 0x41, 0x18, 0xf0, 0xe4, 0xc4, 0xdf, 0xcc,
+	0xbb, 0x50, 0xe1, 0xab, 0x58, 0x76, 0xf5, 0xdf, 0xc2, 0x1a, 0x8b, 0x7b, 0xfb, 0x8e, 0x2f, 0x09,
+	0x67, 0x80, 0xea, 0x9d, 0xfc, 0x20, 0x4a, 0x03, 0xef, 0xe4, 0x87, 0x19, 0x7b, 0xef, 0x67, 0xb0,
+	0xf6, 0x04, 0x2f, 0x41, 0x6e, 0x3e, 0x85, 0x8d, 0x48, 0xcb, 0xc9, 0xb1, 0x1b, 0x09, 0x3d, 0xe8,
+	0x91, 0xc7, 0xc6, 0xae, 0x56, 0x90, 0x5d, 0xcd, 0xfc, 0xb3, 0x02, 0x54, 0xc5, 0xf7, 0x08, 0x04,
+	0x16, 0xf8, 0x2a, 0xbd, 0xd0, 0xab, 0xd2, 0x42, 0xe9, 0x10, 0xfe, 0x1c, 0x1c, 0x8c, 0x43, 0xff,
+	0x2c, 0x8e, 0x71, 0x9b, 0x89, 0x2d, 0xd1, 0xca, 0x50, 0x11, 0x1b, 0x32, 0x12, 0x3a, 0xae, 0x75,
+	0x08, 0x35, 0x99, 0x11, 0x59, 0xe4, 0x29, 0x3e, 0x13, 0x8b, 0x3c, 0xc5, 0x67, 0xe8, 0x86, 0xac,
+	0xa3, 0x4c, 0xec, 0x60, 0x7d, 0x5f, 0x17, 0xee, 0x29, 0xad, 0x7d, 0xd0, 0x23, 0xee, 0x39, 0x7c,
+	0x3e, 0x4a, 0xf2, 0x49, 0x5e, 0x86, 0x45, 0x5c, 0xcc, 0x6d, 0x30, 0x98, 0x4e, 0x1f, 0xb9, 0x6e,
+	0x8c, 0xe2, 0x27, 0x4a, 0x1f, 0x25, 0x5d, 0xfa, 0xdc, 0xbe, 0x0d, 0x10, 0x7f, 0x22, 0x88, 0x34,
+	0x28, 0xbe, 0x6a, 0x1f, 0x58, 0xc6, 0x05, 0xf2, 0xf4, 0xe8, 0xd5, 0xf1, 0x4b, 0x43, 0x21, 0x4f,
+	0x8f, 0xdb, 0x7b, 0xdf, 0x19, 0x85, 0xdb, 0xf7, 0xd8, 0x57, 0x3f, 0xf4, 0x53, 0x9d, 0x1a, 0x68,
+	0xd6, 0x41, 0xfb, 0xc0, 0x7a, 0x7d, 0xb0, 0xcf, 0x46, 0x3f, 0x3e, 0x7c, 0x7e, 0x60, 0x28, 0xa8,
+	0x02, 0xea, 0xfe, 0xa1, 0x65, 0x14, 0x50, 0x15, 0x2a, 0xed, 0x5f, 0x7f, 0xff, 0xfc, 0xf0, 0xc5,
+	0x77, 0x86, 0x7a, 0x7b, 0x1b, 0xaa, 0x12, 0xa2, 0x49, 0xfb, 0x8e, 0x1f, 0x59, 0xc7, 0x94, 0x56,
+	0x87, 0x92, 0x75, 0xf0, 0x68, 0xff, 0xd7, 0x86, 0x42, 0x98, 0x3e, 0x3e, 0x7c, 0x71, 0xd8, 0x7e,
+	0x7a, 0xb0, 0x6f, 0x14, 0x6e, 0x3f, 0x00, 0x3d, 0xc2, 0xf1, 0xc8, 0x0c, 0x2f, 0x5e, 0xbe, 0x38,
+	0x60, 0x73, 0x3d, 0x6b, 0xbf, 0x7c, 0xc1, 0x24, 0x7b, 0x7e, 0xf8, 0xe2, 0xc0, 0x28, 0x90, 0x59,
+	0xdb, 0xbf, 0x7c, 0x6e, 0xa8, 0xe4, 0x61, 0xaf, 0xfd, 0xda, 0x28, 0x6e, 0xfd, 0xdb, 0x3a, 0xa8,
+	0x8f, 0x8e, 0x0e, 0xd1, 0x43, 0x80, 0xf8, 0xf3, 0x0a, 0xb4, 0xc1, 0x72, 0x7d, 0xfa, 0x7b, 0x8b,
+	0xd6, 0x46, 0xe6, 0x48, 0x7f, 0x40, 0x6f, 0x02, 0x2f, 0xa0, 0xaf, 0xa0, 0x2a, 0x7d, 0x05, 0x81,
+	0x2e, 0x51, 0x06, 0xd9, 0xef, 0x22, 0x5a, 0xc9, 0x0f, 0x17, 0xcc, 0x0b, 0xe8, 0x3e, 0x68, 0xe2,
+	0x83, 0x07, 0xc4, 0x0a, 0xdc, 0xd4, 0x87, 0x11, 0xad, 0x8b, 0xa9, 0x56, 0x1e, 0x1e, 0x2e, 0x10,
+	0x99, 0xe3, 0x4f, 0x1d, 0xb8, 0xcc, 0x99, 0x6f, 0x1f, 0xe6, 0xc8, 0xfc, 0x10, 0x20, 0xfe, 0x00,
+	0x81, 0xd3, 0x67, 0xbe, 0x48, 0x98, 0x43, 0xff, 0x05, 0x54, 0xa5, 0x0f, 0x0e, 0xf8, 0x9a, 0xb3,
+	0x9f, 0x20, 0xb4, 0xe4, 0xca, 0xc9, 0xbc, 0x80, 0x76, 0xa1, 0x26, 0x5f, 0x19, 0xa3, 0x26, 0xaf,
+	0x16, 0x33, 0xb7, 0xc8, 0x73, 0xa6, 0xfe, 0x16, 0x56, 0x12, 0x57, 0xaf, 0xe8, 0xb2, 0xac, 0xf0,
+	0x24, 0x97, 0xf4, 0xb5, 0x96, 0x79, 0x01, 0xdd, 0x03, 0x88, 0x2f, 0x52, 0xf9, 0xca, 0x33, 0x37,
+	0xab, 0x2d, 0x23, 0x45, 0x18, 0x98, 0x17, 0xd0, 0x0e, 0x4b, 0x45, 0xc2, 0x4b, 0x7d, 0x6c, 0x8f,
+	0x66, 0xd2, 0x67, 0x27, 0xbe, 0xab, 0x90, 0xd5, 0xcb, 0x97, 0x24, 0x7c, 0xf5, 0x39, 0xf7, 0x26,
+	0x73, 0x56, 0xff, 0x00, 0xaa, 0xd2, 0x5d, 0x08, 0x57, 0x7c, 0xf6, 0x76, 0x24, 0x5f, 0x80, 0x3d,
+	0x68, 0xa4, 0x2e, 0x39, 0xd0, 0x15, 0x66, 0xb9, 0xdc, 0xab, 0x8f, 0x7c, 0x26, 0x5f, 0x40, 0x55,
+	0xfa, 0x70, 0x83, 0x4b, 0x90, 0xfd, 0x94, 0x23, 0xc7, 0xf4, 0xf2, 0x1d, 0x22, 0x5f, 0x7c, 0xce,
+	0xb5, 0xe2, 0x52, 0xa6, 0xe7, 0x4c, 0x12, 0xa6, 0x4f, 0x72, 0x49, 0x7f, 0x96, 0x1d, 0x9b, 0x9e,
+	0xd3, 0xc6, 0xa6, 0x4b, 0x12, 0x1a, 0x29, 0xc2, 0x80, 0x09, 0x2f, 0xdf, 0xc3, 0x25, 0x2c, 0xb7,
+	0xac, 0xf0, 0xbb, 0x50, 0x63, 0x3b, 0x2c, 0xc1, 0x23, 0xe7, 0x32, 0x6e, 0x0e, 0x8f, 0xaf, 0xa1,
+	0xc2, 0x31, 0x54, 0xb4, 0x96, 0x44, 0x54, 0x17, 0x50, 0xde, 0x52, 0xd0, 0x7d, 0xa8, 0x4a, 0x80,
+	0xa2, 0xb0, 0x5b, 0x06, 0x62, 0x6c, 0xc9, 0x20, 0x27, 0x57, 0xdc, 0x0e, 0x85, 0xf5, 0x25, 0x58,
+	0xf8, 0xb2, 0x98, 0x3c, 0x03, 0x26, 0xb6, 0xd2, 0x18, 0x29, 0x9d, 0x3b, 0x36, 0x1c, 0x9f, 0x3d,
+	0x61, 0xb8, 0x85, 0xf3, 0x3f, 0x86, 0x7a, 0x12, 0x2a, 0x44, 0xad, 0xe8, 0x83, 0xbd, 0x0c, 0x7e,
+	0x38, 0x57, 0x7d, 0x9a, 0xc0, 0x01, 0x79, 0xc0, 0x4d, 0xc1, 0x82, 0x73, 0x68, 0x77, 0xa0, 0xf2,
+	0x04, 0xcb, 0xaa, 0x4f, 0xde, 0xb4, 0xb5, 0xae, 0x64, 0x28, 0x69, 0xc9, 0xfe, 0x9a, 0x16, 0x3d,
+	0x64, 0xdf, 0xc4, 0x69, 0x82, 0x32, 0x49, 0xa4, 0x09, 0x99, 0x51, 0xf2, 0x04, 0x6d, 0x5e, 0x40,
+	0x5b, 0x2c, 0x4d, 0x48, 0x52, 0xa7, 0xb0, 0xc0, 0x56, 0x3d, 0x41, 0x12, 0xd0, 0xd4, 0x52, 0x17,
+	0x83, 0x78, 0xa4, 0xca, 0xa7, 0x4c, 0x4f, 0x76, 0x57, 0x41, 0xdb, 0xa0, 0x09, 0x2c, 0x90, 0x13,
+	0xa5, 0xa0, 0xc1, 0x3c, 0xa2, 0x2d, 0xd0, 0x04, 0x1c, 0xc8, 0x89, 0x52, 0xe8, 0x60, 0xbe, 0x8c,
+	0x62, 0x50, 0x42, 0xc6, 0x34, 0x65, 0xce, 0x74, 0xf7, 0x41, 0x13, 0xb8, 0x05, 0x27, 0x4a, 0x21,
+	0x80, 0xad, 0x8b, 0xa9, 0xd6, 0x6c, 0xe6, 0xa4, 0xc4, 0x1b, 0x29, 0x84, 0x68, 0xb1, 0x1f, 0x7c,
+	0x03, 0x7a, 0x54, 0x42, 0xa1, 0x8b, 0x12, 0x79, 0x5c, 0x52, 0xcd, 0xa1, 0xbe, 0x03, 0x45, 0x02,
+	0xa8, 0x21, 0x16, 0x64, 0x24, 0xf0, 0xad, 0xb5, 0x2a, 0xb5, 0x08, 0x61, 0xef, 0x2a, 0xe8, 0x19,
+	0x34, 0x12, 0x40, 0xda, 0xeb, 0x2d, 0x1e, 0xb2, 0xf3, 0xe1, 0xb5, 0xb9, 0x11, 0xe0, 0x11, 0x68,
+	0x0c, 0x1f, 0x22, 0x98, 0x92, 0xf0, 0x61, 0x19, 0x2e, 0x5a, 0xec, 0xc4, 0x3b, 0x00, 0x42, 0xa7,
+	0x11, 0x93, 0xb4, 0xea, 0x2f, 0xe5, 0xaa, 0xfe, 0xf5, 0x16, 0x65, 0x60, 0x81, 0x91, 0xc6, 0x81,
+	0xe6, 0x2f, 0xe8, 0xaa, 0x94, 0x27, 0xb2, 0xd8, 0x11, 0x5d, 0xd7, 0x53, 0x68, 0xa4, 0x00, 0x22,
+	0xce, 0x32, 0x1f, 0x36, 0x9a, 0x63, 0x9e, 0x7d, 0x58, 0x91, 0x00, 0xa1, 0xd7, 0x5b, 0x3c, 0x4e,
+	0xe5, 0x81, 0x44, 0xb3, 0xb9, 0x6c, 0xfd, 0x5d, 0x15, 0x74, 0x56, 0x7b, 0x93, 0xf2, 0x72, 0x1b,
+	0xf4, 0x08, 0x27, 0xe2, 0x0e, 0x93, 0xc6, 0x8d, 0x5a, 0x72, 0xbd, 0xce, 0x83, 0x75, 0x3d, 0x1a,
+	0xd4, 0xa6, 0xd7, 0x8e, 0x33, 0x28, 0x6b, 0x12, 0x65, 0x40, 0x49, 0x77, 0x00, 0xa2, 0x51, 0xc1,
+	0x2c, 0xb2, 0x79, 0x6e, 0x12, 0x65, 0x6a, 0x2e, 0xb3, 0x9c, 0xa9, 0x97, 0xe4, 0x82, 0xee, 0x83,
+	0x1e, 0x21, 0x49, 0x48, 0x5e, 0xdd, 0x62, 0x17, 0x3b, 0x00, 0x88, 0x48, 0x03, 0xbe, 0x41, 0x33,
+	0xa8, 0xd4, 0x62, 0x36, 0xdf, 0x80, 0x26, 0xe0, 0x22, 0x14, 0xa1, 0xc7, 0x32, 0x32, 0xb2, 0xc4,
+	0x56, 0x91, 0xa9, 0x53, 0x80, 0xd1, 0x62, 0x01, 0xf6, 0x40, 0x17, 0x34, 0xc2, 0x0c, 0x69, 0xf8,
+	0x68, 0x31, 0x93, 0x2d, 0xd0, 0x23, 0x44, 0x07, 0xc5, 0xa7, 0x81, 0x84, 0x24, 0x12, 0x56, 0xc5,
+	0x57, 0xae, 0x47, 0x88, 0x0f, 0xa7, 0x49, 0x23, 0x40, 0x73, 0x23, 0x94, 0x48, 0xd5, 0x79, 0xd6,
+	0x6b, 0x24, 0xce, 0xbc, 0x34, 0x3d, 0xed, 0x42, 0x55, 0x02, 0x1c, 0x78, 0x5e, 0xcb, 0xa2, 0x17,
+	0xad, 0x66, 0xb6, 0x23, 0x0a, 0xca, 0x0f, 0xa0, 0x2a, 0xa1, 0x49, 0x9c, 0x47, 0x16, 0x5f, 0xca,
+	0x99, 0xfe, 0x2e, 0xd9, 0xfe, 0x2b, 0x09, 0x38, 0x06, 0xc9, 0xb0, 0x7f, 0x8a, 0x41, 0x2b, 0xaf,
+	0x2b, 0x12, 0x63, 0x1b, 0xca, 0x34, 0x22, 0x0e, 0x50, 0x04, 0xd3, 0x2c, 0x36, 0xd1, 0xa7, 0x00,
+	0x5c, 0x61, 0x49, 0xc2, 0x1c, 0x55, 0x3d, 0x60, 0x99, 0x9c, 0x1c, 0xe4, 0xa5, 0x7c, 0x2c, 0x81,
+	0x45, 0xad, 0x8b, 0xa9, 0x56, 0x29, 0x13, 0xec, 0x88, 0xc4, 0x45, 0xc9, 0xe5, 0xc4, 0x25, 0x33,
+	0xb8, 0x94, 0x69, 0x97, 0x94, 0x5c, 0xe1, 0x7f, 0xe1, 0x80, 0x66, 0x98, 0x7f, 0x6e, 0x64, 0xac,
+	0xc9, 0xa8, 0x0f, 0x0f, 0x0a, 0x39, 0x40, 0xd0, 0xdc, 0x6d, 0x75, 0x08, 0xb5, 0x27, 0x38, 0xc3,
+	0x25, 0x07, 0x0f, 0x5a, 0xac, 0xf6, 0xa7, 0xd0, 0x48, 0xc1, 0x43, 0x3c, 0xe8, 0xe7, 0x83, 0x46,
+	0xb3, 0xc5, 0xda, 0x7d, 0xf0, 0xcf, 0xef, 0x3e, 0x54, 0xfe, 0xf5, 0xdd, 0x87, 0xca, 0x7f, 0xbe,
+	0xfb, 0x50, 0xf9, 0xbd, 0x9f, 0x0f, 0x9c, 0x70, 0x38, 0x3d, 0xd9, 0xec, 0x7a, 0xa3, 0x3b, 0x13,
+	0xbb, 0x3b, 0x3c, 0xeb, 0x61, 0x5f, 0x7e, 0x0a, 0xfc, 0xee, 0x9d, 0xf8, 0xaf, 0xd7, 0x4f, 0xca,
+	0x94, 0xdd, 0xf6, 0xff, 0x0e, 0x00, 0x4f, 0x80, 0x7c, 0xf2, 0xd2, 0x3e, 0x00, 0x00,
 }
 
-func (x *aPIGetFileClient) Recv() (*types.BytesValue, error) {
-	m := new(types.BytesValue)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	// Repo rpcs
+	// CreateRepo creates a new repo.
+	// An error is returned if the repo already exists.
+	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// InspectRepo returns info about a repo.
+	InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error)
+	// ListRepo returns info about all repos.
+	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error)
+	// DeleteRepo deletes a repo.
+	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// RenameRepo renames a repo, preserving its history and provenance.
+	RenameRepo(ctx context.Context, in *RenameRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Commit rpcs
+	// StartCommit creates a new write commit from a parent commit.
+	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
+	// FinishCommit turns a write commit into a read commit.
+	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// InspectCommit returns the info about a commit.
+	InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error)
+	// ListCommit returns info about all commits. This is deprecated in favor of
+	// ListCommitStream.
+	ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (*CommitInfos, error)
+	// ListCommitStream is like ListCommit, but returns its results in a GRPC stream
+	ListCommitStream(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitStreamClient, error)
+	// DeleteCommit deletes a commit.
+	DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// FlushCommit waits for downstream commits to finish
+	FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error)
+	// SubscribeCommit subscribes for new commits on a given branch
+	SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error)
+	// BuildCommit builds a commit that's backed by the given tree
+	BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error)
+	// CreateBranch creates a new branch
+	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// InspectBranch returns info about a branch.
+	InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error)
+	// ListBranch returns info about the heads of branches.
+	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error)
+	// DeleteBranch deletes a branch; note that the commits still exist.
+	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// RenameBranch renames a branch, preserving its history and provenance.
+	RenameBranch(ctx context.Context, in *RenameBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// File rpcs
+	// PutFile writes the specified file to pfs.
+	PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error)
+	// BeginUpload starts a resumable upload session for a file.
+	BeginUpload(ctx context.Context, in *BeginUploadRequest, opts ...grpc.CallOption) (*UploadInfo, error)
+	// PutUploadPart uploads one numbered part of an upload, replacing any part
+	// previously uploaded with the same number.
+	PutUploadPart(ctx context.Context, opts ...grpc.CallOption) (API_PutUploadPartClient, error)
+	// InspectUpload returns an upload session and the parts it has received.
+	InspectUpload(ctx context.Context, in *InspectUploadRequest, opts ...grpc.CallOption) (*UploadInfo, error)
+	// CompleteUpload verifies an upload's checksum, adds the file to its
+	// commit and ends the session.
+	CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// CopyFile copies the contents of one file to another.
+	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetFile returns a byte stream of the contents of the file.
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
+	// InspectFile returns info about a file.
+	InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error)
+	// ListFile returns info about all files. This is deprecated in favor of
+	// ListFileStream
+	ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
+	// ListFileStream is a streaming version of ListFile
+	// TODO(msteffen): When the dash has been updated to use ListFileStream,
+	// replace ListFile with this RPC (https://github.com/pachyderm/dash/issues/201)
+	ListFileStream(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileStreamClient, error)
+	// WalkFile walks over all the files under a directory, including children of children.
+	WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error)
+	// GlobFile returns info about all files. This is deprecated in favor of
+	// GlobFileStream
+	GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
+	// GlobFileStream is a streaming version of GlobFile
+	// TODO(msteffen): When the dash has been updated to use GlobFileStream,
+	// replace GlobFile with this RPC (https://github.com/pachyderm/dash/issues/201)
+	GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error)
+	// DiffFile returns the differences between 2 paths at 2 commits.
+	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error)
+	// DeleteFile deletes a file.
+	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// DeleteAll deletes everything
+	DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Fsck does a file system consistency check for pfs
+	Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error)
+	// RPCs specific to Pachyderm 2.
+	FileOperationV2(ctx context.Context, opts ...grpc.CallOption) (API_FileOperationV2Client, error)
+	GetTarV2(ctx context.Context, in *GetTarRequestV2, opts ...grpc.CallOption) (API_GetTarV2Client, error)
+	// DiffFileV2 returns the differences between 2 paths at 2 commits.
+	// it streams back one file at a time which is either from the new path, or the old path
+	DiffFileV2(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileV2Client, error)
+	// CreateTmpFileSet creates a new temp fileset
+	CreateTmpFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateTmpFileSetClient, error)
+	// RenewTmpFileSet prevents the temporary fileset from being deleted for a set amount of time
+	RenewTmpFileSet(ctx context.Context, in *RenewTmpFileSetRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ClearCommitV2 removes all data from the commit.
+	ClearCommitV2(ctx context.Context, in *ClearCommitRequestV2, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type aPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/CreateRepo", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *aPIClient) InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error) {
-	out := new(FileInfo)
-	err := c.cc.Invoke(ctx, "/pfs.API/InspectFile", in, out, opts...)
+func (c *aPIClient) InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error) {
+	out := new(RepoInfo)
+	err := c.cc.Invoke(ctx, "/pfs.API/InspectRepo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIClient) ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error) {
-	out := new(FileInfos)
-	err := c.cc.Invoke(ctx, "/pfs.API/ListFile", in, out, opts...)
+func (c *aPIClient) ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error) {
+	out := new(ListRepoResponse)
+	err := c.cc.Invoke(ctx, "/pfs.API/ListRepo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIClient) ListFileStream(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/pfs.API/ListFileStream", opts...)
+func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/DeleteRepo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPIListFileStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *aPIClient) RenameRepo(ctx context.Context, in *RenameRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/RenameRepo", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
+	out := new(Commit)
+	err := c.cc.Invoke(ctx, "/pfs.API/StartCommit", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type API_ListFileStreamClient interface {
-	Recv() (*FileInfo, error)
-	grpc.ClientStream
+func (c *aPIClient) FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/FinishCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type aPIListFileStreamClient struct {
-	grpc.ClientStream
+func (c *aPIClient) InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error) {
+	out := new(CommitInfo)
+	err := c.cc.Invoke(ctx, "/pfs.API/InspectCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *aPIListFileStreamClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *aPIClient) ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (*CommitInfos, error) {
+	out := new(CommitInfos)
+	err := c.cc.Invoke(ctx, "/pfs.API/ListCommit", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *aPIClient) WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/pfs.API/WalkFile", opts...)
+func (c *aPIClient) ListCommitStream(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/pfs.API/ListCommitStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPIWalkFileClient{stream}
+	x := &aPIListCommitStreamClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -6313,38 +6945,38 @@ func (c *aPIClient) WalkFile(ctx context.Context, in *WalkFileRequest, opts ...g
 	return x, nil
 }
 
-type API_WalkFileClient interface {
-	Recv() (*FileInfo, error)
+type API_ListCommitStreamClient interface {
+	Recv() (*CommitInfo, error)
 	grpc.ClientStream
 }
 
-type aPIWalkFileClient struct {
+type aPIListCommitStreamClient struct {
 	grpc.ClientStream
 }
 
-func (x *aPIWalkFileClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
+func (x *aPIListCommitStreamClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error) {
-	out := new(FileInfos)
-	err := c.cc.Invoke(ctx, "/pfs.API/GlobFile", in, out, opts...)
+func (c *aPIClient) DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/DeleteCommit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIClient) GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/pfs.API/GlobFileStream", opts...)
+func (c *aPIClient) FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pfs.API/FlushCommit", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPIGlobFileStreamClient{stream}
+	x := &aPIFlushCommitClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -6354,122 +6986,219 @@ func (c *aPIClient) GlobFileStream(ctx context.Context, in *GlobFileRequest, opt
 	return x, nil
 }
 
-type API_GlobFileStreamClient interface {
-	Recv() (*FileInfo, error)
+type API_FlushCommitClient interface {
+	Recv() (*CommitInfo, error)
 	grpc.ClientStream
 }
 
-type aPIGlobFileStreamClient struct {
+type aPIFlushCommitClient struct {
 	grpc.ClientStream
 }
 
-func (x *aPIGlobFileStreamClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
+func (x *aPIFlushCommitClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error) {
-	out := new(DiffFileResponse)
-	err := c.cc.Invoke(ctx, "/pfs.API/DiffFile", in, out, opts...)
+func (c *aPIClient) SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pfs.API/SubscribeCommit", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPISubscribeCommitClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_SubscribeCommitClient interface {
+	Recv() (*CommitInfo, error)
+	grpc.ClientStream
+}
+
+type aPISubscribeCommitClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPISubscribeCommitClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
+	out := new(Commit)
+	err := c.cc.Invoke(ctx, "/pfs.API/BuildCommit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+func (c *aPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/DeleteFile", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pfs.API/CreateBranch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/DeleteAll", in, out, opts...)
+func (c *aPIClient) InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error) {
+	out := new(BranchInfo)
+	err := c.cc.Invoke(ctx, "/pfs.API/InspectBranch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIClient) Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[8], "/pfs.API/Fsck", opts...)
+func (c *aPIClient) ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error) {
+	out := new(BranchInfos)
+	err := c.cc.Invoke(ctx, "/pfs.API/ListBranch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPIFsckClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/DeleteBranch", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *aPIClient) RenameBranch(ctx context.Context, in *RenameBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/RenameBranch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pfs.API/PutFile", opts...)
+	if err != nil {
 		return nil, err
 	}
+	x := &aPIPutFileClient{stream}
 	return x, nil
 }
 
-type API_FsckClient interface {
-	Recv() (*FsckResponse, error)
+type API_PutFileClient interface {
+	Send(*PutFileRequest) error
+	CloseAndRecv() (*types.Empty, error)
 	grpc.ClientStream
 }
 
-type aPIFsckClient struct {
+type aPIPutFileClient struct {
 	grpc.ClientStream
 }
 
-func (x *aPIFsckClient) Recv() (*FsckResponse, error) {
-	m := new(FsckResponse)
+func (x *aPIPutFileClient) Send(m *PutFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIPutFileClient) CloseAndRecv() (*types.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(types.Empty)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *aPIClient) FileOperationV2(ctx context.Context, opts ...grpc.CallOption) (API_FileOperationV2Client, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[9], "/pfs.API/FileOperationV2", opts...)
+func (c *aPIClient) BeginUpload(ctx context.Context, in *BeginUploadRequest, opts ...grpc.CallOption) (*UploadInfo, error) {
+	out := new(UploadInfo)
+	err := c.cc.Invoke(ctx, "/pfs.API/BeginUpload", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPIFileOperationV2Client{stream}
+	return out, nil
+}
+
+func (c *aPIClient) PutUploadPart(ctx context.Context, opts ...grpc.CallOption) (API_PutUploadPartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pfs.API/PutUploadPart", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIPutUploadPartClient{stream}
 	return x, nil
 }
 
-type API_FileOperationV2Client interface {
-	Send(*FileOperationRequestV2) error
-	CloseAndRecv() (*types.Empty, error)
+type API_PutUploadPartClient interface {
+	Send(*PutUploadPartRequest) error
+	CloseAndRecv() (*UploadPart, error)
 	grpc.ClientStream
 }
 
-type aPIFileOperationV2Client struct {
+type aPIPutUploadPartClient struct {
 	grpc.ClientStream
 }
 
-func (x *aPIFileOperationV2Client) Send(m *FileOperationRequestV2) error {
+func (x *aPIPutUploadPartClient) Send(m *PutUploadPartRequest) error {
 	return x.ClientStream.SendMsg(m)
 }
 
-func (x *aPIFileOperationV2Client) CloseAndRecv() (*types.Empty, error) {
+func (x *aPIPutUploadPartClient) CloseAndRecv() (*UploadPart, error) {
 	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	m := new(types.Empty)
+	m := new(UploadPart)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *aPIClient) GetTarV2(ctx context.Context, in *GetTarRequestV2, opts ...grpc.CallOption) (API_GetTarV2Client, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[10], "/pfs.API/GetTarV2", opts...)
+func (c *aPIClient) InspectUpload(ctx context.Context, in *InspectUploadRequest, opts ...grpc.CallOption) (*UploadInfo, error) {
+	out := new(UploadInfo)
+	err := c.cc.Invoke(ctx, "/pfs.API/InspectUpload", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPIGetTarV2Client{stream}
+	return out, nil
+}
+
+func (c *aPIClient) CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/CompleteUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/CopyFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/pfs.API/GetFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIGetFileClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -6479,16 +7208,16 @@ func (c *aPIClient) GetTarV2(ctx context.Context, in *GetTarRequestV2, opts ...g
 	return x, nil
 }
 
-type API_GetTarV2Client interface {
+type API_GetFileClient interface {
 	Recv() (*types.BytesValue, error)
 	grpc.ClientStream
 }
 
-type aPIGetTarV2Client struct {
+type aPIGetFileClient struct {
 	grpc.ClientStream
 }
 
-func (x *aPIGetTarV2Client) Recv() (*types.BytesValue, error) {
+func (x *aPIGetFileClient) Recv() (*types.BytesValue, error) {
 	m := new(types.BytesValue)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
@@ -6496,12 +7225,30 @@ func (x *aPIGetTarV2Client) Recv() (*types.BytesValue, error) {
 	return m, nil
 }
 
-func (c *aPIClient) DiffFileV2(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileV2Client, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[11], "/pfs.API/DiffFileV2", opts...)
+func (c *aPIClient) InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error) {
+	out := new(FileInfo)
+	err := c.cc.Invoke(ctx, "/pfs.API/InspectFile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPIDiffFileV2Client{stream}
+	return out, nil
+}
+
+func (c *aPIClient) ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error) {
+	out := new(FileInfos)
+	err := c.cc.Invoke(ctx, "/pfs.API/ListFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListFileStream(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/pfs.API/ListFileStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListFileStreamClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -6511,31 +7258,261 @@ func (c *aPIClient) DiffFileV2(ctx context.Context, in *DiffFileRequest, opts ..
 	return x, nil
 }
 
-type API_DiffFileV2Client interface {
-	Recv() (*DiffFileResponseV2, error)
+type API_ListFileStreamClient interface {
+	Recv() (*FileInfo, error)
 	grpc.ClientStream
 }
 
-type aPIDiffFileV2Client struct {
+type aPIListFileStreamClient struct {
 	grpc.ClientStream
 }
 
-func (x *aPIDiffFileV2Client) Recv() (*DiffFileResponseV2, error) {
-	m := new(DiffFileResponseV2)
+func (x *aPIListFileStreamClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *aPIClient) CreateTmpFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateTmpFileSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[12], "/pfs.API/CreateTmpFileSet", opts...)
+func (c *aPIClient) WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/pfs.API/WalkFile", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &aPICreateTmpFileSetClient{stream}
-	return x, nil
-}
+	x := &aPIWalkFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_WalkFileClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
+}
+
+type aPIWalkFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIWalkFileClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error) {
+	out := new(FileInfos)
+	err := c.cc.Invoke(ctx, "/pfs.API/GlobFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[8], "/pfs.API/GlobFileStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIGlobFileStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_GlobFileStreamClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
+}
+
+type aPIGlobFileStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIGlobFileStreamClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error) {
+	out := new(DiffFileResponse)
+	err := c.cc.Invoke(ctx, "/pfs.API/DiffFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/DeleteFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteAll(ctx context.Context, in *DeleteAllRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/DeleteAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Fsck(ctx context.Context, in *FsckRequest, opts ...grpc.CallOption) (API_FsckClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[9], "/pfs.API/Fsck", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIFsckClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_FsckClient interface {
+	Recv() (*FsckResponse, error)
+	grpc.ClientStream
+}
+
+type aPIFsckClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIFsckClient) Recv() (*FsckResponse, error) {
+	m := new(FsckResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) FileOperationV2(ctx context.Context, opts ...grpc.CallOption) (API_FileOperationV2Client, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[10], "/pfs.API/FileOperationV2", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIFileOperationV2Client{stream}
+	return x, nil
+}
+
+type API_FileOperationV2Client interface {
+	Send(*FileOperationRequestV2) error
+	CloseAndRecv() (*types.Empty, error)
+	grpc.ClientStream
+}
+
+type aPIFileOperationV2Client struct {
+	grpc.ClientStream
+}
+
+func (x *aPIFileOperationV2Client) Send(m *FileOperationRequestV2) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIFileOperationV2Client) CloseAndRecv() (*types.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(types.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) GetTarV2(ctx context.Context, in *GetTarRequestV2, opts ...grpc.CallOption) (API_GetTarV2Client, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[11], "/pfs.API/GetTarV2", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIGetTarV2Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_GetTarV2Client interface {
+	Recv() (*types.BytesValue, error)
+	grpc.ClientStream
+}
+
+type aPIGetTarV2Client struct {
+	grpc.ClientStream
+}
+
+func (x *aPIGetTarV2Client) Recv() (*types.BytesValue, error) {
+	m := new(types.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) DiffFileV2(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (API_DiffFileV2Client, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[12], "/pfs.API/DiffFileV2", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIDiffFileV2Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_DiffFileV2Client interface {
+	Recv() (*DiffFileResponseV2, error)
+	grpc.ClientStream
+}
+
+type aPIDiffFileV2Client struct {
+	grpc.ClientStream
+}
+
+func (x *aPIDiffFileV2Client) Recv() (*DiffFileResponseV2, error) {
+	m := new(DiffFileResponseV2)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) CreateTmpFileSet(ctx context.Context, opts ...grpc.CallOption) (API_CreateTmpFileSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[13], "/pfs.API/CreateTmpFileSet", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPICreateTmpFileSetClient{stream}
+	return x, nil
+}
 
 type API_CreateTmpFileSetClient interface {
 	Send(*FileOperationRequestV2) error
@@ -6592,6 +7569,8 @@ type APIServer interface {
 	ListRepo(context.Context, *ListRepoRequest) (*ListRepoResponse, error)
 	// DeleteRepo deletes a repo.
 	DeleteRepo(context.Context, *DeleteRepoRequest) (*types.Empty, error)
+	// RenameRepo renames a repo, preserving its history and provenance.
+	RenameRepo(context.Context, *RenameRepoRequest) (*types.Empty, error)
 	// Commit rpcs
 	// StartCommit creates a new write commit from a parent commit.
 	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
@@ -6620,9 +7599,21 @@ type APIServer interface {
 	ListBranch(context.Context, *ListBranchRequest) (*BranchInfos, error)
 	// DeleteBranch deletes a branch; note that the commits still exist.
 	DeleteBranch(context.Context, *DeleteBranchRequest) (*types.Empty, error)
+	// RenameBranch renames a branch, preserving its history and provenance.
+	RenameBranch(context.Context, *RenameBranchRequest) (*types.Empty, error)
 	// File rpcs
 	// PutFile writes the specified file to pfs.
 	PutFile(API_PutFileServer) error
+	// BeginUpload starts a resumable upload session for a file.
+	BeginUpload(context.Context, *BeginUploadRequest) (*UploadInfo, error)
+	// PutUploadPart uploads one numbered part of an upload, replacing any part
+	// previously uploaded with the same number.
+	PutUploadPart(API_PutUploadPartServer) error
+	// InspectUpload returns an upload session and the parts it has received.
+	InspectUpload(context.Context, *InspectUploadRequest) (*UploadInfo, error)
+	// CompleteUpload verifies an upload's checksum, adds the file to its
+	// commit and ends the session.
+	CompleteUpload(context.Context, *CompleteUploadRequest) (*types.Empty, error)
 	// CopyFile copies the contents of one file to another.
 	CopyFile(context.Context, *CopyFileRequest) (*types.Empty, error)
 	// GetFile returns a byte stream of the contents of the file.
@@ -6650,7 +7641,7 @@ type APIServer interface {
 	// DeleteFile deletes a file.
 	DeleteFile(context.Context, *DeleteFileRequest) (*types.Empty, error)
 	// DeleteAll deletes everything
-	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
+	DeleteAll(context.Context, *DeleteAllRequest) (*types.Empty, error)
 	// Fsck does a file system consistency check for pfs
 	Fsck(*FsckRequest, API_FsckServer) error
 	// RPCs specific to Pachyderm 2.
@@ -6683,6 +7674,9 @@ func (*UnimplementedAPIServer) ListRepo(ctx context.Context, req *ListRepoReques
 func (*UnimplementedAPIServer) DeleteRepo(ctx context.Context, req *DeleteRepoRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepo not implemented")
 }
+func (*UnimplementedAPIServer) RenameRepo(ctx context.Context, req *RenameRepoRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameRepo not implemented")
+}
 func (*UnimplementedAPIServer) StartCommit(ctx context.Context, req *StartCommitRequest) (*Commit, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartCommit not implemented")
 }
@@ -6722,9 +7716,24 @@ func (*UnimplementedAPIServer) ListBranch(ctx context.Context, req *ListBranchRe
 func (*UnimplementedAPIServer) DeleteBranch(ctx context.Context, req *DeleteBranchRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBranch not implemented")
 }
+func (*UnimplementedAPIServer) RenameBranch(ctx context.Context, req *RenameBranchRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameBranch not implemented")
+}
 func (*UnimplementedAPIServer) PutFile(srv API_PutFileServer) error {
 	return status.Errorf(codes.Unimplemented, "method PutFile not implemented")
 }
+func (*UnimplementedAPIServer) BeginUpload(ctx context.Context, req *BeginUploadRequest) (*UploadInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginUpload not implemented")
+}
+func (*UnimplementedAPIServer) PutUploadPart(srv API_PutUploadPartServer) error {
+	return status.Errorf(codes.Unimplemented, "method PutUploadPart not implemented")
+}
+func (*UnimplementedAPIServer) InspectUpload(ctx context.Context, req *InspectUploadRequest) (*UploadInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectUpload not implemented")
+}
+func (*UnimplementedAPIServer) CompleteUpload(ctx context.Context, req *CompleteUploadRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteUpload not implemented")
+}
 func (*UnimplementedAPIServer) CopyFile(ctx context.Context, req *CopyFileRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CopyFile not implemented")
 }
@@ -6755,7 +7764,7 @@ func (*UnimplementedAPIServer) DiffFile(ctx context.Context, req *DiffFileReques
 func (*UnimplementedAPIServer) DeleteFile(ctx context.Context, req *DeleteFileRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFile not implemented")
 }
-func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *types.Empty) (*types.Empty, error) {
+func (*UnimplementedAPIServer) DeleteAll(ctx context.Context, req *DeleteAllRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
 }
 func (*UnimplementedAPIServer) Fsck(req *FsckRequest, srv API_FsckServer) error {
@@ -6856,6 +7865,24 @@ func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_RenameRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RenameRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/RenameRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RenameRepo(ctx, req.(*RenameRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartCommitRequest)
 	if err := dec(in); err != nil {
@@ -7099,17 +8126,35 @@ func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_PutFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(APIServer).PutFile(&aPIPutFileServer{stream})
-}
-
-type API_PutFileServer interface {
-	SendAndClose(*types.Empty) error
-	Recv() (*PutFileRequest, error)
-	grpc.ServerStream
-}
-
-type aPIPutFileServer struct {
+func _API_RenameBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RenameBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/RenameBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RenameBranch(ctx, req.(*RenameBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PutFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).PutFile(&aPIPutFileServer{stream})
+}
+
+type API_PutFileServer interface {
+	SendAndClose(*types.Empty) error
+	Recv() (*PutFileRequest, error)
+	grpc.ServerStream
+}
+
+type aPIPutFileServer struct {
 	grpc.ServerStream
 }
 
@@ -7125,6 +8170,86 @@ func (x *aPIPutFileServer) Recv() (*PutFileRequest, error) {
 	return m, nil
 }
 
+func _API_BeginUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).BeginUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/BeginUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).BeginUpload(ctx, req.(*BeginUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PutUploadPart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).PutUploadPart(&aPIPutUploadPartServer{stream})
+}
+
+type API_PutUploadPartServer interface {
+	SendAndClose(*UploadPart) error
+	Recv() (*PutUploadPartRequest, error)
+	grpc.ServerStream
+}
+
+type aPIPutUploadPartServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIPutUploadPartServer) SendAndClose(m *UploadPart) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIPutUploadPartServer) Recv() (*PutUploadPartRequest, error) {
+	m := new(PutUploadPartRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _API_InspectUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InspectUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/InspectUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectUpload(ctx, req.(*InspectUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CompleteUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CompleteUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/CompleteUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CompleteUpload(ctx, req.(*CompleteUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_CopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CopyFileRequest)
 	if err := dec(in); err != nil {
@@ -7318,7 +8443,7 @@ func _API_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(inte
 }
 
 func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
+	in := new(DeleteAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -7330,7 +8455,7 @@ func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(inter
 		FullMethod: "/pfs.API/DeleteAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteAll(ctx, req.(*types.Empty))
+		return srv.(APIServer).DeleteAll(ctx, req.(*DeleteAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -7506,6 +8631,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRepo",
 			Handler:    _API_DeleteRepo_Handler,
 		},
+		{
+			MethodName: "RenameRepo",
+			Handler:    _API_RenameRepo_Handler,
+		},
 		{
 			MethodName: "StartCommit",
 			Handler:    _API_StartCommit_Handler,
@@ -7546,6 +8675,22 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBranch",
 			Handler:    _API_DeleteBranch_Handler,
 		},
+		{
+			MethodName: "RenameBranch",
+			Handler:    _API_RenameBranch_Handler,
+		},
+		{
+			MethodName: "BeginUpload",
+			Handler:    _API_BeginUpload_Handler,
+		},
+		{
+			MethodName: "InspectUpload",
+			Handler:    _API_InspectUpload_Handler,
+		},
+		{
+			MethodName: "CompleteUpload",
+			Handler:    _API_CompleteUpload_Handler,
+		},
 		{
 			MethodName: "CopyFile",
 			Handler:    _API_CopyFile_Handler,
@@ -7604,6 +8749,11 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			Handler:       _API_PutFile_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "PutUploadPart",
+			Handler:       _API_PutUploadPart_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "GetFile",
 			Handler:       _API_GetFile_Handler,
@@ -9195,6 +10345,18 @@ func (m *RepoInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Worm != nil {
+		{
+			size, err := m.Worm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Tombstone {
 		i--
 		if m.Tombstone {
@@ -9270,6 +10432,45 @@ func (m *RepoInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WORMPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WORMPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WORMPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Retention != nil {
+		{
+			size, err := m.Retention.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RepoAuthInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9943,6 +11144,18 @@ func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SymlinkTarget) > 0 {
+		i -= len(m.SymlinkTarget)
+		copy(dAtA[i:], m.SymlinkTarget)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Mode != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.Committed != nil {
 		{
 			size, err := m.Committed.MarshalToSizedBuffer(dAtA[:i])
@@ -10322,6 +11535,18 @@ func (m *CreateRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Worm != nil {
+		{
+			size, err := m.Worm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Update {
 		i--
 		if m.Update {
@@ -10485,6 +11710,16 @@ func (m *DeleteRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.BreakGlass {
+		i--
+		if m.BreakGlass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.SplitTransaction {
 		i--
 		if m.SplitTransaction {
@@ -10530,6 +11765,52 @@ func (m *DeleteRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RenameRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenameRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenameRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.NewName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StartCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10910,24 +12191,28 @@ func (m *ListCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Reverse {
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
 		i--
-		if m.Reverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x6a
+	}
+	if m.MinSizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.MinSizeBytes))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x60
 	}
-	if m.Number != 0 {
-		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x5a
 	}
-	if m.To != nil {
+	if m.Origin != nil {
 		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10935,11 +12220,11 @@ func (m *ListCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x52
 	}
-	if m.From != nil {
+	if m.FinishedBefore != nil {
 		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FinishedBefore.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10947,11 +12232,11 @@ func (m *ListCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x4a
 	}
-	if m.Repo != nil {
+	if m.FinishedAfter != nil {
 		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FinishedAfter.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10959,22 +12244,97 @@ func (m *ListCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x42
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CommitInfos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.StartedBefore != nil {
+		{
+			size, err := m.StartedBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CommitInfos) MarshalTo(dAtA []byte) (int, error) {
+	if m.StartedAfter != nil {
+		{
+			size, err := m.StartedAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Reverse {
+		i--
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Number != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitInfos) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -11029,6 +12389,16 @@ func (m *CreateBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.BreakGlass {
+		i--
+		if m.BreakGlass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Trigger != nil {
 		{
 			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
@@ -11226,6 +12596,52 @@ func (m *DeleteBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RenameBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenameBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenameBranchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.NewName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DeleteCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11250,6 +12666,16 @@ func (m *DeleteCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.BreakGlass {
+		i--
+		if m.BreakGlass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Commit != nil {
 		{
 			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
@@ -11500,6 +12926,28 @@ func (m *PutFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.BreakGlass {
+		i--
+		if m.BreakGlass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.SymlinkTarget) > 0 {
+		i -= len(m.SymlinkTarget)
+		copy(dAtA[i:], m.SymlinkTarget)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Mode != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.Delete {
 		i--
 		if m.Delete {
@@ -11668,6 +13116,18 @@ func (m *PutFileRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SymlinkTarget) > 0 {
+		i -= len(m.SymlinkTarget)
+		copy(dAtA[i:], m.SymlinkTarget)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Mode != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Footer != nil {
 		{
 			size, err := m.Footer.MarshalToSizedBuffer(dAtA[:i])
@@ -11729,7 +13189,7 @@ func (m *PutFileRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *UploadPart) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11739,12 +13199,12 @@ func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UploadPart) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CopyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UploadPart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11753,19 +13213,70 @@ func (m *CopyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Overwrite {
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 		i--
-		if m.Overwrite {
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UploadInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x38
 	}
-	if m.Dst != nil {
+	if m.Updated != nil {
 		{
-			size, err := m.Dst.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -11773,11 +13284,47 @@ func (m *CopyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
 	}
-	if m.Src != nil {
+	if len(m.Parts) > 0 {
+		for iNdEx := len(m.Parts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Started != nil {
 		{
-			size, err := m.Src.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Overwrite {
+		i--
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -11785,12 +13332,19 @@ func (m *CopyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPfs(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *BeginUploadRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11800,12 +13354,12 @@ func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BeginUploadRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InspectFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BeginUploadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11814,8 +13368,259 @@ func (m *InspectFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.File != nil {
-		{
+	if m.Overwrite {
+		i--
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PutUploadPartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutUploadPartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutUploadPartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Number != 0 {
+		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InspectUploadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectUploadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectUploadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompleteUploadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompleteUploadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompleteUploadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BreakGlass {
+		i--
+		if m.BreakGlass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BreakGlass {
+		i--
+		if m.BreakGlass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Overwrite {
+		i--
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Dst != nil {
+		{
+			size, err := m.Dst.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Src != nil {
+		{
+			size, err := m.Src.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPfs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InspectFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.File != nil {
+		{
 			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
@@ -12149,6 +13954,16 @@ func (m *DeleteFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.BreakGlass {
+		i--
+		if m.BreakGlass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.File != nil {
 		{
 			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
@@ -12266,6 +14081,16 @@ func (m *FileOperationRequestV2) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.BreakGlass {
+		i--
+		if m.BreakGlass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Operation != nil {
 		{
 			size := m.Operation.Size()
@@ -13586,10 +15411,47 @@ func (m *ObjectIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPfs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPfs(v)
-	base := offset
-	for v >= 1<<7 {
+func (m *DeleteAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BreakGlass {
+		i--
+		if m.BreakGlass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPfs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPfs(v)
+	base := offset
+	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
@@ -13735,6 +15597,26 @@ func (m *RepoInfo) Size() (n int) {
 	if m.Tombstone {
 		n += 2
 	}
+	if m.Worm != nil {
+		l = m.Worm.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WORMPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Retention != nil {
+		l = m.Retention.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14054,6 +15936,13 @@ func (m *FileInfo) Size() (n int) {
 		l = m.Committed.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.Mode != 0 {
+		n += 1 + sovPfs(uint64(m.Mode))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14197,6 +16086,10 @@ func (m *CreateRepoRequest) Size() (n int) {
 	if m.Update {
 		n += 2
 	}
+	if m.Worm != nil {
+		l = m.Worm.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14268,6 +16161,29 @@ func (m *DeleteRepoRequest) Size() (n int) {
 	if m.SplitTransaction {
 		n += 2
 	}
+	if m.BreakGlass {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenameRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14446,6 +16362,37 @@ func (m *ListCommitRequest) Size() (n int) {
 	if m.Reverse {
 		n += 2
 	}
+	if m.StartedAfter != nil {
+		l = m.StartedAfter.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.StartedBefore != nil {
+		l = m.StartedBefore.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FinishedAfter != nil {
+		l = m.FinishedAfter.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FinishedBefore != nil {
+		l = m.FinishedBefore.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.MinSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.MinSizeBytes))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14498,6 +16445,9 @@ func (m *CreateBranchRequest) Size() (n int) {
 		l = m.Trigger.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.BreakGlass {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14558,6 +16508,26 @@ func (m *DeleteBranchRequest) Size() (n int) {
 	return n
 }
 
+func (m *RenameBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DeleteCommitRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14568,6 +16538,9 @@ func (m *DeleteCommitRequest) Size() (n int) {
 		l = m.Commit.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.BreakGlass {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14706,6 +16679,16 @@ func (m *PutFileRequest) Size() (n int) {
 	if m.Delete {
 		n += 2
 	}
+	if m.Mode != 0 {
+		n += 1 + sovPfs(uint64(m.Mode))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.BreakGlass {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14765,28 +16748,36 @@ func (m *PutFileRecords) Size() (n int) {
 		l = m.Footer.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.Mode != 0 {
+		n += 1 + sovPfs(uint64(m.Mode))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *CopyFileRequest) Size() (n int) {
+func (m *UploadPart) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Src != nil {
-		l = m.Src.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Number != 0 {
+		n += 1 + sovPfs(uint64(m.Number))
 	}
-	if m.Dst != nil {
-		l = m.Dst.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
 	}
-	if m.Overwrite {
-		n += 2
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -14794,45 +16785,47 @@ func (m *CopyFileRequest) Size() (n int) {
 	return n
 }
 
-func (m *InspectFileRequest) Size() (n int) {
+func (m *UploadInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	if m.File != nil {
 		l = m.File.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Overwrite {
+		n += 2
 	}
-	return n
-}
-
-func (m *ListFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPfs(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.File != nil {
-		l = m.File.Size()
+	if len(m.Parts) > 0 {
+		for _, e := range m.Parts {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.Full {
+	if m.Completed {
 		n += 2
 	}
-	if m.History != 0 {
-		n += 1 + sovPfs(uint64(m.History))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *WalkFileRequest) Size() (n int) {
+func (m *BeginUploadRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -14842,23 +16835,29 @@ func (m *WalkFileRequest) Size() (n int) {
 		l = m.File.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.Overwrite {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GlobFileRequest) Size() (n int) {
+func (m *PutUploadPartRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
+	l = len(m.ID)
+	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	l = len(m.Pattern)
+	if m.Number != 0 {
+		n += 1 + sovPfs(uint64(m.Number))
+	}
+	l = len(m.Value)
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
@@ -14868,17 +16867,15 @@ func (m *GlobFileRequest) Size() (n int) {
 	return n
 }
 
-func (m *FileInfos) Size() (n int) {
+func (m *InspectUploadRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.FileInfo) > 0 {
-		for _, e := range m.FileInfo {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -14886,21 +16883,21 @@ func (m *FileInfos) Size() (n int) {
 	return n
 }
 
-func (m *DiffFileRequest) Size() (n int) {
+func (m *CompleteUploadRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.NewFile != nil {
-		l = m.NewFile.Size()
+	l = len(m.ID)
+	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.OldFile != nil {
-		l = m.OldFile.Size()
+	l = len(m.Sha256)
+	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.Shallow {
+	if m.BreakGlass {
 		n += 2
 	}
 	if m.XXX_unrecognized != nil {
@@ -14909,23 +16906,25 @@ func (m *DiffFileRequest) Size() (n int) {
 	return n
 }
 
-func (m *DiffFileResponse) Size() (n int) {
+func (m *CopyFileRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.NewFiles) > 0 {
-		for _, e := range m.NewFiles {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	if m.Src != nil {
+		l = m.Src.Size()
+		n += 1 + l + sovPfs(uint64(l))
 	}
-	if len(m.OldFiles) > 0 {
-		for _, e := range m.OldFiles {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	if m.Dst != nil {
+		l = m.Dst.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	if m.BreakGlass {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -14933,7 +16932,7 @@ func (m *DiffFileResponse) Size() (n int) {
 	return n
 }
 
-func (m *DeleteFileRequest) Size() (n int) {
+func (m *InspectFileRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -14949,33 +16948,175 @@ func (m *DeleteFileRequest) Size() (n int) {
 	return n
 }
 
-func (m *FsckRequest) Size() (n int) {
+func (m *ListFileRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Fix {
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Full {
 		n += 2
 	}
+	if m.History != 0 {
+		n += 1 + sovPfs(uint64(m.History))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *FsckResponse) Size() (n int) {
+func (m *WalkFileRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Fix)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Error)
-	if l > 0 {
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GlobFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FileInfo) > 0 {
+		for _, e := range m.FileInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewFile != nil {
+		l = m.NewFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OldFile != nil {
+		l = m.OldFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Shallow {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NewFiles) > 0 {
+		for _, e := range m.NewFiles {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.OldFiles) > 0 {
+		for _, e := range m.OldFiles {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.BreakGlass {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FsckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fix {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FsckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fix)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -14997,6 +17138,9 @@ func (m *FileOperationRequestV2) Size() (n int) {
 	if m.Operation != nil {
 		n += m.Operation.Size()
 	}
+	if m.BreakGlass {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -15542,54 +17686,1123 @@ func (m *DeleteObjDirectRequest) Size() (n int) {
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func (m *ObjectIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for k, v := range m.Objects {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPfs(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPfs(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAllRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BreakGlass {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPfs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPfs(x uint64) (n int) {
+	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Repo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Branch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Branch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Branch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *File) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: File: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Block) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Object) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthInfo == nil {
+				m.AuthInfo = &RepoAuthInfo{}
+			}
+			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branches = append(m.Branches, &Branch{})
+			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstone = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Worm == nil {
+				m.Worm = &WORMPolicy{}
+			}
+			if err := m.Worm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WORMPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WORMPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WORMPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retention == nil {
+				m.Retention = &types.Duration{}
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func (m *ObjectIndex) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for k, v := range m.Objects {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPfs(uint64(l))
+	return nil
+}
+func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
 		}
-	}
-	if len(m.Tags) > 0 {
-		for k, v := range m.Tags {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPfs(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessLevel", wireType)
 			}
-			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+			m.AccessLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessLevel |= auth.Scope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovPfs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPfs(x uint64) (n int) {
-	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Repo) Unmarshal(dAtA []byte) error {
+func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15612,17 +18825,155 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &Commit{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Branch{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15632,81 +18983,29 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
+			m.Subvenance = append(m.Subvenance, &Branch{})
+			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Branch) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Branch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Branch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectProvenance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15733,18 +19032,16 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DirectProvenance = append(m.DirectProvenance, &Branch{})
+			if err := m.DirectProvenance[len(m.DirectProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15754,23 +19051,27 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Trigger == nil {
+				m.Trigger = &Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15797,7 +19098,7 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *File) Unmarshal(dAtA []byte) error {
+func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15820,15 +19121,15 @@ func (m *File) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: File: wiretype end group for non-group")
+			return fmt.Errorf("proto: BranchInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BranchInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15855,45 +19156,11 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BranchInfo = append(m.BranchInfo, &BranchInfo{})
+			if err := m.BranchInfo[len(m.BranchInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15919,7 +19186,7 @@ func (m *File) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Block) Unmarshal(dAtA []byte) error {
+func (m *Trigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15942,15 +19209,15 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15978,65 +19245,63 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
+			m.All = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronSpec", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Object) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Object: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CronSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16064,8 +19329,27 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Size_ = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			m.Commits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commits |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16091,7 +19375,7 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tag) Unmarshal(dAtA []byte) error {
+func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16114,17 +19398,17 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitOrigin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var stringLen uint64
+			m.Kind = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16134,24 +19418,11 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Kind |= OriginKind(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16177,7 +19448,7 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+func (m *Commit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16200,10 +19471,10 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16244,9 +19515,9 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16256,82 +19527,81 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &types.Timestamp{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
-			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16358,16 +19628,16 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthInfo == nil {
-				m.AuthInfo = &RepoAuthInfo{}
+			if m.Lower == nil {
+				m.Lower = &Commit{}
 			}
-			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16394,31 +19664,13 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branches = append(m.Branches, &Branch{})
-			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Upper == nil {
+				m.Upper = &Commit{}
+			}
+			if err := m.Upper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Tombstone = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16444,7 +19696,7 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
+func (m *CommitProvenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16467,17 +19719,17 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitProvenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitProvenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessLevel", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			m.AccessLevel = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16487,11 +19739,64 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccessLevel |= auth.Scope(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16517,7 +19822,7 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16540,17 +19845,17 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16560,27 +19865,31 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16607,16 +19916,16 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &Commit{}
+			if m.ParentCommit == nil {
+				m.ParentCommit = &Commit{}
 			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16643,14 +19952,16 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Branch{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16677,16 +19988,35 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16713,16 +20043,18 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subvenance = append(m.Subvenance, &Branch{})
-			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tree == nil {
+				m.Tree = &Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DirectProvenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16732,29 +20064,27 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DirectProvenance = append(m.DirectProvenance, &Branch{})
-			if err := m.DirectProvenance[len(m.DirectProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16781,70 +20111,14 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trigger == nil {
-				m.Trigger = &Trigger{}
-			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Subvenance = append(m.Subvenance, &CommitRange{})
+			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BranchInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BranchInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BranchInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildCommits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16871,70 +20145,69 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchInfo = append(m.BranchInfo, &BranchInfo{})
-			if err := m.BranchInfo[len(m.BranchInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChildCommits = append(m.ChildCommits, &Commit{})
+			if err := m.ChildCommits[len(m.ChildCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyProvenance", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
+			m.ReadyProvenance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyProvenance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trees", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Trigger) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Trees = append(m.Trees, &Object{})
+			if err := m.Trees[len(m.Trees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16944,29 +20217,33 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Datums == nil {
+				m.Datums = &Object{}
+			}
+			if err := m.Datums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16976,17 +20253,33 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.All = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16996,29 +20289,31 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CronSpec = string(dAtA[iNdEx:postIndex])
+			m.Provenance = append(m.Provenance, &CommitProvenance{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17028,29 +20323,33 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Size_ = string(dAtA[iNdEx:postIndex])
+			if m.Origin == nil {
+				m.Origin = &CommitOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubvenantCommitsSuccess", wireType)
 			}
-			m.Commits = 0
+			m.SubvenantCommitsSuccess = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17060,7 +20359,45 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Commits |= int64(b&0x7F) << shift
+				m.SubvenantCommitsSuccess |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubvenantCommitsFailure", wireType)
+			}
+			m.SubvenantCommitsFailure = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubvenantCommitsFailure |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubvenantCommitsTotal", wireType)
+			}
+			m.SubvenantCommitsTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubvenantCommitsTotal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -17090,7 +20427,7 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
+func (m *FileInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17113,17 +20450,17 @@ func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitOrigin: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			m.Kind = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17133,70 +20470,71 @@ func (m *CommitOrigin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= OriginKind(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Commit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.FileType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileType |= FileType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17206,33 +20544,29 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17242,81 +20576,29 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommitRange) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitRange: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitRange: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17343,16 +20625,14 @@ func (m *CommitRange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Lower == nil {
-				m.Lower = &Commit{}
-			}
-			if err := m.Lower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Objects = append(m.Objects, &Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRefs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17379,70 +20659,14 @@ func (m *CommitRange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Upper == nil {
-				m.Upper = &Commit{}
-			}
-			if err := m.Upper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlockRefs = append(m.BlockRefs, &BlockRef{})
+			if err := m.BlockRefs[len(m.BlockRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CommitProvenance) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitProvenance: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitProvenance: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17469,18 +20693,37 @@ func (m *CommitProvenance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Committed == nil {
+				m.Committed = &types.Timestamp{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Committed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17490,27 +20733,23 @@ func (m *CommitProvenance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
-			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17537,7 +20776,7 @@ func (m *CommitProvenance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+func (m *ByteRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17560,53 +20799,17 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
 			}
-			var msglen int
+			m.Lower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17616,33 +20819,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Lower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ParentCommit == nil {
-				m.ParentCommit = &Commit{}
-			}
-			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
 			}
-			var msglen int
+			m.Upper = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17652,31 +20838,68 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Upper |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17703,35 +20926,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
+			if m.Block == nil {
+				m.Block = &Block{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
-			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17758,48 +20962,70 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
+			if m.Range == nil {
+				m.Range = &ByteRange{}
 			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17826,14 +21052,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subvenance = append(m.Subvenance, &CommitRange{})
-			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Object == nil {
+				m.Object = &Object{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildCommits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17860,35 +21088,72 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChildCommits = append(m.ChildCommits, &Commit{})
-			if err := m.ChildCommits[len(m.ChildCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BlockRef == nil {
+				m.BlockRef = &BlockRef{}
+			}
+			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadyProvenance", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ReadyProvenance = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReadyProvenance |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Compaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-		case 13:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Compaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Compaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InputPrefixes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17898,29 +21163,81 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trees = append(m.Trees, &Object{})
-			if err := m.Trees[len(m.Trees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InputPrefixes = append(m.InputPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 14:
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Shard) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Shard: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Shard: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17947,16 +21264,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datums == nil {
-				m.Datums = &Object{}
+			if m.Compaction == nil {
+				m.Compaction = &Compaction{}
 			}
-			if err := m.Datums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Compaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17983,18 +21300,18 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.Range == nil {
+				m.Range = &PathRange{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18004,31 +21321,83 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &CommitProvenance{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutputPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 17:
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PathRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PathRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PathRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18038,52 +21407,29 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &CommitOrigin{}
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Lower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubvenantCommitsSuccess", wireType)
-			}
-			m.SubvenantCommitsSuccess = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubvenantCommitsSuccess |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubvenantCommitsFailure", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
 			}
-			m.SubvenantCommitsFailure = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18093,30 +21439,24 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubvenantCommitsFailure |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubvenantCommitsTotal", wireType)
-			}
-			m.SubvenantCommitsTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubvenantCommitsTotal |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Upper = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -18142,7 +21482,7 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileInfo) Unmarshal(dAtA []byte) error {
+func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18165,15 +21505,15 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18200,54 +21540,16 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
-			}
-			m.FileType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FileType |= FileType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
-			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18275,13 +21577,13 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18291,29 +21593,15 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
-			iNdEx = postIndex
-		case 8:
+			m.Update = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worm", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18340,14 +21628,70 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, &Object{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Worm == nil {
+				m.Worm = &WORMPolicy{}
+			}
+			if err := m.Worm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18374,14 +21718,124 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRefs = append(m.BlockRefs, &BlockRef{})
-			if err := m.BlockRefs[len(m.BlockRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRepoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRepoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18408,10 +21862,8 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Committed == nil {
-				m.Committed = &types.Timestamp{}
-			}
-			if err := m.Committed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RepoInfo = append(m.RepoInfo, &RepoInfo{})
+			if err := m.RepoInfo[len(m.RepoInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18440,7 +21892,7 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ByteRange) Unmarshal(dAtA []byte) error {
+func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18463,17 +21915,17 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			m.Lower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18483,16 +21935,33 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Lower |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			m.Upper = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18502,11 +21971,72 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Upper |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitTransaction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SplitTransaction = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlass", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.BreakGlass = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -18532,7 +22062,7 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockRef) Unmarshal(dAtA []byte) error {
+func (m *RenameRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18555,15 +22085,15 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenameRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenameRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18590,18 +22120,18 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &Block{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18611,27 +22141,23 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Range == nil {
-				m.Range = &ByteRange{}
-			}
-			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18658,7 +22184,7 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18681,15 +22207,15 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18716,18 +22242,18 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &Object{}
+			if m.Parent == nil {
+				m.Parent = &Commit{}
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18737,87 +22263,61 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockRef == nil {
-				m.BlockRef = &BlockRef{}
-			}
-			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Compaction) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Compaction: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Compaction: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputPrefixes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18827,23 +22327,25 @@ func (m *Compaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InputPrefixes = append(m.InputPrefixes, string(dAtA[iNdEx:postIndex]))
+			m.Provenance = append(m.Provenance, &CommitProvenance{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18870,7 +22372,7 @@ func (m *Compaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Shard) Unmarshal(dAtA []byte) error {
+func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18893,15 +22395,15 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Shard: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuildCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Shard: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuildCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18928,16 +22430,16 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Compaction == nil {
-				m.Compaction = &Compaction{}
+			if m.Parent == nil {
+				m.Parent = &Commit{}
 			}
-			if err := m.Compaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18964,16 +22466,16 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Range == nil {
-				m.Range = &PathRange{}
+			if m.Tree == nil {
+				m.Tree = &Object{}
 			}
-			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19001,65 +22503,11 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputPath = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PathRange) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PathRange: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PathRange: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19087,13 +22535,13 @@ func (m *PathRange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lower = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19103,81 +22551,63 @@ func (m *PathRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Upper = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
+			m.Provenance = append(m.Provenance, &CommitProvenance{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trees", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Trees = append(m.Trees, &Object{})
+			if err := m.Trees[len(m.Trees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19204,18 +22634,37 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Datums == nil {
+				m.Datums = &Object{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19225,29 +22674,33 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19257,69 +22710,31 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Update = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19346,10 +22761,10 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Origin == nil {
+				m.Origin = &CommitOrigin{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19378,61 +22793,7 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
+func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19455,15 +22816,15 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19490,68 +22851,48 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoInfo = append(m.RepoInfo, &RepoInfo{})
-			if err := m.RepoInfo[len(m.RepoInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19578,16 +22919,16 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Tree == nil {
+				m.Tree = &Object{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -19604,12 +22945,46 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		case 3:
+			m.Empty = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trees = append(m.Trees, &Object{})
+			if err := m.Trees[len(m.Trees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var v int
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19619,17 +22994,16 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SizeBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.All = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitTransaction", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19639,12 +23013,28 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SplitTransaction = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datums == nil {
+				m.Datums = &Object{}
+			}
+			if err := m.Datums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -19670,7 +23060,7 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19693,15 +23083,15 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19728,82 +23118,18 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
 			}
-			var msglen int
+			m.BlockState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19813,26 +23139,11 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockState |= CommitState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provenance = append(m.Provenance, &CommitProvenance{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -19858,7 +23169,7 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19881,15 +23192,15 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BuildCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuildCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19916,16 +23227,52 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19952,18 +23299,57 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
+			if m.To == nil {
+				m.To = &Commit{}
 			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAfter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19973,29 +23359,33 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.StartedAfter == nil {
+				m.StartedAfter = &types.Timestamp{}
+			}
+			if err := m.StartedAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedBefore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -20005,27 +23395,31 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.StartedBefore == nil {
+				m.StartedBefore = &types.Timestamp{}
+			}
+			if err := m.StartedBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20052,14 +23446,16 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &CommitProvenance{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedAfter == nil {
+				m.FinishedAfter = &types.Timestamp{}
+			}
+			if err := m.FinishedAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedBefore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20086,14 +23482,16 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trees = append(m.Trees, &Object{})
-			if err := m.Trees[len(m.Trees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedBefore == nil {
+				m.FinishedBefore = &types.Timestamp{}
+			}
+			if err := m.FinishedBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20120,18 +23518,18 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datums == nil {
-				m.Datums = &Object{}
+			if m.Origin == nil {
+				m.Origin = &CommitOrigin{}
 			}
-			if err := m.Datums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.SizeBytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -20141,16 +23539,29 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSizeBytes", wireType)
+			}
+			m.MinSizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -20160,33 +23571,16 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinSizeBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
-			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -20196,31 +23590,81 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
-			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 12:
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20247,10 +23691,8 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &CommitOrigin{}
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommitInfo = append(m.CommitInfo, &CommitInfo{})
+			if err := m.CommitInfo[len(m.CommitInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20279,7 +23721,7 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20302,15 +23744,15 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20337,16 +23779,16 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Head == nil {
+				m.Head = &Commit{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20374,11 +23816,81 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.SBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Branch{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20405,16 +23917,16 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
+			if m.Trigger == nil {
+				m.Trigger = &Trigger{}
 			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlass", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -20431,10 +23943,64 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Empty = bool(v != 0)
-		case 5:
+			m.BreakGlass = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectBranchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20461,33 +24027,70 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trees = append(m.Trees, &Object{})
-			if err := m.Trees[len(m.Trees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
 			}
-		case 7:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20514,13 +24117,33 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datums == nil {
-				m.Datums = &Object{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Datums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -20546,7 +24169,7 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20569,15 +24192,15 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20604,18 +24227,18 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			m.BlockState = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -20625,11 +24248,12 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockState |= CommitState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -20655,7 +24279,7 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *RenameBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20678,15 +24302,15 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RenameBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RenameBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20713,18 +24337,18 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -20734,31 +24358,81 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Commit{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NewName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20785,35 +24459,16 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &Commit{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
-			}
-			m.Number = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Number |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlass", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -20830,7 +24485,7 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Reverse = bool(v != 0)
+			m.BreakGlass = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -20856,7 +24511,7 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfos) Unmarshal(dAtA []byte) error {
+func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20879,15 +24534,15 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlushCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlushCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20914,8 +24569,42 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommitInfo = append(m.CommitInfo, &CommitInfo{})
-			if err := m.CommitInfo[len(m.CommitInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, &Commit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRepos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToRepos = append(m.ToRepos, &Repo{})
+			if err := m.ToRepos[len(m.ToRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20944,7 +24633,7 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20967,15 +24656,15 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21002,16 +24691,16 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21039,11 +24728,11 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SBranch = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21070,16 +24759,35 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.From == nil {
+				m.From = &Commit{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= CommitState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prov", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21106,14 +24814,70 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Branch{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Prov == nil {
+				m.Prov = &CommitProvenance{}
+			}
+			if err := m.Prov.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21140,13 +24904,51 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trigger == nil {
-				m.Trigger = &Trigger{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
+			}
+			m.OffsetBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -21172,7 +24974,7 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21195,17 +24997,17 @@ func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OverwriteIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OverwriteIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -21215,28 +25017,11 @@ func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Index |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
-			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -21262,7 +25047,7 @@ func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21285,15 +25070,15 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21320,16 +25105,82 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21346,66 +25197,163 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Reverse = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.Recursive = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
 			}
-			if skippy < 0 {
+			m.Delimiter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delimiter |= Delimiter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
+			}
+			m.TargetFileDatums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetFileDatums |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
+			}
+			m.TargetFileBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetFileBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			if m.OverwriteIndex == nil {
+				m.OverwriteIndex = &OverwriteIndex{}
+			}
+			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderRecords", wireType)
+			}
+			m.HeaderRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderRecords |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Delete = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -21415,31 +25363,27 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
-			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlass", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21456,7 +25400,7 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			m.BreakGlass = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -21482,7 +25426,7 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21505,17 +25449,36 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -21525,85 +25488,27 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FlushCommitRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FlushCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21630,14 +25535,16 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, &Commit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OverwriteIndex == nil {
+				m.OverwriteIndex = &OverwriteIndex{}
+			}
+			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToRepos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21664,8 +25571,10 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToRepos = append(m.ToRepos, &Repo{})
-			if err := m.ToRepos[len(m.ToRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BlockRef == nil {
+				m.BlockRef = &BlockRef{}
+			}
+			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21694,7 +25603,7 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21717,15 +25626,35 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRecords: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRecords: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Split = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21752,18 +25681,36 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &PutFileRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstone = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -21773,27 +25720,31 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Header == nil {
+				m.Header = &PutFileRecord{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Footer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21820,18 +25771,18 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Commit{}
+			if m.Footer == nil {
+				m.Footer = &PutFileRecord{}
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Footer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			m.State = 0
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -21841,16 +25792,16 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= CommitState(b&0x7F) << shift
+				m.Mode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prov", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -21860,27 +25811,23 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Prov == nil {
-				m.Prov = &CommitProvenance{}
-			}
-			if err := m.Prov.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21907,7 +25854,7 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
+func (m *UploadPart) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21930,17 +25877,17 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UploadPart: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UploadPart: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var msglen int
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -21950,33 +25897,16 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			m.OffsetBytes = 0
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -21986,16 +25916,16 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OffsetBytes |= int64(b&0x7F) << shift
+				m.SizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			m.SizeBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -22005,84 +25935,26 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OverwriteIndex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OverwriteIndex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Records = append(m.Records, &PutFileRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -22108,7 +25980,7 @@ func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
+func (m *UploadInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22131,53 +26003,17 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UploadInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UploadInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -22187,31 +26023,29 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -22221,68 +26055,33 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Recursive = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
-			}
-			m.Delimiter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Delimiter |= Delimiter(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
 			}
-			m.TargetFileDatums = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -22292,16 +26091,17 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetFileDatums |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
+			m.Overwrite = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			m.TargetFileBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -22311,14 +26111,31 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetFileBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22345,18 +26162,16 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OverwriteIndex == nil {
-				m.OverwriteIndex = &OverwriteIndex{}
-			}
-			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parts = append(m.Parts, &UploadPart{})
+			if err := m.Parts[len(m.Parts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeaderRecords", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
-			m.HeaderRecords = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -22366,14 +26181,31 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HeaderRecords |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updated == nil {
+				m.Updated = &types.Timestamp{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -22390,7 +26222,7 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Delete = bool(v != 0)
+			m.Completed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -22416,7 +26248,7 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
+func (m *BeginUploadRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22439,17 +26271,17 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: BeginUploadRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BeginUploadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			m.SizeBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -22459,14 +26291,105 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutUploadPartRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutUploadPartRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutUploadPartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22494,13 +26417,32 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectHash = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -22510,33 +26452,85 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OverwriteIndex == nil {
-				m.OverwriteIndex = &OverwriteIndex{}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectUploadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectUploadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectUploadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -22546,27 +26540,23 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockRef == nil {
-				m.BlockRef = &BlockRef{}
-			}
-			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22593,7 +26583,7 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
+func (m *CompleteUploadRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22616,37 +26606,17 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRecords: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompleteUploadRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompleteUploadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Split = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -22656,51 +26626,29 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &PutFileRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Tombstone = bool(v != 0)
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -22710,33 +26658,29 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &PutFileRecord{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sha256 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Footer", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlass", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -22746,28 +26690,12 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Footer == nil {
-				m.Footer = &PutFileRecord{}
-			}
-			if err := m.Footer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.BreakGlass = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -22914,6 +26842,26 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Overwrite = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlass", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BreakGlass = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -23791,6 +27739,26 @@ func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlass", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BreakGlass = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -24143,6 +28111,26 @@ func (m *FileOperationRequestV2) Unmarshal(dAtA []byte) error {
 			}
 			m.Operation = &FileOperationRequestV2_DeleteFiles{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlass", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BreakGlass = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -27347,6 +31335,81 @@ func (m *ObjectIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
+func (m *DeleteAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BreakGlass", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BreakGlass = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPfs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -234,6 +234,29 @@ func (c APIClient) ClearCommitV2(repo, commit string) (retErr error) {
 // TODO: Change this to not buffer the file locally.
 // We will want to move to a model where we buffer in chunk storage.
 func (c APIClient) PutFileV2(repo string, commit string, path string, r io.Reader, overwrite bool) error {
+	return c.putFileV2(repo, commit, path, r, overwrite, 0)
+}
+
+// PutSymlinkV2 creates a symlink in PFS.
+func (c APIClient) PutSymlinkV2(repo string, commit string, path string, target string) error {
+	return withTmpFile(func(tarF *os.File) error {
+		if err := tarutil.WithWriter(tarF, func(tw *tar.Writer) error {
+			hdr := tarutil.NewHeader(path, 0)
+			hdr.Typeflag = tar.TypeSymlink
+			hdr.Linkname = target
+			return tw.WriteHeader(hdr)
+		}); err != nil {
+			return err
+		}
+		_, err := tarF.Seek(0, 0)
+		if err != nil {
+			return err
+		}
+		return c.PutTarV2(repo, commit, tarF, true)
+	})
+}
+
+func (c APIClient) putFileV2(repo string, commit string, path string, r io.Reader, overwrite bool, mode uint32) error {
 	return withTmpFile(func(tarF *os.File) error {
 		if err := withTmpFile(func(f *os.File) error {
 			size, err := io.Copy(f, r)
@@ -245,7 +268,13 @@ func (c APIClient) PutFileV2(repo string, commit string, path string, r io.Reade
 				return err
 			}
 			return tarutil.WithWriter(tarF, func(tw *tar.Writer) error {
-				return tarutil.WriteFile(tw, tarutil.NewStreamFile(path, size, f))
+				hdr := tarutil.NewHeader(path, size)
+				hdr.Mode = int64(mode)
+				if err := tw.WriteHeader(hdr); err != nil {
+					return err
+				}
+				_, err := io.Copy(tw, f)
+				return err
 			})
 		}); err != nil {
 			return err
@@ -405,6 +434,14 @@ func (pfc *putFileClientV2) PutFileOverwrite(repo, commit, path string, r io.Rea
 	return 0, pfc.c.PutFileV2(repo, commit, path, r, true)
 }
 
+func (pfc *putFileClientV2) PutFileMode(repo, commit, path string, r io.Reader, overwrite bool, mode uint32) (int, error) {
+	return 0, pfc.c.putFileV2(repo, commit, path, r, overwrite, mode)
+}
+
+func (pfc *putFileClientV2) PutSymlink(repo, commit, path, target string) error {
+	return pfc.c.PutSymlinkV2(repo, commit, path, target)
+}
+
 func (pfc *putFileClientV2) PutFileSplit(repo, commit, path string, delimiter pfs.Delimiter, targetFileDatums int64, targetFileBytes int64, headerRecords int64, overwrite bool, r io.Reader) (int, error) {
 	// TODO: Add split support.
 	return 0, errV1NotImplemented
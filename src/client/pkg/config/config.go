@@ -30,6 +30,12 @@ func configPath() string {
 	return defaultConfigPath
 }
 
+// UploadsDir returns the directory in which pachctl records the sessions of
+// resumable uploads, so that they can be resumed if interrupted.
+func UploadsDir() string {
+	return filepath.Join(filepath.Dir(configPath()), "uploads")
+}
+
 // ActiveContext gets the active context in the config
 func (c *Config) ActiveContext(errorOnNoActive bool) (string, *Context, error) {
 	if c.V2 == nil {
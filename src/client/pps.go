@@ -42,6 +42,13 @@ const (
 	// PPSScratchSpace is where pps workers store data while it's waiting to be
 	// processed.
 	PPSScratchSpace = ".scratch"
+	// PPSChangesDir is the directory, under PPSInputPrefix, where pps workers
+	// write the change lists of inputs that have them enabled.
+	PPSChangesDir = ".changes"
+	// PPSPreviousOutputDir is the directory, under PPSInputPrefix, where pps
+	// workers write the output of a datum's previous successful processing,
+	// for datums whose inputs have change lists enabled.
+	PPSPreviousOutputDir = ".previous"
 	// PPSWorkerPortEnv is environment variable name for the port that workers
 	// use for their gRPC server
 	PPSWorkerPortEnv = "PPS_WORKER_GRPC_PORT"
@@ -63,6 +70,14 @@ const (
 	// OutputCommitIDEnv is an env var that is added to the environment of user
 	// pipelined code and indicates the id of the output commit.
 	OutputCommitIDEnv = "PACH_OUTPUT_COMMIT_ID"
+	// PreviousOutputEnv is an env var that is added to the environment of user
+	// pipeline code when an input has change lists enabled, and indicates
+	// where the datum's previous output is.
+	PreviousOutputEnv = "PACH_PREVIOUS_OUTPUT"
+	// DatumBatchURLEnv is an env var that is added to the environment of the
+	// user code of pipelines with datum batching enabled, and indicates where
+	// the code fetches datums from and reports their results to.
+	DatumBatchURLEnv = "PACH_DATUM_BATCH_URL"
 	// PeerPortEnv is the env var that sets a custom peer port
 	PeerPortEnv = "PEER_PORT"
 )
@@ -186,6 +201,69 @@ func NewCronInputOpts(name string, repo string, spec string, overwrite bool) *pp
 	}
 }
 
+// NewWindowInput returns an input which exposes the files of the last
+// 'commits' commits on the master branch of 'repo' to a single datum, as
+// `/pfs/<repo>/<commit id>`. It only takes required options.
+func NewWindowInput(repo string, commits int64) *pps.Input {
+	return &pps.Input{
+		Window: &pps.WindowInput{
+			Repo:    repo,
+			Commits: commits,
+		},
+	}
+}
+
+// NewWindowInputOpts returns an input which exposes the files of the most
+// recent commits on 'branch' of 'repo' to a single datum, as
+// `/pfs/<name>/<commit id>`. Exactly one of 'commits' and 'duration' should be
+// set. It includes all the options.
+func NewWindowInputOpts(name string, repo string, branch string, commits int64, duration time.Duration, lazy bool) *pps.Input {
+	input := &pps.Input{
+		Window: &pps.WindowInput{
+			Name:    name,
+			Repo:    repo,
+			Branch:  branch,
+			Commits: commits,
+			Lazy:    lazy,
+		},
+	}
+	if duration != 0 {
+		input.Window.Duration = types.DurationProto(duration)
+	}
+	return input
+}
+
+// NewObjectStoreInput returns an input which polls the object store bucket
+// (and optional prefix) at 'url' every 'interval', and exposes each object
+// under it to jobs as its own datum, at `/pfs/<name>/<object path>`. It only
+// takes required options.
+func NewObjectStoreInput(name string, url string, interval time.Duration) *pps.Input {
+	return &pps.Input{
+		ObjectStore: &pps.ObjectStoreInput{
+			Name:     name,
+			URL:      url,
+			Interval: types.DurationProto(interval),
+		},
+	}
+}
+
+// NewObjectStoreInputOpts returns an input which polls the object store bucket
+// (and optional prefix) at 'url' every 'interval', and commits references to
+// its objects to 'repo'. The objects are exposed to jobs at
+// `/pfs/<name>/<object path>`, divided into datums by 'glob'. It includes all
+// the options.
+func NewObjectStoreInputOpts(name string, url string, repo string, glob string, interval time.Duration) *pps.Input {
+	return &pps.Input{
+		ObjectStore: &pps.ObjectStoreInput{
+			Name:     name,
+			URL:      url,
+			Repo:     repo,
+			Glob:     glob,
+			Interval: types.DurationProto(interval),
+		},
+	}
+}
+
 // NewJobInput creates a pps.JobInput.
 func NewJobInput(repoName string, commitID string, glob string) *pps.JobInput {
 	return &pps.JobInput{
@@ -229,6 +307,18 @@ func (c APIClient) InspectJob(jobID string, blockState bool, full ...bool) (*pps
 	return jobInfo, grpcutil.ScrubGRPC(err)
 }
 
+// ExplainJob returns why a job ran: which of its inputs changed since its
+// parent job, and which of its datums were reprocessed.
+func (c APIClient) ExplainJob(jobID string) (*pps.JobExplanation, error) {
+	explanation, err := c.PpsAPIClient.ExplainJob(
+		c.Ctx(),
+		&pps.ExplainJobRequest{
+			Job: NewJob(jobID),
+		},
+	)
+	return explanation, grpcutil.ScrubGRPC(err)
+}
+
 // InspectJobOutputCommit returns info about a job that created a commit.
 // blockState will cause the call to block until the job reaches a terminal state (failure or success).
 func (c APIClient) InspectJobOutputCommit(repoName, commitID string, blockState bool) (*pps.JobInfo, error) {
@@ -390,6 +480,33 @@ func (c APIClient) StopJob(jobID string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ApproveJob approves a job that is awaiting approval, which lets it finish
+// its output commit.
+func (c APIClient) ApproveJob(jobID string, reason string) error {
+	_, err := c.PpsAPIClient.ApproveJob(
+		c.Ctx(),
+		&pps.ApproveJobRequest{
+			Job:    NewJob(jobID),
+			Reason: reason,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// RejectJob rejects a job that is awaiting approval, which fails the job and
+// discards its output.
+func (c APIClient) RejectJob(jobID string, reason string) error {
+	_, err := c.PpsAPIClient.ApproveJob(
+		c.Ctx(),
+		&pps.ApproveJobRequest{
+			Job:    NewJob(jobID),
+			Reject: true,
+			Reason: reason,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // RestartDatum restarts a datum that's being processed as part of a job.
 // datumFilter is a slice of strings which are matched against either the Path
 // or Hash of the datum, the order of the strings in datumFilter is irrelevant.
@@ -404,6 +521,20 @@ func (c APIClient) RestartDatum(jobID string, datumFilter []string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// TraceFile returns the job, datum and input files that wrote the file at
+// 'path' in 'repo'@'commit'. If 'recursive' is true, input files that were
+// themselves written by a pipeline are traced too.
+func (c APIClient) TraceFile(repo, commit, path string, recursive bool) (*pps.FileTrace, error) {
+	trace, err := c.PpsAPIClient.TraceFile(
+		c.Ctx(),
+		&pps.TraceFileRequest{
+			File:      NewFile(repo, commit, path),
+			Recursive: recursive,
+		},
+	)
+	return trace, grpcutil.ScrubGRPC(err)
+}
+
 // ListDatum returns info about datums in a Job
 func (c APIClient) ListDatum(jobID string, pageSize, page int64) (*pps.ListDatumResponse, error) {
 	return c.listDatum(NewJob(jobID), nil, pageSize, page)
@@ -612,6 +743,16 @@ func (c APIClient) GetLogsLoki(
 	return resp
 }
 
+// GetLogsWithFilters gets the logs matching 'request', which, unlike GetLogs,
+// can set any of the GetLogsRequest filters (such as a time range, a pattern
+// to grep for, a log level or a worker).
+func (c APIClient) GetLogsWithFilters(request *pps.GetLogsRequest) *LogsIter {
+	resp := &LogsIter{}
+	resp.logsClient, resp.err = c.PpsAPIClient.GetLogs(c.Ctx(), request)
+	resp.err = grpcutil.ScrubGRPC(resp.err)
+	return resp
+}
+
 // CreatePipeline creates a new pipeline, pipelines are the main computation
 // object in PPS they create a flow of data from a set of input Repos to an
 // output Repo (which has the same name as the pipeline). Whenever new data is
@@ -762,6 +903,23 @@ func (c APIClient) RunPipeline(name string, provenance []*pfs.CommitProvenance,
 	return grpcutil.ScrubGRPC(err)
 }
 
+// RunPipelineWithParameters is like RunPipeline, but the new job runs with
+// 'parameters': env vars that are set for the pipeline's user code (overriding
+// the pipeline's own env vars), and a salt that, with the env vars, determines
+// which previously-processed datums the job may skip.
+func (c APIClient) RunPipelineWithParameters(name string, provenance []*pfs.CommitProvenance, jobID string, parameters *pps.RunParameters) error {
+	_, err := c.PpsAPIClient.RunPipeline(
+		c.Ctx(),
+		&pps.RunPipelineRequest{
+			Pipeline:   NewPipeline(name),
+			Provenance: provenance,
+			JobID:      jobID,
+			Parameters: parameters,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // RunCron runs a pipeline. It can be passed a list of commit provenance.
 // This will trigger a new job provenant on those commits, effectively running the pipeline on the data in those commits.
 func (c APIClient) RunCron(name string) error {
@@ -774,6 +932,21 @@ func (c APIClient) RunCron(name string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// RollbackPipeline re-applies version 'version' of the pipeline 'name', as a
+// new version of the pipeline. If 'reprocess' is true, datums that were
+// already processed by the current version are reprocessed.
+func (c APIClient) RollbackPipeline(name string, version uint64, reprocess bool) error {
+	_, err := c.PpsAPIClient.RollbackPipeline(
+		c.Ctx(),
+		&pps.RollbackPipelineRequest{
+			Pipeline:  NewPipeline(name),
+			Version:   version,
+			Reprocess: reprocess,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // CreateSecret creates a secret on the cluster.
 func (c APIClient) CreateSecret(file []byte) error {
 	_, err := c.PpsAPIClient.CreateSecret(
@@ -816,6 +989,56 @@ func (c APIClient) ListSecret() ([]*pps.SecretInfo, error) {
 	return secretInfos.SecretInfo, grpcutil.ScrubGRPC(err)
 }
 
+// ListMemo returns info about the entries in the datum memoization cache. If
+// 'pipeline' is set, only the entries created by that pipeline are returned.
+func (c APIClient) ListMemo(pipeline string) ([]*pps.MemoInfo, error) {
+	request := &pps.ListMemoRequest{}
+	if pipeline != "" {
+		request.Pipeline = NewPipeline(pipeline)
+	}
+	memoInfos, err := c.PpsAPIClient.ListMemo(c.Ctx(), request)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return memoInfos.MemoInfo, nil
+}
+
+// DeleteMemo deletes the entry with key 'key' from the datum memoization
+// cache.
+func (c APIClient) DeleteMemo(key string) error {
+	_, err := c.PpsAPIClient.DeleteMemo(
+		c.Ctx(),
+		&pps.DeleteMemoRequest{Key: key},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// DeletePipelineMemos deletes the entries created by 'pipeline' from the
+// datum memoization cache.
+func (c APIClient) DeletePipelineMemos(pipeline string) error {
+	_, err := c.PpsAPIClient.DeleteMemo(
+		c.Ctx(),
+		&pps.DeleteMemoRequest{Pipeline: NewPipeline(pipeline)},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// DeleteAllMemos clears the datum memoization cache.
+func (c APIClient) DeleteAllMemos() error {
+	_, err := c.PpsAPIClient.DeleteMemo(
+		c.Ctx(),
+		&pps.DeleteMemoRequest{All: true},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// PreviewPipeline returns what creating or updating a pipeline with 'request'
+// would change, without changing anything.
+func (c APIClient) PreviewPipeline(request *pps.CreatePipelineRequest) (*pps.PipelinePreview, error) {
+	preview, err := c.PpsAPIClient.PreviewPipeline(c.Ctx(), request)
+	return preview, grpcutil.ScrubGRPC(err)
+}
+
 // CreatePipelineService creates a new pipeline service.
 func (c APIClient) CreatePipelineService(
 	name string,
@@ -63,6 +63,10 @@ func InputName(input *Input) string {
 		return ""
 	case input.Pfs != nil:
 		return input.Pfs.Name
+	case input.Window != nil:
+		return input.Window.Name
+	case input.ObjectStore != nil:
+		return input.ObjectStore.Name
 	case input.Cross != nil:
 		if len(input.Cross) > 0 {
 			return InputName(input.Cross[0])
@@ -124,6 +128,18 @@ func InputBranches(input *Input) []*pfs.Branch {
 				Name: input.Git.Branch,
 			})
 		}
+		if input.Window != nil {
+			result = append(result, &pfs.Branch{
+				Repo: &pfs.Repo{Name: input.Window.Repo},
+				Name: input.Window.Branch,
+			})
+		}
+		if input.ObjectStore != nil {
+			result = append(result, &pfs.Branch{
+				Repo: &pfs.Repo{Name: input.ObjectStore.Repo},
+				Name: "master",
+			})
+		}
 	})
 	return result
 }
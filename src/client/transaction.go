@@ -347,9 +347,27 @@ func (c *pfsBuilderClient) InspectBranch(ctx context.Context, req *pfs.InspectBr
 func (c *pfsBuilderClient) ListBranch(ctx context.Context, req *pfs.ListBranchRequest, opts ...grpc.CallOption) (*pfs.BranchInfos, error) {
 	return nil, unsupportedError("ListBranch")
 }
+func (c *pfsBuilderClient) RenameRepo(ctx context.Context, req *pfs.RenameRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("RenameRepo")
+}
+func (c *pfsBuilderClient) RenameBranch(ctx context.Context, req *pfs.RenameBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("RenameBranch")
+}
 func (c *pfsBuilderClient) PutFile(ctx context.Context, opts ...grpc.CallOption) (pfs.API_PutFileClient, error) {
 	return nil, unsupportedError("PutFile")
 }
+func (c *pfsBuilderClient) BeginUpload(ctx context.Context, req *pfs.BeginUploadRequest, opts ...grpc.CallOption) (*pfs.UploadInfo, error) {
+	return nil, unsupportedError("BeginUpload")
+}
+func (c *pfsBuilderClient) PutUploadPart(ctx context.Context, opts ...grpc.CallOption) (pfs.API_PutUploadPartClient, error) {
+	return nil, unsupportedError("PutUploadPart")
+}
+func (c *pfsBuilderClient) InspectUpload(ctx context.Context, req *pfs.InspectUploadRequest, opts ...grpc.CallOption) (*pfs.UploadInfo, error) {
+	return nil, unsupportedError("InspectUpload")
+}
+func (c *pfsBuilderClient) CompleteUpload(ctx context.Context, req *pfs.CompleteUploadRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("CompleteUpload")
+}
 func (c *pfsBuilderClient) CopyFile(ctx context.Context, req *pfs.CopyFileRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("CopyFile")
 }
@@ -380,7 +398,7 @@ func (c *pfsBuilderClient) DiffFile(ctx context.Context, req *pfs.DiffFileReques
 func (c *pfsBuilderClient) DeleteFile(ctx context.Context, req *pfs.DeleteFileRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("DeleteFile")
 }
-func (c *pfsBuilderClient) DeleteAll(ctx context.Context, req *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
+func (c *pfsBuilderClient) DeleteAll(ctx context.Context, req *pfs.DeleteAllRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("DeleteAll")
 }
 func (c *pfsBuilderClient) Fsck(ctx context.Context, req *pfs.FsckRequest, opts ...grpc.CallOption) (pfs.API_FsckClient, error) {
@@ -478,6 +496,9 @@ func (c *objectBuilderClient) DeleteObjDirect(ctx context.Context, req *pfs.Dele
 func (c *ppsBuilderClient) CreateJob(ctx context.Context, req *pps.CreateJobRequest, opts ...grpc.CallOption) (*pps.Job, error) {
 	return nil, unsupportedError("CreateJob")
 }
+func (c *ppsBuilderClient) ExplainJob(ctx context.Context, req *pps.ExplainJobRequest, opts ...grpc.CallOption) (*pps.JobExplanation, error) {
+	return nil, unsupportedError("ExplainJob")
+}
 func (c *ppsBuilderClient) InspectJob(ctx context.Context, req *pps.InspectJobRequest, opts ...grpc.CallOption) (*pps.JobInfo, error) {
 	return nil, unsupportedError("InspectJob")
 }
@@ -493,6 +514,9 @@ func (c *ppsBuilderClient) FlushJob(ctx context.Context, req *pps.FlushJobReques
 func (c *ppsBuilderClient) DeleteJob(ctx context.Context, req *pps.DeleteJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("DeleteJob")
 }
+func (c *ppsBuilderClient) ApproveJob(ctx context.Context, req *pps.ApproveJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("ApproveJob")
+}
 func (c *ppsBuilderClient) StopJob(ctx context.Context, req *pps.StopJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("StopJob")
 }
@@ -505,12 +529,18 @@ func (c *ppsBuilderClient) ListDatum(ctx context.Context, req *pps.ListDatumRequ
 func (c *ppsBuilderClient) ListDatumStream(ctx context.Context, req *pps.ListDatumRequest, opts ...grpc.CallOption) (pps.API_ListDatumStreamClient, error) {
 	return nil, unsupportedError("ListDatumStream")
 }
+func (c *ppsBuilderClient) TraceFile(ctx context.Context, req *pps.TraceFileRequest, opts ...grpc.CallOption) (*pps.FileTrace, error) {
+	return nil, unsupportedError("TraceFile")
+}
 func (c *ppsBuilderClient) RestartDatum(ctx context.Context, req *pps.RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("RestartDatum")
 }
 func (c *ppsBuilderClient) CreatePipeline(ctx context.Context, req *pps.CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("CreatePipeline")
 }
+func (c *ppsBuilderClient) PreviewPipeline(ctx context.Context, req *pps.CreatePipelineRequest, opts ...grpc.CallOption) (*pps.PipelinePreview, error) {
+	return nil, unsupportedError("PreviewPipeline")
+}
 func (c *ppsBuilderClient) InspectPipeline(ctx context.Context, req *pps.InspectPipelineRequest, opts ...grpc.CallOption) (*pps.PipelineInfo, error) {
 	return nil, unsupportedError("InspectPipeline")
 }
@@ -535,6 +565,12 @@ func (c *ppsBuilderClient) DeleteAll(ctx context.Context, req *types.Empty, opts
 func (c *ppsBuilderClient) GetLogs(ctx context.Context, req *pps.GetLogsRequest, opts ...grpc.CallOption) (pps.API_GetLogsClient, error) {
 	return nil, unsupportedError("GetLogs")
 }
+func (c *ppsBuilderClient) ListMemo(ctx context.Context, req *pps.ListMemoRequest, opts ...grpc.CallOption) (*pps.MemoInfos, error) {
+	return nil, unsupportedError("ListMemo")
+}
+func (c *ppsBuilderClient) DeleteMemo(ctx context.Context, req *pps.DeleteMemoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("DeleteMemo")
+}
 func (c *ppsBuilderClient) GarbageCollect(ctx context.Context, req *pps.GarbageCollectRequest, opts ...grpc.CallOption) (*pps.GarbageCollectResponse, error) {
 	return nil, unsupportedError("GarbageCollect")
 }
@@ -544,6 +580,9 @@ func (c *ppsBuilderClient) ActivateAuth(ctx context.Context, req *pps.ActivateAu
 func (c *ppsBuilderClient) RunCron(ctx context.Context, req *pps.RunCronRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("RunCron")
 }
+func (c *ppsBuilderClient) RollbackPipeline(ctx context.Context, req *pps.RollbackPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	return nil, unsupportedError("RollbackPipeline")
+}
 func (c *ppsBuilderClient) CreateSecret(ctx context.Context, req *pps.CreateSecretRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("CreateSecret")
 }
@@ -55,6 +55,7 @@ $ {{alias}} -u s3://bucket/backup`,
 	commands = append(commands, cmdutil.CreateAlias(extract, "extract"))
 
 	var noToken bool
+	var breakGlass bool
 	restore := &cobra.Command{
 		Short: "Restore Pachyderm state from stdin or an object store.",
 		Long:  "Restore Pachyderm state from stdin or an object store.",
@@ -97,9 +98,14 @@ Token: %v
 			}
 
 			defer c.Close()
-			if url != "" {
+			switch {
+			case url != "" && breakGlass:
+				err = c.RestoreURLBreakGlass(url)
+			case url != "":
 				err = c.RestoreURL(url)
-			} else {
+			case breakGlass:
+				err = c.RestoreReaderBreakGlass(snappy.NewReader(os.Stdin))
+			default:
 				err = c.RestoreReader(snappy.NewReader(os.Stdin))
 			}
 			if err != nil {
@@ -112,6 +118,7 @@ Token: %v
 	}
 	restore.Flags().StringVarP(&url, "url", "u", "", "An object storage url (i.e. s3://...) to restore from.")
 	restore.Flags().BoolVar(&noToken, "no-token", false, "Don't generate a new auth token at the beginning of the restore.")
+	restore.Flags().BoolVar(&breakGlass, "break-glass", false, "rewind branches whose commits are still retained by a write-once-read-many policy; this is audit-logged")
 	commands = append(commands, cmdutil.CreateAlias(restore, "restore"))
 
 	inspectCluster := &cobra.Command{
@@ -20,14 +20,17 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	versionlib "github.com/pachyderm/pachyderm/src/client/version"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
 	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/workload"
 
@@ -1079,3 +1082,44 @@ func TestExtractRestoreUnauthenticated(t *testing.T) {
 	require.YesError(t, err)
 	require.Equal(t, "github:alice is not authorized to perform this operation; must be an admin to call Restore", err.Error())
 }
+
+func TestRestoreWORMBranch(t *testing.T) {
+	require.NoError(t, testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		repo := tu.UniqueString("TestRestoreWORMBranch")
+		_, err := c.PfsAPIClient.CreateRepo(c.Ctx(), &pfs.CreateRepoRequest{
+			Repo: client.NewRepo(repo),
+			Worm: &pfs.WORMPolicy{Retention: types.DurationProto(time.Hour)},
+		})
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, "master", "file", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		first, err := c.InspectCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, "master", "file", strings.NewReader("bar\n"))
+		require.NoError(t, err)
+
+		// Restoring a branch onto a descendant of its head is allowed, but
+		// rewinding it past retained commits needs break glass
+		rewind := func() *admin.Op1_12 {
+			return &admin.Op1_12{Branch: &pfs.CreateBranchRequest{
+				Head:   first.Commit,
+				Branch: client.NewBranch(repo, "master"),
+			}}
+		}
+		r := &restoreCtx{pachClient: c}
+		require.NoError(t, r.applyOp(&admin.Op1_12{Branch: &pfs.CreateBranchRequest{
+			Head:   client.NewCommit(repo, "master"),
+			Branch: client.NewBranch(repo, "master"),
+		}}))
+		err = r.applyOp(rewind())
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsWORMRetentionErr(err))
+		r.breakGlass = true
+		require.NoError(t, r.applyOp(rewind()))
+		ci, err := c.InspectCommit(repo, "master")
+		require.NoError(t, err)
+		require.Equal(t, first.Commit.ID, ci.Commit.ID)
+		return nil
+	}))
+}
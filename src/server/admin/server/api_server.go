@@ -210,6 +210,7 @@ func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.A
 				Repo: &pfs.CreateRepoRequest{
 					Repo:        ri.Repo,
 					Description: ri.Description,
+					Worm:        ri.Worm,
 				}},
 			}); err != nil {
 				return err
@@ -403,6 +404,9 @@ func sortPipelineInfos(pis []*pps.PipelineInfo) []*pps.PipelineInfo {
 				if input.Pfs != nil {
 					add(input.Pfs.Repo)
 				}
+				if input.Window != nil {
+					add(input.Window.Repo)
+				}
 			})
 			result = append(result, pi)
 			delete(piMap, name)
@@ -460,6 +464,7 @@ func (a *apiServer) Restore(restoreServer admin.API_RestoreServer) (retErr error
 		}
 		return err
 	}
+	r.breakGlass = req.BreakGlass
 	if req.URL != "" {
 		return r.startFromURL(req.URL)
 	}
@@ -489,6 +494,10 @@ type restoreCtx struct {
 
 	r pbutil.Reader // set iff restoring from URL
 
+	// breakGlass is set from the first request, and lets the restore rewind
+	// branches in WORM repos whose retention hasn't elapsed
+	breakGlass bool
+
 	// streamVersion specifies the version of all ops in the stream (they must all
 	// be the same). streamVersion is set in validateAndApplyOp from first op's
 	// version
@@ -795,8 +804,10 @@ func (r *restoreCtx) applyOp(op *admin.Op1_12) error {
 		}
 	case op.Repo != nil:
 		op.Repo.Repo.Name = ancestry.SanitizeName(op.Repo.Repo.Name)
-		if _, err := c.PfsAPIClient.CreateRepo(ctx, op.Repo); err != nil && !errutil.IsAlreadyExistError(err) {
-			return errors.Wrapf(grpcutil.ScrubGRPC(err), "error creating repo")
+		if _, err := c.PfsAPIClient.CreateRepo(ctx, op.Repo); err != nil {
+			if !errutil.IsAlreadyExistError(err) {
+				return errors.Wrapf(grpcutil.ScrubGRPC(err), "error creating repo")
+			}
 		}
 	case op.Commit != nil:
 		if op.Commit.Finished == nil {
@@ -813,6 +824,10 @@ func (r *restoreCtx) applyOp(op *admin.Op1_12) error {
 		if op.Branch.Branch == nil {
 			op.Branch.Branch = client.NewBranch(op.Branch.Head.Repo.Name, ancestry.SanitizeName(op.Branch.SBranch))
 		}
+		// Restoring into an existing WORM repo may not move its branches off
+		// retained commits, which PFS checks in the same transaction that
+		// moves them, unless the restore breaks glass
+		op.Branch.BreakGlass = r.breakGlass
 		if _, err := c.PfsAPIClient.CreateBranch(ctx, op.Branch); err != nil && !errutil.IsAlreadyExistError(err) {
 			return errors.Wrapf(grpcutil.ScrubGRPC(err), "error creating branch")
 		}
@@ -808,6 +808,36 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(restartDocs, "restart"))
 
+	traceDocs := &cobra.Command{
+		Short: "Find where a Pachyderm resource came from.",
+		Long:  "Find where a Pachyderm resource came from.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(traceDocs, "trace"))
+
+	rollbackDocs := &cobra.Command{
+		Short: "Return a Pachyderm resource to a previous version.",
+		Long:  "Return a Pachyderm resource to a previous version.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(rollbackDocs, "rollback"))
+
+	approveDocs := &cobra.Command{
+		Short: "Let a held Pachyderm resource proceed.",
+		Long:  "Let a held Pachyderm resource proceed.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(approveDocs, "approve"))
+
+	rejectDocs := &cobra.Command{
+		Short: "Discard a held Pachyderm resource.",
+		Long:  "Discard a held Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(rejectDocs, "reject"))
+
+	renameDocs := &cobra.Command{
+		Short: "Rename a Pachyderm resource.",
+		Long:  "Rename a Pachyderm resource.",
+	}
+	subcommands = append(subcommands, cmdutil.CreateAlias(renameDocs, "rename"))
+
 	resumeDocs := &cobra.Command{
 		Short: "Resume a stopped task.",
 		Long:  "Resume a stopped task.",
@@ -872,6 +902,7 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"tag":
 			// These are ignored - they will show up in the help topics section
 		case
+			"approve",
 			"copy",
 			"create",
 			"delete",
@@ -884,10 +915,13 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"inspect",
 			"list",
 			"put",
+			"reject",
 			"restart",
+			"rollback",
 			"start",
 			"stop",
 			"subscribe",
+			"trace",
 			"update":
 			actions = append(actions, subcmd)
 		case
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	// Embed the time zone database, for pachctl images without one
+	_ "time/tzdata"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/server/cmd/pachctl/cmd"
@@ -6,10 +6,15 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"path"
 	"runtime/debug"
 	"runtime/pprof"
 	"strconv"
+	"syscall"
+	"time"
+	// The pachd image has no time zone database, which cron inputs need
+	_ "time/tzdata"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	adminclient "github.com/pachyderm/pachyderm/src/client/admin"
@@ -267,6 +272,9 @@ func doSidecarMode(config interface{}) (retErr error) {
 		return err
 	}
 	txnEnv.Initialize(env, transactionAPIServer, authAPIServer, pfsAPIServer, ppsAPIServer)
+	// k8s sends SIGTERM to every container in the pod at once, but the worker
+	// still needs PFS while it drains, so keep serving until it has exited
+	go waitForWorkerOnSignal(env.PPSWorkerPort)
 	// The sidecar only needs to serve traffic on the peer port, as it only serves
 	// traffic from the user container (the worker binary and occasionally user
 	// pipelines)
@@ -276,6 +284,27 @@ func doSidecarMode(config interface{}) (retErr error) {
 	return server.Wait()
 }
 
+// waitForWorkerOnSignal exits once the sidecar has received SIGTERM and the
+// worker in the same pod has stopped serving on 'workerPort' (the worker drains
+// its in-progress datums before exiting).
+func waitForWorkerOnSignal(workerPort uint16) {
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGTERM)
+	<-sigChan
+	log.Infof("received SIGTERM, waiting for the worker to exit")
+	addr := net.JoinHostPort("localhost", strconv.FormatUint(uint64(workerPort), 10))
+	for {
+		conn, err := net.DialTimeout("tcp", addr, time.Second)
+		if err != nil {
+			break
+		}
+		conn.Close()
+		time.Sleep(time.Second)
+	}
+	log.Infof("worker has exited, exiting")
+	os.Exit(0)
+}
+
 func doFullMode(config interface{}) (retErr error) {
 	defer func() {
 		if retErr != nil {
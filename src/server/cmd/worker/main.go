@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"os"
+	"os/signal"
 	"path"
+	"syscall"
 	"time"
 
 	"github.com/pachyderm/pachyderm/src/client"
@@ -127,9 +129,29 @@ func do(config interface{}) error {
 		return errors.Wrapf(err, "error putting IP address")
 	}
 
+	// When k8s terminates this pod (e.g. because an autoscaling pipeline is
+	// scaling down), finish the datums that are in progress before exiting, so
+	// that they aren't reprocessed by another worker, and then run the
+	// pipeline's teardown code.
+	go drainOnSignal(workerInstance)
+
 	// If server ever exits, return error
 	if _, err := server.ListenTCP("", env.PPSWorkerPort); err != nil {
 		return err
 	}
 	return server.Wait()
 }
+
+func drainOnSignal(workerInstance *worker.Worker) {
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGTERM)
+	<-sigChan
+	log.Infof("received SIGTERM, draining worker")
+	if err := workerInstance.Drain(context.Background()); err != nil {
+		log.Errorf("error draining worker: %v", err)
+	}
+	if err := workerInstance.Teardown(); err != nil {
+		log.Errorf("error running teardown: %v", err)
+	}
+	os.Exit(0)
+}
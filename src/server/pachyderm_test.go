@@ -3135,6 +3135,79 @@ func TestUpdatePipeline(t *testing.T) {
 	require.Equal(t, "buzz\n", buffer.String())
 }
 
+func TestUpdatePipelineRemovesOutputs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestUpdatePipelineRemovesOutputs_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := tu.UniqueString("pipeline")
+	modelsRepo := tu.UniqueString("models")
+	createPipeline := func(outputs ...*pps.Output) {
+		_, err := c.PpsAPIClient.CreatePipeline(
+			context.Background(),
+			&pps.CreatePipelineRequest{
+				Pipeline: client.NewPipeline(pipelineName),
+				Transform: &pps.Transform{
+					Cmd: []string{"bash"},
+					Stdin: []string{
+						"mkdir -p /pfs/out/metrics /pfs/out/models",
+						"echo foo >/pfs/out/metrics/file",
+						"echo bar >/pfs/out/models/file",
+					},
+				},
+				ParallelismSpec: &pps.ParallelismSpec{
+					Constant: 1,
+				},
+				Input:   client.NewPFSInput(dataRepo, "/*"),
+				Outputs: outputs,
+				Update:  true,
+			})
+		require.NoError(t, err)
+	}
+	putFile := func(content string) {
+		_, err := c.PutFile(dataRepo, "master", "file", strings.NewReader(content))
+		require.NoError(t, err)
+		iter, err := c.FlushCommit([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+		require.NoError(t, err)
+		collectCommitInfos(t, iter)
+	}
+	createPipeline(&pps.Output{Name: "metrics"}, &pps.Output{Name: "models", Repo: modelsRepo})
+	putFile("1")
+
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(pipelineName, "metrics", "file", 0, 0, &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+	buffer.Reset()
+	require.NoError(t, c.GetFile(modelsRepo, "master", "file", 0, 0, &buffer))
+	require.Equal(t, "bar\n", buffer.String())
+	metricsInfo, err := c.InspectBranch(pipelineName, "metrics")
+	require.NoError(t, err)
+	modelsInfo, err := c.InspectBranch(modelsRepo, "master")
+	require.NoError(t, err)
+
+	// Remove both outputs. Their branches keep their data, but no longer get
+	// commits (or jobs)
+	createPipeline()
+	putFile("2")
+	for _, prevInfo := range []*pfs.BranchInfo{metricsInfo, modelsInfo} {
+		bi, err := c.InspectBranch(prevInfo.Branch.Repo.Name, prevInfo.Branch.Name)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(bi.Provenance))
+		require.Equal(t, prevInfo.Head.ID, bi.Head.ID)
+	}
+	// One job for each input commit, and one for the update
+	jis, err := c.ListJob(pipelineName, nil, nil, -1, true)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(jis))
+	for _, ji := range jis {
+		require.Equal(t, pps.JobState_JOB_SUCCESS.String(), ji.State.String())
+	}
+}
+
 func TestUpdatePipelineWithInProgressCommitsAndStats(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
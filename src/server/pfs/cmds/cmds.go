@@ -2,6 +2,9 @@ package cmds
 
 import (
 	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -13,12 +16,15 @@ import (
 	"strconv"
 	"strings"
 	gosync "sync"
+	"time"
 
 	prompt "github.com/c-bata/go-prompt"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/config"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/server/cmd/pachctl/shell"
@@ -70,6 +76,13 @@ or type (e.g. csv, binary, images, etc).`,
 	commands = append(commands, cmdutil.CreateDocsAlias(repoDocs, "repo", " repo$"))
 
 	var description string
+	var wormRetention time.Duration
+	wormPolicy := func() *pfsclient.WORMPolicy {
+		if wormRetention == 0 {
+			return nil
+		}
+		return &pfsclient.WORMPolicy{Retention: types.DurationProto(wormRetention)}
+	}
 	createRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Create a new repo.",
@@ -87,6 +100,7 @@ or type (e.g. csv, binary, images, etc).`,
 					&pfsclient.CreateRepoRequest{
 						Repo:        client.NewRepo(args[0]),
 						Description: description,
+						Worm:        wormPolicy(),
 					},
 				)
 				return err
@@ -95,6 +109,7 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	createRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	createRepo.Flags().DurationVar(&wormRetention, "worm-retention", 0, "Make the repo write-once-read-many, retaining each commit's data for this long after it's finished.")
 	commands = append(commands, cmdutil.CreateAlias(createRepo, "create repo"))
 
 	updateRepo := &cobra.Command{
@@ -114,6 +129,7 @@ or type (e.g. csv, binary, images, etc).`,
 					&pfsclient.CreateRepoRequest{
 						Repo:        client.NewRepo(args[0]),
 						Description: description,
+						Worm:        wormPolicy(),
 						Update:      true,
 					},
 				)
@@ -123,6 +139,7 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	updateRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	updateRepo.Flags().DurationVar(&wormRetention, "worm-retention", 0, "Make the repo write-once-read-many, or extend its retention. A repo's retention can't be shortened.")
 	shell.RegisterCompletionFunc(updateRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(updateRepo, "update repo"))
 
@@ -198,6 +215,7 @@ or type (e.g. csv, binary, images, etc).`,
 	var force bool
 	var all bool
 	var splitTransaction bool
+	var breakGlass bool
 	deleteRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Delete a repo.",
@@ -213,6 +231,7 @@ or type (e.g. csv, binary, images, etc).`,
 				Force:            force,
 				All:              all,
 				SplitTransaction: splitTransaction,
+				BreakGlass:       breakGlass,
 			}
 			if len(args) > 0 {
 				if all {
@@ -241,9 +260,26 @@ or type (e.g. csv, binary, images, etc).`,
 	deleteRepo.Flags().BoolVarP(&force, "force", "f", false, "remove the repo regardless of errors; use with care")
 	deleteRepo.Flags().BoolVar(&all, "all", false, "remove all repos")
 	deleteRepo.Flags().BoolVar(&splitTransaction, "split-txn", false, "split large transactions into multiple smaller transactions")
+	deleteRepo.Flags().BoolVar(&breakGlass, "break-glass", false, "delete data that is still retained by a write-once-read-many policy; this is audit-logged")
 	shell.RegisterCompletionFunc(deleteRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteRepo, "delete repo"))
 
+	renameRepo := &cobra.Command{
+		Use:   "{{alias}} <repo> <new-name>",
+		Short: "Rename a repo.",
+		Long:  "Rename a repo, along with its commits, branches and ACL. Pipelines that read from the repo are updated to read from its new name, in the same transaction, so the rename fails if one of them has a running job.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.RenameRepo(args[0], args[1])
+		}),
+	}
+	shell.RegisterCompletionFunc(renameRepo, shell.RepoCompletion)
+	commands = append(commands, cmdutil.CreateAlias(renameRepo, "rename repo"))
+
 	commitDocs := &cobra.Command{
 		Short: "Docs for commits.",
 		Long: `Commits are atomic transactions on the content of a repo.
@@ -385,6 +421,7 @@ $ {{alias}} test -p XXX`,
 
 	var from string
 	var number int
+	var since, until, origin, grep string
 	listCommit := &cobra.Command{
 		Use:   "{{alias}} <repo>[@<branch>]",
 		Short: "Return all commits on a repo.",
@@ -400,7 +437,14 @@ $ {{alias}} foo@master
 $ {{alias}} foo@master -n 20
 
 # return commits in repo "foo" since commit XXX
-$ {{alias}} foo@master --from XXX`,
+$ {{alias}} foo@master --from XXX
+
+# return commits in repo "foo" that were started in the last 24 hours
+$ {{alias}} foo --since 24h
+
+# return commits in repo "foo" that were created by a user and whose
+# description mentions "backfill"
+$ {{alias}} foo --origin user --grep backfill`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
@@ -413,13 +457,50 @@ $ {{alias}} foo@master --from XXX`,
 				return err
 			}
 
+			req := &pfsclient.ListCommitRequest{
+				Repo:        branch.Repo,
+				Number:      uint64(number),
+				Description: grep,
+			}
+			if branch.Name != "" {
+				req.To = client.NewCommit(branch.Repo.Name, branch.Name)
+			}
+			if from != "" {
+				req.From = client.NewCommit(branch.Repo.Name, from)
+			}
+			if since != "" {
+				t, err := cmdutil.ParseTime(since)
+				if err != nil {
+					return err
+				}
+				if req.StartedAfter, err = types.TimestampProto(t); err != nil {
+					return err
+				}
+			}
+			if until != "" {
+				t, err := cmdutil.ParseTime(until)
+				if err != nil {
+					return err
+				}
+				if req.StartedBefore, err = types.TimestampProto(t); err != nil {
+					return err
+				}
+			}
+			if origin != "" {
+				kind, ok := pfsclient.OriginKind_value[strings.ToUpper(origin)]
+				if !ok {
+					return errors.Errorf("unrecognized origin %q, must be one of \"user\", \"auto\" or \"fsck\"", origin)
+				}
+				req.Origin = &pfsclient.CommitOrigin{Kind: pfsclient.OriginKind(kind)}
+			}
+
 			if raw {
-				return c.ListCommitF(branch.Repo.Name, branch.Name, from, uint64(number), false, func(ci *pfsclient.CommitInfo) error {
+				return c.ListCommitRequestF(req, func(ci *pfsclient.CommitInfo) error {
 					return marshaller.Marshal(os.Stdout, ci)
 				})
 			}
 			writer := tabwriter.NewWriter(os.Stdout, pretty.CommitHeader)
-			if err := c.ListCommitF(branch.Repo.Name, branch.Name, from, uint64(number), false, func(ci *pfsclient.CommitInfo) error {
+			if err := c.ListCommitRequestF(req, func(ci *pfsclient.CommitInfo) error {
 				pretty.PrintCommitInfo(writer, ci, fullTimestamps)
 				return nil
 			}); err != nil {
@@ -430,6 +511,10 @@ $ {{alias}} foo@master --from XXX`,
 	}
 	listCommit.Flags().StringVarP(&from, "from", "f", "", "list all commits since this commit")
 	listCommit.Flags().IntVarP(&number, "number", "n", 0, "list only this many commits; if set to zero, list all commits")
+	listCommit.Flags().StringVar(&since, "since", "", "list only commits started after this time, given as an RFC 3339 timestamp or a duration before now (e.g. \"1h\")")
+	listCommit.Flags().StringVar(&until, "until", "", "list only commits started before this time, given as an RFC 3339 timestamp or a duration before now (e.g. \"1h\")")
+	listCommit.Flags().StringVar(&origin, "origin", "", "list only commits with this origin (\"user\", \"auto\" or \"fsck\")")
+	listCommit.Flags().StringVar(&grep, "grep", "", "list only commits whose description matches this regex")
 	listCommit.MarkFlagCustom("from", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
 	listCommit.Flags().AddFlagSet(rawFlags)
 	listCommit.Flags().AddFlagSet(fullTimestampsFlags)
@@ -583,11 +668,17 @@ $ {{alias}} test@master --new`,
 			}
 			defer c.Close()
 
-			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
-				return c.DeleteCommit(commit.Repo.Name, commit.ID)
+			err = txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				_, err := c.PfsAPIClient.DeleteCommit(c.Ctx(), &pfsclient.DeleteCommitRequest{
+					Commit:     commit,
+					BreakGlass: breakGlass,
+				})
+				return err
 			})
+			return grpcutil.ScrubGRPC(err)
 		}),
 	}
+	deleteCommit.Flags().BoolVar(&breakGlass, "break-glass", false, "delete data that is still retained by a write-once-read-many policy; this is audit-logged")
 	shell.RegisterCompletionFunc(deleteCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteCommit, "delete commit"))
 
@@ -633,6 +724,21 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 			defer c.Close()
 
 			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				if breakGlass {
+					request := &pfsclient.CreateBranchRequest{
+						Branch:     branch,
+						Provenance: provenance,
+						BreakGlass: true,
+					}
+					if head != "" {
+						request.Head = client.NewCommit(branch.Repo.Name, head)
+					}
+					if trigger.Branch != "" {
+						request.Trigger = trigger
+					}
+					_, err := c.PfsAPIClient.CreateBranch(c.Ctx(), request)
+					return grpcutil.ScrubGRPC(err)
+				}
 				if trigger.Branch != "" {
 					return c.CreateBranchTrigger(branch.Repo.Name, branch.Name, head, trigger)
 				}
@@ -649,6 +755,7 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	createBranch.Flags().StringVar(&trigger.Size_, "trigger-size", "", "The data size to use in triggering.")
 	createBranch.Flags().Int64Var(&trigger.Commits, "trigger-commits", 0, "The number of commits to use in triggering.")
 	createBranch.Flags().BoolVar(&trigger.All, "trigger-all", false, "Only trigger when all conditions are met, rather than when any are met.")
+	createBranch.Flags().BoolVar(&breakGlass, "break-glass", false, "move the branch back past commits that are still retained by a write-once-read-many policy; this is audit-logged")
 	commands = append(commands, cmdutil.CreateAlias(createBranch, "create branch"))
 
 	inspectBranch := &cobra.Command{
@@ -742,6 +849,26 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	shell.RegisterCompletionFunc(deleteBranch, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteBranch, "delete branch"))
 
+	renameBranch := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch> <new-name>",
+		Short: "Rename a branch.",
+		Long:  "Rename a branch within its repo. Pipelines that read from the branch are updated to read from its new name, in the same transaction, so the rename fails if one of them has a running job.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			branch, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.RenameBranch(branch.Repo.Name, branch.Name, args[1])
+		}),
+	}
+	shell.RegisterCompletionFunc(renameBranch, shell.BranchCompletion)
+	commands = append(commands, cmdutil.CreateAlias(renameBranch, "rename branch"))
+
 	fileDocs := &cobra.Command{
 		Short: "Docs for files.",
 		Long: `Files are the lowest level data objects in Pachyderm.
@@ -763,6 +890,7 @@ from commits with 'get file'.`,
 	var putFileCommit bool
 	var overwrite bool
 	var compress bool
+	var resumable bool
 	putFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/to/file>]",
 		Short: "Put a file into the filesystem.",
@@ -789,6 +917,10 @@ $ {{alias}} -r repo@branch -f dir
 # Put the contents of a directory as repo/branch/file, i.e. put files at the top level:
 $ {{alias}} -r repo@branch:/ -f dir
 
+# Put a large file from the local filesystem as repo/branch/path, such that
+# rerunning the command resumes the upload if it's interrupted:
+$ {{alias}} repo@branch:/path -f file --resumable
+
 # Put the data from a URL as repo/branch/path:
 $ {{alias}} repo@branch:/path -f http://host/path
 
@@ -811,6 +943,9 @@ $ {{alias}} repo@branch -i http://host/path`,
 			if err != nil {
 				return err
 			}
+			if resumable && (split != "" || recursive || inputFile != "" || len(filePaths) != 1 || filePaths[0] == "-") {
+				return errors.Errorf("--resumable can only be used to put a single local file, without --split or --recursive")
+			}
 			opts := []client.Option{client.WithMaxConcurrentStreams(parallelism)}
 			if compress {
 				opts = append(opts, client.WithGZIPCompression())
@@ -821,8 +956,20 @@ $ {{alias}} repo@branch -i http://host/path`,
 			}
 			defer c.Close()
 
+			if resumable {
+				path := file.Path
+				if path == "" {
+					path = joinPaths("", filePaths[0])
+				}
+				return putFileResumable(c, file.Commit.Repo.Name, file.Commit.ID, path, filePaths[0], overwrite, breakGlass, parallelism)
+			}
+
 			// load data into pachyderm
-			pfc, err := c.NewPutFileClient()
+			newPutFileClient := c.NewPutFileClient
+			if breakGlass {
+				newPutFileClient = c.NewPutFileClientBreakGlass
+			}
+			pfc, err := newPutFileClient()
 			if err != nil {
 				return err
 			}
@@ -918,6 +1065,8 @@ $ {{alias}} repo@branch -i http://host/path`,
 	putFile.Flags().UintVar(&headerRecords, "header-records", 0, "the number of records that will be converted to a PFS 'header', and prepended to future retrievals of any subset of data from PFS; needs to be used with --split=(json|line|csv)")
 	putFile.Flags().BoolVarP(&putFileCommit, "commit", "c", false, "DEPRECATED: Put file(s) in a new commit.")
 	putFile.Flags().BoolVarP(&overwrite, "overwrite", "o", false, "Overwrite the existing content of the file, either from previous commits or previous calls to 'put file' within this commit.")
+	putFile.Flags().BoolVar(&breakGlass, "break-glass", false, "overwrite data that is still retained by a write-once-read-many policy; this is audit-logged")
+	putFile.Flags().BoolVar(&resumable, "resumable", false, "Upload a local file in checksummed parts, so that if the upload is interrupted, rerunning the command resumes it.")
 	shell.RegisterCompletionFunc(putFile,
 		func(flag, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
 			if flag == "-f" || flag == "--file" || flag == "-i" || flag == "input-file" {
@@ -950,6 +1099,15 @@ $ {{alias}} repo@branch -i http://host/path`,
 			}
 			defer c.Close()
 
+			if breakGlass {
+				_, err := c.PfsAPIClient.CopyFile(c.Ctx(), &pfsclient.CopyFileRequest{
+					Src:        srcFile,
+					Dst:        destFile,
+					Overwrite:  overwrite,
+					BreakGlass: true,
+				})
+				return grpcutil.ScrubGRPC(err)
+			}
 			return c.CopyFile(
 				srcFile.Commit.Repo.Name, srcFile.Commit.ID, srcFile.Path,
 				destFile.Commit.Repo.Name, destFile.Commit.ID, destFile.Path,
@@ -958,6 +1116,7 @@ $ {{alias}} repo@branch -i http://host/path`,
 		}),
 	}
 	copyFile.Flags().BoolVarP(&overwrite, "overwrite", "o", false, "Overwrite the existing content of the file, either from previous commits or previous calls to 'put file' within this commit.")
+	copyFile.Flags().BoolVar(&breakGlass, "break-glass", false, "overwrite data that is still retained by a write-once-read-many policy; this is audit-logged")
 	shell.RegisterCompletionFunc(copyFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(copyFile, "copy file"))
 
@@ -1275,9 +1434,17 @@ $ {{alias}} foo@master:path1 bar@master:path2`,
 			}
 			defer c.Close()
 
+			if breakGlass {
+				_, err := c.PfsAPIClient.DeleteFile(c.Ctx(), &pfsclient.DeleteFileRequest{
+					File:       file,
+					BreakGlass: true,
+				})
+				return grpcutil.ScrubGRPC(err)
+			}
 			return c.DeleteFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
 		}),
 	}
+	deleteFile.Flags().BoolVar(&breakGlass, "break-glass", false, "delete data that is still retained by a write-once-read-many policy; this is audit-logged")
 	shell.RegisterCompletionFunc(deleteFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(deleteFile, "delete file"))
 
@@ -1445,6 +1612,22 @@ func putFileHelper(c *client.APIClient, pfc client.PutFileClient,
 				return nil
 			}
 			childDest := filepath.Join(path, strings.TrimPrefix(filePath, source))
+			// Symlinks that stay inside of source are put as symlinks, the
+			// rest are put as the content they point to.
+			if info.Mode()&os.ModeSymlink != 0 {
+				target, ok, err := sync.LocalSymlinkTarget(source, filePath)
+				if err != nil {
+					return err
+				}
+				if ok {
+					eg.Go(func() error {
+						limiter.Acquire()
+						defer limiter.Release()
+						return pfc.PutSymlink(repo, commit, childDest, target)
+					})
+					return nil
+				}
+			}
 			eg.Go(func() error {
 				// don't do a second recursive 'put file', just put the one file at
 				// filePath into childDest, and then this walk loop will go on to the
@@ -1470,6 +1653,16 @@ func putFileHelper(c *client.APIClient, pfc client.PutFileClient,
 			retErr = err
 		}
 	}()
+	// Regular files keep their permission bits (other than the default), unless
+	// they're split into several files
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if split == "" && info.Mode().IsRegular() {
+		_, err = pfc.PutFileMode(repo, commit, path, f, overwrite, pfsclient.FileModeToRecord(info.Mode().Perm()))
+		return err
+	}
 	return putFile(f)
 }
 
@@ -1501,6 +1694,133 @@ func isPipe(r io.ReadSeeker) (bool, error) {
 	return fi.Mode()&os.ModeNamedPipe != 0, nil
 }
 
+// resumableUploadPartSize is the size of the parts in which 'put file
+// --resumable' uploads files.
+const resumableUploadPartSize = 64 * 1024 * 1024
+
+// resumableUpload is the state of a resumable upload, as recorded on the
+// local machine.
+type resumableUpload struct {
+	ID      string    `json:"id"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+// putFileResumable uploads the local file 'source' to 'path' in an upload
+// session, recording the session so that a rerun of the same command only
+// uploads the parts that the server hasn't received.
+func putFileResumable(c *client.APIClient, repo, commit, path, source string, overwrite, breakGlass bool, parallelism int) (retErr error) {
+	source, err := filepath.Abs(source)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(source)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return errors.Errorf("--resumable can only be used to put a regular file, %s is not one", source)
+	}
+	f, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+
+	// Sessions are keyed by everything that would make a rerun upload
+	// something different
+	key := sha256.Sum256([]byte(strings.Join([]string{repo, commit, path, source, strconv.FormatBool(overwrite)}, "\x00")))
+	statePath := filepath.Join(config.UploadsDir(), hex.EncodeToString(key[:])+".json")
+	var uploadInfo *pfsclient.UploadInfo
+	state := &resumableUpload{}
+	if data, err := ioutil.ReadFile(statePath); err == nil {
+		if err := json.Unmarshal(data, state); err != nil {
+			return errors.Wrapf(err, "could not parse %s", statePath)
+		}
+		// The local file changed, so its parts can't be reused
+		if state.Size == info.Size() && state.ModTime.Equal(info.ModTime()) {
+			if uploadInfo, err = c.InspectUpload(state.ID); err != nil {
+				fmt.Fprintf(os.Stderr, "could not resume upload %s, starting a new one: %v\n", state.ID, err)
+			}
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	if uploadInfo != nil && uploadInfo.Completed {
+		// An earlier run completed the upload, but didn't hear back
+		return os.Remove(statePath)
+	}
+	if uploadInfo == nil {
+		if uploadInfo, err = c.BeginUpload(repo, commit, path, overwrite); err != nil {
+			return err
+		}
+		state = &resumableUpload{ID: uploadInfo.ID, Size: info.Size(), ModTime: info.ModTime()}
+		data, err := json.Marshal(state)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(statePath), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(statePath, data, 0644); err != nil {
+			return err
+		}
+	}
+
+	// Hash the file while the parts upload
+	var eg errgroup.Group
+	var sum string
+	eg.Go(func() error {
+		hash := sha256.New()
+		if _, err := io.Copy(hash, io.NewSectionReader(f, 0, info.Size())); err != nil {
+			return err
+		}
+		sum = hex.EncodeToString(hash.Sum(nil))
+		return nil
+	})
+	received := make(map[int64]int64)
+	for _, part := range uploadInfo.Parts {
+		received[part.Number] = part.SizeBytes
+	}
+	numParts := (info.Size() + resumableUploadPartSize - 1) / resumableUploadPartSize
+	if len(received) > 0 {
+		fmt.Fprintf(os.Stderr, "resuming upload %s: %d of %d parts already received\n", uploadInfo.ID, len(received), numParts)
+	}
+	limiter := limit.New(parallelism)
+	for number := int64(0); number < numParts; number++ {
+		number := number
+		offset := number * resumableUploadPartSize
+		size := info.Size() - offset
+		if size > resumableUploadPartSize {
+			size = resumableUploadPartSize
+		}
+		if receivedSize, ok := received[number]; ok && receivedSize == size {
+			continue
+		}
+		eg.Go(func() error {
+			limiter.Acquire()
+			defer limiter.Release()
+			_, err := c.PutUploadPart(uploadInfo.ID, number, io.NewSectionReader(f, offset, size))
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return errors.Wrapf(err, "upload interrupted, rerun the command to resume it")
+	}
+	if _, err := c.PfsAPIClient.CompleteUpload(c.Ctx(), &pfsclient.CompleteUploadRequest{
+		ID:         uploadInfo.ID,
+		Sha256:     sum,
+		BreakGlass: breakGlass,
+	}); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	return os.Remove(statePath)
+}
+
 func dlFile(pachClient *client.APIClient, f *pfsclient.File) (_ string, retErr error) {
 	if err := os.MkdirAll(filepath.Join(os.TempDir(), filepath.Dir(f.Path)), 0777); err != nil {
 		return "", err
@@ -13,6 +13,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/server/pkg/progress"
+	pfssync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 )
 
@@ -83,6 +84,18 @@ func Mount(c *client.APIClient, target string, opts *Options) (retErr error) {
 			return err
 		}
 		if err := func() (retErr error) {
+			repo, file := parts[0], pathpkg.Join(parts[1:]...)
+			// Relative symlinks that stay inside of the repo are put as
+			// symlinks, others are put as the content they point to.
+			if info, err := os.Lstat(filepath.Join(root.rootPath, path)); err == nil && info.Mode()&os.ModeSymlink != 0 {
+				target, ok, err := pfssync.LocalSymlinkTarget(filepath.Join(root.rootPath, repo), filepath.Join(root.rootPath, path))
+				if err != nil {
+					return err
+				}
+				if ok {
+					return pfc.PutSymlink(repo, root.branch(repo), file, target)
+				}
+			}
 			f, err := progress.Open(filepath.Join(root.rootPath, path))
 			if err != nil {
 				if errors.Is(err, os.ErrNotExist) {
@@ -95,8 +108,17 @@ func Mount(c *client.APIClient, target string, opts *Options) (retErr error) {
 					retErr = errors.WithStack(err)
 				}
 			}()
-			if _, err := pfc.PutFileOverwrite(parts[0], root.branch(parts[0]),
-				pathpkg.Join(parts[1:]...), f, 0); err != nil {
+			info, err := f.Stat()
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			// Files in the mount are created with the default umask, so only
+			// executable modes carry information worth recording.
+			if perm := info.Mode().Perm(); perm&0111 != 0 {
+				_, err = pfc.PutFileMode(repo, root.branch(repo), file, f, true, uint32(perm))
+				return err
+			}
+			if _, err := pfc.PutFileOverwrite(repo, root.branch(repo), file, f, 0); err != nil {
 				return err
 			}
 			return nil
@@ -599,6 +599,12 @@ func (n *loopbackNode) download(path string, state fileState) (retErr error) {
 			if err := os.MkdirAll(filepath.Dir(p), 0777); err != nil {
 				return errors.WithStack(err)
 			}
+			if fi.FileType == pfs.FileType_SYMLINK {
+				if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+					return errors.WithStack(err)
+				}
+				return errors.WithStack(os.Symlink(fi.SymlinkTarget, p))
+			}
 			f, err := os.Create(p)
 			if err != nil {
 				return errors.WithStack(err)
@@ -608,6 +614,11 @@ func (n *loopbackNode) download(path string, state fileState) (retErr error) {
 					retErr = errors.WithStack(err)
 				}
 			}()
+			if fi.Mode != 0 {
+				if err := f.Chmod(os.FileMode(fi.Mode)); err != nil {
+					return errors.WithStack(err)
+				}
+			}
 			if state < full {
 				return f.Truncate(int64(fi.SizeBytes))
 			}
@@ -3,7 +3,9 @@ package pfs
 import (
 	"fmt"
 	"regexp"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 )
@@ -72,6 +74,14 @@ type ErrCommitNotFinished struct {
 	Commit *pfs.Commit
 }
 
+// ErrWORMRetention represents an error where an operation would delete or
+// overwrite data in a WORM repo before its retention period has elapsed.
+type ErrWORMRetention struct {
+	Repo  *pfs.Repo
+	Op    string
+	Until time.Time
+}
+
 func (e ErrFileNotFound) Error() string {
 	return fmt.Sprintf("file %v not found in repo %v at commit %v", e.File.Path, e.File.Commit.Repo.Name, e.File.Commit.ID)
 }
@@ -121,6 +131,10 @@ func (e ErrCommitNotFinished) Error() string {
 	return fmt.Sprintf("commit %v not finished", e.Commit.ID)
 }
 
+func (e ErrWORMRetention) Error() string {
+	return fmt.Sprintf("cannot %s: repo %v is write-once-read-many and its data is retained until %v", e.Op, e.Repo.Name, e.Until.Format(time.RFC3339))
+}
+
 // ByteRangeSize returns byteRange.Upper - byteRange.Lower.
 func ByteRangeSize(byteRange *pfs.ByteRange) uint64 {
 	return byteRange.Upper - byteRange.Lower
@@ -137,6 +151,8 @@ var (
 	hasNoHeadRe               = regexp.MustCompile(`the branch .+ has no head \(create one with 'start commit'\)`)
 	outputCommitNotFinishedRe = regexp.MustCompile("output commit .+ not finished")
 	commitNotFinishedRe       = regexp.MustCompile("commit .+ not finished")
+	wormRetentionRe           = regexp.MustCompile("repo [^ ]+ is write-once-read-many and its data is retained until")
+	directObjNotFoundRe       = regexp.MustCompile("object .+ not found with direct access")
 )
 
 // IsCommitNotFoundErr returns true if 'err' has an error message that matches
@@ -228,3 +244,44 @@ func IsCommitNotFinishedErr(err error) bool {
 	}
 	return commitNotFinishedRe.MatchString(err.Error())
 }
+
+// IsWORMRetentionErr returns true if the err is due to an attempt to delete or
+// overwrite retained data in a WORM repo.
+func IsWORMRetentionErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return wormRetentionRe.MatchString(err.Error())
+}
+
+// IsDirectObjNotFoundErr returns true if 'err' is an error message about an
+// object read with direct access (e.g. by DirectObjReader) not being found
+func IsDirectObjNotFoundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return directObjNotFoundRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
+
+// WORMRetainedUntil returns the time until which 'policy' retains the data in
+// the commit described by 'commitInfo'. It returns the zero time if the data
+// isn't (or is no longer) retained. Open commits are never retained, as
+// nothing has been committed to them yet.
+func WORMRetainedUntil(policy *pfs.WORMPolicy, commitInfo *pfs.CommitInfo) (time.Time, error) {
+	if policy == nil || commitInfo.Finished == nil {
+		return time.Time{}, nil
+	}
+	finished, err := types.TimestampFromProto(commitInfo.Finished)
+	if err != nil {
+		return time.Time{}, err
+	}
+	retention, err := types.DurationFromProto(policy.Retention)
+	if err != nil {
+		return time.Time{}, err
+	}
+	until := finished.Add(retention)
+	if !time.Now().Before(until) {
+		return time.Time{}, nil
+	}
+	return until, nil
+}
@@ -64,7 +64,7 @@ func (a *apiServer) CreateRepoInTransaction(
 	txnCtx *txnenv.TransactionContext,
 	request *pfs.CreateRepoRequest,
 ) error {
-	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update)
+	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Worm, request.Update)
 }
 
 // CreateRepo implements the protobuf pfs.CreateRepo RPC
@@ -123,8 +123,14 @@ func (a *apiServer) DeleteRepoInTransaction(
 	request *pfs.DeleteRepoRequest,
 ) error {
 	if request.All {
+		if err := a.driver.checkWORMDeleteAll(txnCtx, request.BreakGlass); err != nil {
+			return err
+		}
 		return a.driver.deleteAll(txnCtx)
 	}
+	if err := a.driver.checkWORMDeleteRepo(txnCtx, request.Repo, request.BreakGlass); err != nil {
+		return err
+	}
 	return a.driver.deleteRepo(txnCtx, request.Repo, request.Force)
 }
 
@@ -134,12 +140,11 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
 	if request.SplitTransaction {
-		if err := func() error {
-			if request.All {
-				return a.driver.deleteAllSplitTransaction(a.env.GetPachClient(ctx))
+		if request.All {
+			if err := a.driver.deleteAllSplitTransaction(a.env.GetPachClient(ctx), request.BreakGlass); err != nil {
+				return nil, err
 			}
-			return a.driver.deleteRepoSplitTransaction(ctx, request.Repo, request.Force)
-		}(); err != nil {
+		} else if err := a.driver.deleteRepoSplitTransaction(ctx, request.Repo, request.Force, request.BreakGlass); err != nil {
 			return nil, err
 		}
 		return &types.Empty{}, nil
@@ -152,6 +157,17 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 	return &types.Empty{}, nil
 }
 
+// RenameRepo implements the protobuf pfs.RenameRepo RPC
+func (a *apiServer) RenameRepo(ctx context.Context, request *pfs.RenameRepoRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.renameRepo(a.env.GetPachClient(ctx), request.Repo, request.NewName); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 // Fsckimplements the protobuf pfs.Fsck RPC
 func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -268,7 +284,11 @@ func (a *apiServer) ListCommit(ctx context.Context, request *pfs.ListCommitReque
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	commitInfos, err := a.driver.listCommit(a.env.GetPachClient(ctx), request.Repo, request.To, request.From, request.Number, request.Reverse)
+	filter, err := newCommitFilter(request)
+	if err != nil {
+		return nil, err
+	}
+	commitInfos, err := a.driver.listCommit(a.env.GetPachClient(ctx), request.Repo, request.To, request.From, request.Number, request.Reverse, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -284,7 +304,11 @@ func (a *apiServer) ListCommitStream(request *pfs.ListCommitRequest, respServer
 	defer func(start time.Time) {
 		a.Log(request, fmt.Sprintf("stream containing %d commits", sent), retErr, time.Since(start))
 	}(time.Now())
-	return a.driver.listCommitF(a.env.GetPachClient(respServer.Context()), request.Repo, request.To, request.From, request.Number, request.Reverse, func(ci *pfs.CommitInfo) error {
+	filter, err := newCommitFilter(request)
+	if err != nil {
+		return err
+	}
+	return a.driver.listCommitF(a.env.GetPachClient(respServer.Context()), request.Repo, request.To, request.From, request.Number, request.Reverse, filter, func(ci *pfs.CommitInfo) error {
 		sent++
 		return respServer.Send(ci)
 	})
@@ -296,7 +320,7 @@ func (a *apiServer) CreateBranchInTransaction(
 	txnCtx *txnenv.TransactionContext,
 	request *pfs.CreateBranchRequest,
 ) error {
-	return a.driver.createBranch(txnCtx, request.Branch, request.Head, request.Provenance, request.Trigger)
+	return a.driver.createBranch(txnCtx, request.Branch, request.Head, request.Provenance, request.Trigger, request.BreakGlass)
 }
 
 // CreateBranch implements the protobuf pfs.CreateBranch RPC
@@ -362,12 +386,26 @@ func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchR
 	return &types.Empty{}, nil
 }
 
+// RenameBranch implements the protobuf pfs.RenameBranch RPC
+func (a *apiServer) RenameBranch(ctx context.Context, request *pfs.RenameBranchRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.renameBranch(a.env.GetPachClient(ctx), request.Branch, request.NewName); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 // DeleteCommitInTransaction is identical to DeleteCommit except that it can run
 // inside an existing etcd STM transaction.  This is not an RPC.
 func (a *apiServer) DeleteCommitInTransaction(
 	txnCtx *txnenv.TransactionContext,
 	request *pfs.DeleteCommitRequest,
 ) error {
+	if err := a.driver.checkWORMDeleteCommit(txnCtx, request.Commit, request.BreakGlass); err != nil {
+		return err
+	}
 	return a.driver.deleteCommit(txnCtx, request.Commit)
 }
 
@@ -424,11 +462,49 @@ func (a *apiServer) PutFile(putFileServer pfs.API_PutFileServer) (retErr error)
 	return a.driver.putFiles(pachClient, s)
 }
 
+// BeginUpload implements the protobuf pfs.BeginUpload RPC
+func (a *apiServer) BeginUpload(ctx context.Context, request *pfs.BeginUploadRequest) (response *pfs.UploadInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.beginUpload(a.env.GetPachClient(ctx), request.File, request.Overwrite)
+}
+
+// PutUploadPart implements the protobuf pfs.PutUploadPart RPC
+func (a *apiServer) PutUploadPart(server pfs.API_PutUploadPartServer) (retErr error) {
+	func() { a.Log(nil, nil, nil, 0) }()
+	var response *pfs.UploadPart
+	defer func(start time.Time) { a.Log(nil, response, retErr, time.Since(start)) }(time.Now())
+	defer drainUploadPartServer(server)
+	var err error
+	response, err = a.driver.putUploadPart(a.env.GetPachClient(server.Context()), server)
+	if err != nil {
+		return err
+	}
+	return server.SendAndClose(response)
+}
+
+// InspectUpload implements the protobuf pfs.InspectUpload RPC
+func (a *apiServer) InspectUpload(ctx context.Context, request *pfs.InspectUploadRequest) (response *pfs.UploadInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.inspectUpload(a.env.GetPachClient(ctx), request.ID)
+}
+
+// CompleteUpload implements the protobuf pfs.CompleteUpload RPC
+func (a *apiServer) CompleteUpload(ctx context.Context, request *pfs.CompleteUploadRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.driver.completeUpload(a.env.GetPachClient(ctx), request.ID, request.Sha256, request.BreakGlass); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 // CopyFile implements the protobuf pfs.CopyFile RPC
 func (a *apiServer) CopyFile(ctx context.Context, request *pfs.CopyFileRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	if err := a.driver.copyFile(a.env.GetPachClient(ctx), request.Src, request.Dst, request.Overwrite); err != nil {
+	if err := a.driver.copyFile(a.env.GetPachClient(ctx), request.Src, request.Dst, request.Overwrite, request.BreakGlass); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
@@ -585,7 +661,7 @@ func (a *apiServer) DeleteFile(ctx context.Context, request *pfs.DeleteFileReque
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	err := a.driver.deleteFile(a.env.GetPachClient(ctx), request.File)
+	err := a.driver.deleteFile(a.env.GetPachClient(ctx), request.File, request.BreakGlass)
 	if err != nil {
 		return nil, err
 	}
@@ -593,11 +669,14 @@ func (a *apiServer) DeleteFile(ctx context.Context, request *pfs.DeleteFileReque
 }
 
 // DeleteAll implements the protobuf pfs.DeleteAll RPC
-func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (response *types.Empty, retErr error) {
+func (a *apiServer) DeleteAll(ctx context.Context, request *pfs.DeleteAllRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
 	err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		if err := a.driver.checkWORMDeleteAll(txnCtx, request.BreakGlass); err != nil {
+			return err
+		}
 		return a.driver.deleteAll(txnCtx)
 	})
 	if err != nil {
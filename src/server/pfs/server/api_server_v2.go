@@ -47,8 +47,14 @@ func newAPIServerV2(env *serviceenv.ServiceEnv, txnEnv *txnenv.TransactionEnv, e
 // inside an existing etcd STM transaction.  This is not an RPC.
 func (a *apiServerV2) DeleteRepoInTransaction(txnCtx *txnenv.TransactionContext, request *pfs.DeleteRepoRequest) error {
 	if request.All {
+		if err := a.driver.checkWORMDeleteAll(txnCtx, request.BreakGlass); err != nil {
+			return err
+		}
 		return a.driver.deleteAll(txnCtx)
 	}
+	if err := a.driver.checkWORMDeleteRepo(txnCtx, request.Repo, request.BreakGlass); err != nil {
+		return err
+	}
 	return a.driver.deleteRepo(txnCtx, request.Repo, request.Force)
 }
 
@@ -66,9 +72,22 @@ func (a *apiServerV2) FinishCommitInTransaction(txnCtx *txnenv.TransactionContex
 // DeleteCommitInTransaction is identical to DeleteCommit except that it can run
 // inside an existing etcd STM transaction.  This is not an RPC.
 func (a *apiServerV2) DeleteCommitInTransaction(txnCtx *txnenv.TransactionContext, request *pfs.DeleteCommitRequest) error {
+	if err := a.driver.checkWORMDeleteCommit(txnCtx, request.Commit, request.BreakGlass); err != nil {
+		return err
+	}
 	return a.driver.deleteCommit(txnCtx, request.Commit)
 }
 
+// RenameRepo is not implemented in V2.
+func (a *apiServerV2) RenameRepo(_ context.Context, _ *pfs.RenameRepoRequest) (*types.Empty, error) {
+	return nil, errV1NotImplemented
+}
+
+// RenameBranch is not implemented in V2.
+func (a *apiServerV2) RenameBranch(_ context.Context, _ *pfs.RenameBranchRequest) (*types.Empty, error) {
+	return nil, errV1NotImplemented
+}
+
 // BuildCommit is not implemented in V2.
 func (a *apiServerV2) BuildCommit(_ context.Context, _ *pfs.BuildCommitRequest) (*pfs.Commit, error) {
 	return nil, errV1NotImplemented
@@ -79,6 +98,26 @@ func (a *apiServerV2) PutFile(_ pfs.API_PutFileServer) error {
 	return errV1NotImplemented
 }
 
+// BeginUpload is not implemented in V2.
+func (a *apiServerV2) BeginUpload(_ context.Context, _ *pfs.BeginUploadRequest) (*pfs.UploadInfo, error) {
+	return nil, errV1NotImplemented
+}
+
+// PutUploadPart is not implemented in V2.
+func (a *apiServerV2) PutUploadPart(_ pfs.API_PutUploadPartServer) error {
+	return errV1NotImplemented
+}
+
+// InspectUpload is not implemented in V2.
+func (a *apiServerV2) InspectUpload(_ context.Context, _ *pfs.InspectUploadRequest) (*pfs.UploadInfo, error) {
+	return nil, errV1NotImplemented
+}
+
+// CompleteUpload is not implemented in V2.
+func (a *apiServerV2) CompleteUpload(_ context.Context, _ *pfs.CompleteUploadRequest) (*types.Empty, error) {
+	return nil, errV1NotImplemented
+}
+
 // CopyFile implements the protobuf pfs.CopyFile RPC
 func (a *apiServerV2) CopyFile(ctx context.Context, request *pfs.CopyFileRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -146,8 +185,11 @@ func (a *apiServerV2) DeleteFile(ctx context.Context, request *pfs.DeleteFileReq
 }
 
 // DeleteAll implements the protobuf pfs.DeleteAll RPC
-func (a *apiServerV2) DeleteAll(ctx context.Context, request *types.Empty) (response *types.Empty, retErr error) {
+func (a *apiServerV2) DeleteAll(ctx context.Context, request *pfs.DeleteAllRequest) (response *types.Empty, retErr error) {
 	err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		if err := a.driver.checkWORMDeleteAll(txnCtx, request.BreakGlass); err != nil {
+			return err
+		}
 		return a.driver.deleteAll(txnCtx)
 	})
 	if err != nil {
@@ -170,7 +212,7 @@ func (a *apiServerV2) FileOperationV2(server pfs.API_FileOperationV2Server) (ret
 			return 0, err
 		}
 		var bytesRead int64
-		if err := a.driver.fileOperation(a.env.GetPachClient(server.Context()), request.Commit, func(uw *fileset.UnorderedWriter) error {
+		if err := a.driver.fileOperation(a.env.GetPachClient(server.Context()), request.Commit, request.BreakGlass, func(uw *fileset.UnorderedWriter) error {
 			for {
 				request, err := server.Recv()
 				if err != nil {
@@ -236,7 +278,9 @@ func (ptr *putTarReader) Read(data []byte) (int, error) {
 
 func deleteFiles(uw *fileset.UnorderedWriter, request *pfs.DeleteFilesRequestV2) error {
 	for _, file := range request.Files {
-		uw.Delete(file, request.Tag)
+		if err := uw.Delete(file, request.Tag); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -315,5 +359,5 @@ func (a *apiServerV2) CreateRepoInTransaction(
 	if repo := request.GetRepo(); repo != nil && repo.Name == tmpRepo {
 		return errors.Errorf("%s is a reserved name", tmpRepo)
 	}
-	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update)
+	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Worm, request.Update)
 }
@@ -15,6 +15,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -101,6 +102,11 @@ type driver struct {
 	commits        collectionFactory
 	branches       collectionFactory
 	openCommits    col.Collection
+	uploads        col.Collection
+
+	// how long upload sessions are kept without receiving a part (0 means
+	// forever)
+	uploadTTL time.Duration
 
 	// a cache for hashtrees
 	treeCache *hashtree.Cache
@@ -145,6 +151,7 @@ func newDriver(
 			return pfsdb.Branches(etcdClient, etcdPrefix, repo)
 		},
 		openCommits: pfsdb.OpenCommits(etcdClient, etcdPrefix),
+		uploads:     pfsdb.Uploads(etcdClient, etcdPrefix),
 		treeCache:   treeCache,
 		storageRoot: storageRoot,
 		// Allow up to a third of the requested memory to be used for memory intensive operations
@@ -153,6 +160,13 @@ func newDriver(
 		putFileLimiter:   limit.New(env.StoragePutFileConcurrencyLimit),
 		// TODO: set maxFanIn based on downward API.
 	}
+	if env.UploadTTL != "" {
+		var err error
+		if d.uploadTTL, err = time.ParseDuration(env.UploadTTL); err != nil {
+			log.Errorf("invalid upload TTL %q, upload sessions will not expire: %v", env.UploadTTL, err)
+			d.uploadTTL = 0
+		}
+	}
 
 	// Create spec repo (default repo)
 	repo := client.NewRepo(ppsconsts.SpecRepo)
@@ -169,7 +183,7 @@ func newDriver(
 	return d, nil
 }
 
-func (d *driver) createRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, description string, update bool) error {
+func (d *driver) createRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, description string, worm *pfs.WORMPolicy, update bool) error {
 	// Validate arguments
 	if repo == nil {
 		return errors.New("repo cannot be nil")
@@ -201,7 +215,15 @@ func (d *driver) createRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, d
 			return pfsserver.ErrRepoExists{repo}
 		}
 
-		if existingRepoInfo.Description == description {
+		// A WORM policy can't be removed, so an update without one keeps the
+		// existing policy.
+		if worm == nil {
+			worm = existingRepoInfo.Worm
+		} else if err := validateWORMPolicy(repo, existingRepoInfo.Worm, worm); err != nil {
+			return err
+		}
+
+		if existingRepoInfo.Description == description && proto.Equal(existingRepoInfo.Worm, worm) {
 			// Don't overwrite the stored proto with an identical value. This
 			// optimization is impactful because pps will frequently update the __spec__
 			// repo to make sure it exists.
@@ -218,9 +240,15 @@ func (d *driver) createRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, d
 			return errors.Wrapf(err, "could not update description of %q", repo)
 		}
 		existingRepoInfo.Description = description
+		existingRepoInfo.Worm = worm
 		return repos.Put(repo.Name, &existingRepoInfo)
 	} else {
 		// New repo case
+		if worm != nil {
+			if err := validateWORMPolicy(repo, nil, worm); err != nil {
+				return err
+			}
+		}
 		if authIsActivated {
 			// Create ACL for new repo. Make caller the sole owner. If the ACL already
 			// exists with a different owner, this will fail.
@@ -239,6 +267,7 @@ func (d *driver) createRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, d
 			Repo:        repo,
 			Created:     types.TimestampNow(),
 			Description: description,
+			Worm:        worm,
 		})
 	}
 }
@@ -1013,13 +1042,17 @@ func (d *driver) deleteRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, f
 	return nil
 }
 
-func (d *driver) deleteRepoSplitTransaction(ctx context.Context, repo *pfs.Repo, force bool) error {
+func (d *driver) deleteRepoSplitTransaction(ctx context.Context, repo *pfs.Repo, force, breakGlass bool) error {
 	// Validate arguments.
 	if repo == nil {
 		return errors.New("repo cannot be nil")
 	}
-	// Tombstone repo.
+	// Tombstone repo, once it's clear that deleting it doesn't destroy data
+	// that a WORM policy still retains.
 	if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		if err := d.checkWORMDeleteRepo(txnCtx, repo, breakGlass); err != nil {
+			return err
+		}
 		return d.tombstoneRepo(txnCtx, repo, force)
 	}); err != nil {
 		return err
@@ -2121,10 +2154,101 @@ func (d *driver) resolveCommit(stm col.STM, userCommit *pfs.Commit) (*pfs.Commit
 	return commitInfo, nil
 }
 
+// commitFilter holds the optional filters of a ListCommitRequest. A nil
+// commitFilter matches every commit.
+type commitFilter struct {
+	startedAfter, startedBefore   *time.Time
+	finishedAfter, finishedBefore *time.Time
+	origin                        *pfs.CommitOrigin
+	description                   *regexp.Regexp
+	minSizeBytes                  uint64
+	branch                        string
+}
+
+func newCommitFilter(request *pfs.ListCommitRequest) (*commitFilter, error) {
+	f := &commitFilter{
+		origin:       request.Origin,
+		minSizeBytes: request.MinSizeBytes,
+		branch:       request.Branch,
+	}
+	for _, ts := range []struct {
+		from *types.Timestamp
+		to   **time.Time
+	}{
+		{request.StartedAfter, &f.startedAfter},
+		{request.StartedBefore, &f.startedBefore},
+		{request.FinishedAfter, &f.finishedAfter},
+		{request.FinishedBefore, &f.finishedBefore},
+	} {
+		if ts.from == nil {
+			continue
+		}
+		t, err := types.TimestampFromProto(ts.from)
+		if err != nil {
+			return nil, err
+		}
+		*ts.to = &t
+	}
+	if request.Description != "" {
+		re, err := regexp.Compile(request.Description)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid description regex %q", request.Description)
+		}
+		f.description = re
+	}
+	return f, nil
+}
+
+func (f *commitFilter) match(ci *pfs.CommitInfo) bool {
+	if f == nil {
+		return true
+	}
+	if f.startedAfter != nil || f.startedBefore != nil {
+		if ci.Started == nil {
+			return false
+		}
+		started, err := types.TimestampFromProto(ci.Started)
+		if err != nil {
+			return false
+		}
+		if f.startedAfter != nil && started.Before(*f.startedAfter) ||
+			f.startedBefore != nil && !started.Before(*f.startedBefore) {
+			return false
+		}
+	}
+	if f.finishedAfter != nil || f.finishedBefore != nil {
+		// open commits haven't finished at any time
+		if ci.Finished == nil {
+			return false
+		}
+		finished, err := types.TimestampFromProto(ci.Finished)
+		if err != nil {
+			return false
+		}
+		if f.finishedAfter != nil && finished.Before(*f.finishedAfter) ||
+			f.finishedBefore != nil && !finished.Before(*f.finishedBefore) {
+			return false
+		}
+	}
+	if f.origin != nil && (ci.Origin == nil || ci.Origin.Kind != f.origin.Kind) {
+		return false
+	}
+	if f.description != nil && !f.description.MatchString(ci.Description) {
+		return false
+	}
+	if ci.SizeBytes < f.minSizeBytes {
+		return false
+	}
+	if f.branch != "" && (ci.Branch == nil || ci.Branch.Name != f.branch) {
+		return false
+	}
+	return true
+}
+
 func (d *driver) listCommit(pachClient *client.APIClient, repo *pfs.Repo,
-	to *pfs.Commit, from *pfs.Commit, number uint64, reverse bool) ([]*pfs.CommitInfo, error) {
+	to *pfs.Commit, from *pfs.Commit, number uint64, reverse bool, filter *commitFilter) ([]*pfs.CommitInfo, error) {
 	var result []*pfs.CommitInfo
-	if err := d.listCommitF(pachClient, repo, to, from, number, reverse, func(ci *pfs.CommitInfo) error {
+	if err := d.listCommitF(pachClient, repo, to, from, number, reverse, filter, func(ci *pfs.CommitInfo) error {
 		result = append(result, ci)
 		return nil
 	}); err != nil {
@@ -2134,7 +2258,7 @@ func (d *driver) listCommit(pachClient *client.APIClient, repo *pfs.Repo,
 }
 
 func (d *driver) listCommitF(pachClient *client.APIClient, repo *pfs.Repo,
-	to *pfs.Commit, from *pfs.Commit, number uint64, reverse bool, f func(*pfs.CommitInfo) error) error {
+	to *pfs.Commit, from *pfs.Commit, number uint64, reverse bool, filter *commitFilter, f func(*pfs.CommitInfo) error) error {
 	// Validate arguments
 	if repo == nil {
 		return errors.New("repo cannot be nil")
@@ -2202,11 +2326,13 @@ func (d *driver) listCommitF(pachClient *client.APIClient, repo *pfs.Repo,
 				if number == 0 {
 					return errutil.ErrBreak
 				}
-				number--
-
 				if reverse {
 					ci = cis[len(cis)-1-i]
 				}
+				if !filter.match(ci) {
+					continue
+				}
+				number--
 				if err := f(ci); err != nil {
 					return err
 				}
@@ -2244,13 +2370,16 @@ func (d *driver) listCommitF(pachClient *client.APIClient, repo *pfs.Repo,
 			if err := commits.Get(cursor.ID, &commitInfo); err != nil {
 				return err
 			}
+			cursor = commitInfo.ParentCommit
+			if !filter.match(&commitInfo) {
+				continue
+			}
 			if err := f(&commitInfo); err != nil {
 				if errors.Is(err, errutil.ErrBreak) {
 					return nil
 				}
 				return err
 			}
-			cursor = commitInfo.ParentCommit
 			number--
 		}
 	}
@@ -2754,7 +2883,7 @@ func (d *driver) resolveCommitProvenance(stm col.STM, userCommitProvenance *pfs.
 //
 // This invariant is assumed to hold for all branches upstream of 'branch', but not
 // for 'branch' itself once 'b.Provenance' has been set.
-func (d *driver) createBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Branch, commit *pfs.Commit, provenance []*pfs.Branch, trigger *pfs.Trigger) error {
+func (d *driver) createBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Branch, commit *pfs.Commit, provenance []*pfs.Branch, trigger *pfs.Trigger, breakGlass bool) error {
 	// Validate arguments
 	if branch == nil {
 		return errors.New("branch cannot be nil")
@@ -2819,6 +2948,9 @@ func (d *driver) createBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Bra
 			commit = nil
 		}
 	}
+	if err := d.checkWORMBranchHead(txnCtx, branch, commit, breakGlass); err != nil {
+		return err
+	}
 
 	// Retrieve (and create, if necessary) the current version of this branch
 	branches := d.branches(branch.Repo.Name).ReadWrite(txnCtx.Stm)
@@ -3125,6 +3257,7 @@ func (d *driver) putFiles(pachClient *client.APIClient, s *putFileServer) error
 	var files []*pfs.File
 	var putFilePaths []string
 	var putFileRecords []*pfs.PutFileRecords
+	var breakGlass bool
 	var mu sync.Mutex
 	oneOff, repo, branch, err := d.forEachPutFile(pachClient, s, func(req *pfs.PutFileRequest, r io.Reader) (retErr error) {
 		start := time.Now()
@@ -3133,7 +3266,7 @@ func (d *driver) putFiles(pachClient *client.APIClient, s *putFileServer) error
 		defer func() { logPutFileEnd(req, start, records, retErr) }() //late binding
 		var err error
 		records, err = d.putFile(pachClient, req.File, req.Delimiter, req.TargetFileDatums,
-			req.TargetFileBytes, req.HeaderRecords, req.OverwriteIndex, req.Delete, r)
+			req.TargetFileBytes, req.HeaderRecords, req.OverwriteIndex, req.Delete, req.Mode, req.SymlinkTarget, r)
 		if err != nil {
 			return err
 		}
@@ -3142,6 +3275,7 @@ func (d *driver) putFiles(pachClient *client.APIClient, s *putFileServer) error
 		files = append(files, req.File)
 		putFilePaths = append(putFilePaths, req.File.Path)
 		putFileRecords = append(putFileRecords, records)
+		breakGlass = breakGlass || req.BreakGlass
 		return nil
 	})
 	if err != nil {
@@ -3154,21 +3288,45 @@ func (d *driver) putFiles(pachClient *client.APIClient, s *putFileServer) error
 		// a commit with no ID, that ID will be filled in with the head of
 		// branch (if it exists).
 		return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+			if err := d.checkWORMWrite(txnCtx.Client, txnCtx.Stm, txnCtx.OnCommit, client.NewCommit(repo, branch), putFilePaths, putFileRecords, breakGlass); err != nil {
+				return err
+			}
 			_, err := d.makeCommit(txnCtx, "", client.NewCommit(repo, ""), branch, nil, nil, nil, nil, nil, putFilePaths, putFileRecords, "", time.Time{}, time.Time{}, 0)
 			return err
 		})
 	}
 	for i, file := range files {
-		if err := d.upsertPutFileRecords(pachClient, file, putFileRecords[i]); err != nil {
+		if err := d.upsertPutFileRecords(pachClient, file, putFileRecords[i], breakGlass, nil); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// objectRecords returns the records for a file written by PutObjectSplit as
+// 'objects', which hold 'size' bytes in total.
+func objectRecords(objects []*pfs.Object, size int64) []*pfs.PutFileRecord {
+	var records []*pfs.PutFileRecord
+	// Here we use the invariant that every one but the last object
+	// should have a size of ChunkSize.
+	for _, object := range objects {
+		record := &pfs.PutFileRecord{
+			ObjectHash: object.Hash,
+		}
+		if size > pfs.ChunkSize {
+			record.SizeBytes = pfs.ChunkSize
+		} else {
+			record.SizeBytes = size
+		}
+		size -= pfs.ChunkSize
+		records = append(records, record)
+	}
+	return records
+}
+
 func (d *driver) putFile(pachClient *client.APIClient, file *pfs.File, delimiter pfs.Delimiter,
 	targetFileDatums, targetFileBytes, headerRecords int64, overwriteIndex *pfs.OverwriteIndex,
-	del bool, reader io.Reader) (*pfs.PutFileRecords, error) {
+	del bool, mode uint32, symlinkTarget string, reader io.Reader) (*pfs.PutFileRecords, error) {
 	if err := authserver.CheckIsAuthorized(pachClient, file.Commit.Repo, auth.Scope_WRITER); err != nil {
 		return nil, err
 	}
@@ -3191,6 +3349,28 @@ func (d *driver) putFile(pachClient *client.APIClient, file *pfs.File, delimiter
 	if err := ppath.ValidatePath(file.Path); err != nil {
 		return nil, err
 	}
+	records.Mode = mode
+	if symlinkTarget != "" {
+		if hasPutFileOptions || overwriteIndex != nil {
+			return nil, errors.Errorf("cannot set split or overwrite options when putting a symlink")
+		}
+		readlink := func(p string) (string, error) {
+			fileInfo, err := d.inspectFile(pachClient, client.NewFile(file.Commit.Repo.Name, file.Commit.ID, p))
+			if err != nil {
+				if isNotFoundErr(err) {
+					return "", nil
+				}
+				return "", err
+			}
+			return fileInfo.SymlinkTarget, nil
+		}
+		if err := ppath.ValidateSymlink(file.Path, symlinkTarget, readlink); err != nil {
+			return nil, err
+		}
+		records.Tombstone = true
+		records.SymlinkTarget = symlinkTarget
+		return records, nil
+	}
 
 	if delimiter == pfs.Delimiter_NONE {
 		d.putObjectLimiter.Acquire()
@@ -3200,20 +3380,7 @@ func (d *driver) putFile(pachClient *client.APIClient, file *pfs.File, delimiter
 			return nil, err
 		}
 
-		// Here we use the invariant that every one but the last object
-		// should have a size of ChunkSize.
-		for i, object := range objects {
-			record := &pfs.PutFileRecord{
-				ObjectHash: object.Hash,
-			}
-
-			if size > pfs.ChunkSize {
-				record.SizeBytes = pfs.ChunkSize
-			} else {
-				record.SizeBytes = size
-			}
-			size -= pfs.ChunkSize
-
+		for i, record := range objectRecords(objects, size) {
 			// The first record takes care of the overwriting
 			if i == 0 && overwriteIndex != nil && overwriteIndex.Index != 0 {
 				record.OverwriteIndex = overwriteIndex
@@ -3451,7 +3618,7 @@ func headerDirToPutFileRecords(tree hashtree.HashTree, path string, node *hashtr
 	return pfr, nil // TODO(msteffen) put something real here
 }
 
-func (d *driver) copyFile(pachClient *client.APIClient, src *pfs.File, dst *pfs.File, overwrite bool) (retErr error) {
+func (d *driver) copyFile(pachClient *client.APIClient, src *pfs.File, dst *pfs.File, overwrite, breakGlass bool) (retErr error) {
 	// Validate arguments
 	if src == nil {
 		return errors.New("src cannot be nil")
@@ -3503,7 +3670,7 @@ func (d *driver) copyFile(pachClient *client.APIClient, src *pfs.File, dst *pfs.
 	var records []*pfs.PutFileRecords // used if 'dst' is finished (atomic 'put file')
 	if overwrite {
 		if dstIsOpenCommit {
-			if err := d.deleteFile(pachClient, dst); err != nil {
+			if err := d.deleteFile(pachClient, dst, breakGlass); err != nil {
 				return err
 			}
 		} else {
@@ -3539,7 +3706,7 @@ func (d *driver) copyFile(pachClient *client.APIClient, src *pfs.File, dst *pfs.
 		// to 'records' to be put at the end
 		if dstIsOpenCommit {
 			eg.Go(func() error {
-				return d.upsertPutFileRecords(pachClient, target, record)
+				return d.upsertPutFileRecords(pachClient, target, record, breakGlass, nil)
 			})
 		} else {
 			paths = append(paths, target.Path)
@@ -3587,6 +3754,9 @@ func (d *driver) copyFile(pachClient *client.APIClient, src *pfs.File, dst *pfs.
 	// dst is finished => all PutFileRecords are in 'records'--put in a new commit
 	if !dstIsOpenCommit {
 		return d.txnEnv.WithWriteContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) error {
+			if err := d.checkWORMWrite(txnCtx.Client, txnCtx.Stm, txnCtx.OnCommit, client.NewCommit(dst.Commit.Repo.Name, branch), paths, records, breakGlass); err != nil {
+				return err
+			}
 			_, err = d.makeCommit(txnCtx, "", client.NewCommit(dst.Commit.Repo.Name, ""), branch, nil, nil, nil, nil, nil, paths, records, "", time.Time{}, time.Time{}, 0)
 			return err
 		})
@@ -3962,6 +4132,11 @@ func nodeToFileInfo(ci *pfs.CommitInfo, path string, node *hashtree.NodeProto, f
 	}
 	if node.FileNode != nil {
 		fileInfo.FileType = pfs.FileType_FILE
+		if node.FileNode.SymlinkTarget != "" {
+			fileInfo.FileType = pfs.FileType_SYMLINK
+		}
+		fileInfo.Mode = node.FileNode.Mode
+		fileInfo.SymlinkTarget = node.FileNode.SymlinkTarget
 		if full {
 			fileInfo.Objects = node.FileNode.Objects
 			fileInfo.BlockRefs = node.FileNode.BlockRefs
@@ -4410,7 +4585,7 @@ func (d *driver) diffFile(pachClient *client.APIClient, newFile *pfs.File, oldFi
 	return newFileInfos, oldFileInfos, nil
 }
 
-func (d *driver) deleteFile(pachClient *client.APIClient, file *pfs.File) error {
+func (d *driver) deleteFile(pachClient *client.APIClient, file *pfs.File, breakGlass bool) error {
 	// Validate arguments
 	if file == nil {
 		return errors.New("file cannot be nil")
@@ -4440,13 +4615,18 @@ func (d *driver) deleteFile(pachClient *client.APIClient, file *pfs.File) error
 		if branch == "" {
 			return pfsserver.ErrCommitFinished{file.Commit}
 		}
+		paths := []string{file.Path}
+		records := []*pfs.PutFileRecords{&pfs.PutFileRecords{Tombstone: true}}
 		return d.txnEnv.WithWriteContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) error {
-			_, err := d.makeCommit(txnCtx, "", client.NewCommit(file.Commit.Repo.Name, ""), branch, nil, nil, nil, nil, nil, []string{file.Path}, []*pfs.PutFileRecords{&pfs.PutFileRecords{Tombstone: true}}, "", time.Time{}, time.Time{}, 0)
+			if err := d.checkWORMWrite(txnCtx.Client, txnCtx.Stm, txnCtx.OnCommit, client.NewCommit(file.Commit.Repo.Name, branch), paths, records, breakGlass); err != nil {
+				return err
+			}
+			_, err := d.makeCommit(txnCtx, "", client.NewCommit(file.Commit.Repo.Name, ""), branch, nil, nil, nil, nil, nil, paths, records, "", time.Time{}, time.Time{}, 0)
 			return err
 		})
 	}
 
-	return d.upsertPutFileRecords(pachClient, file, &pfs.PutFileRecords{Tombstone: true})
+	return d.upsertPutFileRecords(pachClient, file, &pfs.PutFileRecords{Tombstone: true}, breakGlass, nil)
 }
 
 func (d *driver) deleteAll(txnCtx *txnenv.TransactionContext) error {
@@ -4461,10 +4641,11 @@ func (d *driver) deleteAll(txnCtx *txnenv.TransactionContext) error {
 			return err
 		}
 	}
+	d.uploads.ReadWrite(txnCtx.Stm).DeleteAll()
 	return nil
 }
 
-func (d *driver) deleteAllSplitTransaction(pachClient *client.APIClient) error {
+func (d *driver) deleteAllSplitTransaction(pachClient *client.APIClient, breakGlass bool) error {
 	// Note: d.listRepo() doesn't return the 'spec' repo, so it doesn't get
 	// deleted here. Instead, PPS is responsible for deleting and re-creating it
 	repoInfos, err := d.listRepo(pachClient, !includeAuth)
@@ -4472,7 +4653,7 @@ func (d *driver) deleteAllSplitTransaction(pachClient *client.APIClient) error {
 		return err
 	}
 	for _, repoInfo := range repoInfos.RepoInfo {
-		if err := d.deleteRepoSplitTransaction(pachClient.Ctx(), repoInfo.Repo, true); err != nil && !auth.IsErrNotAuthorized(err) {
+		if err := d.deleteRepoSplitTransaction(pachClient.Ctx(), repoInfo.Repo, true, breakGlass); err != nil && !auth.IsErrNotAuthorized(err) {
 			return err
 		}
 	}
@@ -4483,10 +4664,16 @@ func (d *driver) deleteAllSplitTransaction(pachClient *client.APIClient) error {
 // Only write the records to etcd if the commit does exist and is open.
 // To check that a key exists in etcd, we assert that its CreateRevision
 // is greater than zero.
+// upsertPutFileRecords adds 'newRecords' to 'file' in its open commit, and
+// calls 'cb' (if it's set) in the same STM. If the records delete or overwrite
+// data that is retained by the repo's WORM policy, the write fails unless
+// 'breakGlass' is set (see checkWORMWrite).
 func (d *driver) upsertPutFileRecords(
 	pachClient *client.APIClient,
 	file *pfs.File,
 	newRecords *pfs.PutFileRecords,
+	breakGlass bool,
+	cb func(col.STM) error,
 ) error {
 	prefix, err := d.scratchFilePrefix(file)
 	if err != nil {
@@ -4494,36 +4681,68 @@ func (d *driver) upsertPutFileRecords(
 	}
 
 	ctx := pachClient.Ctx()
+	var onCommit []func()
 	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
-		commitsCol := d.openCommits.ReadWrite(stm)
-		var commit pfs.Commit
-		err := commitsCol.Get(file.Commit.ID, &commit)
-		if err != nil {
+		onCommit = nil // reset if the STM is retried
+		if err := d.checkWORMWrite(pachClient, stm, func(f func()) { onCommit = append(onCommit, f) },
+			file.Commit, []string{file.Path}, []*pfs.PutFileRecords{newRecords}, breakGlass); err != nil {
 			return err
 		}
-		// Dumb check to make sure the unmarshalled value exists (and matches the current ID)
-		// to denote that the current commit is indeed open
-		if commit.ID != file.Commit.ID {
-			return errors.Errorf("commit %v is not open", file.Commit.ID)
-		}
-		recordsCol := d.putFileRecords.ReadWrite(stm)
-		var existingRecords pfs.PutFileRecords
-		return recordsCol.Upsert(prefix, &existingRecords, func() error {
-			if newRecords.Tombstone {
-				existingRecords.Tombstone = true
-				existingRecords.Records = nil
-			}
-			existingRecords.Split = newRecords.Split
-			existingRecords.Records = append(existingRecords.Records, newRecords.Records...)
-			existingRecords.Header = newRecords.Header
-			existingRecords.Footer = newRecords.Footer
-			return nil
-		})
+		if err := d.upsertPutFileRecordsInSTM(stm, file, prefix, newRecords); err != nil {
+			return err
+		}
+		if cb != nil {
+			return cb(stm)
+		}
+		return nil
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	for _, f := range onCommit {
+		f()
+	}
+	return nil
 }
 
-func (d *driver) applyWrite(key string, records *pfs.PutFileRecords, tree hashtree.HashTree) error {
+// upsertPutFileRecordsInSTM is like upsertPutFileRecords, but adds the
+// records to the open commit in 'stm', under the scratch prefix 'prefix',
+// without checking the repo's WORM policy.
+func (d *driver) upsertPutFileRecordsInSTM(stm col.STM, file *pfs.File, prefix string, newRecords *pfs.PutFileRecords) error {
+	commitsCol := d.openCommits.ReadWrite(stm)
+	var commit pfs.Commit
+	err := commitsCol.Get(file.Commit.ID, &commit)
+	if err != nil {
+		return err
+	}
+	// Dumb check to make sure the unmarshalled value exists (and matches the current ID)
+	// to denote that the current commit is indeed open
+	if commit.ID != file.Commit.ID {
+		return errors.Errorf("commit %v is not open", file.Commit.ID)
+	}
+	recordsCol := d.putFileRecords.ReadWrite(stm)
+	var existingRecords pfs.PutFileRecords
+	return recordsCol.Upsert(prefix, &existingRecords, func() error {
+		if newRecords.Tombstone {
+			existingRecords.Tombstone = true
+			existingRecords.Records = nil
+			existingRecords.SymlinkTarget = ""
+		}
+		if newRecords.SymlinkTarget != "" {
+			existingRecords.SymlinkTarget = newRecords.SymlinkTarget
+		}
+		if newRecords.Mode != 0 {
+			existingRecords.Mode = newRecords.Mode
+		}
+		existingRecords.Split = newRecords.Split
+		existingRecords.Records = append(existingRecords.Records, newRecords.Records...)
+		existingRecords.Header = newRecords.Header
+		existingRecords.Footer = newRecords.Footer
+		return nil
+	})
+}
+
+func (d *driver) applyWrite(key string, records *pfs.PutFileRecords, tree hashtree.HashTree) (retErr error) {
 	// a map that keeps track of the sizes of objects
 	sizeMap := make(map[string]int64)
 
@@ -4532,6 +4751,19 @@ func (d *driver) applyWrite(key string, records *pfs.PutFileRecords, tree hashtr
 			return err
 		}
 	}
+	if records.SymlinkTarget != "" {
+		if err := tree.PutSymlink(key, records.SymlinkTarget); err != nil {
+			return err
+		}
+	}
+	if records.Mode != 0 && !records.Split {
+		// Apply the mode once the file's content has been written
+		defer func() {
+			if retErr == nil {
+				retErr = tree.SetFileMode(key, records.Mode)
+			}
+		}()
+	}
 	if !records.Split {
 		if len(records.Records) == 0 {
 			return nil
@@ -4947,7 +5179,7 @@ func (d *driver) forEachPutFile(pachClient *client.APIClient, server pfs.API_Put
 			if pw != nil {
 				pw.Close() // can't error
 			}
-			if req.Delete {
+			if req.Delete || req.SymlinkTarget != "" {
 				if err := pl.start(req.File.Path); err != nil {
 					return false, "", "", errors.Wrapf(err, "could not lock path %q", req.File.Path)
 				}
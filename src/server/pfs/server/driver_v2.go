@@ -195,7 +195,7 @@ func (d *driverV2) getSubFileSet() int64 {
 	return time.Now().UnixNano()
 }
 
-func (d *driverV2) fileOperation(pachClient *client.APIClient, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error) error {
+func (d *driverV2) fileOperation(pachClient *client.APIClient, commit *pfs.Commit, breakGlass bool, cb func(*fileset.UnorderedWriter) error) error {
 	ctx := pachClient.Ctx()
 	repo := commit.Repo.Name
 	var branch string
@@ -207,20 +207,90 @@ func (d *driverV2) fileOperation(pachClient *client.APIClient, commit *pfs.Commi
 		if (!isNotFoundErr(err) && !isNoHeadErr(err)) || branch == "" {
 			return err
 		}
-		return d.oneOffFileOperation(ctx, repo, branch, cb)
+		return d.oneOffFileOperation(pachClient, repo, branch, breakGlass, cb)
 	}
 	if commitInfo.Finished != nil {
 		if branch == "" {
 			return pfsserver.ErrCommitFinished{commitInfo.Commit}
 		}
-		return d.oneOffFileOperation(ctx, repo, branch, cb)
+		// The one-off commit's parent is the finished head of the branch.
+		return d.oneOffFileOperation(pachClient, repo, branch, breakGlass, cb, fileset.WithReadlink(d.readlink(ctx, []string{compactedCommitPath(commitInfo.Commit)})))
+	}
+	fileSets := []string{commitPath(commitInfo.Commit)}
+	if commitInfo.ParentCommit != nil {
+		fileSets = append([]string{compactedCommitPath(commitInfo.ParentCommit)}, fileSets...)
+	}
+	opts := []fileset.UnorderedWriterOption{fileset.WithReadlink(d.readlink(ctx, fileSets))}
+	// Writes to an open commit aren't applied in a transaction, but the data
+	// they replace is in the commit's parent, which is finished and can't
+	// change
+	var onCommit []func()
+	if err := d.txnEnv.WithReadContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		onCommit = nil
+		opt, err := d.wormOverwriteOption(pachClient, txnCtx.Stm, func(f func()) { onCommit = append(onCommit, f) }, commitInfo.Commit, breakGlass)
+		if err != nil || opt == nil {
+			return err
+		}
+		opts = append(opts, opt)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := d.withCommitWriter(ctx, commitInfo.Commit, cb, opts...); err != nil {
+		return err
+	}
+	for _, f := range onCommit {
+		f()
+	}
+	return nil
+}
+
+// wormOverwriteOption returns an option that makes an UnorderedWriter fail
+// to delete or overwrite files in 'commit' that are still retained by the
+// repo's WORM policy, unless the caller breaks glass (see wormFileCheck and
+// breakGlassCheck). It returns nil if nothing in 'commit' needs to be checked.
+func (d *driverV2) wormOverwriteOption(pachClient *client.APIClient, stm col.STM, onCommit func(func()), commit *pfs.Commit, breakGlass bool) (fileset.UnorderedWriterOption, error) {
+	check, err := d.wormFileCheck(pachClient, stm, commit, d.inspectFile)
+	if err != nil || check == nil {
+		return nil, err
+	}
+	op := "overwrite or delete files in " + commit.Repo.Name + "@" + commit.ID
+	return fileset.WithOverwriteCheck(breakGlassCheck(pachClient, onCommit, check, breakGlass, op)), nil
+}
+
+// readlink returns a function that reports the target of the symlink at a
+// path in the merge of 'fileSets' (or "" if there's no symlink there).
+func (d *driverV2) readlink(ctx context.Context, fileSets []string) func(string) (string, error) {
+	return func(p string) (string, error) {
+		fs, err := d.storage.Open(ctx, fileSets, index.WithPrefix(p))
+		if err != nil {
+			return "", err
+		}
+		var target string
+		if err := fs.Iterate(ctx, func(f fileset.File) error {
+			idx := f.Index()
+			if idx.Path == p && idx.File != nil {
+				target = idx.File.SymlinkTarget
+			}
+			return nil
+		}); err != nil {
+			return "", err
+		}
+		return target, nil
 	}
-	return d.withCommitWriter(ctx, commitInfo.Commit, cb)
 }
 
 // TODO: Cleanup after failure?
-func (d *driverV2) oneOffFileOperation(ctx context.Context, repo, branch string, cb func(*fileset.UnorderedWriter) error) error {
-	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) (retErr error) {
+func (d *driverV2) oneOffFileOperation(pachClient *client.APIClient, repo, branch string, breakGlass bool, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) error {
+	return d.txnEnv.WithWriteContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) (retErr error) {
+		opt, err := d.wormOverwriteOption(txnCtx.Client, txnCtx.Stm, txnCtx.OnCommit, client.NewCommit(repo, branch), breakGlass)
+		if err != nil {
+			return err
+		}
+		writerOpts := opts
+		if opt != nil {
+			writerOpts = append([]fileset.UnorderedWriterOption{opt}, opts...)
+		}
 		commit, err := d.startCommit(txnCtx, "", client.NewCommit(repo, ""), branch, nil, "")
 		if err != nil {
 			return err
@@ -230,17 +300,17 @@ func (d *driverV2) oneOffFileOperation(ctx context.Context, repo, branch string,
 				retErr = d.finishCommitV2(txnCtx, commit, "")
 			}
 		}()
-		return d.withCommitWriter(txnCtx.ClientContext, commit, cb)
+		return d.withCommitWriter(txnCtx.ClientContext, commit, cb, writerOpts...)
 	})
 }
 
 // withCommitWriter calls cb with an unordered writer. All data written to cb is added to the commit, or an error is returned.
-func (d *driverV2) withCommitWriter(ctx context.Context, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error) (retErr error) {
+func (d *driverV2) withCommitWriter(ctx context.Context, commit *pfs.Commit, cb func(*fileset.UnorderedWriter) error, opts ...fileset.UnorderedWriterOption) (retErr error) {
 	n := d.getSubFileSet()
 	subFileSetStr := fileset.SubFileSetStr(n)
 	subFileSetPath := path.Join(commit.Repo.Name, commit.ID, subFileSetStr)
 	return d.storage.WithRenewer(ctx, defaultTTL, func(ctx context.Context, renewer *renew.StringSet) error {
-		id, err := d.withTmpUnorderedWriter(ctx, renewer, false, cb)
+		id, err := d.withTmpUnorderedWriter(ctx, renewer, false, cb, opts...)
 		if err != nil {
 			return err
 		}
@@ -249,10 +319,10 @@ func (d *driverV2) withCommitWriter(ctx context.Context, commit *pfs.Commit, cb
 	})
 }
 
-func (d *driverV2) withTmpUnorderedWriter(ctx context.Context, renewer *renew.StringSet, compact bool, cb func(*fileset.UnorderedWriter) error) (string, error) {
+func (d *driverV2) withTmpUnorderedWriter(ctx context.Context, renewer *renew.StringSet, compact bool, cb func(*fileset.UnorderedWriter) error, extraOpts ...fileset.UnorderedWriterOption) (string, error) {
 	id := uuid.NewWithoutDashes()
 	inputPath := path.Join(tmpRepo, id)
-	opts := []fileset.UnorderedWriterOption{fileset.WithRenewal(defaultTTL, renewer)}
+	opts := append([]fileset.UnorderedWriterOption{fileset.WithRenewal(defaultTTL, renewer)}, extraOpts...)
 	defaultTag := fileset.SubFileSetStr(d.getSubFileSet())
 	uw, err := d.storage.NewUnorderedWriter(ctx, inputPath, defaultTag, opts...)
 	if err != nil {
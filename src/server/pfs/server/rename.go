@@ -0,0 +1,643 @@
+package server
+
+import (
+	"bytes"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	authserver "github.com/pachyderm/pachyderm/src/server/auth/server"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
+)
+
+// renamer rewrites the references to a repo or branch that's being renamed.
+// When renaming a repo, 'branch' and 'newBranch' are unset.
+type renamer struct {
+	repo, newRepo     string
+	branch, newBranch string
+}
+
+func (r *renamer) renamingBranch() bool {
+	return r.newBranch != ""
+}
+
+func (r *renamer) repoRef(repo *pfs.Repo) bool {
+	if r.renamingBranch() || repo == nil || repo.Name != r.repo {
+		return false
+	}
+	repo.Name = r.newRepo
+	return true
+}
+
+func (r *renamer) commitRef(commit *pfs.Commit) bool {
+	return commit != nil && r.repoRef(commit.Repo)
+}
+
+func (r *renamer) branchRef(branch *pfs.Branch) bool {
+	if branch == nil {
+		return false
+	}
+	if !r.renamingBranch() {
+		return r.repoRef(branch.Repo)
+	}
+	if branch.Repo == nil || branch.Repo.Name != r.repo || branch.Name != r.branch {
+		return false
+	}
+	branch.Name = r.newBranch
+	return true
+}
+
+// commitInfo renames every reference in 'ci' and reports whether any changed.
+func (r *renamer) commitInfo(ci *pfs.CommitInfo) bool {
+	changed := r.commitRef(ci.Commit)
+	changed = r.branchRef(ci.Branch) || changed
+	changed = r.commitRef(ci.ParentCommit) || changed
+	for _, child := range ci.ChildCommits {
+		changed = r.commitRef(child) || changed
+	}
+	for _, prov := range ci.Provenance {
+		changed = r.commitRef(prov.Commit) || changed
+		changed = r.branchRef(prov.Branch) || changed
+	}
+	for _, subv := range ci.Subvenance {
+		changed = r.commitRef(subv.Lower) || changed
+		changed = r.commitRef(subv.Upper) || changed
+	}
+	return changed
+}
+
+// branchInfo renames every reference in 'bi' and reports whether any changed.
+func (r *renamer) branchInfo(bi *pfs.BranchInfo) bool {
+	changed := r.branchRef(bi.Branch)
+	if changed {
+		bi.Name = bi.Branch.Name
+	}
+	changed = r.commitRef(bi.Head) || changed
+	for _, branches := range [][]*pfs.Branch{bi.Provenance, bi.Subvenance, bi.DirectProvenance} {
+		for _, branch := range branches {
+			changed = r.branchRef(branch) || changed
+		}
+	}
+	if r.renamingBranch() && bi.Trigger != nil && bi.Trigger.Branch == r.branch && bi.Branch.Repo.Name == r.repo {
+		bi.Trigger.Branch = r.newBranch
+		changed = true
+	}
+	return changed
+}
+
+// repoInfo renames every reference in 'ri' and reports whether any changed.
+func (r *renamer) repoInfo(ri *pfs.RepoInfo) bool {
+	changed := r.repoRef(ri.Repo)
+	for _, branch := range ri.Branches {
+		changed = r.branchRef(branch) || changed
+	}
+	return changed
+}
+
+// input renames the repo or branch in 'input' if it refers to the one being
+// renamed. The input's name is preserved, so that the pipeline's datums (and
+// the paths its code reads them from) don't change.
+func (r *renamer) input(input *pps.Input) {
+	switch {
+	case input.Pfs != nil && input.Pfs.Repo == r.repo:
+		if !r.renamingBranch() {
+			input.Pfs.Repo = r.newRepo
+			return
+		}
+		if input.Pfs.Branch == r.branch {
+			input.Pfs.Branch = r.newBranch
+		}
+		if input.Pfs.Trigger != nil && input.Pfs.Trigger.Branch == r.branch {
+			input.Pfs.Trigger.Branch = r.newBranch
+		}
+	case input.Cron != nil && input.Cron.Repo == r.repo && !r.renamingBranch():
+		input.Cron.Repo = r.newRepo
+	case input.ObjectStore != nil && input.ObjectStore.Repo == r.repo && !r.renamingBranch():
+		input.ObjectStore.Repo = r.newRepo
+	case input.Window != nil && input.Window.Repo == r.repo:
+		if !r.renamingBranch() {
+			input.Window.Repo = r.newRepo
+		} else if input.Window.Branch == r.branch {
+			input.Window.Branch = r.newBranch
+		}
+	}
+}
+
+// checkInput returns an error if the pipeline reading from 'input' can't be
+// updated to follow the rename.
+func (r *renamer) checkInput(pipeline string, input *pps.Input) error {
+	switch {
+	case input.Git != nil && input.Git.Name == r.repo:
+		return errors.Errorf("repo %q is the git input of pipeline %q and can't be renamed", r.repo, pipeline)
+	case input.Cron != nil && input.Cron.Repo == r.repo && r.renamingBranch():
+		return errors.Errorf("branches of repo %q are written by the cron input of pipeline %q and can't be renamed", r.repo, pipeline)
+	case input.ObjectStore != nil && input.ObjectStore.Repo == r.repo && r.renamingBranch():
+		return errors.Errorf("branches of repo %q are written by the object store input of pipeline %q and can't be renamed", r.repo, pipeline)
+	}
+	return nil
+}
+
+// inputPipelines returns the specs of the pipelines that read from the repo
+// being renamed, with their spec commits set, and the head of every spec
+// branch that they were read from (see checkSpecHeads).
+func (d *driver) inputPipelines(pachClient *client.APIClient, r *renamer) ([]*pps.PipelineInfo, map[string]string, error) {
+	var names []string
+	specBranches := d.branches(ppsconsts.SpecRepo).ReadOnly(pachClient.Ctx())
+	if err := specBranches.List(&pfs.BranchInfo{}, col.DefaultOptions, func(name string) error {
+		names = append(names, name)
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	var result []*pps.PipelineInfo
+	specHeads := make(map[string]string)
+	for _, name := range names {
+		branchInfo, err := pachClient.InspectBranch(ppsconsts.SpecRepo, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if branchInfo.Head == nil {
+			specHeads[name] = ""
+			continue
+		}
+		specHeads[name] = branchInfo.Head.ID
+		var buf bytes.Buffer
+		if err := pachClient.GetFile(ppsconsts.SpecRepo, branchInfo.Head.ID, ppsconsts.SpecFile, 0, 0, &buf); err != nil {
+			return nil, nil, errors.Wrapf(err, "could not read the spec of pipeline %q", name)
+		}
+		pipelineInfo := &pps.PipelineInfo{}
+		if err := pipelineInfo.Unmarshal(buf.Bytes()); err != nil {
+			return nil, nil, errors.Wrapf(err, "could not unmarshal the spec of pipeline %q", name)
+		}
+		pipelineInfo.SpecCommit = branchInfo.Head
+		var uses bool
+		var visitErr error
+		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+			if err := r.checkInput(name, input); err != nil && visitErr == nil {
+				visitErr = err
+			}
+			if (input.Pfs != nil && input.Pfs.Repo == r.repo) || (input.Cron != nil && input.Cron.Repo == r.repo) ||
+				(input.Window != nil && input.Window.Repo == r.repo) ||
+				(input.ObjectStore != nil && input.ObjectStore.Repo == r.repo) {
+				uses = true
+			}
+		})
+		if visitErr != nil {
+			return nil, nil, visitErr
+		}
+		if uses {
+			result = append(result, pipelineInfo)
+		}
+	}
+	return result, specHeads, nil
+}
+
+// checkSpecHeads returns an error if a pipeline has been created, deleted or
+// updated since inputPipelines read the spec branches, whose heads were
+// 'specHeads', as the set of pipelines reading from the repo being renamed may
+// have changed. The spec repo and its branches are read through the
+// transaction's STM, so a concurrent change also causes it to be retried.
+func (d *driver) checkSpecHeads(txnCtx *txnenv.TransactionContext, r *renamer, specHeads map[string]string) error {
+	changed := errors.Errorf("pipelines were created or updated while renaming %q; try again", r.repo)
+	specRepoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.Stm).Get(ppsconsts.SpecRepo, specRepoInfo); err != nil {
+		if col.IsErrNotFound(err) && len(specHeads) == 0 {
+			return nil
+		}
+		return err
+	}
+	if len(specRepoInfo.Branches) != len(specHeads) {
+		return changed
+	}
+	specBranches := d.branches(ppsconsts.SpecRepo).ReadWrite(txnCtx.Stm)
+	for _, branch := range specRepoInfo.Branches {
+		head, ok := specHeads[branch.Name]
+		if !ok {
+			return changed
+		}
+		branchInfo := &pfs.BranchInfo{}
+		if err := specBranches.Get(branch.Name, branchInfo); err != nil {
+			return err
+		}
+		if branchInfo.Head.GetID() != head {
+			return changed
+		}
+	}
+	return nil
+}
+
+// pipelineUpdate is the new spec of a pipeline that reads from the repo or
+// branch being renamed.
+type pipelineUpdate struct {
+	pipeline string
+	// specCommit is the spec commit that the new spec replaces
+	specCommit *pfs.Commit
+	// tree is the hashtree of the new spec commit
+	tree *pfs.Object
+}
+
+// preparePipelineUpdates writes the new specs of 'pipelineInfos', which follow
+// the rename, to object storage, so that they can be committed in the same
+// transaction as the rename. As the pipelines' salts and input names don't
+// change, nothing is reprocessed.
+func (d *driver) preparePipelineUpdates(pachClient *client.APIClient, r *renamer, pipelineInfos []*pps.PipelineInfo) ([]*pipelineUpdate, error) {
+	var result []*pipelineUpdate
+	for _, pipelineInfo := range pipelineInfos {
+		update := &pipelineUpdate{
+			pipeline:   pipelineInfo.Pipeline.Name,
+			specCommit: pipelineInfo.SpecCommit,
+		}
+		pipelineInfo.SpecCommit = nil
+		pipelineInfo.Version++
+		pps.VisitInput(pipelineInfo.Input, r.input)
+		data, err := pipelineInfo.Marshal()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not marshal the spec of pipeline %q", update.pipeline)
+		}
+		object, _, err := pachClient.PutObject(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		tree, err := hashtree.NewDBHashTree(d.storageRoot)
+		if err != nil {
+			return nil, err
+		}
+		if err := func() error {
+			defer destroyHashtree(tree)
+			if err := tree.PutFile(ppsconsts.SpecFile, []*pfs.Object{object}, int64(len(data))); err != nil {
+				return err
+			}
+			if err := tree.Hash(); err != nil {
+				return err
+			}
+			update.tree, err = hashtree.PutHashTree(pachClient, tree)
+			return err
+		}(); err != nil {
+			return nil, err
+		}
+		result = append(result, update)
+	}
+	return result, nil
+}
+
+// updatePipelinesInTransaction checks that no pipeline has changed since the
+// spec branches had the heads in 'specHeads', then has PPS commit the new specs
+// in 'updates', and point the pipelines at them.
+func (d *driver) updatePipelinesInTransaction(txnCtx *txnenv.TransactionContext, r *renamer, specHeads map[string]string, updates []*pipelineUpdate) error {
+	if err := d.checkSpecHeads(txnCtx, r, specHeads); err != nil {
+		return err
+	}
+	for _, update := range updates {
+		if err := d.checkNoOpenOutputCommits(txnCtx, update.pipeline); err != nil {
+			return err
+		}
+		if err := txnCtx.Pps().UpdatePipelineSpecInTransaction(txnCtx, update.pipeline, update.specCommit, update.tree); err != nil {
+			return errors.Wrapf(err, "could not update the spec of pipeline %q", update.pipeline)
+		}
+	}
+	return nil
+}
+
+// checkNoOpenOutputCommits returns an error if 'pipeline' has a running job,
+// as the job's output commit would be orphaned by the pipeline's new spec.
+func (d *driver) checkNoOpenOutputCommits(txnCtx *txnenv.TransactionContext, pipeline string) error {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.Stm).Get(pipeline, repoInfo); err != nil {
+		return err
+	}
+	for _, branch := range repoInfo.Branches {
+		branchInfo, err := d.inspectBranch(txnCtx, branch)
+		if err != nil {
+			return err
+		}
+		if branchInfo.Head == nil {
+			continue
+		}
+		commitInfo, err := d.resolveCommit(txnCtx.Stm, branchInfo.Head)
+		if err != nil {
+			return err
+		}
+		if commitInfo.Finished == nil {
+			return errors.Errorf("pipeline %q has a running job (its output commit %s is open); wait for it to finish, or stop the pipeline, before renaming",
+				pipeline, commitInfo.Commit.ID)
+		}
+	}
+	return nil
+}
+
+// renameReferences rewrites the commits and branches in 'repos' that refer to
+// the repo or branch being renamed.
+func (d *driver) renameReferences(txnCtx *txnenv.TransactionContext, r *renamer, repos map[string]bool) error {
+	for repo := range repos {
+		commits := d.commits(repo).ReadWrite(txnCtx.Stm)
+		commitIDs, err := d.collectionKeys(txnCtx, d.commits(repo), &pfs.CommitInfo{})
+		if err != nil {
+			return err
+		}
+		for _, id := range commitIDs {
+			ci := &pfs.CommitInfo{}
+			if err := commits.Get(id, ci); err != nil {
+				return err
+			}
+			if r.commitInfo(ci) {
+				if err := commits.Put(id, ci); err != nil {
+					return err
+				}
+			}
+		}
+		branches := d.branches(repo).ReadWrite(txnCtx.Stm)
+		branchNames, err := d.collectionKeys(txnCtx, d.branches(repo), &pfs.BranchInfo{})
+		if err != nil {
+			return err
+		}
+		for _, name := range branchNames {
+			bi := &pfs.BranchInfo{}
+			if err := branches.Get(name, bi); err != nil {
+				return err
+			}
+			if r.branchInfo(bi) {
+				if err := branches.Put(name, bi); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (d *driver) collectionKeys(txnCtx *txnenv.TransactionContext, c col.Collection, template proto.Message) ([]string, error) {
+	var keys []string
+	if err := c.ReadOnly(txnCtx.ClientContext).List(template, col.DefaultOptions, func(key string) error {
+		keys = append(keys, key)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// relatedRepos returns the repos whose commits or branches may refer to
+// 'repo', i.e. 'repo' itself and the repos up- and downstream of it.
+func relatedRepos(repo string, commitInfos []*pfs.CommitInfo, branchInfos []*pfs.BranchInfo) map[string]bool {
+	result := map[string]bool{repo: true}
+	for _, ci := range commitInfos {
+		for _, prov := range ci.Provenance {
+			result[prov.Commit.Repo.Name] = true
+		}
+		for _, subv := range ci.Subvenance {
+			result[subv.Upper.Repo.Name] = true
+		}
+	}
+	for _, bi := range branchInfos {
+		for _, branches := range [][]*pfs.Branch{bi.Provenance, bi.Subvenance} {
+			for _, branch := range branches {
+				result[branch.Repo.Name] = true
+			}
+		}
+	}
+	return result
+}
+
+// checkNotPipelineOutput returns an error if 'bi' is written by a pipeline.
+func checkNotPipelineOutput(bi *pfs.BranchInfo) error {
+	for _, prov := range bi.Provenance {
+		if prov.Repo.Name == ppsconsts.SpecRepo {
+			return errors.Errorf("branch %s@%s is written by pipeline %q and can't be renamed", bi.Branch.Repo.Name, bi.Branch.Name, prov.Name)
+		}
+	}
+	return nil
+}
+
+// renameRepo renames 'repo' to 'newName', and updates the specs of the
+// pipelines that read from it, in a single transaction.
+func (d *driver) renameRepo(pachClient *client.APIClient, repo *pfs.Repo, newName string) error {
+	// Validate arguments
+	if repo == nil {
+		return errors.New("repo cannot be nil")
+	}
+	if err := ancestry.ValidateName(newName); err != nil {
+		return err
+	}
+	if repo.Name == ppsconsts.SpecRepo || newName == ppsconsts.SpecRepo {
+		return errors.Errorf("cannot rename the special PPS repo %s", ppsconsts.SpecRepo)
+	}
+	r := &renamer{repo: repo.Name, newRepo: newName}
+	pipelineInfos, specHeads, err := d.inputPipelines(pachClient, r)
+	if err != nil {
+		return err
+	}
+	updates, err := d.preparePipelineUpdates(pachClient, r, pipelineInfos)
+	if err != nil {
+		return err
+	}
+	return d.txnEnv.WithWriteContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) error {
+		if err := d.renameRepoInTransaction(txnCtx, r); err != nil {
+			return err
+		}
+		return d.updatePipelinesInTransaction(txnCtx, r, specHeads, updates)
+	})
+}
+
+func (d *driver) renameRepoInTransaction(txnCtx *txnenv.TransactionContext, r *renamer) error {
+	repo, newRepo := client.NewRepo(r.repo), client.NewRepo(r.newRepo)
+	repos := d.repos.ReadWrite(txnCtx.Stm)
+	repoInfo := &pfs.RepoInfo{}
+	if err := repos.Get(repo.Name, repoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return pfsserver.ErrRepoNotFound{Repo: repo}
+		}
+		return err
+	}
+	if err := repos.Get(newRepo.Name, &pfs.RepoInfo{}); err == nil {
+		return pfsserver.ErrRepoExists{Repo: newRepo}
+	} else if !col.IsErrNotFound(err) {
+		return err
+	}
+	if err := authserver.CheckIsAuthorizedInTransaction(txnCtx, repo, auth.Scope_OWNER); err != nil {
+		return err
+	}
+
+	commitIDs, err := d.collectionKeys(txnCtx, d.commits(repo.Name), &pfs.CommitInfo{})
+	if err != nil {
+		return err
+	}
+	var commitInfos []*pfs.CommitInfo
+	for _, id := range commitIDs {
+		ci := &pfs.CommitInfo{}
+		if err := d.commits(repo.Name).ReadWrite(txnCtx.Stm).Get(id, ci); err != nil {
+			return err
+		}
+		// Open commits keep scratch space keyed by the repo's name
+		if ci.Finished == nil {
+			return errors.Errorf("cannot rename repo %q while commit %s is open", repo.Name, id)
+		}
+		commitInfos = append(commitInfos, ci)
+	}
+	var branchInfos []*pfs.BranchInfo
+	for _, branch := range repoInfo.Branches {
+		bi, err := d.inspectBranch(txnCtx, branch)
+		if err != nil {
+			return err
+		}
+		if err := checkNotPipelineOutput(bi); err != nil {
+			return err
+		}
+		branchInfos = append(branchInfos, bi)
+	}
+
+	// Move the repo's ACL first, as an ACL can only be created for a repo that
+	// doesn't exist yet
+	if err := d.renameACL(txnCtx, repo.Name, newRepo.Name); err != nil {
+		return err
+	}
+	if err := d.renameReferences(txnCtx, r, relatedRepos(repo.Name, commitInfos, branchInfos)); err != nil {
+		return err
+	}
+
+	// Move the repo's commits and branches to their new keys
+	oldCommits, newCommits := d.commits(repo.Name).ReadWrite(txnCtx.Stm), d.commits(newRepo.Name).ReadWrite(txnCtx.Stm)
+	for _, id := range commitIDs {
+		ci := &pfs.CommitInfo{}
+		if err := oldCommits.Get(id, ci); err != nil {
+			return err
+		}
+		if err := newCommits.Create(id, ci); err != nil {
+			return err
+		}
+	}
+	oldCommits.DeleteAll()
+	oldBranches, newBranches := d.branches(repo.Name).ReadWrite(txnCtx.Stm), d.branches(newRepo.Name).ReadWrite(txnCtx.Stm)
+	for _, branch := range repoInfo.Branches {
+		bi := &pfs.BranchInfo{}
+		if err := oldBranches.Get(branch.Name, bi); err != nil {
+			return err
+		}
+		if err := newBranches.Create(branch.Name, bi); err != nil {
+			return err
+		}
+	}
+	oldBranches.DeleteAll()
+	r.repoInfo(repoInfo)
+	if err := repos.Delete(repo.Name); err != nil {
+		return err
+	}
+	return repos.Create(newRepo.Name, repoInfo)
+}
+
+// renameACL moves the ACL of 'repo' to 'newName'.
+func (d *driver) renameACL(txnCtx *txnenv.TransactionContext, repo, newName string) error {
+	whoAmI, err := txnCtx.Client.WhoAmI(txnCtx.ClientContext, &auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsErrNotActivated(err) {
+			return nil
+		}
+		return grpcutil.ScrubGRPC(err)
+	}
+	acl, err := txnCtx.Auth().GetACLInTransaction(txnCtx, &auth.GetACLRequest{Repo: repo})
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	// Claim the new name (allowed because no repo has it), then copy the old
+	// ACL over and clear it
+	for _, req := range []*auth.SetACLRequest{
+		{Repo: newName, Entries: []*auth.ACLEntry{{Username: whoAmI.Username, Scope: auth.Scope_OWNER}}},
+		{Repo: newName, Entries: acl.Entries},
+		{Repo: repo},
+	} {
+		if _, err := txnCtx.Auth().SetACLInTransaction(txnCtx, req); err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+	}
+	return nil
+}
+
+// renameBranch renames 'branch' to 'newName' within its repo, and updates the
+// specs of the pipelines that read from it, in a single transaction.
+func (d *driver) renameBranch(pachClient *client.APIClient, branch *pfs.Branch, newName string) error {
+	// Validate arguments
+	if branch == nil {
+		return errors.New("branch cannot be nil")
+	}
+	if branch.Repo == nil {
+		return errors.New("branch repo cannot be nil")
+	}
+	if err := ancestry.ValidateName(newName); err != nil {
+		return err
+	}
+	if branch.Repo.Name == ppsconsts.SpecRepo {
+		return errors.Errorf("cannot rename branches of the special PPS repo %s", ppsconsts.SpecRepo)
+	}
+	r := &renamer{repo: branch.Repo.Name, branch: branch.Name, newBranch: newName}
+	pipelineInfos, specHeads, err := d.inputPipelines(pachClient, r)
+	if err != nil {
+		return err
+	}
+	updates, err := d.preparePipelineUpdates(pachClient, r, pipelineInfos)
+	if err != nil {
+		return err
+	}
+	return d.txnEnv.WithWriteContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) error {
+		if err := d.renameBranchInTransaction(txnCtx, r); err != nil {
+			return err
+		}
+		return d.updatePipelinesInTransaction(txnCtx, r, specHeads, updates)
+	})
+}
+
+func (d *driver) renameBranchInTransaction(txnCtx *txnenv.TransactionContext, r *renamer) error {
+	branch := client.NewBranch(r.repo, r.branch)
+	if err := authserver.CheckIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Scope_WRITER); err != nil {
+		return err
+	}
+	branchInfo, err := d.inspectBranch(txnCtx, branch)
+	if err != nil {
+		return err
+	}
+	if err := checkNotPipelineOutput(branchInfo); err != nil {
+		return err
+	}
+	branches := d.branches(r.repo).ReadWrite(txnCtx.Stm)
+	if err := branches.Get(r.newBranch, &pfs.BranchInfo{}); err == nil {
+		return errors.Errorf("branch %q already exists in repo %q", r.newBranch, r.repo)
+	} else if !col.IsErrNotFound(err) {
+		return err
+	}
+
+	var commitInfos []*pfs.CommitInfo
+	if branchInfo.Head != nil {
+		headInfo := &pfs.CommitInfo{}
+		if err := d.commits(r.repo).ReadWrite(txnCtx.Stm).Get(branchInfo.Head.ID, headInfo); err != nil {
+			return err
+		}
+		commitInfos = append(commitInfos, headInfo)
+	}
+	if err := d.renameReferences(txnCtx, r, relatedRepos(r.repo, commitInfos, []*pfs.BranchInfo{branchInfo})); err != nil {
+		return err
+	}
+
+	// Move the branch to its new key
+	if err := branches.Get(r.branch, branchInfo); err != nil {
+		return err
+	}
+	if err := branches.Delete(r.branch); err != nil {
+		return err
+	}
+	if err := branches.Create(r.newBranch, branchInfo); err != nil {
+		return err
+	}
+	repoInfo := &pfs.RepoInfo{}
+	return d.repos.ReadWrite(txnCtx.Stm).Update(r.repo, repoInfo, func() error {
+		r.repoInfo(repoInfo)
+		return nil
+	})
+}
@@ -50,6 +50,12 @@ func (s *source) Iterate(ctx context.Context, cb func(*pfs.FileInfo, fileset.Fil
 		}
 		if fileset.IsDir(idx.Path) {
 			fi.FileType = pfs.FileType_DIR
+		} else if idx.File != nil {
+			if idx.File.SymlinkTarget != "" {
+				fi.FileType = pfs.FileType_SYMLINK
+			}
+			fi.Mode = idx.File.Mode
+			fi.SymlinkTarget = idx.File.SymlinkTarget
 		}
 		if s.full {
 			cachedFi, ok := checkFileInfoCache(cache, idx)
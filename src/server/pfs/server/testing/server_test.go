@@ -3,6 +3,8 @@ package testing
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -23,18 +25,23 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/sql"
 	pfssync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
 	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
 	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
+	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 
 	"github.com/gogo/protobuf/types"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"golang.org/x/net/context"
 	"golang.org/x/sync/errgroup"
 )
@@ -2399,6 +2406,672 @@ func TestListCommit(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSymlinkAndMode(t *testing.T) {
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = env.PachClient.PutFileMode(repo, commit.ID, "dir/run.sh", strings.NewReader("echo foo\n"), true, 0755)
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutSymlink(repo, commit.ID, "dir/link", "run.sh"))
+		require.NoError(t, env.PachClient.PutSymlink(repo, commit.ID, "dir/up", "../dir/run.sh"))
+		require.YesError(t, env.PachClient.PutSymlink(repo, commit.ID, "dir/escape", "../../etc/passwd"))
+		require.YesError(t, env.PachClient.PutSymlink(repo, commit.ID, "dir/abs", "/etc/passwd"))
+		// Targets are resolved through the symlinks in the commit
+		require.NoError(t, env.PachClient.PutSymlink(repo, commit.ID, "dir/root", ".."))
+		require.NoError(t, env.PachClient.PutSymlink(repo, commit.ID, "dir/chained", "root/dir/run.sh"))
+		require.YesError(t, env.PachClient.PutSymlink(repo, commit.ID, "dir/escape", "root/.."))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+
+		fi, err := env.PachClient.InspectFile(repo, commit.ID, "dir/run.sh")
+		require.NoError(t, err)
+		require.Equal(t, pfs.FileType_FILE, fi.FileType)
+		require.Equal(t, uint32(0755), fi.Mode)
+		require.Equal(t, uint64(9), fi.SizeBytes)
+
+		fi, err = env.PachClient.InspectFile(repo, commit.ID, "dir/link")
+		require.NoError(t, err)
+		require.Equal(t, pfs.FileType_SYMLINK, fi.FileType)
+		require.Equal(t, "run.sh", fi.SymlinkTarget)
+
+		_, err = env.PachClient.InspectFile(repo, commit.ID, "dir/escape")
+		require.YesError(t, err)
+
+		// Pulling the commit recreates the symlink and mode locally
+		dir := tu.UniqueString("/tmp/pfs/symlink")
+		defer os.RemoveAll(dir)
+		puller := pfssync.NewPuller()
+		require.NoError(t, puller.Pull(env.PachClient, dir, repo, commit.ID, "/", false, false, 1, nil, ""))
+		target, err := os.Readlink(filepath.Join(dir, "dir", "link"))
+		require.NoError(t, err)
+		require.Equal(t, "run.sh", target)
+		data, err := ioutil.ReadFile(filepath.Join(dir, "dir", "link"))
+		require.NoError(t, err)
+		require.Equal(t, "echo foo\n", string(data))
+		info, err := os.Stat(filepath.Join(dir, "dir", "run.sh"))
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+		// Overwriting a symlink with a regular file replaces it
+		_, err = env.PachClient.PutFileOverwrite(repo, "master", "dir/link", strings.NewReader("bar\n"), 0)
+		require.NoError(t, err)
+		fi, err = env.PachClient.InspectFile(repo, "master", "dir/link")
+		require.NoError(t, err)
+		require.Equal(t, pfs.FileType_FILE, fi.FileType)
+		require.Equal(t, "", fi.SymlinkTarget)
+
+		// Appending to a file can set its mode too
+		_, err = env.PachClient.PutFileMode(repo, "master", "dir/run.sh", strings.NewReader("echo bar\n"), false, 0700)
+		require.NoError(t, err)
+		fi, err = env.PachClient.InspectFile(repo, "master", "dir/run.sh")
+		require.NoError(t, err)
+		require.Equal(t, uint32(0700), fi.Mode)
+		require.Equal(t, uint64(18), fi.SizeBytes)
+
+		// Pushing local files keeps their mode, whether or not they
+		// overwrite existing files
+		pushDir := tu.UniqueString("/tmp/pfs/push")
+		defer os.RemoveAll(pushDir)
+		require.NoError(t, os.MkdirAll(pushDir, 0700))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(pushDir, "run.sh"), []byte("echo baz\n"), 0750))
+		for _, overwrite := range []bool{false, true} {
+			commit, err := env.PachClient.StartCommit(repo, "master")
+			require.NoError(t, err)
+			require.NoError(t, pfssync.Push(env.PachClient, pushDir, commit, overwrite))
+			require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+			fi, err = env.PachClient.InspectFile(repo, commit.ID, "run.sh")
+			require.NoError(t, err)
+			require.Equal(t, uint32(0750), fi.Mode)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestWORMRepo(t *testing.T) {
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		repo := "worm"
+		_, err := c.PfsAPIClient.CreateRepo(c.Ctx(), &pfs.CreateRepoRequest{
+			Repo: pclient.NewRepo(repo),
+			Worm: &pfs.WORMPolicy{Retention: types.DurationProto(time.Hour)},
+		})
+		require.NoError(t, err)
+
+		_, err = c.PutFile(repo, "master", "file", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		first, err := c.InspectCommit(repo, "master")
+		require.NoError(t, err)
+
+		// Appending and adding new files is allowed
+		_, err = c.PutFile(repo, "master", "file", strings.NewReader("bar\n"))
+		require.NoError(t, err)
+		commit, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, commit.ID, "new", strings.NewReader("baz\n"))
+		require.NoError(t, err)
+
+		// Deleting or overwriting retained data is not
+		err = c.DeleteFile(repo, commit.ID, "file")
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsWORMRetentionErr(err))
+		_, err = c.PutFileOverwrite(repo, commit.ID, "file", strings.NewReader("qux\n"), 0)
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsWORMRetentionErr(err))
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+		err = c.DeleteFile(repo, "master", "file")
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsWORMRetentionErr(err))
+		require.YesError(t, c.CreateBranch(repo, "master", first.Commit.ID, nil))
+		require.YesError(t, c.DeleteCommit(repo, "master"))
+		require.YesError(t, c.DeleteRepo(repo, true))
+
+		// The retention can be extended, but not shortened
+		_, err = c.PfsAPIClient.CreateRepo(c.Ctx(), &pfs.CreateRepoRequest{
+			Repo:   pclient.NewRepo(repo),
+			Worm:   &pfs.WORMPolicy{Retention: types.DurationProto(time.Minute)},
+			Update: true,
+		})
+		require.YesError(t, err)
+		_, err = c.PfsAPIClient.CreateRepo(c.Ctx(), &pfs.CreateRepoRequest{
+			Repo:   pclient.NewRepo(repo),
+			Worm:   &pfs.WORMPolicy{Retention: types.DurationProto(2 * time.Hour)},
+			Update: true,
+		})
+		require.NoError(t, err)
+		require.NoError(t, c.UpdateRepo(repo))
+		ri, err := c.InspectRepo(repo)
+		require.NoError(t, err)
+		require.Equal(t, 2*time.Hour, time.Duration(ri.Worm.Retention.Seconds)*time.Second)
+
+		// Break glass bypasses the retention, and is audit-logged once per
+		// request, when the transaction that bypasses it commits
+		hook := logtest.NewGlobal()
+		auditEntries := func(op string) int {
+			var n int
+			for _, entry := range hook.AllEntries() {
+				if entry.Data["audit"] == "worm-break-glass" && entry.Data["operation"] == op {
+					n++
+				}
+			}
+			return n
+		}
+		txn, err := c.StartTransaction()
+		require.NoError(t, err)
+		tc := c.WithTransaction(txn)
+		_, err = tc.PfsAPIClient.DeleteCommit(tc.Ctx(), &pfs.DeleteCommitRequest{
+			Commit:     pclient.NewCommit(repo, "master"),
+			BreakGlass: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 0, auditEntries("delete commit "+repo+"@master"))
+		_, err = c.FinishTransaction(txn)
+		require.NoError(t, err)
+		require.Equal(t, 1, auditEntries("delete commit "+repo+"@master"))
+
+		// It also lets files be overwritten or deleted, both in open commits
+		// and by one-off writes, and branches be rewound
+		pfc, err := c.NewPutFileClientBreakGlass()
+		require.NoError(t, err)
+		_, err = pfc.PutFileOverwrite(repo, "master", "file", strings.NewReader("qux\n"), 0)
+		require.NoError(t, err)
+		require.NoError(t, pfc.Close())
+		require.Equal(t, 1, auditEntries("overwrite or delete files in "+repo+"@master"))
+		commit, err = c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = c.PfsAPIClient.DeleteFile(c.Ctx(), &pfs.DeleteFileRequest{
+			File:       pclient.NewFile(repo, commit.ID, "file"),
+			BreakGlass: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, auditEntries("overwrite or delete files in "+repo+"@"+commit.ID))
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+		_, err = c.PfsAPIClient.CreateBranch(c.Ctx(), &pfs.CreateBranchRequest{
+			Branch:     pclient.NewBranch(repo, "master"),
+			Head:       first.Commit,
+			BreakGlass: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, auditEntries("rewind branch "+repo+"@master"))
+		_, err = c.PfsAPIClient.DeleteRepo(c.Ctx(), &pfs.DeleteRepoRequest{
+			Repo:             pclient.NewRepo(repo),
+			Force:            true,
+			BreakGlass:       true,
+			SplitTransaction: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, auditEntries("delete repo "+repo))
+
+		// Data can be deleted once its retention has elapsed
+		repo = "short"
+		_, err = c.PfsAPIClient.CreateRepo(c.Ctx(), &pfs.CreateRepoRequest{
+			Repo: pclient.NewRepo(repo),
+			Worm: &pfs.WORMPolicy{Retention: types.DurationProto(time.Second)},
+		})
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, "master", "file", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		time.Sleep(2 * time.Second)
+		require.NoError(t, c.DeleteFile(repo, "master", "file"))
+		// Deleting the file created a new commit, which is retained in turn
+		require.YesError(t, c.DeleteRepo(repo, true))
+		time.Sleep(2 * time.Second)
+		require.NoError(t, c.DeleteRepo(repo, true))
+
+		// DeleteAll needs break glass while any WORM repo retains data
+		repo = "deleteall"
+		_, err = c.PfsAPIClient.CreateRepo(c.Ctx(), &pfs.CreateRepoRequest{
+			Repo: pclient.NewRepo(repo),
+			Worm: &pfs.WORMPolicy{Retention: types.DurationProto(time.Hour)},
+		})
+		require.NoError(t, err)
+		_, err = c.PutFile(repo, "master", "file", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		_, err = c.PfsAPIClient.DeleteAll(c.Ctx(), &pfs.DeleteAllRequest{})
+		require.YesError(t, err)
+		require.True(t, pfsserver.IsWORMRetentionErr(err))
+		_, err = c.PfsAPIClient.DeleteAll(c.Ctx(), &pfs.DeleteAllRequest{BreakGlass: true})
+		require.NoError(t, err)
+		require.Equal(t, 1, auditEntries("delete repo "+repo))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestRenameRepo(t *testing.T) {
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		require.NoError(t, c.CreateRepo("in"))
+		require.NoError(t, c.CreateRepo("out"))
+		require.NoError(t, c.CreateRepo("taken"))
+		require.NoError(t, c.CreateBranch("out", "master", "", []*pfs.Branch{pclient.NewBranch("in", "master")}))
+		_, err := c.PutFile("in", "master", "file", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		_, err = c.PutFile("in", "master", "file", strings.NewReader("bar\n"))
+		require.NoError(t, err)
+
+		// Invalid renames are rejected
+		require.YesError(t, c.RenameRepo("in", "taken"))
+		require.YesError(t, c.RenameRepo("missing", "new"))
+		require.YesError(t, c.RenameRepo("in", "bad name"))
+		commit, err := c.StartCommit("in", "master")
+		require.NoError(t, err)
+		require.YesError(t, c.RenameRepo("in", "renamed"))
+		require.NoError(t, c.FinishCommit("in", commit.ID))
+
+		require.NoError(t, c.RenameRepo("in", "renamed"))
+		_, err = c.InspectRepo("in")
+		require.YesError(t, err)
+		repoInfo, err := c.InspectRepo("renamed")
+		require.NoError(t, err)
+		require.Equal(t, "renamed", repoInfo.Repo.Name)
+
+		// The commits, their history and content moved with the repo
+		commitInfos, err := c.ListCommit("renamed", "master", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(commitInfos))
+		for _, ci := range commitInfos {
+			require.Equal(t, "renamed", ci.Commit.Repo.Name)
+			require.Equal(t, "renamed", ci.Branch.Repo.Name)
+		}
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile("renamed", "master", "file", 0, 0, &buf))
+		require.Equal(t, "foo\nbar\n", buf.String())
+
+		// Provenance was rewritten downstream
+		branchInfo, err := c.InspectBranch("out", "master")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(branchInfo.Provenance))
+		require.Equal(t, "renamed", branchInfo.Provenance[0].Repo.Name)
+		outInfo, err := c.InspectCommit("out", "master")
+		require.NoError(t, err)
+		for _, prov := range outInfo.Provenance {
+			require.Equal(t, "renamed", prov.Commit.Repo.Name)
+		}
+		commitInfo, err := c.InspectCommit("renamed", "master")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitInfo.Subvenance))
+		require.Equal(t, "out", commitInfo.Subvenance[0].Upper.Repo.Name)
+
+		// New commits propagate downstream as before
+		_, err = c.PutFile("renamed", "master", "file", strings.NewReader("baz\n"))
+		require.NoError(t, err)
+		commitInfos, err = c.ListCommit("out", "master", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(commitInfos))
+
+		// The old name is free to use again
+		require.NoError(t, c.CreateRepo("in"))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestRenameRepoUpdatesPipelines(t *testing.T) {
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		require.NoError(t, c.CreateRepo("in"))
+		_, err := c.PutFile("in", "master", "file", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+
+		// Fake a pipeline that reads from "in": its spec, and its output repo,
+		// which is provenant on its input and spec branches
+		input := pclient.NewPFSInput("in", "/*")
+		input.Pfs.Name = "in"
+		data, err := (&pps.PipelineInfo{
+			Pipeline:     pclient.NewPipeline("pipe"),
+			Version:      1,
+			Input:        input,
+			OutputBranch: "master",
+		}).Marshal()
+		require.NoError(t, err)
+		_, err = c.PutFile(ppsconsts.SpecRepo, "pipe", ppsconsts.SpecFile, bytes.NewReader(data))
+		require.NoError(t, err)
+		require.NoError(t, c.CreateRepo("pipe"))
+		require.NoError(t, c.CreateBranch("pipe", "master", "", []*pfs.Branch{
+			pclient.NewBranch("in", "master"),
+			pclient.NewBranch(ppsconsts.SpecRepo, "pipe"),
+		}))
+		require.NoError(t, c.FinishCommit("pipe", "master"))
+		specCommitInfo, err := c.InspectCommit(ppsconsts.SpecRepo, "pipe")
+		require.NoError(t, err)
+		specCommit := specCommitInfo.Commit
+
+		// Updating the pipeline fails if PPS rejects the new spec, so the rename
+		// is rolled back
+		require.YesError(t, c.RenameRepo("in", "renamed"))
+		_, err = c.InspectRepo("in")
+		require.NoError(t, err)
+		_, err = c.InspectRepo("renamed")
+		require.YesError(t, err)
+		branchInfo, err := c.InspectBranch("pipe", "master")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(branchInfo.Provenance))
+		for _, prov := range branchInfo.Provenance {
+			require.NotEqual(t, "renamed", prov.Repo.Name)
+		}
+		specCommitInfo, err = c.InspectCommit(ppsconsts.SpecRepo, "pipe")
+		require.NoError(t, err)
+		require.Equal(t, specCommit.ID, specCommitInfo.Commit.ID)
+
+		// Otherwise, PPS commits the pipeline's new spec along with the rename
+		env.MockPPSTransactionServer.UpdatePipelineSpecInTransaction.Use(func(txnCtx *txnenv.TransactionContext, pipeline string, prevSpecCommit *pfs.Commit, specTree *pfs.Object) error {
+			require.Equal(t, "pipe", pipeline)
+			require.Equal(t, specCommit.ID, prevSpecCommit.ID)
+			commit, err := txnCtx.Pfs().StartCommitInTransaction(txnCtx, &pfs.StartCommitRequest{
+				Parent: pclient.NewCommit(ppsconsts.SpecRepo, ""),
+				Branch: pipeline,
+			}, nil)
+			if err != nil {
+				return err
+			}
+			return txnCtx.Pfs().FinishCommitInTransaction(txnCtx, &pfs.FinishCommitRequest{Commit: commit, Tree: specTree})
+		})
+		require.NoError(t, c.RenameRepo("in", "renamed"))
+		specCommitInfo, err = c.InspectCommit(ppsconsts.SpecRepo, "pipe")
+		require.NoError(t, err)
+		require.NotEqual(t, specCommit.ID, specCommitInfo.Commit.ID)
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(ppsconsts.SpecRepo, "pipe", ppsconsts.SpecFile, 0, 0, &buf))
+		pipelineInfo := &pps.PipelineInfo{}
+		require.NoError(t, pipelineInfo.Unmarshal(buf.Bytes()))
+		require.Equal(t, "renamed", pipelineInfo.Input.Pfs.Repo)
+		require.Equal(t, "in", pipelineInfo.Input.Pfs.Name)
+		require.Equal(t, uint64(2), pipelineInfo.Version)
+		branchInfo, err = c.InspectBranch("pipe", "master")
+		require.NoError(t, err)
+		var provRepos []string
+		for _, prov := range branchInfo.Provenance {
+			provRepos = append(provRepos, prov.Repo.Name)
+		}
+		require.ElementsEqual(t, []string{"renamed", ppsconsts.SpecRepo}, provRepos)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestRenameBranch(t *testing.T) {
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		require.NoError(t, c.CreateRepo("in"))
+		require.NoError(t, c.CreateRepo("out"))
+		require.NoError(t, c.CreateBranch("out", "master", "", []*pfs.Branch{pclient.NewBranch("in", "master")}))
+		_, err := c.PutFile("in", "master", "file", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.CreateBranch("in", "other", "master", nil))
+
+		require.YesError(t, c.RenameBranch("in", "master", "other"))
+		require.YesError(t, c.RenameBranch("in", "missing", "new"))
+		require.NoError(t, c.RenameBranch("in", "master", "main"))
+
+		_, err = c.InspectBranch("in", "master")
+		require.YesError(t, err)
+		branchInfo, err := c.InspectBranch("in", "main")
+		require.NoError(t, err)
+		require.Equal(t, "main", branchInfo.Branch.Name)
+		require.Equal(t, 1, len(branchInfo.Subvenance))
+		repoInfo, err := c.InspectRepo("in")
+		require.NoError(t, err)
+		var names []string
+		for _, b := range repoInfo.Branches {
+			names = append(names, b.Name)
+		}
+		require.ElementsEqual(t, []string{"main", "other"}, names)
+
+		commitInfo, err := c.InspectCommit("in", "main")
+		require.NoError(t, err)
+		require.Equal(t, "main", commitInfo.Branch.Name)
+		outBranch, err := c.InspectBranch("out", "master")
+		require.NoError(t, err)
+		require.Equal(t, "main", outBranch.Provenance[0].Name)
+
+		// Commits to the renamed branch still propagate downstream
+		_, err = c.PutFile("in", "main", "file", strings.NewReader("bar\n"))
+		require.NoError(t, err)
+		outInfo, err := c.InspectCommit("out", "master")
+		require.NoError(t, err)
+		found := false
+		for _, prov := range outInfo.Provenance {
+			if prov.Commit.Repo.Name == "in" {
+				require.Equal(t, "main", prov.Branch.Name)
+				found = true
+			}
+		}
+		require.True(t, found)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestUpload(t *testing.T) {
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		repo := "upload"
+		require.NoError(t, c.CreateRepo(repo))
+		_, err := c.PutFile(repo, "master", "file", strings.NewReader("old\n"))
+		require.NoError(t, err)
+
+		parts := []string{"foo\n", "bar\n", "baz\n"}
+		uploadInfo, err := c.BeginUpload(repo, "master", "file", true)
+		require.NoError(t, err)
+		_, err = c.PutUploadPart(uploadInfo.ID, 2, strings.NewReader(parts[2]))
+		require.NoError(t, err)
+		_, err = c.PutUploadPart(uploadInfo.ID, 0, strings.NewReader("garbage"))
+		require.NoError(t, err)
+
+		// Parts must be contiguous
+		sum := sha256.Sum256([]byte(strings.Join(parts, "")))
+		require.YesError(t, c.CompleteUpload(uploadInfo.ID, hex.EncodeToString(sum[:])))
+
+		// Parts can be uploaded in parallel, and retried
+		var eg errgroup.Group
+		for i := range parts[:2] {
+			i := i
+			eg.Go(func() error {
+				_, err := c.PutUploadPart(uploadInfo.ID, int64(i), strings.NewReader(parts[i]))
+				return err
+			})
+		}
+		require.NoError(t, eg.Wait())
+		uploadInfo, err = c.InspectUpload(uploadInfo.ID)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(uploadInfo.Parts))
+		for i, part := range uploadInfo.Parts {
+			require.Equal(t, int64(i), part.Number)
+			require.Equal(t, int64(len(parts[i])), part.SizeBytes)
+		}
+
+		// The checksum is verified before the file is written
+		require.YesError(t, c.CompleteUpload(uploadInfo.ID, hex.EncodeToString(make([]byte, sha256.Size))))
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(repo, "master", "file", 0, 0, &buf))
+		require.Equal(t, "old\n", buf.String())
+
+		require.NoError(t, c.CompleteUpload(uploadInfo.ID, hex.EncodeToString(sum[:])))
+		buf.Reset()
+		require.NoError(t, c.GetFile(repo, "master", "file", 0, 0, &buf))
+		require.Equal(t, "foo\nbar\nbaz\n", buf.String())
+		commitInfos, err := c.ListCommit(repo, "master", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commitInfos))
+
+		// Completing the upload again (e.g. after losing the response) does
+		// nothing, and the session takes no more parts
+		require.NoError(t, c.CompleteUpload(uploadInfo.ID, hex.EncodeToString(sum[:])))
+		buf.Reset()
+		require.NoError(t, c.GetFile(repo, "master", "file", 0, 0, &buf))
+		require.Equal(t, "foo\nbar\nbaz\n", buf.String())
+		commitInfos, err = c.ListCommit(repo, "master", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commitInfos))
+		uploadInfo, err = c.InspectUpload(uploadInfo.ID)
+		require.NoError(t, err)
+		require.True(t, uploadInfo.Completed)
+		_, err = c.PutUploadPart(uploadInfo.ID, 3, strings.NewReader("qux\n"))
+		require.YesError(t, err)
+
+		// Parts whose data garbage collection removed aren't listed
+		uploadInfo, err = c.BeginUpload(repo, "master", "collected", true)
+		require.NoError(t, err)
+		part, err := c.PutUploadPart(uploadInfo.ID, 0, strings.NewReader("collected\n"))
+		require.NoError(t, err)
+		var objects []*pfs.Object
+		for _, record := range part.Records {
+			objects = append(objects, pclient.NewObject(record.ObjectHash))
+		}
+		_, err = c.ObjectAPIClient.DeleteObjects(c.Ctx(), &pfs.DeleteObjectsRequest{Objects: objects})
+		require.NoError(t, err)
+		uploadInfo, err = c.InspectUpload(uploadInfo.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(uploadInfo.Parts))
+
+		// Uploads into an open commit append to the file
+		commit, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		uploadInfo, err = c.BeginUpload(repo, commit.ID, "file", false)
+		require.NoError(t, err)
+		_, err = c.PutUploadPart(uploadInfo.ID, 0, strings.NewReader("qux\n"))
+		require.NoError(t, err)
+		sum = sha256.Sum256([]byte("qux\n"))
+		require.NoError(t, c.CompleteUpload(uploadInfo.ID, hex.EncodeToString(sum[:])))
+		require.NoError(t, c.FinishCommit(repo, commit.ID))
+		buf.Reset()
+		require.NoError(t, c.GetFile(repo, "master", "file", 0, 0, &buf))
+		require.Equal(t, "foo\nbar\nbaz\nqux\n", buf.String())
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestUploadExpires(t *testing.T) {
+	t.Parallel()
+	config := &serviceenv.PachdFullConfiguration{}
+	config.UploadTTL = "2s"
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		repo := "upload"
+		require.NoError(t, c.CreateRepo(repo))
+		abandoned, err := c.BeginUpload(repo, "master", "abandoned", true)
+		require.NoError(t, err)
+		active, err := c.BeginUpload(repo, "master", "active", true)
+		require.NoError(t, err)
+
+		// Receiving a part keeps a session alive
+		time.Sleep(1200 * time.Millisecond)
+		_, err = c.PutUploadPart(active.ID, 0, strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		time.Sleep(1200 * time.Millisecond)
+		_, err = c.InspectUpload(active.ID)
+		require.NoError(t, err)
+
+		// Beginning an upload deletes the expired sessions
+		_, err = c.BeginUpload(repo, "master", "other", true)
+		require.NoError(t, err)
+		_, err = c.InspectUpload(abandoned.ID)
+		require.YesError(t, err)
+		require.Matches(t, "not found", err.Error())
+		_, err = c.PutUploadPart(abandoned.ID, 0, strings.NewReader("bar\n"))
+		require.YesError(t, err)
+
+		sum := sha256.Sum256([]byte("foo\n"))
+		require.NoError(t, c.CompleteUpload(active.ID, hex.EncodeToString(sum[:])))
+
+		// Sessions that expire are rejected even before they're deleted
+		expiring, err := c.BeginUpload(repo, "master", "expiring", true)
+		require.NoError(t, err)
+		time.Sleep(2500 * time.Millisecond)
+		_, err = c.InspectUpload(expiring.ID)
+		require.YesError(t, err)
+		require.Matches(t, "expired", err.Error())
+		return nil
+	}, config)
+	require.NoError(t, err)
+}
+
+func TestListCommitFilter(t *testing.T) {
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+
+		listCommit := func(req *pfs.ListCommitRequest) []*pfs.CommitInfo {
+			req.Repo = pclient.NewRepo(repo)
+			var cis []*pfs.CommitInfo
+			require.NoError(t, env.PachClient.ListCommitRequestF(req, func(ci *pfs.CommitInfo) error {
+				cis = append(cis, ci)
+				return nil
+			}))
+			return cis
+		}
+
+		_, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.FinishCommit(repo, "master"))
+		mid := types.TimestampNow()
+		for _, desc := range []string{"backfill 1", "daily", "backfill 2"} {
+			_, err := env.PachClient.PfsAPIClient.StartCommit(env.PachClient.Ctx(), &pfs.StartCommitRequest{
+				Parent:      pclient.NewCommit(repo, ""),
+				Branch:      "master",
+				Description: desc,
+			})
+			require.NoError(t, err)
+			_, err = env.PachClient.PutFile(repo, "master", desc, strings.NewReader(desc))
+			require.NoError(t, err)
+			require.NoError(t, env.PachClient.FinishCommit(repo, "master"))
+		}
+		_, err = env.PachClient.StartCommit(repo, "dev")
+		require.NoError(t, err)
+
+		require.Equal(t, 5, len(listCommit(&pfs.ListCommitRequest{})))
+		require.Equal(t, 4, len(listCommit(&pfs.ListCommitRequest{StartedAfter: mid})))
+		require.Equal(t, 1, len(listCommit(&pfs.ListCommitRequest{StartedBefore: mid})))
+		// the open commit on 'dev' has not finished
+		require.Equal(t, 3, len(listCommit(&pfs.ListCommitRequest{FinishedAfter: mid})))
+		require.Equal(t, 1, len(listCommit(&pfs.ListCommitRequest{Branch: "dev"})))
+		require.Equal(t, 3, len(listCommit(&pfs.ListCommitRequest{MinSizeBytes: 1})))
+		require.Equal(t, 0, len(listCommit(&pfs.ListCommitRequest{
+			Origin: &pfs.CommitOrigin{Kind: pfs.OriginKind_AUTO},
+		})))
+		require.Equal(t, 5, len(listCommit(&pfs.ListCommitRequest{
+			Origin: &pfs.CommitOrigin{Kind: pfs.OriginKind_USER},
+		})))
+
+		cis := listCommit(&pfs.ListCommitRequest{Description: "^backfill"})
+		require.Equal(t, 2, len(cis))
+		require.Equal(t, "backfill 2", cis[0].Description)
+		require.Equal(t, "backfill 1", cis[1].Description)
+
+		// filtered out commits don't count towards 'number', whether or not
+		// 'to' is set
+		cis = listCommit(&pfs.ListCommitRequest{Description: "^backfill", Number: 1})
+		require.Equal(t, 1, len(cis))
+		require.Equal(t, "backfill 2", cis[0].Description)
+		cis = listCommit(&pfs.ListCommitRequest{
+			To:          pclient.NewCommit(repo, "master"),
+			Description: "1$",
+			Number:      1,
+		})
+		require.Equal(t, 1, len(cis))
+		require.Equal(t, "backfill 1", cis[0].Description)
+
+		_, err = env.PachClient.PfsAPIClient.ListCommit(env.PachClient.Ctx(), &pfs.ListCommitRequest{
+			Repo:        pclient.NewRepo(repo),
+			Description: "(",
+		})
+		require.YesError(t, err)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func TestOffsetRead(t *testing.T) {
 	t.Parallel()
 	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
@@ -6273,7 +6946,7 @@ func TestFuzzProvenance(t *testing.T) {
 		t.Log("Random seed is", seed)
 		r := rand.New(rand.NewSource(seed))
 
-		_, err := env.PachClient.PfsAPIClient.DeleteAll(env.PachClient.Ctx(), &types.Empty{})
+		_, err := env.PachClient.PfsAPIClient.DeleteAll(env.PachClient.Ctx(), &pfs.DeleteAllRequest{})
 		require.NoError(t, err)
 		nOps := 300
 		opShares := []int{
@@ -6986,7 +7659,7 @@ func TestLargeDeleteRepo(t *testing.T) {
 			require.NoError(t, env.PachClient.DeleteRepo(repos[i], false, true))
 			require.NoError(t, env.PachClient.FsckFastExit())
 		}
-		_, err = env.PachClient.PfsAPIClient.DeleteAll(env.PachClient.Ctx(), &types.Empty{})
+		_, err = env.PachClient.PfsAPIClient.DeleteAll(env.PachClient.Ctx(), &pfs.DeleteAllRequest{})
 		require.NoError(t, err)
 		return nil
 	}))
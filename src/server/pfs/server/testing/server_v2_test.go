@@ -1047,6 +1047,57 @@ func TestPutFileOverwriteV2(t *testing.T) {
 	}, newPachdConfig()))
 }
 
+func TestSymlinkV2(t *testing.T) {
+	require.NoError(t, testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		putSymlinks := func(repo, commit string, links ...string) error {
+			buf := &bytes.Buffer{}
+			if err := tarutil.WithWriter(buf, func(tw *tar.Writer) error {
+				for i := 0; i < len(links); i += 2 {
+					hdr := tarutil.NewHeader(links[i], 0)
+					hdr.Typeflag = tar.TypeSymlink
+					hdr.Linkname = links[i+1]
+					if err := tw.WriteHeader(hdr); err != nil {
+						return err
+					}
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			return env.PachClient.PutTarV2(repo, commit, buf, true)
+		}
+		repo := "test"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.PutSymlinkV2(repo, commit.ID, "dir/link", "file"))
+		require.YesError(t, env.PachClient.PutSymlinkV2(repo, commit.ID, "dir/escape", "../.."))
+		// Targets are resolved through the symlinks written earlier in the
+		// same write...
+		require.YesError(t, putSymlinks(repo, commit.ID, "other/root", "..", "other/escape", "root/.."))
+		// ...and through the symlinks already in the commit.
+		require.NoError(t, env.PachClient.PutSymlinkV2(repo, commit.ID, "dir/root", ".."))
+		require.YesError(t, env.PachClient.PutSymlinkV2(repo, commit.ID, "dir/escape", "root/.."))
+		require.NoError(t, env.PachClient.PutSymlinkV2(repo, commit.ID, "dir/chained", "root/dir/link"))
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+
+		// A write to the branch resolves targets through the symlinks in its
+		// head.
+		require.YesError(t, env.PachClient.PutSymlinkV2(repo, "master", "dir/escape", "root/.."))
+		require.NoError(t, env.PachClient.PutSymlinkV2(repo, "master", "dir/up", "root/dir"))
+
+		_, err = env.PachClient.InspectFile(repo, "master", "dir/escape")
+		require.YesError(t, err)
+		_, err = env.PachClient.InspectFile(repo, "master", "other/escape")
+		require.YesError(t, err)
+		fi, err := env.PachClient.InspectFile(repo, "master", "dir/chained")
+		require.NoError(t, err)
+		require.Equal(t, pfs.FileType_SYMLINK, fi.FileType)
+		require.Equal(t, "root/dir/link", fi.SymlinkTarget)
+		return nil
+	}, newPachdConfig()))
+}
+
 func TestTmpFileSet(t *testing.T) {
 	require.NoError(t, testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
 		pclient, err := env.PachClient.NewCreateTmpFileSetClient()
@@ -0,0 +1,355 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	authserver "github.com/pachyderm/pachyderm/src/server/auth/server"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	ppath "github.com/pachyderm/pachyderm/src/server/pkg/path"
+	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+func (d *driver) beginUpload(pachClient *client.APIClient, file *pfs.File, overwrite bool) (*pfs.UploadInfo, error) {
+	// Validate arguments
+	if file == nil || file.Commit == nil || file.Commit.Repo == nil {
+		return nil, errors.New("file, its commit and its repo must be set")
+	}
+	if err := checkFilePath(file.Path); err != nil {
+		return nil, err
+	}
+	if err := ppath.ValidatePath(file.Path); err != nil {
+		return nil, err
+	}
+	if err := authserver.CheckIsAuthorized(pachClient, file.Commit.Repo, auth.Scope_WRITER); err != nil {
+		return nil, err
+	}
+	if err := d.deleteExpiredUploads(pachClient.Ctx()); err != nil {
+		log.Errorf("could not delete expired uploads: %v", err)
+	}
+	now := types.TimestampNow()
+	uploadInfo := &pfs.UploadInfo{
+		ID:        uuid.NewWithoutDashes(),
+		File:      file,
+		Overwrite: overwrite,
+		Started:   now,
+		Updated:   now,
+	}
+	if err := d.txnEnv.WithWriteContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) error {
+		if err := d.repos.ReadWrite(txnCtx.Stm).Get(file.Commit.Repo.Name, &pfs.RepoInfo{}); err != nil {
+			if col.IsErrNotFound(err) {
+				return pfsserver.ErrRepoNotFound{Repo: file.Commit.Repo}
+			}
+			return err
+		}
+		return d.uploads.ReadWrite(txnCtx.Stm).Create(uploadInfo.ID, uploadInfo)
+	}); err != nil {
+		return nil, err
+	}
+	return uploadInfo, nil
+}
+
+// getUpload returns the upload session 'id' if the caller has 'scope' on its
+// repo.
+func (d *driver) getUpload(pachClient *client.APIClient, id string, scope auth.Scope) (*pfs.UploadInfo, error) {
+	uploadInfo := &pfs.UploadInfo{}
+	if err := d.uploads.ReadOnly(pachClient.Ctx()).Get(id, uploadInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, errors.Errorf("upload %q not found", id)
+		}
+		return nil, err
+	}
+	if err := authserver.CheckIsAuthorized(pachClient, uploadInfo.File.Commit.Repo, scope); err != nil {
+		return nil, err
+	}
+	if uploadExpired(uploadInfo, time.Now(), d.uploadTTL) {
+		if err := d.deleteExpiredUploads(pachClient.Ctx()); err != nil {
+			log.Errorf("could not delete expired uploads: %v", err)
+		}
+		return nil, errors.Errorf("upload %q has expired", id)
+	}
+	return uploadInfo, nil
+}
+
+// uploadExpired returns true if 'uploadInfo' hasn't been updated for more
+// than 'ttl' before 'now'. A ttl of 0 is ignored.
+func uploadExpired(uploadInfo *pfs.UploadInfo, now time.Time, ttl time.Duration) bool {
+	if ttl <= 0 {
+		return false
+	}
+	updated := uploadInfo.Updated
+	if updated == nil {
+		updated = uploadInfo.Started
+	}
+	t, err := types.TimestampFromProto(updated)
+	if err != nil {
+		return false
+	}
+	return now.Sub(t) > ttl
+}
+
+// deleteExpiredUploads deletes the upload sessions that have expired. The
+// objects holding their parts can't be deleted with them, as files with the
+// same content share those objects, but they're no longer referenced, so
+// garbage collection removes them.
+func (d *driver) deleteExpiredUploads(ctx context.Context) error {
+	if d.uploadTTL <= 0 {
+		return nil
+	}
+	var expired []string
+	uploadInfo := &pfs.UploadInfo{}
+	now := time.Now()
+	if err := d.uploads.ReadOnly(ctx).List(uploadInfo, col.DefaultOptions, func(id string) error {
+		if uploadExpired(uploadInfo, now, d.uploadTTL) {
+			expired = append(expired, id)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		uploads := d.uploads.ReadWrite(stm)
+		for _, id := range expired {
+			// Check again, in case the session received a part meanwhile
+			if err := uploads.Get(id, uploadInfo); err != nil {
+				if col.IsErrNotFound(err) {
+					continue
+				}
+				return err
+			}
+			if !uploadExpired(uploadInfo, now, d.uploadTTL) {
+				continue
+			}
+			if err := uploads.Delete(id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// inspectUpload returns the upload session 'id'. Garbage collection removes
+// the data of the parts of unfinished sessions, so the parts whose data is
+// gone are left out, and have to be uploaded again.
+func (d *driver) inspectUpload(pachClient *client.APIClient, id string) (*pfs.UploadInfo, error) {
+	uploadInfo, err := d.getUpload(pachClient, id, auth.Scope_READER)
+	if err != nil || uploadInfo.Completed {
+		return uploadInfo, err
+	}
+	parts := uploadInfo.Parts[:0]
+	for _, part := range uploadInfo.Parts {
+		collected, err := d.partCollected(pachClient, part)
+		if err != nil {
+			return nil, err
+		}
+		if !collected {
+			parts = append(parts, part)
+		}
+	}
+	uploadInfo.Parts = parts
+	return uploadInfo, nil
+}
+
+// partCollected returns true if garbage collection has removed any of the
+// objects holding the data of 'part'.
+func (d *driver) partCollected(pachClient *client.APIClient, part *pfs.UploadPart) (bool, error) {
+	for _, record := range part.Records {
+		resp, err := pachClient.ObjectAPIClient.CheckObject(pachClient.Ctx(), &pfs.CheckObjectRequest{
+			Object: client.NewObject(record.ObjectHash),
+		})
+		if err != nil {
+			return false, grpcutil.ScrubGRPC(err)
+		}
+		if !resp.Exists {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (d *driver) putUploadPart(pachClient *client.APIClient, server pfs.API_PutUploadPartServer) (*pfs.UploadPart, error) {
+	request, err := server.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if request.Number < 0 {
+		return nil, errors.Errorf("upload part number must be non-negative, got %d", request.Number)
+	}
+	if uploadInfo, err := d.getUpload(pachClient, request.ID, auth.Scope_WRITER); err != nil {
+		return nil, err
+	} else if uploadInfo.Completed {
+		return nil, errors.Errorf("upload %q has already completed", request.ID)
+	}
+	r := &putUploadPartReader{server: server}
+	r.buffer.Write(request.Value)
+	d.putObjectLimiter.Acquire()
+	defer d.putObjectLimiter.Release()
+	objects, size, err := pachClient.PutObjectSplit(r)
+	if err != nil {
+		return nil, err
+	}
+	part := &pfs.UploadPart{
+		Number:    request.Number,
+		SizeBytes: size,
+		Records:   objectRecords(objects, size),
+	}
+	if err := d.txnEnv.WithWriteContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) error {
+		uploadInfo := &pfs.UploadInfo{}
+		return d.uploads.ReadWrite(txnCtx.Stm).Update(request.ID, uploadInfo, func() error {
+			if uploadInfo.Completed {
+				return errors.Errorf("upload %q has already completed", request.ID)
+			}
+			uploadInfo.Updated = types.TimestampNow()
+			// A retried part replaces the one uploaded before it
+			i := sort.Search(len(uploadInfo.Parts), func(i int) bool {
+				return uploadInfo.Parts[i].Number >= part.Number
+			})
+			if i < len(uploadInfo.Parts) && uploadInfo.Parts[i].Number == part.Number {
+				uploadInfo.Parts[i] = part
+				return nil
+			}
+			uploadInfo.Parts = append(uploadInfo.Parts, nil)
+			copy(uploadInfo.Parts[i+1:], uploadInfo.Parts[i:])
+			uploadInfo.Parts[i] = part
+			return nil
+		})
+	}); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, errors.Errorf("upload %q not found", request.ID)
+		}
+		return nil, err
+	}
+	return part, nil
+}
+
+// errUploadCompleted is returned from the transaction that completes an
+// upload if another request completed it first.
+var errUploadCompleted = errors.New("upload has already completed")
+
+// completeUpload checks that the data received by upload 'id' hashes to
+// 'sum', and if so adds it to the upload's file. The session is marked as
+// completed in the same transaction, so completing it again (e.g. if a client
+// retries after losing the response) does nothing.
+func (d *driver) completeUpload(pachClient *client.APIClient, id string, sum string, breakGlass bool) error {
+	uploadInfo, err := d.getUpload(pachClient, id, auth.Scope_WRITER)
+	if err != nil {
+		return err
+	}
+	if uploadInfo.Completed {
+		return nil
+	}
+	records := &pfs.PutFileRecords{Tombstone: uploadInfo.Overwrite}
+	for i, part := range uploadInfo.Parts {
+		if part.Number != int64(i) {
+			return errors.Errorf("upload %q is missing part %d", id, i)
+		}
+		records.Records = append(records.Records, part.Records...)
+	}
+	hash := sha256.New()
+	for _, record := range records.Records {
+		if err := pachClient.GetObject(record.ObjectHash, hash); err != nil {
+			return err
+		}
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); actual != strings.ToLower(sum) {
+		return errors.Errorf("upload %q has SHA-256 %s, expected %s", id, actual, sum)
+	}
+	file := proto.Clone(uploadInfo.File).(*pfs.File)
+	if err := d.addFileRecords(pachClient, file, records, breakGlass, func(stm col.STM) error {
+		uploadInfo := &pfs.UploadInfo{}
+		return d.uploads.ReadWrite(stm).Update(id, uploadInfo, func() error {
+			if uploadInfo.Completed {
+				return errUploadCompleted
+			}
+			uploadInfo.Completed = true
+			uploadInfo.Updated = types.TimestampNow()
+			return nil
+		})
+	}); err != nil {
+		if errors.Is(err, errUploadCompleted) {
+			return nil
+		}
+		if col.IsErrNotFound(err) {
+			return errors.Errorf("upload %q not found", id)
+		}
+		return err
+	}
+	return nil
+}
+
+// addFileRecords adds 'records' to 'file', creating a new commit if
+// file.Commit is a branch with no open head (like a one-off 'put file'), and
+// calls 'cb' in the same transaction. 'breakGlass' is as in
+// upsertPutFileRecords.
+func (d *driver) addFileRecords(pachClient *client.APIClient, file *pfs.File, records *pfs.PutFileRecords, breakGlass bool, cb func(col.STM) error) error {
+	var branch string
+	if !uuid.IsUUIDWithoutDashes(file.Commit.ID) {
+		branch = file.Commit.ID
+	}
+	commitInfo, err := d.inspectCommit(pachClient, file.Commit, pfs.CommitState_STARTED)
+	if err != nil {
+		if (!isNotFoundErr(err) && !isNoHeadErr(err)) || branch == "" {
+			return err
+		}
+	} else if commitInfo.Finished == nil {
+		return d.upsertPutFileRecords(pachClient, file, records, breakGlass, cb)
+	} else if branch == "" {
+		return pfsserver.ErrCommitFinished{Commit: file.Commit}
+	}
+	return d.txnEnv.WithWriteContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) error {
+		if err := d.checkWORMWrite(txnCtx.Client, txnCtx.Stm, txnCtx.OnCommit, client.NewCommit(file.Commit.Repo.Name, branch),
+			[]string{file.Path}, []*pfs.PutFileRecords{records}, breakGlass); err != nil {
+			return err
+		}
+		if _, err := d.makeCommit(txnCtx, "", client.NewCommit(file.Commit.Repo.Name, ""), branch, nil, nil, nil, nil, nil,
+			[]string{file.Path}, []*pfs.PutFileRecords{records}, "", time.Time{}, time.Time{}, 0); err != nil {
+			return err
+		}
+		return cb(txnCtx.Stm)
+	})
+}
+
+type putUploadPartReader struct {
+	server pfs.API_PutUploadPartServer
+	buffer bytes.Buffer
+}
+
+func (r *putUploadPartReader) Read(p []byte) (int, error) {
+	if r.buffer.Len() == 0 {
+		request, err := r.server.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buffer.Reset()
+		// buffer.Write cannot error
+		r.buffer.Write(request.Value)
+	}
+	return r.buffer.Read(p)
+}
+
+func drainUploadPartServer(server pfs.API_PutUploadPartServer) {
+	for {
+		if _, err := server.Recv(); err != nil {
+			break
+		}
+	}
+}
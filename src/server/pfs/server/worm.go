@@ -0,0 +1,372 @@
+package server
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
+)
+
+// validateWORMPolicy checks that 'policy' is a valid WORM policy and that it
+// doesn't weaken 'existing' (the repo's current policy, which may be nil).
+func validateWORMPolicy(repo *pfs.Repo, existing, policy *pfs.WORMPolicy) error {
+	if policy.Retention == nil {
+		return errors.Errorf("WORM policy for repo %q must set a retention", repo.Name)
+	}
+	retention, err := types.DurationFromProto(policy.Retention)
+	if err != nil {
+		return err
+	}
+	if retention <= 0 {
+		return errors.Errorf("WORM retention for repo %q must be positive", repo.Name)
+	}
+	if existing != nil && policy.Retention.Compare(existing.Retention) < 0 {
+		return errors.Errorf("cannot shorten the WORM retention of repo %q", repo.Name)
+	}
+	return nil
+}
+
+// checkWORMCommit returns an ErrWORMRetention if 'op' would destroy data in
+// the commit described by 'commitInfo' before its retention has elapsed.
+func checkWORMCommit(policy *pfs.WORMPolicy, commitInfo *pfs.CommitInfo, op string) error {
+	until, err := pfsserver.WORMRetainedUntil(policy, commitInfo)
+	if err != nil {
+		return err
+	}
+	if !until.IsZero() {
+		return pfsserver.ErrWORMRetention{Repo: commitInfo.Commit.Repo, Op: op, Until: until}
+	}
+	return nil
+}
+
+// wormPolicy returns the WORM policy of 'repo', or nil if 'repo' isn't a WORM
+// repo (or doesn't exist).
+func (d *driver) wormPolicy(stm col.STM, repo *pfs.Repo) (*pfs.WORMPolicy, error) {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(stm).Get(repo.Name, repoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return repoInfo.Worm, nil
+}
+
+// breakGlass lets 'op' proceed despite 'err' if 'err' is a WORM retention
+// error and the caller of 'pachClient', who must be a cluster admin,
+// explicitly asked to break glass. The break glass is audit-logged by a
+// function passed to 'onCommit', which must run it once the write that
+// bypasses the retention commits, so that retried and dry-run transactions
+// don't log it.
+func breakGlass(pachClient *client.APIClient, onCommit func(func()), err error, enabled bool, op string) error {
+	if err == nil || !enabled || !pfsserver.IsWORMRetentionErr(err) {
+		return err
+	}
+	username := "unknown"
+	whoAmI, werr := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{})
+	if werr != nil && !auth.IsErrNotActivated(werr) {
+		return grpcutil.ScrubGRPC(werr)
+	}
+	if werr == nil {
+		if !whoAmI.IsAdmin {
+			return &auth.ErrNotAuthorized{
+				Subject: whoAmI.Username,
+				AdminOp: op + " with break glass",
+			}
+		}
+		username = whoAmI.Username
+	}
+	retentionErr := err
+	onCommit(func() {
+		log.WithFields(log.Fields{
+			"audit":     "worm-break-glass",
+			"user":      username,
+			"operation": op,
+		}).Warnf("WORM retention bypassed with break glass: %v", retentionErr)
+	})
+	return nil
+}
+
+// checkWORMDeleteRepo returns an error if deleting 'repo' would destroy data
+// that is still retained by the repo's WORM policy.
+func (d *driver) checkWORMDeleteRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, breakGlassEnabled bool) error {
+	policy, err := d.wormPolicy(txnCtx.Stm, repo)
+	if err != nil || policy == nil {
+		return err
+	}
+	// Collections can't be listed through an STM, so list the commits outside
+	// of it, and then read each commit (and the branches, which new commits
+	// update) through it, so that the delete conflicts with any concurrent
+	// writes that would change the outcome of the check
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(txnCtx.Stm).Get(repo.Name, repoInfo); err != nil {
+		return err
+	}
+	branches := d.branches(repo.Name).ReadWrite(txnCtx.Stm)
+	for _, branch := range repoInfo.Branches {
+		if err := branches.Get(branch.Name, &pfs.BranchInfo{}); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+	}
+	var latest time.Time
+	commits := d.commits(repo.Name).ReadWrite(txnCtx.Stm)
+	if err := d.commits(repo.Name).ReadOnly(txnCtx.ClientContext).List(&pfs.CommitInfo{}, col.DefaultOptions, func(id string) error {
+		commitInfo := &pfs.CommitInfo{}
+		if err := commits.Get(id, commitInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		until, err := pfsserver.WORMRetainedUntil(policy, commitInfo)
+		if err != nil {
+			return err
+		}
+		if until.After(latest) {
+			latest = until
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if !latest.IsZero() {
+		err = pfsserver.ErrWORMRetention{Repo: repo, Op: "delete repo", Until: latest}
+	}
+	return breakGlass(txnCtx.Client, txnCtx.OnCommit, err, breakGlassEnabled, "delete repo "+repo.Name)
+}
+
+// checkWORMDeleteAll returns an error if deleting all repos would destroy
+// data that is still retained by a WORM policy.
+func (d *driver) checkWORMDeleteAll(txnCtx *txnenv.TransactionContext, breakGlassEnabled bool) error {
+	repoInfos, err := d.listRepo(txnCtx.Client, !includeAuth)
+	if err != nil {
+		return err
+	}
+	for _, repoInfo := range repoInfos.RepoInfo {
+		if repoInfo.Worm == nil {
+			continue
+		}
+		if err := d.checkWORMDeleteRepo(txnCtx, repoInfo.Repo, breakGlassEnabled); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkWORMDeleteCommit returns an error if deleting 'commit' (and the
+// downstream commits that are deleted along with it) would destroy data that
+// is still retained by a WORM policy.
+func (d *driver) checkWORMDeleteCommit(txnCtx *txnenv.TransactionContext, commit *pfs.Commit, breakGlassEnabled bool) error {
+	commitInfo, err := d.resolveCommit(txnCtx.Stm, proto.Clone(commit).(*pfs.Commit))
+	if err != nil {
+		// Let deleteCommit report invalid commits
+		return nil
+	}
+	check := func(ci *pfs.CommitInfo) error {
+		policy, err := d.wormPolicy(txnCtx.Stm, ci.Commit.Repo)
+		if err != nil {
+			return err
+		}
+		return checkWORMCommit(policy, ci, "delete commit "+ci.Commit.ID)
+	}
+	err = check(commitInfo)
+	for _, subv := range commitInfo.Subvenance {
+		if err != nil {
+			break
+		}
+		policy, perr := d.wormPolicy(txnCtx.Stm, subv.Upper.Repo)
+		if perr != nil {
+			return perr
+		}
+		if policy == nil {
+			continue
+		}
+		commits := d.commits(subv.Upper.Repo.Name).ReadWrite(txnCtx.Stm)
+		for c := subv.Upper; c != nil && err == nil; {
+			ci := &pfs.CommitInfo{}
+			if gerr := commits.Get(c.ID, ci); gerr != nil {
+				return gerr
+			}
+			err = check(ci)
+			if c.ID == subv.Lower.ID {
+				break
+			}
+			c = ci.ParentCommit
+		}
+	}
+	return breakGlass(txnCtx.Client, txnCtx.OnCommit, err, breakGlassEnabled, "delete commit "+commit.Repo.Name+"@"+commit.ID)
+}
+
+// checkWORMBranchHead returns an error if pointing 'branch' at 'head' would
+// rewind it past a commit that is still retained by the repo's WORM policy.
+// Moving a branch forward (to a descendant of its current head) is always
+// allowed.
+func (d *driver) checkWORMBranchHead(txnCtx *txnenv.TransactionContext, branch *pfs.Branch, head *pfs.Commit, breakGlassEnabled bool) error {
+	policy, err := d.wormPolicy(txnCtx.Stm, branch.Repo)
+	if err != nil || policy == nil {
+		return err
+	}
+	branchInfo := &pfs.BranchInfo{}
+	if err := d.branches(branch.Repo.Name).ReadWrite(txnCtx.Stm).Get(branch.Name, branchInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if branchInfo.Head == nil {
+		return nil
+	}
+	commits := d.commits(branch.Repo.Name).ReadWrite(txnCtx.Stm)
+	if head != nil && head.Repo.Name == branch.Repo.Name {
+		headInfo, err := d.resolveCommit(txnCtx.Stm, proto.Clone(head).(*pfs.Commit))
+		if err != nil {
+			return err
+		}
+		for c := headInfo.Commit; c != nil; {
+			if c.ID == branchInfo.Head.ID {
+				return nil
+			}
+			ci := &pfs.CommitInfo{}
+			if err := commits.Get(c.ID, ci); err != nil {
+				return err
+			}
+			c = ci.ParentCommit
+		}
+	}
+	oldHead := &pfs.CommitInfo{}
+	if err := commits.Get(branchInfo.Head.ID, oldHead); err != nil {
+		return err
+	}
+	err = checkWORMCommit(policy, oldHead, "rewind branch "+branch.Name)
+	return breakGlass(txnCtx.Client, txnCtx.OnCommit, err, breakGlassEnabled, "rewind branch "+branch.Repo.Name+"@"+branch.Name)
+}
+
+// wormFileCheck returns a function that reports an error if the file at a
+// given path may not be deleted or overwritten in 'commit', because the
+// repo's WORM policy still retains it. The data being replaced is the data in
+// 'commit' itself if it's finished (one-off writes to a branch), or the data
+// in its parent otherwise. The policy and 'commit' are read through 'stm',
+// which must be the STM that applies the write, so that the write conflicts
+// with any concurrent change to them. wormFileCheck returns a nil function if
+// nothing in 'commit' needs to be checked.
+func (d *driver) wormFileCheck(
+	pachClient *client.APIClient,
+	stm col.STM,
+	commit *pfs.Commit,
+	inspectFile func(*client.APIClient, *pfs.File) (*pfs.FileInfo, error),
+) (func(string) error, error) {
+	policy, err := d.wormPolicy(stm, commit.Repo)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+	commitInfo, err := d.resolveCommit(stm, proto.Clone(commit).(*pfs.Commit))
+	if err != nil {
+		if col.IsErrNotFound(err) || isNotFoundErr(err) || isNoHeadErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if commitInfo.Finished == nil {
+		if commitInfo.ParentCommit == nil {
+			return nil, nil
+		}
+		parent := commitInfo.ParentCommit
+		commitInfo = &pfs.CommitInfo{}
+		if err := d.commits(parent.Repo.Name).ReadWrite(stm).Get(parent.ID, commitInfo); err != nil {
+			return nil, err
+		}
+	}
+	until, err := pfsserver.WORMRetainedUntil(policy, commitInfo)
+	if err != nil || until.IsZero() {
+		return nil, err
+	}
+	// The data being replaced is in a finished commit, which can't change, so
+	// it can be read outside of 'stm'
+	base := commitInfo.Commit
+	return func(p string) error {
+		if _, err := inspectFile(pachClient, client.NewFile(base.Repo.Name, base.ID, p)); err != nil {
+			if isNotFoundErr(err) {
+				return nil
+			}
+			return err
+		}
+		return pfsserver.ErrWORMRetention{Repo: base.Repo, Op: "overwrite or delete " + p, Until: until}
+	}, nil
+}
+
+// breakGlassCheck wraps 'check' (as returned by wormFileCheck) so that the
+// caller may break glass for 'op'. The first retained file that is bypassed
+// is audit-logged through 'onCommit', which covers the rest of the write.
+func breakGlassCheck(pachClient *client.APIClient, onCommit func(func()), check func(string) error, enabled bool, op string) func(string) error {
+	var bypassed bool
+	return func(p string) error {
+		err := check(p)
+		if bypassed && pfsserver.IsWORMRetentionErr(err) {
+			return nil
+		}
+		if err := breakGlass(pachClient, onCommit, err, enabled, op); err != nil {
+			return err
+		}
+		bypassed = err != nil
+		return nil
+	}
+}
+
+// overwrites returns true if applying 'records' deletes or replaces data that
+// is already in the file.
+func overwrites(records *pfs.PutFileRecords) bool {
+	if records.Tombstone {
+		return true
+	}
+	for _, record := range records.Records {
+		if record.OverwriteIndex != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// checkWORMWrite returns an error if writing 'records' to 'paths' in 'commit'
+// would delete or overwrite data that is still retained by the repo's WORM
+// policy, unless the caller breaks glass. It must be called in 'stm', the STM
+// that applies the write (see wormFileCheck).
+func (d *driver) checkWORMWrite(
+	pachClient *client.APIClient,
+	stm col.STM,
+	onCommit func(func()),
+	commit *pfs.Commit,
+	paths []string,
+	records []*pfs.PutFileRecords,
+	breakGlassEnabled bool,
+) error {
+	var overwritten []string
+	for i, r := range records {
+		if overwrites(r) {
+			overwritten = append(overwritten, paths[i])
+		}
+	}
+	if len(overwritten) == 0 {
+		return nil
+	}
+	check, err := d.wormFileCheck(pachClient, stm, commit, d.inspectFile)
+	if err != nil || check == nil {
+		return err
+	}
+	check = breakGlassCheck(pachClient, onCommit, check, breakGlassEnabled, "overwrite or delete files in "+commit.Repo.Name+"@"+commit.ID)
+	for _, p := range overwritten {
+		if err := check(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
@@ -292,6 +293,20 @@ func ParseHistory(history string) (int64, error) {
 	return int64(result), err
 }
 
+// ParseTime parses a time flag argument, which may either be an RFC 3339
+// timestamp or a duration (e.g. "1h30m"), which is interpreted as that long
+// before now.
+func ParseTime(s string) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, errors.Errorf("could not parse %q as a duration or an RFC 3339 timestamp", s)
+	}
+	return t, nil
+}
+
 // RepeatedStringArg is an alias for []string
 type RepeatedStringArg []string
 
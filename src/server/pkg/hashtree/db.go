@@ -792,6 +792,10 @@ func (h *dbHashTree) putFile(path string, objects []*pfs.Object, brs []*pfs.Bloc
 			return errorf(PathConflict, "could not put file at %q; a file of "+
 				"type %s is already there", path, node.nodetype())
 		}
+		if node != nil && node.FileNode.SymlinkTarget != "" && (len(objects) > 0 || len(brs) > 0) {
+			return errorf(PathConflict, "could not put file at %q; a symlink "+
+				"is already there", path)
+		}
 
 		// validation: 'hasHeaderFooter' can be set only if parent dir has 'Shared'
 		// field for header and footer data (indicating other children of this dir
@@ -869,6 +873,66 @@ func (h *dbHashTree) putFile(path string, objects []*pfs.Object, brs []*pfs.Bloc
 	return errors.EnsureStack(err)
 }
 
+// PutSymlink creates a symlink at 'path' pointing to 'target', replacing any
+// regular file or symlink that's already there.
+func (h *dbHashTree) PutSymlink(path string, target string) error {
+	path = ppath.Clean(path)
+	err := h.Batch(func(tx *bolt.Tx) error {
+		node, err := get(tx, path)
+		if err != nil && Code(err) != PathNotFound {
+			return errorf(Internal, "could not get node at %q: %v", path, err)
+		}
+		if node != nil && node.nodetype() != file {
+			return errorf(PathConflict, "could not put symlink at %q; a file of "+
+				"type %s is already there", path, node.nodetype())
+		}
+		var sizeDelta int64
+		if node != nil {
+			sizeDelta = -node.SubtreeSize
+		}
+		if err := put(tx, path, &NodeProto{
+			Name: ppath.Base(path),
+			FileNode: &FileNodeProto{
+				SymlinkTarget: target,
+			},
+		}); err != nil {
+			return err
+		}
+		return visit(tx, path, func(node *NodeProto, parent, child string) error {
+			if node.DirNode == nil {
+				// node created as part of this visit call, fill in the basics
+				node.Name = ppath.Base(parent)
+				node.DirNode = &DirectoryNodeProto{}
+			}
+			node.SubtreeSize += sizeDelta
+			return nil
+		})
+	})
+	return errors.EnsureStack(err)
+}
+
+// SetFileMode sets the POSIX permission bits of the file at 'path'.
+func (h *dbHashTree) SetFileMode(path string, mode uint32) error {
+	path = ppath.Clean(path)
+	err := h.Batch(func(tx *bolt.Tx) error {
+		node, err := get(tx, path)
+		if err != nil {
+			return err
+		}
+		if node.nodetype() != file {
+			return errorf(PathConflict, "could not set the mode of %q; it's a "+
+				"%s, not a file", path, node.nodetype())
+		}
+		node.FileNode.Mode = mode
+		if err := put(tx, path, node); err != nil {
+			return err
+		}
+		// Mark the parents as changed, so that their hashes are recomputed
+		return visit(tx, path, func(*NodeProto, string, string) error { return nil })
+	})
+	return errors.EnsureStack(err)
+}
+
 // PutDir creates a directory (or does nothing if one exists).
 func (h *dbHashTree) PutDir(path string) error {
 	path = ppath.Clean(path)
@@ -1388,7 +1452,20 @@ func HashFileNode(n *FileNodeProto) []byte {
 	for _, object := range n.Objects {
 		hash.Write([]byte(object.Hash))
 	}
-	return hash.Sum(nil)
+	return hashFileMetadata(hash.Sum(nil), n)
+}
+
+// hashFileMetadata folds the mode and symlink target of 'n' (if set) into
+// 'hash', the hash of its content, so that changing either one changes the
+// hash of the file. Files without metadata keep their content hash.
+func hashFileMetadata(hash []byte, n *FileNodeProto) []byte {
+	if n == nil || (n.Mode == 0 && n.SymlinkTarget == "") {
+		return hash
+	}
+	h := sha256.New()
+	h.Write(hash)
+	h.Write([]byte(fmt.Sprintf(":%o:%s", n.Mode, n.SymlinkTarget)))
+	return h.Sum(nil)
 }
 
 func canonicalize(tx *bolt.Tx, path string) error {
@@ -1699,7 +1776,7 @@ func (o *Ordered) PutFile(path string, hash []byte, size int64, fileNodeProto *F
 	path = ppath.Clean(path)
 	nodeProto := &NodeProto{
 		Name:        ppath.Base(path),
-		Hash:        hash,
+		Hash:        hashFileMetadata(hash, fileNodeProto),
 		SubtreeSize: size,
 		FileNode:    fileNodeProto,
 	}
@@ -40,7 +40,14 @@ type FileNodeProto struct {
 	// block_refs/objects. Without this signal, all calls to pfs.GetFile() would
 	// need to check the parent directory's metadata before beginning to return
 	// the file's contents, which would be slow.)
-	HasHeaderFooter      bool     `protobuf:"varint,6,opt,name=has_header_footer,json=hasHeaderFooter,proto3" json:"has_header_footer,omitempty"`
+	HasHeaderFooter bool `protobuf:"varint,6,opt,name=has_header_footer,json=hasHeaderFooter,proto3" json:"has_header_footer,omitempty"`
+	// mode holds the POSIX permission bits of the file, or 0 if they were never
+	// set.
+	Mode uint32 `protobuf:"varint,7,opt,name=mode,proto3" json:"mode,omitempty"`
+	// symlink_target, if set, indicates that this node is a symbolic link to
+	// the given path (relative to the link's parent directory), rather than a
+	// regular file. Symlinks have no objects or block_refs.
+	SymlinkTarget        string   `protobuf:"bytes,8,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -100,6 +107,20 @@ func (m *FileNodeProto) GetHasHeaderFooter() bool {
 	return false
 }
 
+func (m *FileNodeProto) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *FileNodeProto) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
 // Shared refers to data common to all direct children of a directory (i.e.
 // headers and footers)
 type Shared struct {
@@ -513,43 +534,46 @@ func init() {
 }
 
 var fileDescriptor_4bd44075bd9a7a70 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xd5, 0xd8, 0x4e, 0xe2, 0xdc, 0xa4, 0x22, 0x0c, 0x08, 0xac, 0x0a, 0xb5, 0xc1, 0x08, 0x14,
-	0x10, 0x24, 0x52, 0x41, 0x80, 0x58, 0x56, 0xa5, 0x2a, 0x59, 0x00, 0x9a, 0xb2, 0x62, 0x13, 0xf9,
-	0x71, 0x5d, 0x1b, 0xbb, 0x9e, 0x68, 0xc6, 0xa9, 0x48, 0xbf, 0x83, 0x2f, 0x60, 0xc1, 0x9f, 0x20,
-	0xb1, 0xe4, 0x13, 0x50, 0xbf, 0x04, 0xcd, 0xa3, 0x75, 0x0b, 0x5d, 0x44, 0xba, 0xe7, 0xdc, 0x73,
-	0x8f, 0xef, 0x99, 0xcc, 0x40, 0x28, 0x51, 0x9c, 0xa0, 0x98, 0x2d, 0xcb, 0xa3, 0x59, 0x1e, 0xc9,
-	0xbc, 0x11, 0x88, 0x17, 0xc5, 0x74, 0x29, 0x78, 0xc3, 0xa9, 0x7f, 0x8e, 0x37, 0x6f, 0x27, 0x55,
-	0x81, 0x75, 0x33, 0x5b, 0x66, 0x52, 0xfd, 0x4c, 0x3f, 0xfc, 0x4e, 0x60, 0x63, 0xbf, 0xa8, 0xf0,
-	0x3d, 0x4f, 0xf1, 0xa3, 0x9e, 0x78, 0x08, 0x3d, 0x1e, 0x7f, 0xc1, 0xa4, 0x91, 0x81, 0x37, 0x76,
-	0x27, 0x83, 0x9d, 0xc1, 0x54, 0xc9, 0x3f, 0x68, 0x8e, 0x9d, 0xf7, 0xe8, 0x53, 0x80, 0xb8, 0xe2,
-	0x49, 0xb9, 0x10, 0x98, 0xc9, 0xa0, 0xa3, 0x95, 0x1b, 0x5a, 0xb9, 0xab, 0x68, 0x86, 0x19, 0xeb,
-	0xc7, 0xb6, 0x92, 0xf4, 0x09, 0xdc, 0xcc, 0x23, 0xb9, 0xc8, 0x31, 0x4a, 0x51, 0x2c, 0x32, 0xce,
-	0x1b, 0x14, 0x41, 0x77, 0x4c, 0x26, 0x3e, 0xbb, 0x91, 0x47, 0xf2, 0x40, 0xf3, 0xfb, 0x9a, 0x9e,
-	0x7b, 0x3e, 0x19, 0x39, 0x73, 0xcf, 0x77, 0x46, 0xee, 0xdc, 0xf3, 0xdd, 0x91, 0x17, 0x7e, 0x23,
-	0xd0, 0x3d, 0xcc, 0x23, 0x81, 0x29, 0x7d, 0x00, 0x5d, 0x63, 0x12, 0x90, 0x31, 0xf9, 0x77, 0x39,
-	0xdb, 0x52, 0x22, 0xfb, 0x09, 0xe7, 0x1a, 0x91, 0x69, 0xd1, 0x6d, 0x18, 0xd8, 0x75, 0x64, 0x71,
-	0x8a, 0x81, 0x3b, 0x26, 0x13, 0x97, 0x81, 0xa1, 0x0e, 0x8b, 0x53, 0x54, 0x02, 0x23, 0x35, 0x02,
-	0xcf, 0x08, 0x0c, 0xa5, 0x04, 0x61, 0x06, 0x74, 0xaf, 0x10, 0x98, 0x34, 0x5c, 0xac, 0xdb, 0xf3,
-	0xdb, 0x04, 0x3f, 0xc9, 0x8b, 0x2a, 0x15, 0x58, 0x07, 0xee, 0xd8, 0x9d, 0xf4, 0xd9, 0x05, 0xa6,
-	0x13, 0xe8, 0x4a, 0x9d, 0x43, 0xbb, 0x0d, 0x76, 0x46, 0xd3, 0x8b, 0xbf, 0xcb, 0xe4, 0x63, 0xb6,
-	0x7f, 0xf9, 0x10, 0xc2, 0x9f, 0x04, 0xfa, 0xad, 0x3f, 0x05, 0xaf, 0x8e, 0x8e, 0x51, 0xe7, 0xef,
-	0x33, 0x5d, 0x2b, 0x4e, 0x19, 0xe9, 0xb8, 0x43, 0xa6, 0x6b, 0x7a, 0x1f, 0x86, 0x72, 0x15, 0x2b,
-	0xef, 0xcb, 0x01, 0x07, 0x96, 0xd3, 0x09, 0x5f, 0x40, 0x3f, 0x2b, 0x2a, 0x5c, 0xd4, 0x3c, 0x45,
-	0xbb, 0xd1, 0xdd, 0x76, 0xa3, 0x2b, 0xd7, 0x82, 0xf9, 0x99, 0x85, 0xf4, 0x15, 0xf8, 0x69, 0x21,
-	0xcc, 0x50, 0x47, 0x0f, 0xdd, 0x6b, 0x87, 0xfe, 0x3f, 0x10, 0xd6, 0x4b, 0x0b, 0xa1, 0x50, 0xf8,
-	0x83, 0xc0, 0xc6, 0x41, 0x24, 0xf3, 0x4f, 0x02, 0x6d, 0x96, 0x00, 0x7a, 0x27, 0x28, 0x64, 0xc1,
-	0x6b, 0x1d, 0xa7, 0xc3, 0xce, 0x21, 0x9d, 0x81, 0x93, 0xc9, 0xc0, 0xd1, 0xd7, 0x6a, 0xbb, 0xb5,
-	0xbf, 0x32, 0x3e, 0xdd, 0x97, 0x6f, 0xeb, 0x46, 0xac, 0x99, 0x93, 0xc9, 0xcd, 0x39, 0xf4, 0x2c,
-	0xa4, 0x23, 0x70, 0x4b, 0x5c, 0xdb, 0x03, 0x52, 0x25, 0x7d, 0x0c, 0x9d, 0x93, 0xa8, 0x5a, 0xa1,
-	0xbd, 0x0f, 0xb7, 0x5a, 0xc3, 0x76, 0x4d, 0xa3, 0x78, 0xe3, 0xbc, 0x26, 0xe1, 0x23, 0x18, 0xee,
-	0xae, 0x92, 0x12, 0x1b, 0x73, 0x2f, 0xe9, 0x1d, 0xe8, 0xc6, 0x1a, 0x5b, 0x4f, 0x8b, 0xc2, 0x67,
-	0xd0, 0x79, 0x57, 0xa7, 0xf8, 0x95, 0x0e, 0x81, 0x94, 0xba, 0x37, 0x64, 0xa4, 0x54, 0x72, 0x9e,
-	0x65, 0x12, 0x1b, 0xfd, 0x39, 0x8f, 0x59, 0xb4, 0xbb, 0xf7, 0xeb, 0x6c, 0x8b, 0xfc, 0x3e, 0xdb,
-	0x22, 0x7f, 0xce, 0xb6, 0xc8, 0xe7, 0x97, 0x47, 0x45, 0x93, 0xaf, 0xe2, 0x69, 0xc2, 0x8f, 0x67,
-	0xcb, 0x28, 0xc9, 0xd7, 0x29, 0x8a, 0xcb, 0x95, 0x14, 0xc9, 0xec, 0x9a, 0x07, 0x1e, 0x77, 0xf5,
-	0xc3, 0x7d, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x5c, 0xcd, 0xd9, 0xfe, 0x03, 0x00, 0x00,
+	// 612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xdd, 0x6e, 0xd3, 0x4c,
+	0x10, 0xd5, 0xda, 0x4e, 0xe2, 0x4c, 0x92, 0xef, 0x0b, 0x0b, 0x02, 0xab, 0x42, 0xad, 0x31, 0x2a,
+	0x32, 0x08, 0x12, 0xa9, 0x20, 0x40, 0x5c, 0x56, 0xa5, 0x2a, 0xb9, 0x00, 0xb4, 0xed, 0x15, 0x37,
+	0x91, 0x7f, 0xc6, 0xb5, 0xb1, 0x63, 0x47, 0xbb, 0x4e, 0x45, 0xfa, 0x1c, 0x3c, 0x03, 0x6f, 0x82,
+	0xc4, 0x25, 0x2f, 0x80, 0x84, 0xfa, 0x24, 0xc8, 0xbb, 0xdb, 0x3a, 0x85, 0x5e, 0x44, 0x9a, 0x73,
+	0xe6, 0xcc, 0x78, 0xce, 0x78, 0x62, 0xf0, 0x04, 0xf2, 0x33, 0xe4, 0xd3, 0x65, 0x7e, 0x3a, 0x4d,
+	0x03, 0x91, 0xd6, 0x1c, 0xf1, 0x2a, 0x98, 0x2c, 0x79, 0x55, 0x57, 0xd4, 0xbe, 0xc4, 0x5b, 0x77,
+	0xa2, 0x22, 0xc3, 0xb2, 0x9e, 0x2e, 0x13, 0xd1, 0xfc, 0x54, 0xde, 0xfb, 0x45, 0x60, 0x74, 0x98,
+	0x15, 0xf8, 0xbe, 0x8a, 0xf1, 0xa3, 0xac, 0xd8, 0x85, 0x5e, 0x15, 0x7e, 0xc6, 0xa8, 0x16, 0x8e,
+	0xe5, 0x9a, 0xfe, 0x60, 0x6f, 0x30, 0x69, 0xe4, 0x1f, 0x24, 0xc7, 0x2e, 0x73, 0xf4, 0x29, 0x40,
+	0x58, 0x54, 0x51, 0x3e, 0xe7, 0x98, 0x08, 0xa7, 0x23, 0x95, 0x23, 0xa9, 0xdc, 0x6f, 0x68, 0x86,
+	0x09, 0xeb, 0x87, 0x3a, 0x12, 0xf4, 0x09, 0xdc, 0x4a, 0x03, 0x31, 0x4f, 0x31, 0x88, 0x91, 0xcf,
+	0x93, 0xaa, 0xaa, 0x91, 0x3b, 0x5d, 0x97, 0xf8, 0x36, 0xfb, 0x3f, 0x0d, 0xc4, 0x91, 0xe4, 0x0f,
+	0x25, 0x4d, 0x29, 0x58, 0x8b, 0x2a, 0x46, 0xa7, 0xe7, 0x12, 0x7f, 0xc4, 0x64, 0x4c, 0x77, 0xe1,
+	0x3f, 0xb1, 0x5e, 0x14, 0x59, 0x99, 0xcf, 0xeb, 0x80, 0x9f, 0x62, 0xed, 0xd8, 0x2e, 0xf1, 0xfb,
+	0x6c, 0xa4, 0xd9, 0x13, 0x49, 0xce, 0x2c, 0x9b, 0x8c, 0x8d, 0x99, 0x65, 0x1b, 0x63, 0x73, 0x66,
+	0xd9, 0xe6, 0xd8, 0xf2, 0xbe, 0x12, 0xe8, 0x1e, 0xa7, 0x01, 0xc7, 0x98, 0x3e, 0x84, 0xae, 0x7a,
+	0xbe, 0x43, 0x5c, 0xf2, 0xb7, 0x2f, 0x9d, 0x6a, 0x44, 0x7a, 0x3a, 0xe3, 0x06, 0x91, 0x4a, 0xd1,
+	0x1d, 0x18, 0x68, 0x27, 0x22, 0x3b, 0x47, 0xc7, 0x74, 0x89, 0x6f, 0x32, 0x50, 0xd4, 0x71, 0x76,
+	0x8e, 0x8d, 0x40, 0x49, 0x95, 0xc0, 0x52, 0x02, 0x45, 0x35, 0x02, 0x2f, 0x01, 0x7a, 0x90, 0x71,
+	0x8c, 0xea, 0x8a, 0xaf, 0xdb, 0xd5, 0x6f, 0x81, 0x1d, 0xa5, 0x59, 0x11, 0x73, 0x2c, 0x1d, 0xd3,
+	0x35, 0xfd, 0x3e, 0xbb, 0xc2, 0xd4, 0x87, 0xae, 0x90, 0x3e, 0x64, 0xb7, 0xc1, 0xde, 0x78, 0x72,
+	0xf5, 0xa6, 0x95, 0x3f, 0xa6, 0xf3, 0x9b, 0x4b, 0xf0, 0xbe, 0x13, 0xe8, 0xb7, 0xfd, 0x29, 0x58,
+	0x65, 0xb0, 0x40, 0xe9, 0xbf, 0xcf, 0x64, 0xdc, 0x70, 0x4d, 0x23, 0x69, 0x77, 0xc8, 0x64, 0x4c,
+	0x1f, 0xc0, 0x50, 0xac, 0xc2, 0xa6, 0xf7, 0xa6, 0xc1, 0x81, 0xe6, 0xa4, 0xc3, 0x17, 0xd0, 0x4f,
+	0xb2, 0x02, 0xe7, 0x65, 0xf3, 0xa6, 0xd4, 0x44, 0xf7, 0xda, 0x89, 0xae, 0x5d, 0x14, 0xb3, 0x13,
+	0x0d, 0xe9, 0x2b, 0xb0, 0xe3, 0x8c, 0xab, 0xa2, 0x8e, 0x2c, 0xba, 0xdf, 0x16, 0xfd, 0xbb, 0x10,
+	0xd6, 0x8b, 0x33, 0xde, 0x20, 0xef, 0x1b, 0x81, 0xd1, 0x51, 0x20, 0xd2, 0x13, 0x8e, 0xda, 0x8b,
+	0x03, 0xbd, 0x33, 0xe4, 0x22, 0xab, 0x4a, 0x69, 0xa7, 0xc3, 0x2e, 0x21, 0x9d, 0x82, 0x91, 0x08,
+	0xc7, 0x90, 0x17, 0xb9, 0xd3, 0xb6, 0xbf, 0x56, 0x3e, 0x39, 0x14, 0x6f, 0xcb, 0x9a, 0xaf, 0x99,
+	0x91, 0x88, 0xad, 0x19, 0xf4, 0x34, 0xa4, 0x63, 0x30, 0x73, 0x5c, 0xeb, 0x05, 0x35, 0x21, 0x7d,
+	0x0c, 0x9d, 0xb3, 0xa0, 0x58, 0xa1, 0xbe, 0x87, 0xdb, 0x6d, 0xc3, 0x76, 0x4c, 0xa5, 0x78, 0x63,
+	0xbc, 0x26, 0xde, 0x23, 0x18, 0xee, 0xaf, 0xa2, 0x1c, 0x6b, 0x75, 0xd2, 0xf4, 0x2e, 0x74, 0x43,
+	0x89, 0x75, 0x4f, 0x8d, 0xbc, 0x67, 0xd0, 0x79, 0x57, 0xc6, 0xf8, 0x85, 0x0e, 0x81, 0xe4, 0x32,
+	0x37, 0x64, 0x24, 0x6f, 0xe4, 0x55, 0x92, 0x08, 0xac, 0xe5, 0xe3, 0x2c, 0xa6, 0xd1, 0xfe, 0xc1,
+	0x8f, 0x8b, 0x6d, 0xf2, 0xf3, 0x62, 0x9b, 0xfc, 0xbe, 0xd8, 0x26, 0x9f, 0x5e, 0x9e, 0x66, 0x75,
+	0xba, 0x0a, 0x27, 0x51, 0xb5, 0x98, 0x2e, 0x83, 0x28, 0x5d, 0xc7, 0xc8, 0x37, 0x23, 0xc1, 0xa3,
+	0xe9, 0x0d, 0xdf, 0x86, 0xb0, 0x2b, 0xff, 0xf3, 0xcf, 0xff, 0x0c, 0x00, 0xd0, 0x24, 0x4c, 0x7a,
+	0x39, 0x04, 0x00, 0x00,
 }
 
 func (m *FileNodeProto) Marshal() (dAtA []byte, err error) {
@@ -576,6 +600,18 @@ func (m *FileNodeProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SymlinkTarget) > 0 {
+		i -= len(m.SymlinkTarget)
+		copy(dAtA[i:], m.SymlinkTarget)
+		i = encodeVarintHashtree(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Mode != 0 {
+		i = encodeVarintHashtree(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.HasHeaderFooter {
 		i--
 		if m.HasHeaderFooter {
@@ -959,6 +995,13 @@ func (m *FileNodeProto) Size() (n int) {
 	if m.HasHeaderFooter {
 		n += 2
 	}
+	if m.Mode != 0 {
+		n += 1 + sovHashtree(uint64(m.Mode))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovHashtree(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1230,6 +1273,57 @@ func (m *FileNodeProto) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.HasHeaderFooter = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHashtree(dAtA[iNdEx:])
@@ -410,6 +410,37 @@ func TestRewriteChangesHash(t *testing.T) {
 	require.Equal(t, rootPre.SubtreeSize, rootPost.SubtreeSize)
 }
 
+// Symlinks and file modes are stored in file nodes and change the hash of the
+// file and its parents.
+func TestSymlinkAndMode(t *testing.T) {
+	h := newHashTree(t)
+	require.NoError(t, h.PutFile("/dir/foo", obj(`hash:"ebc57"`), 1))
+	require.NoError(t, h.Hash())
+	dirPre := getT(t, h, "/dir")
+
+	require.NoError(t, h.SetFileMode("/dir/foo", 0755))
+	require.NoError(t, h.Hash())
+	require.Equal(t, uint32(0755), getT(t, h, "/dir/foo").FileNode.Mode)
+	dirMode := getT(t, h, "/dir")
+	require.NotEqual(t, dirPre.Hash, dirMode.Hash)
+	require.YesError(t, h.SetFileMode("/dir", 0755))
+
+	require.NoError(t, h.PutSymlink("/dir/bar", "foo"))
+	require.NoError(t, h.Hash())
+	bar := getT(t, h, "/dir/bar")
+	require.Equal(t, "foo", bar.FileNode.SymlinkTarget)
+	require.Equal(t, int64(0), bar.SubtreeSize)
+	require.NotEqual(t, dirMode.Hash, getT(t, h, "/dir").Hash)
+	require.Equal(t, int64(1), getT(t, h, "/dir").SubtreeSize)
+
+	// Content can't be appended to a symlink, but it can be replaced by one
+	require.YesError(t, h.PutFile("/dir/bar", obj(`hash:"8e02c"`), 1))
+	require.NoError(t, h.PutSymlink("/dir/foo", "bar"))
+	require.NoError(t, h.Hash())
+	require.Equal(t, int64(0), getT(t, h, "/dir").SubtreeSize)
+	require.YesError(t, h.PutSymlink("/dir", "foo"))
+}
+
 func TestGlobFile(t *testing.T) {
 	h := newHashTree(t)
 	require.NoError(t, h.PutFile("/foo", obj(`hash:"20c27"`), 1))
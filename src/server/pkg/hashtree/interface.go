@@ -161,6 +161,14 @@ type HashTree interface {
 	// uses Block Refs instead of objects.
 	PutFileOverwriteBlockRefs(path string, brs []*pfs.BlockRef, overwriteIndex *pfs.OverwriteIndex, sizeDelta int64) error
 
+	// PutSymlink creates a symbolic link at 'path' that points to 'target'.
+	// Any regular file or symlink already at 'path' is replaced.
+	PutSymlink(path string, target string) error
+
+	// SetFileMode sets the POSIX permission bits of the regular file or symlink
+	// at 'path'.
+	SetFileMode(path string, mode uint32) error
+
 	// PutDir creates a directory (or does nothing if one exists).
 	PutDir(path string) error
 
@@ -29,6 +29,60 @@ type localClient struct {
 	root string
 }
 
+// rootedClient is a read-only Client that refuses names that would leave the
+// root of the local client that it wraps (i.e. absolute names, which the local
+// client uses as is, and names starting with "..").
+type rootedClient struct {
+	Client
+}
+
+// localPathInRoot returns true if 'name', relative to a local client's root,
+// stays inside of the root.
+func localPathInRoot(name string) bool {
+	name = filepath.Clean(name)
+	return !filepath.IsAbs(name) && name != ".." && !strings.HasPrefix(name, ".."+string(filepath.Separator))
+}
+
+func (c *rootedClient) check(name string) error {
+	if !localPathInRoot(name) {
+		return errors.Errorf("object %q is outside of the local bucket", name)
+	}
+	return nil
+}
+
+func (c *rootedClient) Writer(_ context.Context, name string) (io.WriteCloser, error) {
+	return nil, errors.Errorf("local bucket is read-only")
+}
+
+func (c *rootedClient) Reader(ctx context.Context, name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	if err := c.check(name); err != nil {
+		return nil, err
+	}
+	return c.Client.Reader(ctx, name, offset, size)
+}
+
+func (c *rootedClient) Delete(_ context.Context, name string) error {
+	return errors.Errorf("local bucket is read-only")
+}
+
+func (c *rootedClient) Walk(ctx context.Context, prefix string, fn func(name string) error) error {
+	if err := c.check(prefix); err != nil {
+		return err
+	}
+	return c.Client.Walk(ctx, prefix, fn)
+}
+
+func (c *rootedClient) WalkInfo(ctx context.Context, prefix string, fn func(info *ObjectInfo) error) error {
+	if err := c.check(prefix); err != nil {
+		return err
+	}
+	return c.Client.WalkInfo(ctx, prefix, fn)
+}
+
+func (c *rootedClient) Exists(ctx context.Context, name string) bool {
+	return c.check(name) == nil && c.Client.Exists(ctx, name)
+}
+
 func (c *localClient) normPath(path string) string {
 	path = filepath.Clean(path)
 	if !filepath.IsAbs(path) {
@@ -84,7 +138,13 @@ func (c *localClient) Delete(_ context.Context, path string) error {
 	return errors.EnsureStack(os.Remove(c.normPath(path)))
 }
 
-func (c *localClient) Walk(_ context.Context, dir string, walkFn func(name string) error) error {
+func (c *localClient) Walk(ctx context.Context, dir string, walkFn func(name string) error) error {
+	return c.WalkInfo(ctx, dir, func(info *ObjectInfo) error {
+		return walkFn(info.Name)
+	})
+}
+
+func (c *localClient) WalkInfo(_ context.Context, dir string, walkFn func(info *ObjectInfo) error) error {
 	dir = c.normPath(dir)
 	fi, _ := os.Stat(dir)
 	prefix := ""
@@ -105,7 +165,11 @@ func (c *localClient) Walk(_ context.Context, dir string, walkFn func(name strin
 		if !strings.HasPrefix(filepath.Base(relPath), prefix) {
 			return nil
 		}
-		return walkFn(relPath)
+		return walkFn(&ObjectInfo{
+			Name:    relPath,
+			Size:    fileInfo.Size(),
+			ModTime: fileInfo.ModTime(),
+		})
 	})
 	return errors.EnsureStack(err)
 }
@@ -92,7 +92,13 @@ func (c *minioClient) Writer(ctx context.Context, name string) (io.WriteCloser,
 	return newMinioWriter(ctx, c, name), nil
 }
 
-func (c *minioClient) Walk(_ context.Context, name string, fn func(name string) error) error {
+func (c *minioClient) Walk(ctx context.Context, name string, fn func(name string) error) error {
+	return c.WalkInfo(ctx, name, func(info *ObjectInfo) error {
+		return fn(info.Name)
+	})
+}
+
+func (c *minioClient) WalkInfo(_ context.Context, name string, fn func(info *ObjectInfo) error) error {
 	recursive := true // Recursively walk by default.
 
 	doneCh := make(chan struct{})
@@ -101,7 +107,12 @@ func (c *minioClient) Walk(_ context.Context, name string, fn func(name string)
 		if objInfo.Err != nil {
 			return objInfo.Err
 		}
-		if err := fn(objInfo.Key); err != nil {
+		if err := fn(&ObjectInfo{
+			Name:    objInfo.Key,
+			Size:    objInfo.Size,
+			ETag:    objInfo.ETag,
+			ModTime: objInfo.LastModified,
+		}); err != nil {
 			return err
 		}
 	}
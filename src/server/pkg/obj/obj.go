@@ -184,6 +184,15 @@ var EnvVarToSecretKey = []struct {
 	{Key: LogOptionsEnvVar, Value: "log-options"},
 }
 
+// ObjectInfo is the metadata of an object, as listed by WalkInfo.
+type ObjectInfo struct {
+	Name string
+	Size int64
+	// ETag is the object's entity tag, if the object store has them
+	ETag    string
+	ModTime time.Time
+}
+
 // Client is an interface to object storage.
 type Client interface {
 	// Writer returns a writer which writes to an object.
@@ -201,6 +210,9 @@ type Client interface {
 	Delete(ctx context.Context, name string) error
 	// Walk calls `fn` with the names of objects which can be found under `prefix`.
 	Walk(ctx context.Context, prefix string, fn func(name string) error) error
+	// WalkInfo is like Walk, but calls `fn` with the metadata of the objects
+	// that the object store lists with them, without reading the objects.
+	WalkInfo(ctx context.Context, prefix string, fn func(info *ObjectInfo) error) error
 	// Exsits checks if a given object already exists
 	Exists(ctx context.Context, name string) bool
 	// IsRetryable determines if an operation should be retried given an error
@@ -591,6 +603,99 @@ func NewClientFromURLAndSecret(url *ObjectStoreURL, reverse ...bool) (c Client,
 	}
 }
 
+// NewClientFromURLAndCredentials constructs a client for the bucket in 'url'
+// using only the credentials in 'creds' (the data of a k8s secret with the
+// same keys as pachd's storage secret, e.g. amazon-id and amazon-secret). It
+// never falls back on pachd's own credentials or identity (e.g. its IAM role),
+// and doesn't support local URLs (see NewLocalClientFromURL), so that it can be
+// used to read buckets on behalf of users.
+func NewClientFromURLAndCredentials(url *ObjectStoreURL, creds map[string][]byte) (c Client, err error) {
+	get := func(keys ...string) ([]string, error) {
+		var values []string
+		for _, key := range keys {
+			value := strings.TrimSpace(string(creds[key]))
+			if value == "" {
+				return nil, errors.Errorf("%s not found in credentials", key)
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	}
+	switch url.Store {
+	case "s3":
+		values, err := get("amazon-region", "amazon-id", "amazon-secret")
+		if err != nil {
+			return nil, err
+		}
+		c, err = NewAmazonClient(values[0], url.Bucket, &AmazonCreds{
+			ID:     values[1],
+			Secret: values[2],
+			Token:  strings.TrimSpace(string(creds["amazon-token"])),
+		}, "", strings.TrimSpace(string(creds["custom-endpoint"])), false)
+	case "gcs", "gs":
+		values, err := get("google-cred")
+		if err != nil {
+			return nil, err
+		}
+		c, err = NewGoogleClient(url.Bucket, []option.ClientOption{option.WithCredentialsJSON([]byte(values[0]))})
+	case "as", "wasb":
+		values, err := get("microsoft-id", "microsoft-secret")
+		if err != nil {
+			return nil, err
+		}
+		c, err = NewMicrosoftClient(url.Bucket, values[0], values[1])
+	case "local":
+		return nil, errors.Errorf("local object stores can't be read with credentials")
+	default:
+		return nil, errors.Errorf("unrecognized object store: %s", url.Store)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return TracingObjClient(url.Store, c), nil
+}
+
+// LocalBucketPath returns the directory that holds the local "bucket" in 'url'
+// (a local:// URL), which is <root>/<bucket>. It fails if 'root' is empty, or
+// if the bucket or the URL's prefix would leave 'root'.
+func LocalBucketPath(url *ObjectStoreURL, root string) (string, error) {
+	switch {
+	case url.Store != "local":
+		return "", errors.Errorf("%s is not a local object store", url.Store)
+	case root == "":
+		return "", errors.Errorf("local object stores can't be read, as no root is configured for them")
+	case url.Bucket == "" || url.Bucket == "." || url.Bucket == ".." || strings.ContainsAny(url.Bucket, `/\`):
+		return "", errors.Errorf("invalid local bucket %q", url.Bucket)
+	case !localPathInRoot(url.Object):
+		return "", errors.Errorf("invalid local object prefix %q", url.Object)
+	}
+	return filepath.Join(root, url.Bucket), nil
+}
+
+// NewLocalClientFromURL constructs a read-only client for the local "bucket"
+// in 'url', which is the directory <root>/<bucket>. Unlike the local client
+// built by NewClientFromURLAndSecret, it never reads outside of that
+// directory, so that it can be used to read local buckets (e.g. in tests) on
+// behalf of users.
+func NewLocalClientFromURL(url *ObjectStoreURL, root string) (Client, error) {
+	dir, err := LocalBucketPath(url, root)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read local bucket %q", url.Bucket)
+	}
+	if !fi.IsDir() {
+		return nil, errors.Errorf("local bucket %q is not a directory", url.Bucket)
+	}
+	c, err := NewLocalClient(dir)
+	if err != nil {
+		return nil, err
+	}
+	return TracingObjClient(url.Store, &rootedClient{Client: c}), nil
+}
+
 // ObjectStoreURL represents a parsed URL to an object in an object store.
 type ObjectStoreURL struct {
 	// The object store, e.g. s3, gcs, as...
@@ -76,6 +76,15 @@ func (o *tracingObjClient) Walk(ctx context.Context, prefix string, fn func(name
 	return o.Client.Walk(ctx, prefix, fn)
 }
 
+func (o *tracingObjClient) WalkInfo(ctx context.Context, prefix string, fn func(info *ObjectInfo) error) (retErr error) {
+	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/WalkInfo",
+		"prefix", prefix)
+	defer func() {
+		tracing.FinishAnySpan(span, "err", retErr)
+	}()
+	return o.Client.WalkInfo(ctx, prefix, fn)
+}
+
 // Exists implements the corresponding method in the Client interface
 func (o *tracingObjClient) Exists(ctx context.Context, name string) (retVal bool) {
 	span, ctx := tracing.AddSpanToAnyExisting(ctx, "/"+o.provider+"/Exists",
@@ -90,6 +90,80 @@ func ValidatePath(path string) error {
 	return nil
 }
 
+// maxSymlinkDepth is the number of symlinks that resolving a symlink's target
+// may follow, which keeps cycles of symlinks from resolving forever
+const maxSymlinkDepth = 40
+
+// ValidateSymlink checks that a symlink at 'p' pointing to 'target' is legal.
+// Targets must be relative and must not resolve to a location outside of the
+// repo. 'readlink', if it's set, returns the target of the existing symlink at
+// a path (or "" if there's no symlink there), and the target is resolved
+// through the existing symlinks that it passes through, like a filesystem
+// would (e.g. "a/.." leaves the repo if "a" is a symlink to "..").
+func ValidateSymlink(p, target string, readlink func(string) (string, error)) error {
+	if target == "" {
+		return errors.Errorf("symlink target for %q invalid: target must not be empty", p)
+	}
+	if path.IsAbs(target) {
+		return errors.Errorf("symlink target %q for %q invalid: target must be a relative path", target, p)
+	}
+	depth := 0
+	if _, ok, err := resolveSymlink(strings.TrimPrefix(Dir(p), "/"), target, readlink, &depth); err != nil {
+		return errors.Wrapf(err, "symlink target %q for %q invalid", target, p)
+	} else if !ok {
+		return errors.Errorf("symlink target %q for %q invalid: target is outside of the repo", target, p)
+	}
+	return nil
+}
+
+// resolveSymlink resolves 'target' relative to 'dir' (relative to the root of
+// the repo), following the symlinks reported by 'readlink' (if it's set). It
+// returns false if the target leaves the repo.
+func resolveSymlink(dir, target string, readlink func(string) (string, error), depth *int) (string, bool, error) {
+	var resolved []string
+	if dir != "" {
+		resolved = strings.Split(dir, "/")
+	}
+	for _, elem := range strings.Split(target, "/") {
+		switch elem {
+		case "", ".":
+			continue
+		case "..":
+			if len(resolved) == 0 {
+				return "", false, nil
+			}
+			resolved = resolved[:len(resolved)-1]
+			continue
+		}
+		resolved = append(resolved, elem)
+		if readlink == nil {
+			continue
+		}
+		link, err := readlink(Clean(strings.Join(resolved, "/")))
+		if err != nil {
+			return "", false, err
+		}
+		if link == "" {
+			continue
+		}
+		if path.IsAbs(link) {
+			return "", false, nil
+		}
+		if *depth++; *depth > maxSymlinkDepth {
+			return "", false, errors.New("too many levels of symbolic links")
+		}
+		linkResolved, ok, err := resolveSymlink(strings.Join(resolved[:len(resolved)-1], "/"), link, readlink, depth)
+		if err != nil || !ok {
+			return "", ok, err
+		}
+		resolved = nil
+		if linkResolved != "" {
+			resolved = strings.Split(linkResolved, "/")
+		}
+	}
+	return strings.Join(resolved, "/"), true, nil
+}
+
 // IsGlob checks if the pattern contains a glob character
 func IsGlob(pattern string) bool {
 	pattern = Clean(pattern)
@@ -145,5 +145,25 @@ func TestGlobLiteralPrefix(t *testing.T) {
 	}
 }
 
+func TestValidateSymlink(t *testing.T) {
+	for _, target := range []string{"bar", "./bar", "../bar", "../dir/../bar", "baz/../../bar"} {
+		require.NoError(t, ValidateSymlink("/dir/foo", target, nil), "for %q", target)
+	}
+	for _, target := range []string{"", "/bar", "../../bar", "../baz/../../bar"} {
+		require.YesError(t, ValidateSymlink("/dir/foo", target, nil), "for %q", target)
+	}
+	require.YesError(t, ValidateSymlink("/foo", "../bar", nil))
+
+	// Targets are resolved through the symlinks that already exist
+	links := map[string]string{"/dir/a": "..", "/dir/loop": "loop", "/dir/c": "a/dir"}
+	readlink := func(p string) (string, error) { return links[p], nil }
+	require.NoError(t, ValidateSymlink("/dir/b", "a", readlink))
+	require.NoError(t, ValidateSymlink("/dir/b", "c/..", readlink))
+	require.YesError(t, ValidateSymlink("/dir/b", "a/..", readlink))
+	require.YesError(t, ValidateSymlink("/dir/b", "c/../..", readlink))
+	require.NoError(t, ValidateSymlink("/dir/b", "a/..", nil))
+	require.YesError(t, ValidateSymlink("/dir/b", "loop", readlink))
+}
+
 func TestGlobPrefix(t *testing.T) {
 }
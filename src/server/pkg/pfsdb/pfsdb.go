@@ -17,6 +17,7 @@ const (
 	commitsPrefix        = "/commits"
 	branchesPrefix       = "/branches"
 	openCommitsPrefix    = "/openCommits"
+	uploadsPrefix        = "/uploads"
 	mergesPrefix         = "/merges"
 	shardsPrefix         = "/shards"
 )
@@ -90,3 +91,15 @@ func OpenCommits(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 		nil,
 	)
 }
+
+// Uploads returns a collection of upload sessions
+func Uploads(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, uploadsPrefix),
+		nil,
+		&pfs.UploadInfo{},
+		nil,
+		nil,
+	)
+}
@@ -21,4 +21,13 @@ const (
 
 	// SpoutMarkerBranch is the branch that spouts use for keeping track of spout marker files
 	SpoutMarkerBranch = "marker"
+
+	// LogsBranch is the branch of a pipeline's output repo that its jobs' logs
+	// are persisted to, if the pipeline sets persist_logs
+	LogsBranch = "logs"
+
+	// MemoTagPrefix prefixes the object store tags of the datum output
+	// hashtrees in the memoization cache (the rest of the tag is the entry's
+	// key)
+	MemoTagPrefix = "memo-"
 )
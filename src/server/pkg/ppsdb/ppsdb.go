@@ -13,6 +13,7 @@ import (
 const (
 	pipelinesPrefix = "/pipelines"
 	jobsPrefix      = "/jobs"
+	memosPrefix     = "/memos"
 )
 
 var (
@@ -25,6 +26,10 @@ var (
 
 	// JobsOutputIndex maps job outputs to the job that create them.
 	JobsOutputIndex = &col.Index{Field: "OutputCommit", Multi: false}
+
+	// MemosPipelineIndex maps pipelines to the memoization cache entries that
+	// they created.
+	MemosPipelineIndex = &col.Index{Field: "Pipeline", Multi: false}
 )
 
 // Pipelines returns a Collection of pipelines
@@ -50,3 +55,16 @@ func Jobs(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 		nil,
 	)
 }
+
+// Memos returns a Collection of datum memoization cache entries, keyed by
+// MemoInfo.Key
+func Memos(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, memosPrefix),
+		[]*col.Index{MemosPipelineIndex},
+		&pps.MemoInfo{},
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,94 @@
+package ppsutil
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// specChangeIgnoredFields are the fields of a CreatePipelineRequest that say
+// how to apply it, rather than being part of the pipeline's spec
+var specChangeIgnoredFields = []string{"update", "reprocess", "dry_run", "spec_commit"}
+
+// SpecChanges returns the fields that differ between the pipeline specs
+// 'old' and 'new', sorted by field.
+func SpecChanges(old, new *pps.CreatePipelineRequest) ([]*pps.SpecChange, error) {
+	toMap := func(request *pps.CreatePipelineRequest) (map[string]interface{}, error) {
+		js, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(request)
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		result := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(js), &result); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		for _, field := range specChangeIgnoredFields {
+			delete(result, field)
+		}
+		return result, nil
+	}
+	oldMap, err := toMap(old)
+	if err != nil {
+		return nil, err
+	}
+	newMap, err := toMap(new)
+	if err != nil {
+		return nil, err
+	}
+	var result []*pps.SpecChange
+	diffJSON("", oldMap, newMap, &result)
+	return result, nil
+}
+
+// diffJSON appends the differences between the JSON values 'old' and 'new',
+// found at 'field', to 'changes'. Objects are compared field by field, and
+// other values as a whole.
+func diffJSON(field string, old, new interface{}, changes *[]*pps.SpecChange) {
+	oldObj, oldOK := old.(map[string]interface{})
+	newObj, newOK := new.(map[string]interface{})
+	if oldOK && newOK {
+		keys := make(map[string]bool)
+		for k := range oldObj {
+			keys[k] = true
+		}
+		for k := range newObj {
+			keys[k] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+		for _, k := range sorted {
+			sub := k
+			if field != "" {
+				sub = field + "." + k
+			}
+			diffJSON(sub, oldObj[k], newObj[k], changes)
+		}
+		return
+	}
+	if reflect.DeepEqual(old, new) {
+		return
+	}
+	toString := func(v interface{}) string {
+		if v == nil {
+			return ""
+		}
+		js, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(js)
+	}
+	*changes = append(*changes, &pps.SpecChange{
+		Field: field,
+		Old:   toString(old),
+		New:   toString(new),
+	})
+}
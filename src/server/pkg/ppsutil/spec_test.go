@@ -0,0 +1,85 @@
+package ppsutil
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestSpecChanges(t *testing.T) {
+	old := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline("edges"),
+		Transform: &pps.Transform{
+			Image: "pachyderm/opencv",
+			Cmd:   []string{"python3", "/edges.py"},
+		},
+		Input:       client.NewPFSInput("images", "/*"),
+		Description: "Finds edges",
+	}
+	changes, err := SpecChanges(old, old)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(changes))
+
+	new := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline("edges"),
+		Transform: &pps.Transform{
+			Image: "pachyderm/opencv:1.1",
+			Cmd:   []string{"python3", "/edges.py"},
+		},
+		Input:        client.NewPFSInput("images", "/*"),
+		OutputBranch: "results",
+		// The fields that say how to apply the spec aren't changes to it
+		Update:    true,
+		Reprocess: true,
+	}
+	changes, err = SpecChanges(old, new)
+	require.NoError(t, err)
+	// Changed, removed and added fields, sorted by field, with nested fields
+	// compared individually
+	require.Equal(t, []*pps.SpecChange{
+		{Field: "description", Old: `"Finds edges"`},
+		{Field: "output_branch", New: `"results"`},
+		{Field: "transform.image", Old: `"pachyderm/opencv"`, New: `"pachyderm/opencv:1.1"`},
+	}, changes)
+
+	// Other values, such as lists, are compared as a whole
+	new = &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline("edges"),
+		Transform: &pps.Transform{
+			Image: "pachyderm/opencv",
+			Cmd:   []string{"python3", "/edges.py", "--fast"},
+		},
+		Input:       client.NewPFSInput("images", "/*"),
+		Description: "Finds edges",
+	}
+	changes, err = SpecChanges(old, new)
+	require.NoError(t, err)
+	require.Equal(t, []*pps.SpecChange{
+		{Field: "transform.cmd", Old: `["python3","/edges.py"]`, New: `["python3","/edges.py","--fast"]`},
+	}, changes)
+}
+
+func TestDiffJSON(t *testing.T) {
+	var changes []*pps.SpecChange
+	diffJSON("", map[string]interface{}{
+		"a": map[string]interface{}{"b": 1.0, "c": "x"},
+		"d": true,
+	}, map[string]interface{}{
+		"a": map[string]interface{}{"b": 2.0, "c": "x"},
+		"e": []interface{}{"y"},
+	}, &changes)
+	require.Equal(t, []*pps.SpecChange{
+		{Field: "a.b", Old: "1", New: "2"},
+		{Field: "d", Old: "true"},
+		{Field: "e", New: `["y"]`},
+	}, changes)
+
+	// An object replacing another value is a change to the field as a whole
+	changes = nil
+	diffJSON("input", "x", map[string]interface{}{"pfs": "y"}, &changes)
+	require.Equal(t, []*pps.SpecChange{
+		{Field: "input", Old: `"x"`, New: `{"pfs":"y"}`},
+	}, changes)
+}
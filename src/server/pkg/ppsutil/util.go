@@ -15,8 +15,11 @@ package ppsutil
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -42,6 +45,13 @@ func PipelineRepo(pipeline *pps.Pipeline) *pfs.Repo {
 	return &pfs.Repo{Name: pipeline.Name}
 }
 
+// WorkNamespace returns the namespace of the work queue (see
+// src/server/pkg/work) that a pipeline's master uses to distribute datums to
+// its workers.
+func WorkNamespace(pipelineInfo *pps.PipelineInfo) string {
+	return fmt.Sprintf("/pipeline-%s/v%d", pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+}
+
 // PipelineRcName generates the name of the k8s replication controller that
 // manages a pipeline's workers
 func PipelineRcName(name string, version uint64) string {
@@ -102,6 +112,44 @@ func getResourceListFromSpec(resources *pps.ResourceSpec) (*v1.ResourceList, err
 	return &result, nil
 }
 
+// defaultSetupTimeout is how long workers may run their pipeline's setup_cmd
+// for, if it doesn't set setup_timeout
+const defaultSetupTimeout = 10 * time.Minute
+
+// SetupTimeout returns how long each of a pipeline's workers may run the
+// setup_cmd of 'transform' for before it's killed and retried (ten minutes,
+// unless the transform sets setup_timeout)
+func SetupTimeout(transform *pps.Transform) time.Duration {
+	if transform.SetupTimeout != nil {
+		if timeout, err := types.DurationFromProto(transform.SetupTimeout); err == nil {
+			return timeout
+		}
+	}
+	return defaultSetupTimeout
+}
+
+// defaultTeardownTimeout is how long workers may run their pipeline's
+// teardown_cmd for, if it doesn't set teardown_timeout
+const defaultTeardownTimeout = time.Minute
+
+// TeardownTimeout returns how long each of a pipeline's workers may run the
+// teardown_cmd of 'transform' for when it's shut down (a minute, unless the
+// transform sets teardown_timeout)
+func TeardownTimeout(transform *pps.Transform) time.Duration {
+	if transform.TeardownTimeout != nil {
+		if timeout, err := types.DurationFromProto(transform.TeardownTimeout); err == nil {
+			return timeout
+		}
+	}
+	return defaultTeardownTimeout
+}
+
+// GetRequestsResourceList returns a list of resources from a ResourceSpec
+// that a container (e.g. a pipeline's sidecar) minimally requires.
+func GetRequestsResourceList(requests *pps.ResourceSpec) (*v1.ResourceList, error) {
+	return getResourceListFromSpec(requests)
+}
+
 // GetLimitsResourceList returns a list of resources from a pipeline
 // ResourceSpec that it is maximally limited to.
 func GetLimitsResourceList(limits *pps.ResourceSpec) (*v1.ResourceList, error) {
@@ -143,6 +191,7 @@ func GetPipelineInfoAllowIncomplete(pachClient *client.APIClient, name string, p
 	result.Reason = ptr.Reason
 	result.JobCounts = ptr.JobCounts
 	result.LastJobState = ptr.LastJobState
+	result.Transitions = ptr.Transitions
 	result.SpecCommit = ptr.SpecCommit
 	return result, nil
 }
@@ -157,16 +206,105 @@ func GetPipelineInfo(pachClient *client.APIClient, name string, ptr *pps.EtcdPip
 	return result, err
 }
 
+// The components that pipeline and job state transitions are attributed to
+const (
+	ActorMaster = "master"
+	ActorWorker = "worker"
+	ActorUser   = "user"
+)
+
+// maxStateTransitions is the number of state transitions kept for each
+// pipeline and job
+const maxStateTransitions = 50
+
+// RecordStateTransition appends a transition from the state 'from' to 'to',
+// made by 'actor', to 'transitions', dropping the oldest transitions if there
+// are more than maxStateTransitions.
+func RecordStateTransition(transitions []*pps.StateTransition, from, to, reason, actor string) []*pps.StateTransition {
+	now, _ := types.TimestampProto(time.Now())
+	transitions = append(transitions, &pps.StateTransition{
+		From:   from,
+		To:     to,
+		Reason: reason,
+		Actor:  actor,
+		Time:   now,
+	})
+	if len(transitions) > maxStateTransitions {
+		transitions = append([]*pps.StateTransition(nil), transitions[len(transitions)-maxStateTransitions:]...)
+	}
+	return transitions
+}
+
+// ApprovalReason describes the approval or rejection 'approval' of a job, e.g.
+// "approved by github:alice: looks good"
+func ApprovalReason(approval *pps.JobApproval) string {
+	reason := "rejected"
+	if approval.Approved {
+		reason = "approved"
+	}
+	if approval.User != "" {
+		reason += " by " + approval.User
+	}
+	if approval.Reason != "" {
+		reason += ": " + approval.Reason
+	}
+	return reason
+}
+
+// AwaitingApprovalSince returns when the job with the state transitions
+// 'transitions' last started awaiting approval, or the zero time if it never
+// did (or that transition has been dropped)
+func AwaitingApprovalSince(transitions []*pps.StateTransition) time.Time {
+	awaiting := pps.JobState_JOB_AWAITING_APPROVAL.String()
+	for i := len(transitions) - 1; i >= 0; i-- {
+		if t := transitions[i]; t.To == awaiting && t.From != awaiting {
+			since, err := types.TimestampFromProto(t.Time)
+			if err != nil {
+				return time.Time{}
+			}
+			return since
+		}
+	}
+	return time.Time{}
+}
+
+// JobSalt returns the salt that the datums of a job are hashed with, for a
+// pipeline whose salt is 'pipelineSalt' that was run with 'parameters'. Jobs
+// run without parameters use the pipeline's salt.
+func JobSalt(pipelineSalt string, parameters *pps.RunParameters) string {
+	if parameters == nil || (len(parameters.Env) == 0 && parameters.Salt == "") {
+		return pipelineSalt
+	}
+	hash := sha256.New()
+	hash.Write([]byte(parameters.Salt))
+	for _, kv := range RunParametersEnv(parameters) {
+		hash.Write([]byte{0})
+		hash.Write([]byte(kv))
+	}
+	return pipelineSalt + "-" + hex.EncodeToString(hash.Sum(nil))[:32]
+}
+
+// RunParametersEnv returns the environment variables set by 'parameters', as
+// sorted "KEY=value" strings
+func RunParametersEnv(parameters *pps.RunParameters) []string {
+	var result []string
+	for k, v := range parameters.GetEnv() {
+		result = append(result, k+"="+v)
+	}
+	sort.Strings(result)
+	return result
+}
+
 // FailPipeline updates the pipeline's state to failed and sets the failure reason
-func FailPipeline(ctx context.Context, etcdClient *etcd.Client, pipelinesCollection col.Collection, pipelineName string, reason string) error {
+func FailPipeline(ctx context.Context, etcdClient *etcd.Client, pipelinesCollection col.Collection, pipelineName string, reason string, actor string) error {
 	return SetPipelineState(ctx, etcdClient, pipelinesCollection, pipelineName,
-		nil, pps.PipelineState_PIPELINE_FAILURE, reason)
+		nil, pps.PipelineState_PIPELINE_FAILURE, reason, actor)
 }
 
 // CrashingPipeline updates the pipeline's state to crashing and sets the reason
-func CrashingPipeline(ctx context.Context, etcdClient *etcd.Client, pipelinesCollection col.Collection, pipelineName string, reason string) error {
+func CrashingPipeline(ctx context.Context, etcdClient *etcd.Client, pipelinesCollection col.Collection, pipelineName string, reason string, actor string) error {
 	return SetPipelineState(ctx, etcdClient, pipelinesCollection, pipelineName,
-		nil, pps.PipelineState_PIPELINE_CRASHING, reason)
+		nil, pps.PipelineState_PIPELINE_CRASHING, reason, actor)
 }
 
 // PipelineTransitionError represents an error transitioning a pipeline from
@@ -190,12 +328,13 @@ func (p PipelineTransitionError) Error() string {
 }
 
 // SetPipelineState is a helper that moves the state of 'pipeline' from any of
-// the states in 'from' (if not nil) to 'to'. It will annotate any trace in
-// 'ctx' with information about 'pipeline' that it reads.
+// the states in 'from' (if not nil) to 'to', and records the transition as
+// made by 'actor'. It will annotate any trace in 'ctx' with information about
+// 'pipeline' that it reads.
 //
 // This function logs a lot for a library function, but it's mostly (maybe
 // exclusively?) called by the PPS master
-func SetPipelineState(ctx context.Context, etcdClient *etcd.Client, pipelinesCollection col.Collection, pipeline string, from []pps.PipelineState, to pps.PipelineState, reason string) (retErr error) {
+func SetPipelineState(ctx context.Context, etcdClient *etcd.Client, pipelinesCollection col.Collection, pipeline string, from []pps.PipelineState, to pps.PipelineState, reason string, actor string) (retErr error) {
 	log.Infof("SetPipelineState attempting to move %s to %s", pipeline, to)
 	_, err := col.NewSTM(ctx, etcdClient, func(stm col.STM) error {
 		pipelines := pipelinesCollection.ReadWrite(stm)
@@ -242,6 +381,10 @@ func SetPipelineState(ctx context.Context, etcdClient *etcd.Client, pipelinesCol
 			}
 		}
 		log.Infof("SetPipelineState moving pipeline %s from %s to %s", pipeline, pipelinePtr.State, to)
+		if pipelinePtr.State != to || pipelinePtr.Reason != reason {
+			pipelinePtr.Transitions = RecordStateTransition(pipelinePtr.Transitions,
+				pipelinePtr.State.String(), to.String(), reason, actor)
+		}
 		pipelinePtr.State = to
 		pipelinePtr.Reason = reason
 		return pipelines.Put(pipeline, pipelinePtr)
@@ -276,6 +419,16 @@ func JobInput(pipelineInfo *pps.PipelineInfo, outputCommitInfo *pfs.CommitInfo)
 				input.Git.Commit = commit.ID
 			}
 		}
+		if input.Window != nil {
+			if commit, ok := branchToCommit[key(input.Window.Repo, input.Window.Branch)]; ok {
+				input.Window.Commit = commit.ID
+			}
+		}
+		if input.ObjectStore != nil {
+			if commit, ok := branchToCommit[key(input.ObjectStore.Repo, "master")]; ok {
+				input.ObjectStore.Commit = commit.ID
+			}
+		}
 	})
 	return jobInput
 }
@@ -310,6 +463,12 @@ func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequ
 		Standby:               pipelineInfo.Standby,
 		S3Out:                 pipelineInfo.S3Out,
 		Metadata:              pipelineInfo.Metadata,
+		Memoize:               pipelineInfo.Memoize,
+		DatumBatching:         pipelineInfo.DatumBatching,
+		PersistLogs:           pipelineInfo.PersistLogs,
+		Approval:              pipelineInfo.Approval,
+		Sidecars:              pipelineInfo.Sidecars,
+		Outputs:               pipelineInfo.Outputs,
 	}
 }
 
@@ -320,15 +479,16 @@ func IsTerminal(state pps.JobState) bool {
 	switch state {
 	case pps.JobState_JOB_SUCCESS, pps.JobState_JOB_FAILURE, pps.JobState_JOB_KILLED:
 		return true
-	case pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING, pps.JobState_JOB_MERGING, pps.JobState_JOB_EGRESSING:
+	case pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING, pps.JobState_JOB_MERGING, pps.JobState_JOB_EGRESSING, pps.JobState_JOB_AWAITING_APPROVAL:
 		return false
 	default:
 		panic(fmt.Sprintf("unrecognized job state: %s", state))
 	}
 }
 
-// UpdateJobState performs the operations involved with a job state transition.
-func UpdateJobState(pipelines col.ReadWriteCollection, jobs col.ReadWriteCollection, jobPtr *pps.EtcdJobInfo, state pps.JobState, reason string) error {
+// UpdateJobState performs the operations involved with a job state transition,
+// and records the transition as made by 'actor'.
+func UpdateJobState(pipelines col.ReadWriteCollection, jobs col.ReadWriteCollection, jobPtr *pps.EtcdJobInfo, state pps.JobState, reason string, actor string) error {
 	if jobPtr.State == pps.JobState_JOB_FAILURE {
 		return errors.Errorf("cannot put %q in state %s as it's already in state JOB_FAILURE", jobPtr.Job.ID, state.String())
 	}
@@ -360,6 +520,12 @@ func UpdateJobState(pipelines col.ReadWriteCollection, jobs col.ReadWriteCollect
 	if err != nil {
 		return err
 	}
+	if len(jobPtr.Transitions) == 0 {
+		// the job is new
+		jobPtr.Transitions = RecordStateTransition(nil, "", state.String(), reason, actor)
+	} else if jobPtr.State != state || jobPtr.Reason != reason {
+		jobPtr.Transitions = RecordStateTransition(jobPtr.Transitions, jobPtr.State.String(), state.String(), reason, actor)
+	}
 	jobPtr.State = state
 	jobPtr.Reason = reason
 	return jobs.Put(jobPtr.Job.ID, jobPtr)
@@ -380,6 +546,60 @@ func ContainsS3Inputs(in *pps.Input) bool {
 	return found
 }
 
+// ContainsWindowInputs returns 'true' if 'in' is or contains any window
+// inputs.
+func ContainsWindowInputs(in *pps.Input) bool {
+	var found bool
+	pps.VisitInput(in, func(in *pps.Input) {
+		if in.Window != nil {
+			found = true
+		}
+	})
+	return found
+}
+
+// DatumRecordPrefix returns the prefix, in object storage, of the records
+// that the workers of 'pipelineName' keep of their datums' last successful
+// processing, for inputs with change lists enabled.
+func DatumRecordPrefix(pipelineName string) string {
+	return path.Join("datum-records", pipelineName) + "/"
+}
+
+// DatumRecordSaltPrefix returns the prefix, in object storage, of the records
+// that the workers of 'pipelineName' keep while the pipeline has the salt
+// 'salt'. Records are keyed by salt so that reprocessing a pipeline starts
+// over without them.
+func DatumRecordSaltPrefix(pipelineName, salt string) string {
+	return path.Join(DatumRecordPrefix(pipelineName), salt) + "/"
+}
+
+// StaleDatumRecordPrefix returns the prefix of the records that the workers of
+// 'prevPipelineInfo' kept and that 'pipelineInfo', its next version, will no
+// longer read, either because the update changes the pipeline's salt or
+// because it disables change lists. It returns "" if the records are still
+// in use.
+func StaleDatumRecordPrefix(prevPipelineInfo, pipelineInfo *pps.PipelineInfo) string {
+	if !ContainsChangesInputs(prevPipelineInfo.Input) {
+		return ""
+	}
+	if prevPipelineInfo.Salt == pipelineInfo.Salt && ContainsChangesInputs(pipelineInfo.Input) {
+		return ""
+	}
+	return DatumRecordSaltPrefix(prevPipelineInfo.Pipeline.Name, prevPipelineInfo.Salt)
+}
+
+// ContainsChangesInputs returns 'true' if 'in' is or contains any PFS inputs
+// with 'Changes' set to true.
+func ContainsChangesInputs(in *pps.Input) bool {
+	var found bool
+	pps.VisitInput(in, func(in *pps.Input) {
+		if in.Pfs != nil && in.Pfs.Changes {
+			found = true
+		}
+	})
+	return found
+}
+
 // SidecarS3GatewayService returns the name of the kubernetes service created
 // for the job 'jobID' to hand sidecar s3 gateway requests. This helper is in
 // ppsutil because both PPS (which creates the service, in the s3 gateway
@@ -399,3 +619,35 @@ func ErrorState(s pps.PipelineState) bool {
 		pps.PipelineState_PIPELINE_RESTARTING: true,
 	}[s]
 }
+
+// ObjectRef is the content of each file in the repo of an object store input.
+// It refers to an object in the input's bucket, which workers fetch when they
+// process a datum containing the file.
+type ObjectRef struct {
+	// URL is the object store input's URL
+	URL string `json:"url"`
+	// Object is the object's name in the bucket
+	Object string `json:"object"`
+	// Hash is the SHA256 hash of the object's content when it was committed.
+	// Including it means that the file changes, and datums containing it are
+	// reprocessed, whenever the object changes.
+	Hash string `json:"hash"`
+	// Size, ETag and ModTime are the object's metadata when it was committed.
+	// The PPS master only re-reads (and re-hashes) objects whose metadata has
+	// changed.
+	Size    int64     `json:"size,omitempty"`
+	ETag    string    `json:"etag,omitempty"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+// ObjectStoreInputLocalRootEnv is the environment variable that holds, in
+// pachd and in a pipeline's workers, the directory holding the local buckets
+// that object store inputs may read.
+const ObjectStoreInputLocalRootEnv = "OBJECT_STORE_INPUT_LOCAL_ROOT"
+
+// ObjectStoreSecretPath returns the path, in a pipeline's worker containers,
+// at which the credentials secret of its object store input 'inputName' is
+// mounted.
+func ObjectStoreSecretPath(inputName string) string {
+	return path.Join("/pachyderm-object-store-secrets", inputName)
+}
@@ -0,0 +1,97 @@
+package ppsutil
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestRecordStateTransition(t *testing.T) {
+	transitions := RecordStateTransition(nil, "", "PIPELINE_STARTING", "pipeline created", ActorUser)
+	require.Equal(t, 1, len(transitions))
+	require.Equal(t, "", transitions[0].From)
+	require.Equal(t, "PIPELINE_STARTING", transitions[0].To)
+	require.Equal(t, ActorUser, transitions[0].Actor)
+	require.NotNil(t, transitions[0].Time)
+
+	for i := 0; i < maxStateTransitions+5; i++ {
+		transitions = RecordStateTransition(transitions, "PIPELINE_RUNNING", "PIPELINE_CRASHING", fmt.Sprint(i), ActorMaster)
+	}
+	require.Equal(t, maxStateTransitions, len(transitions))
+	require.Equal(t, "5", transitions[0].Reason)
+	require.Equal(t, fmt.Sprint(maxStateTransitions+4), transitions[len(transitions)-1].Reason)
+}
+
+func TestApprovalReason(t *testing.T) {
+	require.Equal(t, "rejected", ApprovalReason(&pps.JobApproval{}))
+	require.Equal(t, "approved by github:alice", ApprovalReason(&pps.JobApproval{Approved: true, User: "github:alice"}))
+	require.Equal(t, "rejected by robot:ci: metrics regressed",
+		ApprovalReason(&pps.JobApproval{User: "robot:ci", Reason: "metrics regressed"}))
+}
+
+func TestAwaitingApprovalSince(t *testing.T) {
+	ts := func(sec int64) *types.Timestamp { return &types.Timestamp{Seconds: sec} }
+	awaiting := pps.JobState_JOB_AWAITING_APPROVAL.String()
+	require.True(t, AwaitingApprovalSince(nil).IsZero())
+	transitions := []*pps.StateTransition{
+		{From: "", To: pps.JobState_JOB_STARTING.String(), Time: ts(1)},
+		{From: pps.JobState_JOB_MERGING.String(), To: awaiting, Time: ts(2)},
+		// recording the approval doesn't restart the timeout
+		{From: awaiting, To: awaiting, Reason: "approved", Time: ts(3)},
+	}
+	require.True(t, time.Unix(2, 0).Equal(AwaitingApprovalSince(transitions)))
+	require.True(t, AwaitingApprovalSince(transitions[:1]).IsZero())
+}
+
+func TestJobSalt(t *testing.T) {
+	require.Equal(t, "salt", JobSalt("salt", nil))
+	require.Equal(t, "salt", JobSalt("salt", &pps.RunParameters{}))
+	env := &pps.RunParameters{Env: map[string]string{"A": "1", "B": "2"}}
+	require.NotEqual(t, "salt", JobSalt("salt", env))
+	require.Equal(t, JobSalt("salt", env), JobSalt("salt", &pps.RunParameters{Env: map[string]string{"B": "2", "A": "1"}}))
+	require.NotEqual(t, JobSalt("salt", env), JobSalt("salt", &pps.RunParameters{Env: map[string]string{"A": "1", "B": "3"}}))
+	require.NotEqual(t, JobSalt("salt", env), JobSalt("other", env))
+	require.NotEqual(t, JobSalt("salt", env), JobSalt("salt", &pps.RunParameters{Env: env.Env, Salt: "run"}))
+	require.Equal(t, []string{"A=1", "B=2"}, RunParametersEnv(env))
+	require.Equal(t, 0, len(RunParametersEnv(nil)))
+}
+
+func TestSetupTimeout(t *testing.T) {
+	require.Equal(t, 10*time.Minute, SetupTimeout(&pps.Transform{}))
+	require.Equal(t, 5*time.Second, SetupTimeout(&pps.Transform{SetupTimeout: types.DurationProto(5 * time.Second)}))
+}
+
+func TestTeardownTimeout(t *testing.T) {
+	require.Equal(t, time.Minute, TeardownTimeout(&pps.Transform{}))
+	require.Equal(t, 5*time.Second, TeardownTimeout(&pps.Transform{TeardownTimeout: types.DurationProto(5 * time.Second)}))
+}
+
+func TestStaleDatumRecordPrefix(t *testing.T) {
+	changesInput := client.NewPFSInput("images", "/*")
+	changesInput.Pfs.Changes = true
+	prev := &pps.PipelineInfo{
+		Pipeline: client.NewPipeline("edges"),
+		Input:    changesInput,
+		Salt:     "a",
+	}
+	require.Equal(t, "datum-records/edges/a/", DatumRecordSaltPrefix("edges", "a"))
+
+	// The records stay in use while the salt and change lists do
+	next := *prev
+	require.Equal(t, "", StaleDatumRecordPrefix(prev, &next))
+	// Reprocessing changes the salt, and so the records
+	next.Salt = "b"
+	require.Equal(t, "datum-records/edges/a/", StaleDatumRecordPrefix(prev, &next))
+	// Without change lists, the records are never read
+	next = *prev
+	next.Input = client.NewPFSInput("images", "/*")
+	require.Equal(t, "datum-records/edges/a/", StaleDatumRecordPrefix(prev, &next))
+	// A pipeline without change lists has no records
+	require.Equal(t, "", StaleDatumRecordPrefix(&next, prev))
+}
@@ -26,6 +26,13 @@ type GlobalConfiguration struct {
 	SamlPort      uint16 `env:"SAML_PORT,default=654"`
 	OidcPort      uint16 `env:"OIDC_PORT,default=657"`
 
+	// ObjectStoreInputLocalRoot is the directory holding the local buckets that
+	// object store inputs may read: local://<bucket>/<prefix> is read from
+	// <root>/<bucket>/<prefix>, and nothing outside of the root is readable.
+	// It's under the default storage root, which local deployments share with
+	// their workers. Empty disables local object store inputs.
+	ObjectStoreInputLocalRoot string `env:"OBJECT_STORE_INPUT_LOCAL_ROOT,default=/pach/object-store-inputs"`
+
 	// PPSSpecCommitID is only set for workers and sidecar pachd instances.
 	// Because both pachd and worker need to know the spec commit (the worker so
 	// that it can avoid jobs for other versions of the same pipelines and the
@@ -70,6 +77,14 @@ type PachdSpecificConfiguration struct {
 	MetricsEndpoint            string `env:"METRICS_ENDPOINT",default="`
 	// TODO: Merge this with the worker specific pod name (PPS_POD_NAME) into a global configuration pod name.
 	PachdPodName string `env:"PACHD_POD_NAME,required"`
+	// Entries in the datum memoization cache are evicted once they haven't
+	// been used for MemoTTL, or (least recently used first) once there are more
+	// than MemoMaxEntries of them. Zero disables the corresponding limit.
+	MemoTTL        string `env:"MEMO_TTL,default=168h"`
+	MemoMaxEntries int    `env:"MEMO_MAX_ENTRIES,default=100000"`
+	// Upload sessions expire once they haven't received a part for UploadTTL.
+	// Empty disables expiry.
+	UploadTTL string `env:"UPLOAD_TTL,default=24h"`
 }
 
 // StorageConfiguration contains the storage configuration.
@@ -151,11 +151,16 @@ func (m *Range) GetChunkRef() *chunk.DataRef {
 }
 
 type File struct {
-	Parts                []*Part          `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
-	DataRefs             []*chunk.DataRef `protobuf:"bytes,2,rep,name=data_refs,json=dataRefs,proto3" json:"data_refs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Parts    []*Part          `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	DataRefs []*chunk.DataRef `protobuf:"bytes,2,rep,name=data_refs,json=dataRefs,proto3" json:"data_refs,omitempty"`
+	// mode holds the POSIX permission bits of the file, or 0 if they were never
+	// set.
+	Mode uint32 `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	// symlink_target is set if the file is a symbolic link.
+	SymlinkTarget        string   `protobuf:"bytes,4,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *File) Reset()         { *m = File{} }
@@ -205,6 +210,20 @@ func (m *File) GetDataRefs() []*chunk.DataRef {
 	return nil
 }
 
+func (m *File) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *File) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
 type Part struct {
 	Tag                  string           `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	SizeBytes            int64            `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
@@ -280,29 +299,31 @@ func init() {
 }
 
 var fileDescriptor_5610f63adbdd53a8 = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x4a, 0xeb, 0x40,
-	0x14, 0xc7, 0x99, 0x26, 0x29, 0xcd, 0xe9, 0xe5, 0x72, 0x99, 0xc5, 0x25, 0xdc, 0x8b, 0xb5, 0x06,
-	0x17, 0x05, 0x25, 0x01, 0x7d, 0x83, 0x2a, 0x82, 0xbb, 0x3a, 0x0b, 0x17, 0x6e, 0xea, 0x34, 0x39,
-	0xf9, 0xa0, 0xb5, 0x09, 0x33, 0x53, 0xb1, 0x3e, 0xa1, 0x4b, 0x1f, 0x41, 0xfa, 0x24, 0x32, 0x67,
-	0xb2, 0xa8, 0x28, 0xba, 0x39, 0x9c, 0x8f, 0xff, 0x39, 0xbf, 0x7f, 0x3e, 0xe0, 0x54, 0xa3, 0x7a,
-	0x44, 0x95, 0xb6, 0xcb, 0x32, 0xd5, 0xa6, 0x51, 0xb2, 0xc4, 0xb4, 0xa8, 0x57, 0xa8, 0xd1, 0xa4,
-	0xf5, 0x3a, 0xc7, 0x27, 0x17, 0x93, 0x56, 0x35, 0xa6, 0xe1, 0x01, 0x15, 0xff, 0x8e, 0xbf, 0x58,
-	0xca, 0xaa, 0xcd, 0x7a, 0xe9, 0xa2, 0x13, 0xc7, 0xf7, 0x10, 0x5c, 0x5b, 0x39, 0xe7, 0xe0, 0xb7,
-	0xd2, 0x54, 0x11, 0x1b, 0xb3, 0x49, 0x28, 0x28, 0xe7, 0x31, 0x04, 0x4a, 0xae, 0x4b, 0x8c, 0x7a,
-	0x63, 0x36, 0x19, 0x9e, 0xfd, 0x4a, 0x1c, 0x46, 0xd8, 0x9e, 0x70, 0x23, 0x7e, 0x08, 0xbe, 0xb5,
-	0x12, 0x79, 0x24, 0x19, 0x76, 0x92, 0xab, 0x7a, 0x85, 0x82, 0x06, 0x71, 0x0d, 0x01, 0x2d, 0xf0,
-	0xbf, 0xd0, 0x6f, 0x8a, 0x42, 0xa3, 0x21, 0x86, 0x27, 0xba, 0x8a, 0xff, 0x87, 0x70, 0x25, 0xb5,
-	0x99, 0x13, 0xbe, 0x47, 0xf8, 0x81, 0x6d, 0xcc, 0xac, 0x85, 0x13, 0x08, 0xc9, 0xee, 0x5c, 0x61,
-	0xd1, 0x31, 0x7e, 0x27, 0xee, 0x01, 0x2e, 0xa5, 0x91, 0x02, 0x0b, 0x31, 0xa0, 0x52, 0x60, 0x11,
-	0xdf, 0x82, 0x6f, 0xc1, 0xfc, 0x08, 0x82, 0x56, 0x2a, 0xa3, 0x23, 0x36, 0xf6, 0xf6, 0x4c, 0xcd,
-	0xa4, 0x32, 0xc2, 0x4d, 0xec, 0xdd, 0x5c, 0x1a, 0x69, 0xcf, 0xea, 0xa8, 0x47, 0xb2, 0x4f, 0x77,
-	0x73, 0x97, 0xe8, 0x38, 0x07, 0xdf, 0xee, 0xf2, 0x3f, 0xe0, 0x19, 0x59, 0x76, 0xaf, 0xc8, 0xa6,
-	0xfc, 0x00, 0x40, 0xd7, 0xcf, 0x38, 0x5f, 0x6c, 0x0d, 0x6a, 0x32, 0xef, 0x89, 0xd0, 0x76, 0xa6,
-	0xb6, 0xf1, 0x91, 0xe2, 0x7d, 0x4f, 0x99, 0xde, 0xbc, 0xec, 0x46, 0xec, 0x75, 0x37, 0x62, 0x6f,
-	0xbb, 0x11, 0xbb, 0xbb, 0x28, 0x6b, 0x53, 0x6d, 0x16, 0x49, 0xd6, 0x3c, 0xa4, 0xad, 0xcc, 0xaa,
-	0x6d, 0x8e, 0x6a, 0x3f, 0xd3, 0x2a, 0x4b, 0x7f, 0xfa, 0x2d, 0x16, 0x7d, 0xfa, 0xc8, 0xe7, 0xef,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x32, 0x95, 0x53, 0x41, 0x02, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xdd, 0x6a, 0xdb, 0x30,
+	0x14, 0x46, 0xb1, 0x1d, 0xe2, 0x93, 0x25, 0x0c, 0x5d, 0x0c, 0xb3, 0xb1, 0xcc, 0x33, 0x1b, 0x04,
+	0x36, 0x6c, 0xd8, 0xde, 0x20, 0x1b, 0x83, 0xdd, 0xa5, 0xa2, 0x57, 0xbd, 0x71, 0x15, 0xfb, 0xf8,
+	0x87, 0x38, 0xb6, 0x91, 0x94, 0xd2, 0xf4, 0x2d, 0xfa, 0x56, 0xbd, 0xec, 0x23, 0x94, 0x3c, 0x49,
+	0x91, 0xe4, 0x8b, 0x14, 0x42, 0x7b, 0x23, 0xbe, 0xf3, 0x9d, 0x4f, 0xe7, 0xfb, 0x8e, 0x10, 0xfc,
+	0x94, 0x28, 0x6e, 0x50, 0x24, 0xfd, 0xb6, 0x4c, 0xa4, 0xea, 0x04, 0x2f, 0x31, 0x29, 0xea, 0x06,
+	0x25, 0xaa, 0xa4, 0x6e, 0x73, 0xbc, 0xb5, 0x67, 0xdc, 0x8b, 0x4e, 0x75, 0xd4, 0x33, 0xc5, 0xc7,
+	0x6f, 0x67, 0x2e, 0x65, 0xd5, 0xbe, 0xdd, 0xda, 0xd3, 0x8a, 0xa3, 0x6b, 0xf0, 0xfe, 0x6b, 0x39,
+	0xa5, 0xe0, 0xf6, 0x5c, 0x55, 0x01, 0x09, 0xc9, 0xd2, 0x67, 0x06, 0xd3, 0x08, 0x3c, 0xc1, 0xdb,
+	0x12, 0x83, 0x51, 0x48, 0x96, 0xd3, 0x5f, 0xef, 0x62, 0x6b, 0xc3, 0x34, 0xc7, 0x6c, 0x8b, 0x7e,
+	0x01, 0x57, 0x47, 0x09, 0x1c, 0x23, 0x99, 0x0e, 0x92, 0x7f, 0x75, 0x83, 0xcc, 0x34, 0xa2, 0x1a,
+	0x3c, 0x73, 0x81, 0x7e, 0x80, 0x71, 0x57, 0x14, 0x12, 0x95, 0xf1, 0x70, 0xd8, 0x50, 0xd1, 0x4f,
+	0xe0, 0x37, 0x5c, 0xaa, 0xd4, 0xd8, 0x8f, 0x8c, 0xfd, 0x44, 0x13, 0x6b, 0x1d, 0xe1, 0x07, 0xf8,
+	0x26, 0x6e, 0x2a, 0xb0, 0x18, 0x3c, 0xe6, 0xb1, 0x5d, 0xe0, 0x2f, 0x57, 0x9c, 0x61, 0xc1, 0x26,
+	0xa6, 0x64, 0x58, 0x44, 0xf7, 0x04, 0x5c, 0xed, 0x4c, 0xbf, 0x82, 0xd7, 0x73, 0xa1, 0x64, 0x40,
+	0x42, 0xe7, 0x24, 0xd5, 0x9a, 0x0b, 0xc5, 0x6c, 0x47, 0x0f, 0xce, 0xb9, 0xe2, 0x7a, 0xae, 0x0c,
+	0x46, 0xa1, 0x73, 0x6e, 0x70, 0x6e, 0x81, 0xd4, 0x8f, 0xb3, 0xeb, 0x72, 0xbb, 0xe4, 0x8c, 0x19,
+	0x4c, 0xbf, 0xc3, 0x5c, 0x1e, 0x76, 0x4d, 0xdd, 0x6e, 0x53, 0xc5, 0x45, 0x89, 0x2a, 0x70, 0x4d,
+	0xf6, 0xd9, 0xc0, 0x5e, 0x1a, 0x32, 0xca, 0xc1, 0xd5, 0xb6, 0xf4, 0x3d, 0x38, 0x8a, 0x97, 0xc3,
+	0xf3, 0x6a, 0x48, 0x3f, 0x03, 0xc8, 0xfa, 0x0e, 0xd3, 0xcd, 0x41, 0xa1, 0x34, 0x8b, 0x3b, 0xcc,
+	0xd7, 0xcc, 0x4a, 0x13, 0x2f, 0x03, 0x3a, 0xaf, 0x07, 0x5c, 0x5d, 0x3c, 0x1c, 0x17, 0xe4, 0xf1,
+	0xb8, 0x20, 0x4f, 0xc7, 0x05, 0xb9, 0xfa, 0x53, 0xd6, 0xaa, 0xda, 0x6f, 0xe2, 0xac, 0xdb, 0x25,
+	0x3d, 0xcf, 0xaa, 0x43, 0x8e, 0xe2, 0x14, 0x49, 0x91, 0x25, 0x6f, 0x7d, 0xa9, 0xcd, 0xd8, 0x7c,
+	0x90, 0xdf, 0xcf, 0x03, 0x00, 0xca, 0x01, 0x30, 0x65, 0x7d, 0x02, 0x00, 0x00,
 }
 
 func (m *Index) Marshal() (dAtA []byte, err error) {
@@ -438,6 +459,18 @@ func (m *File) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SymlinkTarget) > 0 {
+		i -= len(m.SymlinkTarget)
+		copy(dAtA[i:], m.SymlinkTarget)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Mode != 0 {
+		i = encodeVarintIndex(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.DataRefs) > 0 {
 		for iNdEx := len(m.DataRefs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -598,6 +631,13 @@ func (m *File) Size() (n int) {
 			n += 1 + l + sovIndex(uint64(l))
 		}
 	}
+	if m.Mode != 0 {
+		n += 1 + sovIndex(uint64(m.Mode))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1031,6 +1071,57 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIndex(dAtA[iNdEx:])
@@ -92,11 +92,19 @@ func mergeFile(fss []*fileStream) *index.Index {
 		File: &index.File{},
 	}
 	var ps []*partStream
+	var hasMetadata bool
 	for _, fs := range fss {
 		idx := fs.file.Index()
 		if fs.deletive && idx.File.Parts == nil {
 			break
 		}
+		// The newest additive file with metadata determines the metadata of
+		// the merged file.
+		if !fs.deletive && !hasMetadata && (idx.File.Mode != 0 || idx.File.SymlinkTarget != "") {
+			mergeIdx.File.Mode = idx.File.Mode
+			mergeIdx.File.SymlinkTarget = idx.File.SymlinkTarget
+			hasMetadata = true
+		}
 		ps = append(ps, &partStream{
 			parts:    idx.File.Parts,
 			deletive: fs.deletive,
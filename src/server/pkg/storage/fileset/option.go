@@ -63,6 +63,25 @@ func WithRenewal(ttl time.Duration, r *renew.StringSet) UnorderedWriterOption {
 	}
 }
 
+// WithOverwriteCheck configures the UnorderedWriter to call the provided
+// function with the path of each file before it is overwritten or deleted. If
+// the function returns an error, the write fails with that error.
+func WithOverwriteCheck(check func(string) error) UnorderedWriterOption {
+	return func(uw *UnorderedWriter) {
+		uw.overwriteCheck = check
+	}
+}
+
+// WithReadlink configures the UnorderedWriter to resolve the targets of the
+// symlinks that it writes through the existing symlinks reported by
+// 'readlink', which returns the target of the symlink at a path (or "" if
+// there's no symlink there).
+func WithReadlink(readlink func(string) (string, error)) UnorderedWriterOption {
+	return func(uw *UnorderedWriter) {
+		uw.readlink = readlink
+	}
+}
+
 // WriterOption configures a file set writer.
 type WriterOption func(w *Writer)
 
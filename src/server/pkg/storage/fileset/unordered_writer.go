@@ -4,18 +4,23 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"os"
 	"path"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	ppath "github.com/pachyderm/pachyderm/src/server/pkg/path"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/renew"
 	"github.com/pachyderm/pachyderm/src/server/pkg/tar"
 )
 
 type memFile struct {
-	path  string
-	parts map[string]*memPart
+	path          string
+	parts         map[string]*memPart
+	mode          uint32
+	symlinkTarget string
 }
 
 type memPart struct {
@@ -43,6 +48,14 @@ func (mfs *memFileSet) appendFile(p string, tag string) io.Writer {
 	return mfs.createMemPart(p, tag)
 }
 
+func (mfs *memFileSet) setMetadata(p string, mode uint32, symlinkTarget string) {
+	mf := mfs.additive[p]
+	if mode != 0 {
+		mf.mode = mode
+	}
+	mf.symlinkTarget = symlinkTarget
+}
+
 func (mfs *memFileSet) createMemPart(p string, tag string) *memPart {
 	if _, ok := mfs.additive[p]; !ok {
 		mfs.additive[p] = &memFile{
@@ -89,6 +102,7 @@ func (mfs *memFileSet) serialize(w *Writer) error {
 func (mfs *memFileSet) serializeAdditive(w *Writer) error {
 	for _, mf := range sortMemFiles(mfs.additive) {
 		if err := w.Append(mf.path, func(fw *FileWriter) error {
+			fw.SetMetadata(mf.mode, mf.symlinkTarget)
 			return serializeParts(fw, mf)
 		}); err != nil {
 			return err
@@ -152,6 +166,12 @@ type UnorderedWriter struct {
 	subFileSet                 int64
 	ttl                        time.Duration
 	renewer                    *renew.StringSet
+	overwriteCheck             func(string) error
+	readlink                   func(string) (string, error)
+	// symlinks maps the paths written or deleted by this writer to their
+	// symlink targets ("" if the path is no longer a symlink), so that
+	// symlinks are resolved through the earlier writes.
+	symlinks map[string]string
 }
 
 func newUnorderedWriter(ctx context.Context, storage *Storage, name string, memThreshold int64, defaultTag string, opts ...UnorderedWriterOption) (*UnorderedWriter, error) {
@@ -166,6 +186,7 @@ func newUnorderedWriter(ctx context.Context, storage *Storage, name string, memT
 		name:         name,
 		defaultTag:   defaultTag,
 		memFileSet:   newMemFileSet(),
+		symlinks:     make(map[string]string),
 	}
 	for _, opt := range opts {
 		opt(uw)
@@ -193,11 +214,26 @@ func (uw *UnorderedWriter) Put(r io.Reader, overwrite bool, customTag ...string)
 		if hdr.Typeflag == tar.TypeDir {
 			continue
 		}
+		if hdr.Typeflag == tar.TypeSymlink {
+			if err := ppath.ValidateSymlink(p, hdr.Linkname, uw.resolveLink); err != nil {
+				return err
+			}
+		}
 		// TODO: Tag overwrite?
-		if overwrite {
+		if overwrite || hdr.Typeflag == tar.TypeSymlink {
+			if err := uw.checkOverwrite(p); err != nil {
+				return err
+			}
 			uw.memFileSet.deleteFile(p, "")
+			uw.symlinks[p] = ""
 		}
 		w := uw.memFileSet.appendFile(p, tag)
+		if hdr.Typeflag == tar.TypeSymlink {
+			uw.memFileSet.setMetadata(p, uint32(os.FileMode(hdr.Mode).Perm()), hdr.Linkname)
+			uw.symlinks[p] = hdr.Linkname
+			continue
+		}
+		uw.memFileSet.setMetadata(p, uint32(os.FileMode(hdr.Mode).Perm()), "")
 		for {
 			n, err := io.CopyN(w, tr, uw.memAvailable)
 			uw.memAvailable -= n
@@ -220,13 +256,52 @@ func (uw *UnorderedWriter) Put(r io.Reader, overwrite bool, customTag ...string)
 // Delete deletes a file from the file set.
 // TODO: Directory deletion needs more invariant checks.
 // Right now you have to specify the trailing slash explicitly.
-func (uw *UnorderedWriter) Delete(name string, tags ...string) {
+func (uw *UnorderedWriter) Delete(name string, tags ...string) error {
 	name = Clean(name, IsDir(name))
+	if err := uw.checkOverwrite(name); err != nil {
+		return err
+	}
 	var tag string
 	if len(tag) > 0 {
 		tag = tags[0]
 	}
 	uw.memFileSet.deleteFile(name, tag)
+	if tag == "" {
+		if IsDir(name) {
+			for p := range uw.symlinks {
+				if strings.HasPrefix(p, name) {
+					delete(uw.symlinks, p)
+				}
+			}
+		}
+		uw.symlinks[name] = ""
+	}
+	return nil
+}
+
+// resolveLink returns the target of the symlink at 'p' (or "" if there's no
+// symlink there), taking the earlier writes and deletes of this writer into
+// account before falling back to the configured readlink.
+func (uw *UnorderedWriter) resolveLink(p string) (string, error) {
+	if target, ok := uw.symlinks[p]; ok {
+		return target, nil
+	}
+	for deleted, target := range uw.symlinks {
+		if target == "" && IsDir(deleted) && strings.HasPrefix(p, deleted) {
+			return "", nil
+		}
+	}
+	if uw.readlink == nil {
+		return "", nil
+	}
+	return uw.readlink(p)
+}
+
+func (uw *UnorderedWriter) checkOverwrite(name string) error {
+	if uw.overwriteCheck == nil {
+		return nil
+	}
+	return uw.overwriteCheck(name)
 }
 
 // serialize will be called whenever the in-memory file set is past the memory threshold.
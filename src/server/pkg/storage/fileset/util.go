@@ -54,7 +54,19 @@ func deleteIndex(w *Writer, idx *index.Index) error {
 func WriteTarEntry(w io.Writer, f File) error {
 	idx := f.Index()
 	tw := tar.NewWriter(w)
-	if err := tw.WriteHeader(tarutil.NewHeader(idx.Path, index.SizeBytes(idx))); err != nil {
+	if idx.File.SymlinkTarget != "" {
+		hdr := tarutil.NewHeader(idx.Path, 0)
+		hdr.Typeflag = tar.TypeSymlink
+		hdr.Linkname = idx.File.SymlinkTarget
+		hdr.Mode = int64(idx.File.Mode)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		return tw.Flush()
+	}
+	hdr := tarutil.NewHeader(idx.Path, index.SizeBytes(idx))
+	hdr.Mode = int64(idx.File.Mode)
+	if err := tw.WriteHeader(hdr); err != nil {
 		return err
 	}
 	if err := f.Content(tw); err != nil {
@@ -28,6 +28,12 @@ func (fw *FileWriter) Append(tag string) {
 	fw.idx.File.Parts = append(fw.idx.File.Parts, &index.Part{Tag: tag})
 }
 
+// SetMetadata sets the mode and symlink target of the file.
+func (fw *FileWriter) SetMetadata(mode uint32, symlinkTarget string) {
+	fw.idx.File.Mode = mode
+	fw.idx.File.SymlinkTarget = symlinkTarget
+}
+
 func (fw *FileWriter) Write(data []byte) (int, error) {
 	parts := fw.idx.File.Parts
 	part := parts[len(parts)-1]
@@ -145,7 +151,9 @@ func (w *Writer) Copy(file File) error {
 	copyIdx := &index.Index{
 		Path: idx.Path,
 		File: &index.File{
-			Parts: idx.File.Parts,
+			Parts:         idx.File.Parts,
+			Mode:          idx.File.Mode,
+			SymlinkTarget: idx.File.SymlinkTarget,
 		},
 	}
 	if err := w.nextIdx(copyIdx); err != nil {
@@ -77,6 +77,24 @@ func (p *Puller) makeFile(path string, f func(io.Writer) error) (retErr error) {
 	return nil
 }
 
+func (p *Puller) makeSymlink(path string, target string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.Symlink(target, path)
+}
+
+// PullFunc writes what 'f' writes to a file at 'path'. If 'pipe' is true, it
+// creates a named pipe at 'path' instead, and calls 'f' once the pipe is
+// opened (as Pull does for lazy inputs), in which case an error from 'f' is
+// returned by CleanUp.
+func (p *Puller) PullFunc(path string, pipe bool, f func(io.Writer) error) error {
+	if pipe {
+		return p.makePipe(path, f)
+	}
+	return p.makeFile(path, f)
+}
+
 // Pull clones an entire repo at a certain commit.
 // root is the local path you want to clone to.
 // repo, commit, file specify the file/dir we are pulling.
@@ -109,13 +127,20 @@ func (p *Puller) Pull(client *pachclient.APIClient, root string, repo, commit, f
 					blockRefs = append(blockRefs, objectInfo.BlockRef)
 				}
 				blockRefs = append(blockRefs, fileInfo.BlockRefs...)
-				statsTree.PutFile(statsPath, fileInfo.Hash, int64(fileInfo.SizeBytes), &hashtree.FileNodeProto{BlockRefs: blockRefs})
+				statsTree.PutFile(statsPath, fileInfo.Hash, int64(fileInfo.SizeBytes), &hashtree.FileNodeProto{
+					BlockRefs:     blockRefs,
+					Mode:          fileInfo.Mode,
+					SymlinkTarget: fileInfo.SymlinkTarget,
+				})
 			}
 		}
 		path := filepath.Join(root, basepath)
 		if fileInfo.FileType == pfs.FileType_DIR {
 			return os.MkdirAll(path, 0700)
 		}
+		if fileInfo.FileType == pfs.FileType_SYMLINK {
+			return p.makeSymlink(path, fileInfo.SymlinkTarget)
+		}
 		if pipes {
 			return p.makePipe(path, func(w io.Writer) error {
 				return client.GetFile(repo, commit, fileInfo.File.Path, 0, 0, w)
@@ -127,9 +152,15 @@ func (p *Puller) Pull(client *pachclient.APIClient, root string, repo, commit, f
 		eg.Go(func() (retErr error) {
 			limiter.Acquire()
 			defer limiter.Release()
-			return p.makeFile(path, func(w io.Writer) error {
+			if err := p.makeFile(path, func(w io.Writer) error {
 				return client.GetFile(repo, commit, fileInfo.File.Path, 0, 0, w)
-			})
+			}); err != nil {
+				return err
+			}
+			if fileInfo.Mode != 0 {
+				return os.Chmod(path, os.FileMode(fileInfo.Mode))
+			}
+			return nil
 		})
 		return nil
 	}); err != nil {
@@ -305,6 +336,23 @@ func Push(client *pachclient.APIClient, root string, commit *pfs.Commit, overwri
 				return nil
 			}
 
+			relPath, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+
+			// Symlinks that point inside of root are pushed as symlinks, other
+			// symlinks are pushed as the content they point to.
+			if info.Mode()&os.ModeSymlink != 0 {
+				target, ok, err := LocalSymlinkTarget(root, path)
+				if err != nil {
+					return err
+				}
+				if ok {
+					return client.PutSymlink(commit.Repo.Name, commit.ID, relPath, target)
+				}
+			}
+
 			f, err := os.Open(path)
 			if err != nil {
 				return err
@@ -315,18 +363,13 @@ func Push(client *pachclient.APIClient, root string, commit *pfs.Commit, overwri
 				}
 			}()
 
-			relPath, err := filepath.Rel(root, path)
+			// Stat the opened file, rather than using 'info', so that symlinks
+			// pushed as their content get the mode of their target
+			fileInfo, err := f.Stat()
 			if err != nil {
 				return err
 			}
-
-			if overwrite {
-				if err := client.DeleteFile(commit.Repo.Name, commit.ID, relPath); err != nil {
-					return err
-				}
-			}
-
-			_, err = client.PutFile(commit.Repo.Name, commit.ID, relPath, f)
+			_, err = client.PutFileMode(commit.Repo.Name, commit.ID, relPath, f, overwrite, pfs.FileModeToRecord(fileInfo.Mode().Perm()))
 			return err
 		})
 		return nil
@@ -337,6 +380,26 @@ func Push(client *pachclient.APIClient, root string, commit *pfs.Commit, overwri
 	return g.Wait()
 }
 
+// LocalSymlinkTarget returns the target of the symlink at 'path', relative to
+// the symlink's directory, if it resolves to a location inside of 'root'.
+func LocalSymlinkTarget(root, path string) (string, bool, error) {
+	target, err := os.Readlink(path)
+	if err != nil {
+		return "", false, err
+	}
+	if filepath.IsAbs(target) {
+		target, err = filepath.Rel(filepath.Dir(path), target)
+		if err != nil {
+			return "", false, nil
+		}
+	}
+	rel, err := filepath.Rel(root, filepath.Join(filepath.Dir(path), target))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", false, nil
+	}
+	return filepath.ToSlash(target), true, nil
+}
+
 // PushObj pushes data from commit to an object store.
 func PushObj(pachClient *pachclient.APIClient, commit *pfs.Commit, objClient obj.Client, root string) error {
 	var eg errgroup.Group
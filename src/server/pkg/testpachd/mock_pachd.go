@@ -436,7 +436,13 @@ type createBranchFunc func(context.Context, *pfs.CreateBranchRequest) (*types.Em
 type inspectBranchFunc func(context.Context, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error)
 type listBranchFunc func(context.Context, *pfs.ListBranchRequest) (*pfs.BranchInfos, error)
 type deleteBranchFunc func(context.Context, *pfs.DeleteBranchRequest) (*types.Empty, error)
+type renameRepoFunc func(context.Context, *pfs.RenameRepoRequest) (*types.Empty, error)
+type renameBranchFunc func(context.Context, *pfs.RenameBranchRequest) (*types.Empty, error)
 type putFileFunc func(pfs.API_PutFileServer) error
+type beginUploadFunc func(context.Context, *pfs.BeginUploadRequest) (*pfs.UploadInfo, error)
+type putUploadPartFunc func(pfs.API_PutUploadPartServer) error
+type inspectUploadFunc func(context.Context, *pfs.InspectUploadRequest) (*pfs.UploadInfo, error)
+type completeUploadFunc func(context.Context, *pfs.CompleteUploadRequest) (*types.Empty, error)
 type copyFileFunc func(context.Context, *pfs.CopyFileRequest) (*types.Empty, error)
 type getFileFunc func(*pfs.GetFileRequest, pfs.API_GetFileServer) error
 type inspectFileFunc func(context.Context, *pfs.InspectFileRequest) (*pfs.FileInfo, error)
@@ -447,7 +453,7 @@ type globFileFunc func(context.Context, *pfs.GlobFileRequest) (*pfs.FileInfos, e
 type globFileStreamFunc func(*pfs.GlobFileRequest, pfs.API_GlobFileStreamServer) error
 type diffFileFunc func(context.Context, *pfs.DiffFileRequest) (*pfs.DiffFileResponse, error)
 type deleteFileFunc func(context.Context, *pfs.DeleteFileRequest) (*types.Empty, error)
-type deleteAllPFSFunc func(context.Context, *types.Empty) (*types.Empty, error)
+type deleteAllPFSFunc func(context.Context, *pfs.DeleteAllRequest) (*types.Empty, error)
 type fsckFunc func(*pfs.FsckRequest, pfs.API_FsckServer) error
 type fileOperationFuncV2 func(pfs.API_FileOperationV2Server) error
 type getTarFuncV2 func(*pfs.GetTarRequestV2, pfs.API_GetTarV2Server) error
@@ -473,7 +479,13 @@ type mockCreateBranch struct{ handler createBranchFunc }
 type mockInspectBranch struct{ handler inspectBranchFunc }
 type mockListBranch struct{ handler listBranchFunc }
 type mockDeleteBranch struct{ handler deleteBranchFunc }
+type mockRenameRepo struct{ handler renameRepoFunc }
+type mockRenameBranch struct{ handler renameBranchFunc }
 type mockPutFile struct{ handler putFileFunc }
+type mockBeginUpload struct{ handler beginUploadFunc }
+type mockPutUploadPart struct{ handler putUploadPartFunc }
+type mockInspectUpload struct{ handler inspectUploadFunc }
+type mockCompleteUpload struct{ handler completeUploadFunc }
 type mockCopyFile struct{ handler copyFileFunc }
 type mockGetFile struct{ handler getFileFunc }
 type mockInspectFile struct{ handler inspectFileFunc }
@@ -510,7 +522,13 @@ func (mock *mockCreateBranch) Use(cb createBranchFunc)         { mock.handler =
 func (mock *mockInspectBranch) Use(cb inspectBranchFunc)       { mock.handler = cb }
 func (mock *mockListBranch) Use(cb listBranchFunc)             { mock.handler = cb }
 func (mock *mockDeleteBranch) Use(cb deleteBranchFunc)         { mock.handler = cb }
+func (mock *mockRenameRepo) Use(cb renameRepoFunc)             { mock.handler = cb }
+func (mock *mockRenameBranch) Use(cb renameBranchFunc)         { mock.handler = cb }
 func (mock *mockPutFile) Use(cb putFileFunc)                   { mock.handler = cb }
+func (mock *mockBeginUpload) Use(cb beginUploadFunc)           { mock.handler = cb }
+func (mock *mockPutUploadPart) Use(cb putUploadPartFunc)       { mock.handler = cb }
+func (mock *mockInspectUpload) Use(cb inspectUploadFunc)       { mock.handler = cb }
+func (mock *mockCompleteUpload) Use(cb completeUploadFunc)     { mock.handler = cb }
 func (mock *mockCopyFile) Use(cb copyFileFunc)                 { mock.handler = cb }
 func (mock *mockGetFile) Use(cb getFileFunc)                   { mock.handler = cb }
 func (mock *mockInspectFile) Use(cb inspectFileFunc)           { mock.handler = cb }
@@ -553,7 +571,13 @@ type mockPFSServer struct {
 	InspectBranch    mockInspectBranch
 	ListBranch       mockListBranch
 	DeleteBranch     mockDeleteBranch
+	RenameRepo       mockRenameRepo
+	RenameBranch     mockRenameBranch
 	PutFile          mockPutFile
+	BeginUpload      mockBeginUpload
+	PutUploadPart    mockPutUploadPart
+	InspectUpload    mockInspectUpload
+	CompleteUpload   mockCompleteUpload
 	CopyFile         mockCopyFile
 	GetFile          mockGetFile
 	InspectFile      mockInspectFile
@@ -676,12 +700,48 @@ func (api *pfsServerAPI) DeleteBranch(ctx context.Context, req *pfs.DeleteBranch
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteBranch")
 }
+func (api *pfsServerAPI) RenameRepo(ctx context.Context, req *pfs.RenameRepoRequest) (*types.Empty, error) {
+	if api.mock.RenameRepo.handler != nil {
+		return api.mock.RenameRepo.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RenameRepo")
+}
+func (api *pfsServerAPI) RenameBranch(ctx context.Context, req *pfs.RenameBranchRequest) (*types.Empty, error) {
+	if api.mock.RenameBranch.handler != nil {
+		return api.mock.RenameBranch.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.RenameBranch")
+}
 func (api *pfsServerAPI) PutFile(serv pfs.API_PutFileServer) error {
 	if api.mock.PutFile.handler != nil {
 		return api.mock.PutFile.handler(serv)
 	}
 	return errors.Errorf("unhandled pachd mock pfs.PutFile")
 }
+func (api *pfsServerAPI) BeginUpload(ctx context.Context, req *pfs.BeginUploadRequest) (*pfs.UploadInfo, error) {
+	if api.mock.BeginUpload.handler != nil {
+		return api.mock.BeginUpload.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.BeginUpload")
+}
+func (api *pfsServerAPI) PutUploadPart(serv pfs.API_PutUploadPartServer) error {
+	if api.mock.PutUploadPart.handler != nil {
+		return api.mock.PutUploadPart.handler(serv)
+	}
+	return errors.Errorf("unhandled pachd mock pfs.PutUploadPart")
+}
+func (api *pfsServerAPI) InspectUpload(ctx context.Context, req *pfs.InspectUploadRequest) (*pfs.UploadInfo, error) {
+	if api.mock.InspectUpload.handler != nil {
+		return api.mock.InspectUpload.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.InspectUpload")
+}
+func (api *pfsServerAPI) CompleteUpload(ctx context.Context, req *pfs.CompleteUploadRequest) (*types.Empty, error) {
+	if api.mock.CompleteUpload.handler != nil {
+		return api.mock.CompleteUpload.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pfs.CompleteUpload")
+}
 func (api *pfsServerAPI) CopyFile(ctx context.Context, req *pfs.CopyFileRequest) (*types.Empty, error) {
 	if api.mock.CopyFile.handler != nil {
 		return api.mock.CopyFile.handler(ctx, req)
@@ -742,7 +802,7 @@ func (api *pfsServerAPI) DeleteFile(ctx context.Context, req *pfs.DeleteFileRequ
 	}
 	return nil, errors.Errorf("unhandled pachd mock pfs.DeleteFile")
 }
-func (api *pfsServerAPI) DeleteAll(ctx context.Context, req *types.Empty) (*types.Empty, error) {
+func (api *pfsServerAPI) DeleteAll(ctx context.Context, req *pfs.DeleteAllRequest) (*types.Empty, error) {
 	if api.mock.DeleteAll.handler != nil {
 		return api.mock.DeleteAll.handler(ctx, req)
 	}
@@ -795,17 +855,21 @@ func (api *pfsServerAPI) RenewTmpFileSet(ctx context.Context, req *pfs.RenewTmpF
 
 type createJobFunc func(context.Context, *pps.CreateJobRequest) (*pps.Job, error)
 type inspectJobFunc func(context.Context, *pps.InspectJobRequest) (*pps.JobInfo, error)
+type explainJobFunc func(context.Context, *pps.ExplainJobRequest) (*pps.JobExplanation, error)
 type listJobFunc func(context.Context, *pps.ListJobRequest) (*pps.JobInfos, error)
 type listJobStreamFunc func(*pps.ListJobRequest, pps.API_ListJobStreamServer) error
 type flushJobFunc func(*pps.FlushJobRequest, pps.API_FlushJobServer) error
 type deleteJobFunc func(context.Context, *pps.DeleteJobRequest) (*types.Empty, error)
+type approveJobFunc func(context.Context, *pps.ApproveJobRequest) (*types.Empty, error)
 type stopJobFunc func(context.Context, *pps.StopJobRequest) (*types.Empty, error)
 type updateJobStateFunc func(context.Context, *pps.UpdateJobStateRequest) (*types.Empty, error)
 type inspectDatumFunc func(context.Context, *pps.InspectDatumRequest) (*pps.DatumInfo, error)
 type listDatumFunc func(context.Context, *pps.ListDatumRequest) (*pps.ListDatumResponse, error)
 type listDatumStreamFunc func(*pps.ListDatumRequest, pps.API_ListDatumStreamServer) error
 type restartDatumFunc func(context.Context, *pps.RestartDatumRequest) (*types.Empty, error)
+type traceFileFunc func(context.Context, *pps.TraceFileRequest) (*pps.FileTrace, error)
 type createPipelineFunc func(context.Context, *pps.CreatePipelineRequest) (*types.Empty, error)
+type previewPipelineFunc func(context.Context, *pps.CreatePipelineRequest) (*pps.PipelinePreview, error)
 type inspectPipelineFunc func(context.Context, *pps.InspectPipelineRequest) (*pps.PipelineInfo, error)
 type listPipelineFunc func(context.Context, *pps.ListPipelineRequest) (*pps.PipelineInfos, error)
 type deletePipelineFunc func(context.Context, *pps.DeletePipelineRequest) (*types.Empty, error)
@@ -813,28 +877,35 @@ type startPipelineFunc func(context.Context, *pps.StartPipelineRequest) (*types.
 type stopPipelineFunc func(context.Context, *pps.StopPipelineRequest) (*types.Empty, error)
 type runPipelineFunc func(context.Context, *pps.RunPipelineRequest) (*types.Empty, error)
 type runCronFunc func(context.Context, *pps.RunCronRequest) (*types.Empty, error)
+type rollbackPipelineFunc func(context.Context, *pps.RollbackPipelineRequest) (*types.Empty, error)
 type createSecretFunc func(context.Context, *pps.CreateSecretRequest) (*types.Empty, error)
 type deleteSecretFunc func(context.Context, *pps.DeleteSecretRequest) (*types.Empty, error)
 type inspectSecretFunc func(context.Context, *pps.InspectSecretRequest) (*pps.SecretInfo, error)
 type listSecretFunc func(context.Context, *types.Empty) (*pps.SecretInfos, error)
 type deleteAllPPSFunc func(context.Context, *types.Empty) (*types.Empty, error)
 type getLogsFunc func(*pps.GetLogsRequest, pps.API_GetLogsServer) error
+type listMemoFunc func(context.Context, *pps.ListMemoRequest) (*pps.MemoInfos, error)
+type deleteMemoFunc func(context.Context, *pps.DeleteMemoRequest) (*types.Empty, error)
 type garbageCollectFunc func(context.Context, *pps.GarbageCollectRequest) (*pps.GarbageCollectResponse, error)
 type activateAuthPPSFunc func(context.Context, *pps.ActivateAuthRequest) (*pps.ActivateAuthResponse, error)
 
 type mockCreateJob struct{ handler createJobFunc }
 type mockInspectJob struct{ handler inspectJobFunc }
+type mockExplainJob struct{ handler explainJobFunc }
 type mockListJob struct{ handler listJobFunc }
 type mockListJobStream struct{ handler listJobStreamFunc }
 type mockFlushJob struct{ handler flushJobFunc }
 type mockDeleteJob struct{ handler deleteJobFunc }
+type mockApproveJob struct{ handler approveJobFunc }
 type mockStopJob struct{ handler stopJobFunc }
 type mockUpdateJobState struct{ handler updateJobStateFunc }
 type mockInspectDatum struct{ handler inspectDatumFunc }
 type mockListDatum struct{ handler listDatumFunc }
 type mockListDatumStream struct{ handler listDatumStreamFunc }
 type mockRestartDatum struct{ handler restartDatumFunc }
+type mockTraceFile struct{ handler traceFileFunc }
 type mockCreatePipeline struct{ handler createPipelineFunc }
+type mockPreviewPipeline struct{ handler previewPipelineFunc }
 type mockInspectPipeline struct{ handler inspectPipelineFunc }
 type mockListPipeline struct{ handler listPipelineFunc }
 type mockDeletePipeline struct{ handler deletePipelineFunc }
@@ -842,78 +913,95 @@ type mockStartPipeline struct{ handler startPipelineFunc }
 type mockStopPipeline struct{ handler stopPipelineFunc }
 type mockRunPipeline struct{ handler runPipelineFunc }
 type mockRunCron struct{ handler runCronFunc }
+type mockRollbackPipeline struct{ handler rollbackPipelineFunc }
 type mockCreateSecret struct{ handler createSecretFunc }
 type mockDeleteSecret struct{ handler deleteSecretFunc }
 type mockInspectSecret struct{ handler inspectSecretFunc }
 type mockListSecret struct{ handler listSecretFunc }
 type mockDeleteAllPPS struct{ handler deleteAllPPSFunc }
 type mockGetLogs struct{ handler getLogsFunc }
+type mockListMemo struct{ handler listMemoFunc }
+type mockDeleteMemo struct{ handler deleteMemoFunc }
 type mockGarbageCollect struct{ handler garbageCollectFunc }
 type mockActivateAuthPPS struct{ handler activateAuthPPSFunc }
 
-func (mock *mockCreateJob) Use(cb createJobFunc)             { mock.handler = cb }
-func (mock *mockInspectJob) Use(cb inspectJobFunc)           { mock.handler = cb }
-func (mock *mockListJob) Use(cb listJobFunc)                 { mock.handler = cb }
-func (mock *mockListJobStream) Use(cb listJobStreamFunc)     { mock.handler = cb }
-func (mock *mockFlushJob) Use(cb flushJobFunc)               { mock.handler = cb }
-func (mock *mockDeleteJob) Use(cb deleteJobFunc)             { mock.handler = cb }
-func (mock *mockStopJob) Use(cb stopJobFunc)                 { mock.handler = cb }
-func (mock *mockUpdateJobState) Use(cb updateJobStateFunc)   { mock.handler = cb }
-func (mock *mockInspectDatum) Use(cb inspectDatumFunc)       { mock.handler = cb }
-func (mock *mockListDatum) Use(cb listDatumFunc)             { mock.handler = cb }
-func (mock *mockListDatumStream) Use(cb listDatumStreamFunc) { mock.handler = cb }
-func (mock *mockRestartDatum) Use(cb restartDatumFunc)       { mock.handler = cb }
-func (mock *mockCreatePipeline) Use(cb createPipelineFunc)   { mock.handler = cb }
-func (mock *mockInspectPipeline) Use(cb inspectPipelineFunc) { mock.handler = cb }
-func (mock *mockListPipeline) Use(cb listPipelineFunc)       { mock.handler = cb }
-func (mock *mockDeletePipeline) Use(cb deletePipelineFunc)   { mock.handler = cb }
-func (mock *mockStartPipeline) Use(cb startPipelineFunc)     { mock.handler = cb }
-func (mock *mockStopPipeline) Use(cb stopPipelineFunc)       { mock.handler = cb }
-func (mock *mockRunPipeline) Use(cb runPipelineFunc)         { mock.handler = cb }
-func (mock *mockRunCron) Use(cb runCronFunc)                 { mock.handler = cb }
-func (mock *mockCreateSecret) Use(cb createSecretFunc)       { mock.handler = cb }
-func (mock *mockDeleteSecret) Use(cb deleteSecretFunc)       { mock.handler = cb }
-func (mock *mockInspectSecret) Use(cb inspectSecretFunc)     { mock.handler = cb }
-func (mock *mockListSecret) Use(cb listSecretFunc)           { mock.handler = cb }
-func (mock *mockDeleteAllPPS) Use(cb deleteAllPPSFunc)       { mock.handler = cb }
-func (mock *mockGetLogs) Use(cb getLogsFunc)                 { mock.handler = cb }
-func (mock *mockGarbageCollect) Use(cb garbageCollectFunc)   { mock.handler = cb }
-func (mock *mockActivateAuthPPS) Use(cb activateAuthPPSFunc) { mock.handler = cb }
+func (mock *mockCreateJob) Use(cb createJobFunc)               { mock.handler = cb }
+func (mock *mockInspectJob) Use(cb inspectJobFunc)             { mock.handler = cb }
+func (mock *mockExplainJob) Use(cb explainJobFunc)             { mock.handler = cb }
+func (mock *mockListJob) Use(cb listJobFunc)                   { mock.handler = cb }
+func (mock *mockListJobStream) Use(cb listJobStreamFunc)       { mock.handler = cb }
+func (mock *mockFlushJob) Use(cb flushJobFunc)                 { mock.handler = cb }
+func (mock *mockDeleteJob) Use(cb deleteJobFunc)               { mock.handler = cb }
+func (mock *mockApproveJob) Use(cb approveJobFunc)             { mock.handler = cb }
+func (mock *mockStopJob) Use(cb stopJobFunc)                   { mock.handler = cb }
+func (mock *mockUpdateJobState) Use(cb updateJobStateFunc)     { mock.handler = cb }
+func (mock *mockInspectDatum) Use(cb inspectDatumFunc)         { mock.handler = cb }
+func (mock *mockListDatum) Use(cb listDatumFunc)               { mock.handler = cb }
+func (mock *mockListDatumStream) Use(cb listDatumStreamFunc)   { mock.handler = cb }
+func (mock *mockRestartDatum) Use(cb restartDatumFunc)         { mock.handler = cb }
+func (mock *mockTraceFile) Use(cb traceFileFunc)               { mock.handler = cb }
+func (mock *mockCreatePipeline) Use(cb createPipelineFunc)     { mock.handler = cb }
+func (mock *mockPreviewPipeline) Use(cb previewPipelineFunc)   { mock.handler = cb }
+func (mock *mockInspectPipeline) Use(cb inspectPipelineFunc)   { mock.handler = cb }
+func (mock *mockListPipeline) Use(cb listPipelineFunc)         { mock.handler = cb }
+func (mock *mockDeletePipeline) Use(cb deletePipelineFunc)     { mock.handler = cb }
+func (mock *mockStartPipeline) Use(cb startPipelineFunc)       { mock.handler = cb }
+func (mock *mockStopPipeline) Use(cb stopPipelineFunc)         { mock.handler = cb }
+func (mock *mockRunPipeline) Use(cb runPipelineFunc)           { mock.handler = cb }
+func (mock *mockRunCron) Use(cb runCronFunc)                   { mock.handler = cb }
+func (mock *mockRollbackPipeline) Use(cb rollbackPipelineFunc) { mock.handler = cb }
+func (mock *mockCreateSecret) Use(cb createSecretFunc)         { mock.handler = cb }
+func (mock *mockDeleteSecret) Use(cb deleteSecretFunc)         { mock.handler = cb }
+func (mock *mockInspectSecret) Use(cb inspectSecretFunc)       { mock.handler = cb }
+func (mock *mockListSecret) Use(cb listSecretFunc)             { mock.handler = cb }
+func (mock *mockDeleteAllPPS) Use(cb deleteAllPPSFunc)         { mock.handler = cb }
+func (mock *mockGetLogs) Use(cb getLogsFunc)                   { mock.handler = cb }
+func (mock *mockListMemo) Use(cb listMemoFunc)                 { mock.handler = cb }
+func (mock *mockDeleteMemo) Use(cb deleteMemoFunc)             { mock.handler = cb }
+func (mock *mockGarbageCollect) Use(cb garbageCollectFunc)     { mock.handler = cb }
+func (mock *mockActivateAuthPPS) Use(cb activateAuthPPSFunc)   { mock.handler = cb }
 
 type ppsServerAPI struct {
 	mock *mockPPSServer
 }
 
 type mockPPSServer struct {
-	api             ppsServerAPI
-	CreateJob       mockCreateJob
-	InspectJob      mockInspectJob
-	ListJob         mockListJob
-	ListJobStream   mockListJobStream
-	FlushJob        mockFlushJob
-	DeleteJob       mockDeleteJob
-	StopJob         mockStopJob
-	UpdateJobState  mockUpdateJobState
-	InspectDatum    mockInspectDatum
-	ListDatum       mockListDatum
-	ListDatumStream mockListDatumStream
-	RestartDatum    mockRestartDatum
-	CreatePipeline  mockCreatePipeline
-	InspectPipeline mockInspectPipeline
-	ListPipeline    mockListPipeline
-	DeletePipeline  mockDeletePipeline
-	StartPipeline   mockStartPipeline
-	StopPipeline    mockStopPipeline
-	RunPipeline     mockRunPipeline
-	RunCron         mockRunCron
-	CreateSecret    mockCreateSecret
-	DeleteSecret    mockDeleteSecret
-	InspectSecret   mockInspectSecret
-	ListSecret      mockListSecret
-	DeleteAll       mockDeleteAllPPS
-	GetLogs         mockGetLogs
-	GarbageCollect  mockGarbageCollect
-	ActivateAuth    mockActivateAuthPPS
+	api              ppsServerAPI
+	CreateJob        mockCreateJob
+	InspectJob       mockInspectJob
+	ExplainJob       mockExplainJob
+	ListJob          mockListJob
+	ListJobStream    mockListJobStream
+	FlushJob         mockFlushJob
+	DeleteJob        mockDeleteJob
+	ApproveJob       mockApproveJob
+	StopJob          mockStopJob
+	UpdateJobState   mockUpdateJobState
+	InspectDatum     mockInspectDatum
+	ListDatum        mockListDatum
+	ListDatumStream  mockListDatumStream
+	RestartDatum     mockRestartDatum
+	TraceFile        mockTraceFile
+	CreatePipeline   mockCreatePipeline
+	PreviewPipeline  mockPreviewPipeline
+	InspectPipeline  mockInspectPipeline
+	ListPipeline     mockListPipeline
+	DeletePipeline   mockDeletePipeline
+	StartPipeline    mockStartPipeline
+	StopPipeline     mockStopPipeline
+	RunPipeline      mockRunPipeline
+	RunCron          mockRunCron
+	RollbackPipeline mockRollbackPipeline
+	CreateSecret     mockCreateSecret
+	DeleteSecret     mockDeleteSecret
+	InspectSecret    mockInspectSecret
+	ListSecret       mockListSecret
+	DeleteAll        mockDeleteAllPPS
+	GetLogs          mockGetLogs
+	ListMemo         mockListMemo
+	DeleteMemo       mockDeleteMemo
+	GarbageCollect   mockGarbageCollect
+	ActivateAuth     mockActivateAuthPPS
 }
 
 func (api *ppsServerAPI) CreateJob(ctx context.Context, req *pps.CreateJobRequest) (*pps.Job, error) {
@@ -928,6 +1016,12 @@ func (api *ppsServerAPI) InspectJob(ctx context.Context, req *pps.InspectJobRequ
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.InspectJob")
 }
+func (api *ppsServerAPI) ExplainJob(ctx context.Context, req *pps.ExplainJobRequest) (*pps.JobExplanation, error) {
+	if api.mock.ExplainJob.handler != nil {
+		return api.mock.ExplainJob.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.ExplainJob")
+}
 func (api *ppsServerAPI) ListJob(ctx context.Context, req *pps.ListJobRequest) (*pps.JobInfos, error) {
 	if api.mock.ListJob.handler != nil {
 		return api.mock.ListJob.handler(ctx, req)
@@ -958,6 +1052,12 @@ func (api *ppsServerAPI) UpdateJobState(ctx context.Context, req *pps.UpdateJobS
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.UpdateJobState")
 }
+func (api *ppsServerAPI) ApproveJob(ctx context.Context, req *pps.ApproveJobRequest) (*types.Empty, error) {
+	if api.mock.ApproveJob.handler != nil {
+		return api.mock.ApproveJob.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.ApproveJob")
+}
 func (api *ppsServerAPI) StopJob(ctx context.Context, req *pps.StopJobRequest) (*types.Empty, error) {
 	if api.mock.StopJob.handler != nil {
 		return api.mock.StopJob.handler(ctx, req)
@@ -988,12 +1088,24 @@ func (api *ppsServerAPI) RestartDatum(ctx context.Context, req *pps.RestartDatum
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.RestartDatum")
 }
+func (api *ppsServerAPI) TraceFile(ctx context.Context, req *pps.TraceFileRequest) (*pps.FileTrace, error) {
+	if api.mock.TraceFile.handler != nil {
+		return api.mock.TraceFile.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.TraceFile")
+}
 func (api *ppsServerAPI) CreatePipeline(ctx context.Context, req *pps.CreatePipelineRequest) (*types.Empty, error) {
 	if api.mock.CreatePipeline.handler != nil {
 		return api.mock.CreatePipeline.handler(ctx, req)
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.CreatePipeline")
 }
+func (api *ppsServerAPI) PreviewPipeline(ctx context.Context, req *pps.CreatePipelineRequest) (*pps.PipelinePreview, error) {
+	if api.mock.PreviewPipeline.handler != nil {
+		return api.mock.PreviewPipeline.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.PreviewPipeline")
+}
 func (api *ppsServerAPI) InspectPipeline(ctx context.Context, req *pps.InspectPipelineRequest) (*pps.PipelineInfo, error) {
 	if api.mock.InspectPipeline.handler != nil {
 		return api.mock.InspectPipeline.handler(ctx, req)
@@ -1036,6 +1148,12 @@ func (api *ppsServerAPI) RunCron(ctx context.Context, req *pps.RunCronRequest) (
 	}
 	return nil, errors.Errorf("unhandled pachd mock pps.RunCron")
 }
+func (api *ppsServerAPI) RollbackPipeline(ctx context.Context, req *pps.RollbackPipelineRequest) (*types.Empty, error) {
+	if api.mock.RollbackPipeline.handler != nil {
+		return api.mock.RollbackPipeline.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.RollbackPipeline")
+}
 func (api *ppsServerAPI) CreateSecret(ctx context.Context, req *pps.CreateSecretRequest) (*types.Empty, error) {
 	if api.mock.CreateSecret.handler != nil {
 		return api.mock.CreateSecret.handler(ctx, req)
@@ -1072,6 +1190,18 @@ func (api *ppsServerAPI) GetLogs(req *pps.GetLogsRequest, serv pps.API_GetLogsSe
 	}
 	return errors.Errorf("unhandled pachd mock pps.GetLogs")
 }
+func (api *ppsServerAPI) ListMemo(ctx context.Context, req *pps.ListMemoRequest) (*pps.MemoInfos, error) {
+	if api.mock.ListMemo.handler != nil {
+		return api.mock.ListMemo.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.ListMemo")
+}
+func (api *ppsServerAPI) DeleteMemo(ctx context.Context, req *pps.DeleteMemoRequest) (*types.Empty, error) {
+	if api.mock.DeleteMemo.handler != nil {
+		return api.mock.DeleteMemo.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.DeleteMemo")
+}
 func (api *ppsServerAPI) GarbageCollect(ctx context.Context, req *pps.GarbageCollectRequest) (*pps.GarbageCollectResponse, error) {
 	if api.mock.GarbageCollect.handler != nil {
 		return api.mock.GarbageCollect.handler(ctx, req)
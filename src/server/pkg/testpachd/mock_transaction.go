@@ -3,6 +3,7 @@ package testpachd
 import (
 	"fmt"
 
+	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
 )
@@ -18,6 +19,16 @@ func (mock *mockUpdateJobStateInTransaction) Use(cb updateJobStateInTransactionF
 	mock.handler = cb
 }
 
+type updatePipelineSpecInTransactionFunc func(*txnenv.TransactionContext, string, *pfs.Commit, *pfs.Object) error
+
+type mockUpdatePipelineSpecInTransaction struct {
+	handler updatePipelineSpecInTransactionFunc
+}
+
+func (mock *mockUpdatePipelineSpecInTransaction) Use(cb updatePipelineSpecInTransactionFunc) {
+	mock.handler = cb
+}
+
 type ppsTransactionAPI struct {
 	mock *MockPPSTransactionServer
 }
@@ -25,8 +36,9 @@ type ppsTransactionAPI struct {
 // MockPPSTransactionServer provides a mocking interface for overriding PPS
 // behavior inside transactions.
 type MockPPSTransactionServer struct {
-	api                         ppsTransactionAPI
-	UpdateJobStateInTransaction mockUpdateJobStateInTransaction
+	api                             ppsTransactionAPI
+	UpdateJobStateInTransaction     mockUpdateJobStateInTransaction
+	UpdatePipelineSpecInTransaction mockUpdatePipelineSpecInTransaction
 }
 
 func (api *ppsTransactionAPI) UpdateJobStateInTransaction(txnCtx *txnenv.TransactionContext, req *pps.UpdateJobStateRequest) error {
@@ -36,6 +48,13 @@ func (api *ppsTransactionAPI) UpdateJobStateInTransaction(txnCtx *txnenv.Transac
 	return fmt.Errorf("unhandled pachd mock: pps.UpdateJobStateInTransaction")
 }
 
+func (api *ppsTransactionAPI) UpdatePipelineSpecInTransaction(txnCtx *txnenv.TransactionContext, pipeline string, prevSpecCommit *pfs.Commit, specTree *pfs.Object) error {
+	if api.mock.UpdatePipelineSpecInTransaction.handler != nil {
+		return api.mock.UpdatePipelineSpecInTransaction.handler(txnCtx, pipeline, prevSpecCommit, specTree)
+	}
+	return fmt.Errorf("unhandled pachd mock: pps.UpdatePipelineSpecInTransaction")
+}
+
 // NewMockPPSTransactionServer instantiates a MockPPSTransactionServer
 func NewMockPPSTransactionServer() *MockPPSTransactionServer {
 	result := &MockPPSTransactionServer{}
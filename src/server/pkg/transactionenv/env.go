@@ -73,6 +73,9 @@ type TransactionContext struct {
 	Stm           col.STM
 	pfsPropagater PfsPropagater
 	txnEnv        *TransactionEnv
+	// onCommit is shared (rather than copied) by copies of the context, such
+	// as the sudo contexts that PFS derives from it
+	onCommit *[]func()
 }
 
 // Auth returns a reference to the Auth API Server so that transactionally-
@@ -89,6 +92,13 @@ func (t *TransactionContext) Pfs() PfsTransactionServer {
 	return t.txnEnv.pfsServer
 }
 
+// Pps returns a reference to the PPS API Server so that transactionally-
+// supported methods can be called across the API boundary without using RPCs
+// (which will not maintain transactional guarantees)
+func (t *TransactionContext) Pps() PpsTransactionServer {
+	return t.txnEnv.ppsServer
+}
+
 // PropagateCommit saves a branch to be propagated at the end of the transaction
 // (if all operations complete successfully).  This is used to batch together
 // propagations and dedupe downstream commits in PFS.
@@ -96,6 +106,13 @@ func (t *TransactionContext) PropagateCommit(branch *pfs.Branch, isNewCommit boo
 	return t.pfsPropagater.PropagateCommit(branch, isNewCommit)
 }
 
+// OnCommit registers 'f' to be called once the transaction has been
+// committed. Callbacks registered by attempts of the STM that are retried, or
+// by read-only transactions, are dropped.
+func (t *TransactionContext) OnCommit(f func()) {
+	*t.onCommit = append(*t.onCommit, f)
+}
+
 func (t *TransactionContext) finish() error {
 	return t.pfsPropagater.Run()
 }
@@ -146,6 +163,7 @@ type PfsTransactionServer interface {
 // methods that can be called through the PPS server.
 type PpsTransactionServer interface {
 	UpdateJobStateInTransaction(*TransactionContext, *pps.UpdateJobStateRequest) error
+	UpdatePipelineSpecInTransaction(txnCtx *TransactionContext, pipeline string, prevSpecCommit *pfs.Commit, specTree *pfs.Object) error
 }
 
 // TransactionEnv contains the APIServer instances for each subsystem that may
@@ -344,14 +362,17 @@ func (env *TransactionEnv) WithTransaction(ctx context.Context, cb func(Transact
 // WithWriteContext will call the given callback with a TransactionContext
 // which can be used to perform reads and writes on the current cluster state.
 func (env *TransactionEnv) WithWriteContext(ctx context.Context, cb func(*TransactionContext) error) error {
+	var onCommit []func()
 	_, err := col.NewSTM(ctx, env.serviceEnv.GetEtcdClient(), func(stm col.STM) error {
 		pachClient := env.serviceEnv.GetPachClient(ctx)
+		onCommit = nil
 		txnCtx := &TransactionContext{
 			Client:        pachClient,
 			ClientContext: pachClient.Ctx(),
 			Stm:           stm,
 			pfsPropagater: env.pfsServer.NewPropagater(stm),
 			txnEnv:        env,
+			onCommit:      &onCommit,
 		}
 
 		err := cb(txnCtx)
@@ -360,7 +381,13 @@ func (env *TransactionEnv) WithWriteContext(ctx context.Context, cb func(*Transa
 		}
 		return txnCtx.finish()
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	for _, f := range onCommit {
+		f()
+	}
+	return nil
 }
 
 // WithReadContext will call the given callback with a TransactionContext
@@ -375,6 +402,7 @@ func (env *TransactionEnv) WithReadContext(ctx context.Context, cb func(*Transac
 			Stm:           stm,
 			pfsPropagater: env.pfsServer.NewPropagater(stm),
 			txnEnv:        env,
+			onCommit:      &[]func(){},
 		}
 
 		err := cb(txnCtx)
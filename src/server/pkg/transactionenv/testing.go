@@ -132,3 +132,8 @@ func NewMockPpsTransactionServer() *MockPpsTransactionServer {
 func (mpts *MockPpsTransactionServer) UpdateJobStateInTransaction(*TransactionContext, *pps.UpdateJobStateRequest) error {
 	return unimplementedError("PpsTransactionServer.UpdateJobStateInTransaction")
 }
+
+// UpdatePipelineSpecInTransaction always errors
+func (mpts *MockPpsTransactionServer) UpdatePipelineSpecInTransaction(*TransactionContext, string, *pfs.Commit, *pfs.Object) error {
+	return unimplementedError("PpsTransactionServer.UpdatePipelineSpecInTransaction")
+}
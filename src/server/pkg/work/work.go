@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sync"
 	"sync/atomic"
 
 	etcd "github.com/coreos/etcd/clientv3"
@@ -251,6 +252,36 @@ func (tq *TaskQueue) deleteAllTasks() error {
 	return err
 }
 
+// QueueStats summarizes the subtasks in a task queue.
+type QueueStats struct {
+	// Outstanding is the number of subtasks that haven't been processed yet,
+	// including those currently being processed.
+	Outstanding int64
+	// Done is the number of subtasks that have been processed (successfully or
+	// not) by tasks that are still running.
+	Done int64
+}
+
+// Stats returns the QueueStats of the task queue in 'taskNamespace'. It only
+// reads from etcd, so it can be called by processes that aren't part of the
+// queue, e.g. to decide how many workers the queue needs.
+func Stats(ctx context.Context, etcdClient *etcd.Client, etcdPrefix string, taskNamespace string) (*QueueStats, error) {
+	stats := &QueueStats{}
+	subtaskInfo := &TaskInfo{}
+	subtaskCol := newTaskEtcd(etcdClient, etcdPrefix, taskNamespace).subtaskCol
+	if err := subtaskCol.ReadOnly(ctx).List(subtaskInfo, col.DefaultOptions, func(string) error {
+		if subtaskInfo.State == State_RUNNING {
+			stats.Outstanding++
+		} else {
+			stats.Done++
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
 // Worker is a worker that will process subtasks in a task.
 // A worker watches the task collection for tasks to be created / deleted and appropriately
 // runs / deletes tasks in the internal task queue with a function that watches the
@@ -259,6 +290,9 @@ func (tq *TaskQueue) deleteAllTasks() error {
 // in the task.
 type Worker struct {
 	*taskEtcd
+	mu       sync.Mutex
+	draining bool
+	active   sync.WaitGroup
 }
 
 // NewWorker creates a new worker.
@@ -266,6 +300,38 @@ func NewWorker(etcdClient *etcd.Client, etcdPrefix string, taskNamespace string)
 	return &Worker{taskEtcd: newTaskEtcd(etcdClient, etcdPrefix, taskNamespace)}
 }
 
+// Drain stops the worker from claiming any more subtasks, and waits until the
+// subtasks that it has already claimed are processed (or 'ctx' is done).
+// Unclaimed subtasks are left for other workers.
+func (w *Worker) Drain(ctx context.Context) error {
+	w.mu.Lock()
+	w.draining = true
+	w.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		w.active.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// startSubtask reports whether the worker may claim a subtask, and if so
+// counts it as active until the caller calls w.active.Done().
+func (w *Worker) startSubtask() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.draining {
+		return false
+	}
+	w.active.Add(1)
+	return true
+}
+
 // ProcessFunc is a callback that is used for processing a subtask in a task.
 type ProcessFunc func(context.Context, *Task) error
 
@@ -333,6 +399,10 @@ func (w *Worker) taskFunc(task *Task, taskEntry *taskEntry, processFunc ProcessF
 
 func (w *Worker) subtaskFunc(subtaskKey string, processFunc ProcessFunc) subtaskFunc {
 	return func(ctx context.Context) {
+		if !w.startSubtask() {
+			return
+		}
+		defer w.active.Done()
 		if err := func() error {
 			// (bryce) this should be refactored to have the check and claim in the same stm.
 			// there is a rare race condition that does not affect correctness, but it is less
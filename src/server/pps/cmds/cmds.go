@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	pachdclient "github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -28,6 +29,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/tabwriter"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/server/pps/pretty"
+	"github.com/pachyderm/pachyderm/src/server/worker/local"
 
 	prompt "github.com/c-bata/go-prompt"
 	units "github.com/docker/go-units"
@@ -105,7 +107,30 @@ If the job fails, the output commit will not be populated with data.`,
 	}
 	commands = append(commands, cmdutil.CreateDocsAlias(jobDocs, "job", " job$"))
 
+	// events is set by 'inspect job --events' and 'inspect pipeline --events',
+	// which print the job's or pipeline's state transitions
+	var events bool
+	printStateTransitions := func(transitions []*ppsclient.StateTransition) error {
+		if raw {
+			e := encoder(output)
+			for _, transition := range transitions {
+				if err := e.EncodeProto(transition); err != nil {
+					return err
+				}
+			}
+			return nil
+		} else if output != "" {
+			cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+		}
+		writer := tabwriter.NewWriter(os.Stdout, pretty.StateTransitionHeader)
+		for _, transition := range transitions {
+			pretty.PrintStateTransition(writer, transition, fullTimestamps)
+		}
+		return writer.Flush()
+	}
+
 	var block bool
+	var explain bool
 	inspectJob := &cobra.Command{
 		Use:   "{{alias}} <job>",
 		Short: "Return info about a job.",
@@ -116,6 +141,24 @@ If the job fails, the output commit will not be populated with data.`,
 				return err
 			}
 			defer client.Close()
+			if explain {
+				if block {
+					if _, err := client.InspectJob(args[0], true); err != nil {
+						return err
+					}
+				}
+				explanation, err := client.ExplainJob(args[0])
+				if err != nil {
+					return err
+				}
+				if raw {
+					return encoder(output).EncodeProto(explanation)
+				} else if output != "" {
+					cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+				}
+				pretty.PrintJobExplanation(os.Stdout, explanation)
+				return nil
+			}
 			jobInfo, err := client.InspectJob(args[0], block, true)
 			if err != nil {
 				cmdutil.ErrorAndExit("error from InspectJob: %s", err.Error())
@@ -123,6 +166,9 @@ If the job fails, the output commit will not be populated with data.`,
 			if jobInfo == nil {
 				cmdutil.ErrorAndExit("job %s not found.", args[0])
 			}
+			if events {
+				return printStateTransitions(jobInfo.Transitions)
+			}
 			if raw {
 				return encoder(output).EncodeProto(jobInfo)
 			} else if output != "" {
@@ -136,6 +182,8 @@ If the job fails, the output commit will not be populated with data.`,
 		}),
 	}
 	inspectJob.Flags().BoolVarP(&block, "block", "b", false, "block until the job has either succeeded or failed")
+	inspectJob.Flags().BoolVar(&explain, "explain", false, "Explain why the job ran: which of its inputs changed since its parent job, whether the pipeline's spec changed, and how many of its datums were new, changed or skipped.")
+	inspectJob.Flags().BoolVar(&events, "events", false, "Print the job's recent state transitions.")
 	inspectJob.Flags().AddFlagSet(outputFlags)
 	inspectJob.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(inspectJob, shell.JobCompletion)
@@ -336,6 +384,41 @@ $ {{alias}} foo@XXX -p bar -p baz`,
 	shell.RegisterCompletionFunc(stopJob, shell.JobCompletion)
 	commands = append(commands, cmdutil.CreateAlias(stopJob, "stop job"))
 
+	var approvalReason string
+	approveJob := &cobra.Command{
+		Use:   "{{alias}} <job>",
+		Short: "Approve a job that is awaiting approval.",
+		Long:  "Approve a job that is awaiting approval. The job's output commit is finished, and downstream pipelines process it.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.ApproveJob(args[0], approvalReason)
+		}),
+	}
+	approveJob.Flags().StringVar(&approvalReason, "reason", "", "Why the job is approved, recorded with the job.")
+	shell.RegisterCompletionFunc(approveJob, shell.JobCompletion)
+	commands = append(commands, cmdutil.CreateAlias(approveJob, "approve job"))
+
+	rejectJob := &cobra.Command{
+		Use:   "{{alias}} <job>",
+		Short: "Reject a job that is awaiting approval.",
+		Long:  "Reject a job that is awaiting approval. The job fails, and its output is discarded.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.RejectJob(args[0], approvalReason)
+		}),
+	}
+	rejectJob.Flags().StringVar(&approvalReason, "reason", "", "Why the job is rejected, recorded with the job.")
+	shell.RegisterCompletionFunc(rejectJob, shell.JobCompletion)
+	commands = append(commands, cmdutil.CreateAlias(rejectJob, "reject job"))
+
 	datumDocs := &cobra.Command{
 		Short: "Docs for datums.",
 		Long: `Datums are the small independent units of processing for Pachyderm jobs.
@@ -467,12 +550,54 @@ each datum.`,
 	inspectDatum.Flags().AddFlagSet(outputFlags)
 	commands = append(commands, cmdutil.CreateAlias(inspectDatum, "inspect datum"))
 
+	var recursive bool
+	traceFile := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/to/file>",
+		Short: "Return the job, datum and input files that wrote a file.",
+		Long:  "Return the job, datum and input files that wrote a file in a pipeline's output repo. With --recursive, input files that were written by other pipelines are traced too, back to source repos.",
+		Example: `
+		# Find the datum that wrote "out.csv" in the HEAD commit of the "model" repo
+		$ {{alias}} model@master:/out.csv
+
+		# Trace "out.csv" back through all upstream pipelines
+		$ {{alias}} model@master:/out.csv --recursive`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			file, err := cmdutil.ParseFile(args[0])
+			if err != nil {
+				return err
+			}
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			trace, err := client.TraceFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, recursive)
+			if err != nil {
+				return err
+			}
+			if raw {
+				return encoder(output).EncodeProto(trace)
+			} else if output != "" {
+				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+			}
+			pretty.PrintFileTrace(os.Stdout, trace)
+			return nil
+		}),
+	}
+	traceFile.Flags().BoolVarP(&recursive, "recursive", "r", false, "Trace the input files that were written by other pipelines too.")
+	traceFile.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(traceFile, "trace file"))
+
 	var (
 		jobID       string
 		datumID     string
 		commaInputs string // comma-separated list of input files of interest
 		master      bool
 		worker      bool
+		workerPod   string
+		since       time.Duration
+		grep        string
+		level       string
 		follow      bool
 		tail        int64
 	)
@@ -514,7 +639,10 @@ $ {{alias}} --pipeline=filter
 $ {{alias}} --job=aedfa12aedf
 
 # Return logs emitted by the pipeline \"filter\" while processing /apple.txt and a file with the hash 123aef
-$ {{alias}} --pipeline=filter --inputs=/apple.txt,123aef`,
+$ {{alias}} --pipeline=filter --inputs=/apple.txt,123aef
+
+# Return logs containing "ERROR" emitted in the last hour by one worker of the "filter" pipeline
+$ {{alias}} --pipeline=filter --since 1h --grep ERROR --worker-pod <pod>`,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
 			client, err := pachdclient.NewOnUserMachine("user")
 			if err != nil {
@@ -536,7 +664,30 @@ $ {{alias}} --pipeline=filter --inputs=/apple.txt,123aef`,
 			}
 
 			// Issue RPC
-			iter := client.GetLogs(pipelineName, jobID, data, datumID, master, follow, tail)
+			request := &ppsclient.GetLogsRequest{
+				DataFilters: data,
+				Master:      master,
+				Follow:      follow,
+				Tail:        tail,
+				Grep:        grep,
+				Level:       level,
+				Worker:      workerPod,
+			}
+			if pipelineName != "" {
+				request.Pipeline = pachdclient.NewPipeline(pipelineName)
+			}
+			if jobID != "" {
+				request.Job = pachdclient.NewJob(jobID)
+			}
+			if datumID != "" {
+				request.Datum = &ppsclient.Datum{Job: pachdclient.NewJob(jobID), ID: datumID}
+			}
+			if since > 0 {
+				if request.Since, err = types.TimestampProto(time.Now().Add(-since)); err != nil {
+					return err
+				}
+			}
+			iter := client.GetLogsWithFilters(request)
 			var buf bytes.Buffer
 			encoder := json.NewEncoder(&buf)
 			for iter.Next() {
@@ -570,6 +721,10 @@ $ {{alias}} --pipeline=filter --inputs=/apple.txt,123aef`,
 		"generated while processing these files (accepts PFS paths or file hashes)")
 	getLogs.Flags().BoolVar(&master, "master", false, "Return log messages from the master process (pipeline must be set).")
 	getLogs.Flags().BoolVar(&worker, "worker", false, "Return log messages from the worker process.")
+	getLogs.Flags().StringVar(&workerPod, "worker-pod", "", "Filter for log lines from the worker with this ID, which is the name of its pod (as shown by 'inspect job'). This isn't --worker, which returns the logs of worker processes rather than of user code.")
+	getLogs.Flags().DurationVar(&since, "since", 0, "Return log lines from no longer ago than this (e.g. 1h).")
+	getLogs.Flags().StringVar(&grep, "grep", "", "Filter for log lines matching this regular expression.")
+	getLogs.Flags().StringVar(&level, "level", "", "Filter for log lines at this level or above (debug, info, warning or error).")
 	getLogs.Flags().BoolVar(&raw, "raw", false, "Return log messages verbatim from server.")
 	getLogs.Flags().BoolVarP(&follow, "follow", "f", false, "Follow logs as more are created.")
 	getLogs.Flags().Int64VarP(&tail, "tail", "t", 0, "Lines of recent logs to display.")
@@ -608,7 +763,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 		Short: "Create a new pipeline.",
 		Long:  "Create a new pipeline from a pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(false, build, pushImages, registry, username, pipelinePath, false)
+			return pipelineHelper(false, build, pushImages, registry, username, pipelinePath, false, false)
 		}),
 	}
 	createPipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
@@ -619,11 +774,12 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	commands = append(commands, cmdutil.CreateAlias(createPipeline, "create pipeline"))
 
 	var reprocess bool
+	var dryRun bool
 	updatePipeline := &cobra.Command{
 		Short: "Update an existing Pachyderm pipeline.",
 		Long:  "Update a Pachyderm pipeline with a new pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(reprocess, build, pushImages, registry, username, pipelinePath, true)
+			return pipelineHelper(reprocess, build, pushImages, registry, username, pipelinePath, true, dryRun)
 		}),
 	}
 	updatePipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
@@ -632,12 +788,44 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	updatePipeline.Flags().StringVarP(&registry, "registry", "r", "index.docker.io", "The registry to push images to.")
 	updatePipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as.")
 	updatePipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
+	updatePipeline.Flags().BoolVar(&dryRun, "dry-run", false, "If true, print the changes to the pipeline's spec, the datums that would be reprocessed and the ACL changes, without updating the pipeline.")
 	commands = append(commands, cmdutil.CreateAlias(updatePipeline, "update pipeline"))
 
+	var toVersion uint64
+	rollbackPipeline := &cobra.Command{
+		Use:   "{{alias}} <pipeline>",
+		Short: "Roll back a pipeline to a previous version of its spec.",
+		Long:  "Roll back a pipeline to a previous version of its spec, by re-applying that version as a new version of the pipeline. Use 'list pipeline <pipeline> --history all' to see the available versions and how they differ.",
+		Example: `
+		# Roll back the pipeline "filter" to version 3
+		$ {{alias}} filter --to-version 3
+
+		# Roll back the pipeline "filter" to version 3, and reprocess all of its datums
+		$ {{alias}} filter --to-version 3 --reprocess`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			if toVersion == 0 {
+				return errors.New("--to-version must be set")
+			}
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.RollbackPipeline(args[0], toVersion, reprocess)
+		}),
+	}
+	rollbackPipeline.Flags().Uint64Var(&toVersion, "to-version", 0, "The version of the pipeline's spec to roll back to.")
+	rollbackPipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by the current version of the pipeline.")
+	commands = append(commands, cmdutil.CreateAlias(rollbackPipeline, "rollback pipeline"))
+
+	var runLocal bool
+	var inputDir, outputDir, outputBranch string
+	var runEnv []string
+	var runSalt string
 	runPipeline := &cobra.Command{
 		Use:   "{{alias}} <pipeline> [<repo>@[<branch>|<commit>|<branch>=<commit>]...]",
 		Short: "Run an existing Pachyderm pipeline on the specified commits-branch pairs.",
-		Long:  "Run a Pachyderm pipeline on the datums from specific commit-branch pairs. If you only specify a branch, Pachyderm uses the HEAD commit to complete the pair. Similarly, if you only specify a commit, Pachyderm will try to use the branch the commit originated on. Note: Pipelines run automatically when data is committed to them. This command is for the case where you want to run the pipeline on a specific set of data, or if you want to rerun the pipeline. The datums that were successfully processed in previous runs will not be processed unless you specify the --reprocess flag.",
+		Long:  "Run a Pachyderm pipeline on the datums from specific commit-branch pairs. If you only specify a branch, Pachyderm uses the HEAD commit to complete the pair. Similarly, if you only specify a commit, Pachyderm will try to use the branch the commit originated on. Note: Pipelines run automatically when data is committed to them. This command is for the case where you want to run the pipeline on a specific set of data, or if you want to rerun the pipeline. The datums that were successfully processed in previous runs will not be processed unless you specify the --reprocess flag.\n\nWith --local, the first argument is instead a pipeline spec, whose transform command is run as a local process for each of its datums (read from the cluster, or from --input-dir). This is meant for testing pipeline code, and exits with an error if any datum fails.",
 		Example: `
 		# Rerun the latest job for the "filter" pipeline
 		$ {{alias}} filter
@@ -652,9 +840,21 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 		$ {{alias}} filter repo1@testing
 
 		# Run the pipeline "filter" on the commit "af159e which originated on the "master" branch on repo "repo1"
-		$ {{alias}} filter repo1@af159`,
+		$ {{alias}} filter repo1@af159
+
+		# Rerun the latest job for the "filter" pipeline, with THRESHOLD=10 set in its environment
+		$ {{alias}} filter --env THRESHOLD=10
+
+		# Run the code of the pipeline in filter.json locally, on the data in ./data/<repo>, writing its output to ./out
+		$ {{alias}} --local filter.json --input-dir data --output-dir out`,
 
 		Run: cmdutil.RunMinimumArgs(1, func(args []string) (retErr error) {
+			if runLocal {
+				if len(args) != 1 {
+					return errors.New("--local takes exactly one argument, the pipeline spec")
+				}
+				return runPipelineLocal(args[0], inputDir, outputDir, outputBranch)
+			}
 			client, err := pachdclient.NewOnUserMachine("user")
 			if err != nil {
 				return err
@@ -664,7 +864,11 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if err != nil {
 				return err
 			}
-			err = client.RunPipeline(args[0], prov, jobID)
+			parameters, err := parseRunParameters(runEnv, runSalt)
+			if err != nil {
+				return err
+			}
+			err = client.RunPipelineWithParameters(args[0], prov, jobID, parameters)
 			if err != nil {
 				return err
 			}
@@ -672,6 +876,12 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 		}),
 	}
 	runPipeline.Flags().StringVar(&jobID, "job", "", "rerun the given job")
+	runPipeline.Flags().StringArrayVar(&runEnv, "env", []string{}, "An env var (as <name>=<value>) to set for the pipeline's code in this run, overriding the pipeline's own. Can be repeated. Datums processed by runs with different env vars aren't skipped.")
+	runPipeline.Flags().StringVar(&runSalt, "salt", "", "A salt for this run. Datums processed by runs with different salts aren't skipped.")
+	runPipeline.Flags().BoolVar(&runLocal, "local", false, "Run the code of the pipeline spec given as the first argument locally, instead of running a pipeline in the cluster.")
+	runPipeline.Flags().StringVar(&inputDir, "input-dir", "", "With --local, read each input repo from <input-dir>/<repo> rather than from the cluster.")
+	runPipeline.Flags().StringVar(&outputDir, "output-dir", "", "With --local, write the output of all datums to this directory, which must be empty or not exist.")
+	runPipeline.Flags().StringVar(&outputBranch, "output-branch", "", "With --local, put the output of all datums in a commit on this branch of the pipeline's output repo, if they all succeed.")
 	commands = append(commands, cmdutil.CreateAlias(runPipeline, "run pipeline"))
 
 	runCron := &cobra.Command{
@@ -713,6 +923,9 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if pipelineInfo == nil {
 				return errors.Errorf("pipeline %s not found", args[0])
 			}
+			if events {
+				return printStateTransitions(pipelineInfo.Transitions)
+			}
 			if raw {
 				return encoder(output).EncodeProto(pipelineInfo)
 			} else if output != "" {
@@ -725,6 +938,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			return pretty.PrintDetailedPipelineInfo(os.Stdout, pi)
 		}),
 	}
+	inspectPipeline.Flags().BoolVar(&events, "events", false, "Print the pipeline's recent state transitions.")
 	inspectPipeline.Flags().AddFlagSet(outputFlags)
 	inspectPipeline.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(inspectPipeline, "inspect pipeline"))
@@ -885,6 +1099,26 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 					break
 				}
 			}
+			if history != 0 {
+				// Versions of each pipeline are listed newest first, so each
+				// version's changes are relative to the version after it
+				writer := tabwriter.NewWriter(os.Stdout, pretty.PipelineHistoryHeader)
+				for i, pipelineInfo := range pipelineInfos {
+					var changes []*ppsclient.SpecChange
+					if i+1 < len(pipelineInfos) && pipelineInfos[i+1].Pipeline.Name == pipelineInfo.Pipeline.Name &&
+						pipelineInfo.Transform != nil && pipelineInfos[i+1].Transform != nil {
+						changes, err = ppsutil.SpecChanges(
+							ppsutil.PipelineReqFromInfo(pipelineInfos[i+1]),
+							ppsutil.PipelineReqFromInfo(pipelineInfo),
+						)
+						if err != nil {
+							return err
+						}
+					}
+					pretty.PrintPipelineHistoryInfo(writer, pipelineInfo, changes, fullTimestamps)
+				}
+				return writer.Flush()
+			}
 			writer := tabwriter.NewWriter(os.Stdout, pretty.PipelineHeader)
 			for _, pipelineInfo := range pipelineInfos {
 				pretty.PrintPipelineInfo(writer, pipelineInfo, fullTimestamps)
@@ -895,7 +1129,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	listPipeline.Flags().BoolVarP(&spec, "spec", "s", false, "Output 'create pipeline' compatibility specs.")
 	listPipeline.Flags().AddFlagSet(outputFlags)
 	listPipeline.Flags().AddFlagSet(fullTimestampsFlags)
-	listPipeline.Flags().StringVar(&history, "history", "none", "Return revision history for pipelines.")
+	listPipeline.Flags().StringVar(&history, "history", "none", "Return revision history for pipelines, along with the fields of the spec that changed in each version.")
 	listPipeline.Flags().StringArrayVar(&stateStrs, "state", []string{}, "Return only pipelines with the specified state. Can be repeated to include multiple states")
 	commands = append(commands, cmdutil.CreateAlias(listPipeline, "list pipeline"))
 
@@ -1097,6 +1331,80 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	}
 	commands = append(commands, cmdutil.CreateAlias(listSecret, "list secret"))
 
+	memoDocs := &cobra.Command{
+		Short: "Docs for memoized datums.",
+		Long: `Pipelines with "memoize" set share the outputs of their datums through a
+cluster-wide cache. A datum whose inputs, image and transform match an entry in
+the cache isn't processed again; the cached output is reused instead.
+
+Entries are evicted when they haven't been used for some time, or when the
+cache is full. They can also be deleted with "pachctl delete memo".`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(memoDocs, "memo", " memo$"))
+
+	var memoPipeline string
+	listMemo := &cobra.Command{
+		Short: "Return the entries in the datum memoization cache.",
+		Long:  "Return the entries in the datum memoization cache, optionally only those created by one pipeline.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			memoInfos, err := client.ListMemo(memoPipeline)
+			if err != nil {
+				return err
+			}
+			if raw {
+				e := encoder(output)
+				for _, memoInfo := range memoInfos {
+					if err := e.EncodeProto(memoInfo); err != nil {
+						return err
+					}
+				}
+				return nil
+			} else if output != "" {
+				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.MemoHeader)
+			for _, memoInfo := range memoInfos {
+				pretty.PrintMemoInfo(writer, memoInfo)
+			}
+			return writer.Flush()
+		}),
+	}
+	listMemo.Flags().StringVarP(&memoPipeline, "pipeline", "p", "", "Only list entries created by this pipeline.")
+	listMemo.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(listMemo, "list memo"))
+
+	var allMemos bool
+	deleteMemo := &cobra.Command{
+		Use:   "{{alias}} [<key>]",
+		Short: "Delete entries from the datum memoization cache.",
+		Long:  "Delete one entry, the entries created by a pipeline, or all entries from the datum memoization cache.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) (retErr error) {
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			switch {
+			case len(args) == 1 && memoPipeline == "" && !allMemos:
+				return client.DeleteMemo(args[0])
+			case len(args) == 0 && memoPipeline != "" && !allMemos:
+				return client.DeletePipelineMemos(memoPipeline)
+			case len(args) == 0 && memoPipeline == "" && allMemos:
+				return client.DeleteAllMemos()
+			default:
+				return errors.Errorf("exactly one of a key, --pipeline and --all must be set")
+			}
+		}),
+	}
+	deleteMemo.Flags().StringVarP(&memoPipeline, "pipeline", "p", "", "Delete the entries created by this pipeline.")
+	deleteMemo.Flags().BoolVar(&allMemos, "all", false, "Delete all entries.")
+	commands = append(commands, cmdutil.CreateAlias(deleteMemo, "delete memo"))
+
 	var memory string
 	garbageCollect := &cobra.Command{
 		Short: "Garbage collect unused data.",
@@ -1112,6 +1420,8 @@ collection with "pachctl garbage-collect".
 
 Currently "pachctl garbage-collect" can only be started when there are no
 pipelines running.  You also need to ensure that there's no ongoing "put file".
+This includes unfinished "put file --resumable" uploads, whose parts are
+removed (as are those of uploads that expired without finishing).
 Garbage collection puts the cluster into a readonly mode where no new jobs can
 be created and no data can be added.
 
@@ -1142,7 +1452,10 @@ you can increase the amount of memory used for the bloom filters with the
 	return commands
 }
 
-func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, username, pipelinePath string, update bool) error {
+func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, username, pipelinePath string, update bool, dryRun bool) error {
+	if dryRun && (build || pushImages) {
+		return errors.New("--dry-run can't be used with --build or --push-images")
+	}
 	if build && pushImages {
 		logrus.Warning("`--push-images` is redundant, as it's already enabled with `--build`")
 	}
@@ -1184,6 +1497,14 @@ func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, usern
 			request.Update = true
 			request.Reprocess = reprocess
 		}
+		if dryRun {
+			preview, err := pc.PreviewPipeline(request)
+			if err != nil {
+				return err
+			}
+			pretty.PrintPipelinePreview(os.Stdout, preview)
+			continue
+		}
 
 		isLocal := true
 		url, err := url.Parse(pipelinePath)
@@ -1261,6 +1582,84 @@ func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, usern
 	return nil
 }
 
+// runPipelineLocal runs the code of the pipeline in the spec at 'pipelinePath'
+// locally, for 'run pipeline --local'
+func runPipelineLocal(pipelinePath, inputDir, outputDir, outputBranch string) error {
+	pipelineReader, err := ppsutil.NewPipelineManifestReader(pipelinePath)
+	if err != nil {
+		return err
+	}
+	request, err := pipelineReader.NextCreatePipelineRequest()
+	if err != nil {
+		return err
+	}
+
+	var pc *pachdclient.APIClient
+	if inputDir != "" {
+		if outputBranch != "" {
+			return errors.New("--output-branch can't be used with --input-dir")
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if pc, err = local.ServeDir(ctx, inputDir); err != nil {
+			return err
+		}
+	} else {
+		if pc, err = pachdclient.NewOnUserMachine("user"); err != nil {
+			return errors.Wrapf(err, "error connecting to pachd")
+		}
+		defer pc.Close()
+	}
+
+	result, err := local.Run(pc, request, &local.Options{
+		OutputDir:    outputDir,
+		OutputBranch: outputBranch,
+		Logs:         os.Stderr,
+	})
+	if err != nil {
+		return err
+	}
+	writer := tabwriter.NewWriter(os.Stdout, pretty.DatumHeader)
+	for _, d := range result.Datums {
+		pretty.PrintDatumInfo(writer, d.DatumInfo())
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	for _, d := range result.Datums {
+		if d.Err != nil {
+			fmt.Fprintf(os.Stderr, "datum %s failed: %v\n", d.ID, d.Err)
+		}
+	}
+	if result.OutputCommit != nil {
+		fmt.Printf("output: %s@%s\n", result.OutputCommit.Repo.Name, result.OutputCommit.ID)
+	}
+	if n := result.Failed(); n > 0 {
+		return errors.Errorf("%d of %d datums failed", n, len(result.Datums))
+	}
+	return nil
+}
+
+// parseRunParameters parses the --env and --salt flags of 'run pipeline' into
+// the parameters of the run (nil if neither is set)
+func parseRunParameters(envStrs []string, salt string) (*ppsclient.RunParameters, error) {
+	if len(envStrs) == 0 && salt == "" {
+		return nil, nil
+	}
+	parameters := &ppsclient.RunParameters{Salt: salt}
+	for _, envStr := range envStrs {
+		kv := strings.SplitN(envStr, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, errors.Errorf("invalid env var %q, must be of the form <name>=<value>", envStr)
+		}
+		if parameters.Env == nil {
+			parameters.Env = make(map[string]string)
+		}
+		parameters.Env[kv[0]] = kv[1]
+	}
+	return parameters, nil
+}
+
 func dockerBuildHelper(request *ppsclient.CreatePipelineRequest, build bool, registry, username, pipelineParentPath string) error {
 	// create docker client
 	dockerClient, err := docker.NewClientFromEnv()
@@ -619,6 +619,54 @@ func TestEditPipeline(t *testing.T) {
 		`).Run())
 }
 
+func TestRollbackPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	require.NoError(t, tu.BashCmd(`
+		yes | pachctl delete all
+	`).Run())
+	createPipeline := `
+		pachctl {{.action}} pipeline <<EOF
+		  pipeline:
+		    name: my-pipeline
+		  input:
+		    pfs:
+		      glob: /*
+		      repo: data
+		  transform:
+		    cmd: [ /bin/bash ]
+		    stdin:
+		      - "{{.stdin}}"
+		EOF
+		`
+	require.NoError(t, tu.BashCmd(`pachctl create repo data`).Run())
+	require.NoError(t, tu.BashCmd(createPipeline,
+		"action", "create", "stdin", "cp /pfs/data/* /pfs/out").Run())
+	require.NoError(t, tu.BashCmd(createPipeline,
+		"action", "update", "stdin", "echo updated >/pfs/out/file").Run())
+
+	// Rolling back re-applies version 1 as version 3
+	require.NoError(t, tu.BashCmd(`
+		pachctl rollback pipeline my-pipeline --to-version 1
+		pachctl list pipeline my-pipeline | match 'my-pipeline.*3'
+		pachctl inspect pipeline my-pipeline --raw \
+		| match 'cp /pfs/data/\* /pfs/out' \
+		| match -v 'echo updated'
+		pachctl list pipeline my-pipeline --history all | match 'my-pipeline.*2'
+		`).Run())
+
+	// The version to roll back to must be given, and must exist
+	require.NoError(t, tu.BashCmd(`
+		( pachctl rollback pipeline my-pipeline 2>&1 || true ) \
+		| match 'to-version must be set'
+		( pachctl rollback pipeline my-pipeline --to-version 10 2>&1 || true ) \
+		| match 'has no version 10'
+		( pachctl rollback pipeline my-pipeline --to-version 3 2>&1 || true ) \
+		| match 'already at version 3'
+		`).Run())
+}
+
 func TestPipelineBuildLifecyclePython(t *testing.T) {
 	if os.Getenv("RUN_BAD_TESTS") == "" {
 		t.Skip("Skipping because RUN_BAD_TESTS was empty")
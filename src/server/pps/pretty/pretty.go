@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	units "github.com/docker/go-units"
 	"github.com/fatih/color"
@@ -17,20 +19,29 @@ import (
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 )
 
 const (
 	// PipelineHeader is the header for pipelines.
 	PipelineHeader = "NAME\tVERSION\tINPUT\tCREATED\tSTATE / LAST JOB\tDESCRIPTION\t\n"
+	// PipelineHistoryHeader is the header for historical versions of pipelines.
+	PipelineHistoryHeader = "NAME\tVERSION\tINPUT\tCREATED\tCHANGES\t\n"
 	// JobHeader is the header for jobs
-	JobHeader = "ID\tPIPELINE\tSTARTED\tDURATION\tRESTART\tPROGRESS\tDL\tUL\tSTATE\t\n"
+	JobHeader = "ID\tPIPELINE\tSTARTED\tDURATION\tRESTART\tPROGRESS\tDL\tUL\tSTATE\tTRIGGER\t\n"
 	// DatumHeader is the header for datums
 	DatumHeader = "ID\tFILES\tSTATUS\tTIME\t\n"
 	// SecretHeader is the header for secrets
 	SecretHeader = "NAME\tTYPE\tCREATED\t\n"
+	// MemoHeader is the header for memoized datums
+	MemoHeader = "KEY\tPIPELINE\tJOB\tSIZE\tCREATED\tLAST USED\tHITS\t\n"
+	// StateTransitionHeader is the header for pipeline and job state transitions
+	StateTransitionHeader = "TIME\tFROM\tTO\tACTOR\tREASON\t\n"
 	// jobReasonLen is the amount of the job reason that we print
 	jobReasonLen = 25
+	// jobTriggerLen is the amount of the job trigger that we print
+	jobTriggerLen = 40
 )
 
 func safeTrim(s string, l int) string {
@@ -63,6 +74,11 @@ func PrintJobInfo(w io.Writer, jobInfo *ppsclient.JobInfo, fullTimestamps bool)
 	} else {
 		fmt.Fprintf(w, "%s\t", JobState(jobInfo.State))
 	}
+	if jobInfo.Trigger != "" {
+		fmt.Fprintf(w, "%s\t", safeTrim(jobInfo.Trigger, jobTriggerLen))
+	} else {
+		fmt.Fprintf(w, "-\t")
+	}
 	fmt.Fprintln(w)
 }
 
@@ -90,6 +106,37 @@ func PrintPipelineInfo(w io.Writer, pipelineInfo *ppsclient.PipelineInfo, fullTi
 	fmt.Fprintln(w)
 }
 
+// PrintPipelineHistoryInfo pretty-prints a historical version of a pipeline,
+// along with the fields of its spec that 'changes' says differ from the
+// previous version.
+func PrintPipelineHistoryInfo(w io.Writer, pipelineInfo *ppsclient.PipelineInfo, changes []*ppsclient.SpecChange, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t", pipelineInfo.Pipeline.Name)
+	fmt.Fprintf(w, "%d\t", pipelineInfo.Version)
+	if pipelineInfo.Transform == nil {
+		fmt.Fprint(w, "-\t")
+		fmt.Fprint(w, "-\t")
+		fmt.Fprint(w, "could not retrieve pipeline spec\t")
+		fmt.Fprintln(w)
+		return
+	}
+	fmt.Fprintf(w, "%s\t", ShorthandInput(pipelineInfo.Input))
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", pipelineInfo.CreatedAt.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(pipelineInfo.CreatedAt))
+	}
+	if len(changes) == 0 {
+		fmt.Fprint(w, "-\t")
+	} else {
+		var fields []string
+		for _, change := range changes {
+			fields = append(fields, change.Field)
+		}
+		fmt.Fprintf(w, "%s\t", strings.Join(fields, ", "))
+	}
+	fmt.Fprintln(w)
+}
+
 // PrintWorkerStatusHeader pretty prints a worker status header.
 func PrintWorkerStatusHeader(w io.Writer) {
 	fmt.Fprint(w, "WORKER\tJOB\tDATUM\tSTARTED\tQUEUE\t\n")
@@ -131,12 +178,15 @@ func PrintDetailedJobInfo(jobInfo *PrintableJobInfo) error {
 	template, err := template.New("JobInfo").Funcs(funcMap).Parse(
 		`ID: {{.Job.ID}} {{if .Pipeline}}
 Pipeline: {{.Pipeline.Name}} {{end}} {{if .ParentJob}}
-Parent: {{.ParentJob.ID}} {{end}}{{if .FullTimestamps}}
+Parent: {{.ParentJob.ID}} {{end}}{{if .Trigger}}
+Trigger: {{.Trigger}} {{end}}{{if .Parameters}}
+Run Parameters: {{runParameters .Parameters}} {{end}}{{if .FullTimestamps}}
 Started: {{.Started}}{{else}}
 Started: {{prettyAgo .Started}} {{end}}{{if .Finished}}
 Duration: {{prettyTimeDifference .Started .Finished}} {{end}}
 State: {{jobState .State}}
-Reason: {{.Reason}}
+Reason: {{.Reason}}{{if .Approval}}
+Approval: {{jobApproval .Approval}}{{end}}
 Processed: {{.DataProcessed}}
 Failed: {{.DataFailed}}
 Skipped: {{.DataSkipped}}
@@ -171,7 +221,8 @@ ParallelismSpec: {{.ParallelismSpec}}
 Transform:
 {{prettyTransform .Transform}} {{if .OutputCommit}}
 Output Commit: {{.OutputCommit.ID}} {{end}} {{ if .StatsCommit }}
-Stats Commit: {{.StatsCommit.ID}} {{end}} {{ if .Egress }}
+Stats Commit: {{.StatsCommit.ID}} {{end}} {{ if .NamedOutputCommits }}
+Named Output Commits: {{namedOutputCommits .NamedOutputCommits}} {{end}} {{ if .Egress }}
 Egress: {{.Egress.URL}} {{end}}
 `)
 	if err != nil {
@@ -223,11 +274,14 @@ Datum Timeout: {{.DatumTimeout}}
 Job Timeout: {{.JobTimeout}}
 Input:
 {{pipelineInput .PipelineInfo}}
-{{ if .GithookURL }}Githook URL: {{.GithookURL}} {{end}}
+{{ if .NextCronTicks }}Next Cron Ticks:
+{{cronTicks .NextCronTicks}}{{end}}{{ if .GithookURL }}Githook URL: {{.GithookURL}} {{end}}
 Output Branch: {{.OutputBranch}}
-Transform:
+{{ if .Outputs }}Named Outputs: {{pipelineOutputs .Outputs}}
+{{end}}Transform:
 {{prettyTransform .Transform}}
 {{ if .Egress }}Egress: {{.Egress.URL}} {{end}}
+{{ if .Approval }}Approval: {{pipelineApproval .Approval}} {{end}}
 {{if .RecentError}} Recent Error: {{.RecentError}} {{end}}
 Job Counts:
 {{jobCounts .JobCounts}}
@@ -325,6 +379,138 @@ func PrintSecretInfo(w io.Writer, secretInfo *ppsclient.SecretInfo) {
 	fmt.Fprintf(w, "%s\t%s\t%s\t\n", secretInfo.Secret.Name, secretInfo.Type, pretty.Ago(secretInfo.CreationTimestamp))
 }
 
+// PrintMemoInfo pretty-prints an entry in the datum memoization cache.
+func PrintMemoInfo(w io.Writer, memoInfo *ppsclient.MemoInfo) {
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t\n", memoInfo.Key, memoInfo.Pipeline.Name,
+		memoInfo.Job.ID, pretty.Size(memoInfo.SizeBytes), pretty.Ago(memoInfo.Created),
+		pretty.Ago(memoInfo.LastUsed), memoInfo.Hits)
+}
+
+// PrintStateTransition pretty-prints a pipeline or job state transition.
+func PrintStateTransition(w io.Writer, transition *ppsclient.StateTransition, fullTimestamps bool) {
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", transition.Time.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(transition.Time))
+	}
+	from := transition.From
+	if from == "" {
+		from = "-"
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t", from, transition.To, transition.Actor)
+	if transition.Reason == "" {
+		fmt.Fprint(w, "-\t")
+	} else {
+		fmt.Fprintf(w, "%s\t", transition.Reason)
+	}
+	fmt.Fprintln(w)
+}
+
+// PrintJobExplanation pretty-prints why a job ran
+func PrintJobExplanation(w io.Writer, explanation *ppsclient.JobExplanation) {
+	fmt.Fprintf(w, "Job: %s\n", explanation.Job.ID)
+	if explanation.ParentJob != nil {
+		fmt.Fprintf(w, "Parent: %s\n", explanation.ParentJob.ID)
+	} else {
+		fmt.Fprintln(w, "Parent: none")
+	}
+	fmt.Fprintf(w, "Trigger: %s\n", explanation.Trigger)
+	if explanation.SaltChanged {
+		fmt.Fprintln(w, "Spec: changed, with a new salt (all datums reprocessed)")
+	} else if explanation.SpecChanged {
+		fmt.Fprintln(w, "Spec: changed")
+	} else {
+		fmt.Fprintln(w, "Spec: unchanged")
+	}
+	if len(explanation.InputChanges) == 0 {
+		fmt.Fprintln(w, "Inputs: unchanged")
+	} else {
+		fmt.Fprintln(w, "Inputs:")
+		for _, change := range explanation.InputChanges {
+			old, new := "(none)", "(none)"
+			if change.OldCommit != nil {
+				old = change.OldCommit.ID
+			}
+			if change.NewCommit != nil {
+				new = change.NewCommit.ID
+			}
+			fmt.Fprintf(w, "  %s@%s: %s -> %s\n", change.Branch.Repo.Name, change.Branch.Name, old, new)
+		}
+	}
+	fmt.Fprintf(w, "Datums: %d total, %d new, %d changed, %d unchanged, %d skipped, %d recovered\n",
+		explanation.DatumsTotal, explanation.DatumsNew, explanation.DatumsChanged, explanation.DatumsUnchanged, explanation.DatumsSkipped, explanation.DatumsRecovered)
+}
+
+// PrintFileTrace pretty-prints the datums that wrote a file, with each
+// datum's inputs (and their traces, if any) indented below it
+func PrintFileTrace(w io.Writer, trace *ppsclient.FileTrace) {
+	printFileTrace(w, trace, "")
+}
+
+func printFileTrace(w io.Writer, trace *ppsclient.FileTrace, indent string) {
+	fmt.Fprintf(w, "%s%s@%s:%s\n", indent, trace.File.Commit.Repo.Name, trace.File.Commit.ID, trace.File.Path)
+	if len(trace.Datums) == 0 {
+		fmt.Fprintf(w, "%s  (source data)\n", indent)
+	}
+	for _, datum := range trace.Datums {
+		fmt.Fprintf(w, "%s  datum %s (job %s)\n", indent, datum.Datum.ID, datum.Datum.Job.ID)
+		upstream := make(map[string][]*ppsclient.FileTrace)
+		for _, u := range datum.Upstream {
+			upstream[u.File.Commit.ID] = append(upstream[u.File.Commit.ID], u)
+		}
+		for _, input := range datum.Inputs {
+			file := input.File
+			fmt.Fprintf(w, "%s    input %s@%s:%s\n", indent, file.Commit.Repo.Name, file.Commit.ID, file.Path)
+			for _, u := range upstream[file.Commit.ID] {
+				if strings.HasPrefix(u.File.Path, strings.TrimSuffix(file.Path, "/")+"/") || u.File.Path == file.Path {
+					printFileTrace(w, u, indent+"      ")
+				}
+			}
+		}
+	}
+}
+
+// PrintPipelinePreview pretty-prints what creating or updating a pipeline
+// would change
+func PrintPipelinePreview(w io.Writer, preview *ppsclient.PipelinePreview) {
+	if preview.Update {
+		fmt.Fprintf(w, "Pipeline %s would be updated.\n", preview.Pipeline.Name)
+	} else {
+		fmt.Fprintf(w, "Pipeline %s would be created.\n", preview.Pipeline.Name)
+	}
+	if preview.AuthError != "" {
+		fmt.Fprintf(w, "Not authorized: %s\n", preview.AuthError)
+	}
+	if len(preview.SpecChanges) > 0 {
+		fmt.Fprintln(w, "Spec changes:")
+		for _, change := range preview.SpecChanges {
+			old, new := change.Old, change.New
+			if old == "" {
+				old = "(unset)"
+			}
+			if new == "" {
+				new = "(unset)"
+			}
+			fmt.Fprintf(w, "  %s: %s -> %s\n", change.Field, old, new)
+		}
+	} else if preview.Update {
+		fmt.Fprintln(w, "Spec changes: none")
+	}
+	for _, branch := range preview.AddedInputs {
+		fmt.Fprintf(w, "New input: %s@%s\n", branch.Repo.Name, branch.Name)
+	}
+	for _, branch := range preview.RemovedInputs {
+		fmt.Fprintf(w, "Removed input: %s@%s\n", branch.Repo.Name, branch.Name)
+	}
+	for _, change := range preview.AclChanges {
+		fmt.Fprintf(w, "ACL change (if auth is active): pipeline gets %s on %s\n", change.Scope, change.Repo)
+	}
+	if preview.DatumHashesChanged {
+		fmt.Fprintln(w, "Datum hashes change: all datums will be reprocessed")
+	}
+	fmt.Fprintf(w, "Datums: %d total, %d to process, %d skipped\n", preview.DatumsTotal, preview.DatumsProcessed, preview.DatumsSkipped)
+}
+
 // PrintFileHeader prints the header for a pfs file.
 func PrintFileHeader(w io.Writer) {
 	fmt.Fprintf(w, "  REPO\tCOMMIT\tPATH\t\n")
@@ -366,6 +552,8 @@ func JobState(jobState ppsclient.JobState) string {
 		return color.New(color.FgRed).SprintFunc()("killed")
 	case ppsclient.JobState_JOB_EGRESSING:
 		return color.New(color.FgYellow).SprintFunc()("egressing")
+	case ppsclient.JobState_JOB_AWAITING_APPROVAL:
+		return color.New(color.FgYellow).SprintFunc()("awaiting approval")
 
 	}
 	return "-"
@@ -455,6 +643,12 @@ func ShorthandInput(input *ppsclient.Input) string {
 	case input == nil:
 		return "none"
 	case input.Pfs != nil:
+		switch input.Pfs.JoinMode {
+		case ppsclient.JoinMode_JOIN_SEMI:
+			return fmt.Sprintf("%s:%s (semi)", input.Pfs.Repo, input.Pfs.Glob)
+		case ppsclient.JoinMode_JOIN_ANTI:
+			return fmt.Sprintf("%s:%s (anti)", input.Pfs.Repo, input.Pfs.Glob)
+		}
 		return fmt.Sprintf("%s:%s", input.Pfs.Repo, input.Pfs.Glob)
 	case input.Cross != nil:
 		var subInput []string
@@ -481,7 +675,18 @@ func ShorthandInput(input *ppsclient.Input) string {
 		}
 		return "(" + strings.Join(subInput, " ∪ ") + ")"
 	case input.Cron != nil:
+		if input.Cron.Timezone != "" {
+			return fmt.Sprintf("%s:%s (%s)", input.Cron.Name, input.Cron.Spec, input.Cron.Timezone)
+		}
 		return fmt.Sprintf("%s:%s", input.Cron.Name, input.Cron.Spec)
+	case input.Window != nil:
+		if input.Window.Duration != nil {
+			d, _ := types.DurationFromProto(input.Window.Duration)
+			return fmt.Sprintf("%s:last %s", input.Window.Repo, d)
+		}
+		return fmt.Sprintf("%s:last %d commits", input.Window.Repo, input.Window.Commits)
+	case input.ObjectStore != nil:
+		return fmt.Sprintf("%s:%s", input.ObjectStore.Name, input.ObjectStore.URL)
 	}
 	return ""
 }
@@ -499,4 +704,99 @@ var funcMap = template.FuncMap{
 	"prettySize":           pretty.Size,
 	"jobCounts":            jobCounts,
 	"prettyTransform":      prettyTransform,
+	"cronTicks":            cronTicks,
+	"jobApproval":          jobApproval,
+	"pipelineApproval":     pipelineApproval,
+	"runParameters":        runParameters,
+	"namedOutputCommits":   namedOutputCommits,
+	"pipelineOutputs":      pipelineOutputs,
+}
+
+// namedOutputCommits formats the named output commits of a job, e.g.
+// "metrics=<commit ID> models=<commit ID>"
+func namedOutputCommits(commits map[string]*pfsclient.Commit) string {
+	var names []string
+	for name := range commits {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var result []string
+	for _, name := range names {
+		result = append(result, fmt.Sprintf("%s=%s", name, commits[name].ID))
+	}
+	return strings.Join(result, " ")
+}
+
+// pipelineOutputs formats the named outputs of a pipeline, e.g.
+// "metrics (branch: edges@metrics) models (branch: models@master)"
+func pipelineOutputs(outputs []*ppsclient.Output) string {
+	var result []string
+	for _, output := range outputs {
+		result = append(result, fmt.Sprintf("%s (branch: %s@%s)", output.Name, output.Repo, output.Branch))
+	}
+	return strings.Join(result, " ")
+}
+
+// runParameters formats the parameters that a job was run with, e.g.
+// "MODE=fast THRESHOLD=10 (salt: abc)"
+func runParameters(parameters *ppsclient.RunParameters) string {
+	result := strings.Join(ppsutil.RunParametersEnv(parameters), " ")
+	if parameters.Salt != "" {
+		if result != "" {
+			result += " "
+		}
+		result += fmt.Sprintf("(salt: %s)", parameters.Salt)
+	}
+	return result
+}
+
+// jobApproval formats the approval or rejection of a job, e.g. "approved by
+// github:alice 2 hours ago: looks good"
+func jobApproval(approval *ppsclient.JobApproval) string {
+	result := "rejected"
+	if approval.Approved {
+		result = "approved"
+	}
+	if approval.User != "" {
+		result += " by " + approval.User
+	}
+	if approval.Time != nil {
+		result += " " + pretty.Ago(approval.Time)
+	}
+	if approval.Reason != "" {
+		result += ": " + approval.Reason
+	}
+	return result
+}
+
+// pipelineApproval formats who may approve a pipeline's jobs, and how long
+// they have to
+func pipelineApproval(approval *ppsclient.Approval) string {
+	result := "required from any user with WRITER access to the output repo"
+	if len(approval.Approvers) > 0 {
+		result = "required from " + strings.Join(approval.Approvers, ", ")
+	}
+	if approval.Timeout != nil {
+		result += fmt.Sprintf(" within %s", pretty.Duration(approval.Timeout))
+	}
+	return result
+}
+
+// cronTicks formats the next tick of each of a pipeline's cron inputs, sorted
+// by input name.
+func cronTicks(ticks map[string]*types.Timestamp) string {
+	var names []string
+	for name := range ticks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var buffer bytes.Buffer
+	for _, name := range names {
+		t, err := types.TimestampFromProto(ticks[name])
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(&buffer, "  %s: %s\n", name, t.Format(time.RFC3339))
+	}
+	return buffer.String()
 }
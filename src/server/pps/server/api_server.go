@@ -31,10 +31,12 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/lokiutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	ppath "github.com/pachyderm/pachyderm/src/server/pkg/path"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
@@ -53,7 +55,6 @@ import (
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/types"
 	opentracing "github.com/opentracing/opentracing-go"
-	"github.com/robfig/cron"
 	logrus "github.com/sirupsen/logrus"
 	"github.com/willf/bloom"
 	"golang.org/x/net/context"
@@ -127,6 +128,7 @@ type apiServer struct {
 	// collections
 	pipelines col.Collection
 	jobs      col.Collection
+	memos     col.Collection
 }
 
 func merge(from, to map[string]bool) {
@@ -185,6 +187,16 @@ func validateNames(names map[string]bool, input *pps.Input) error {
 			return errors.Errorf(`name "%s" was used more than once`, input.Git.Name)
 		}
 		names[input.Git.Name] = true
+	case input.Window != nil:
+		if names[input.Window.Name] {
+			return errors.Errorf(`name "%s" was used more than once`, input.Window.Name)
+		}
+		names[input.Window.Name] = true
+	case input.ObjectStore != nil:
+		if names[input.ObjectStore.Name] {
+			return errors.Errorf(`name "%s" was used more than once`, input.ObjectStore.Name)
+		}
+		names[input.ObjectStore.Name] = true
 	}
 	return nil
 }
@@ -193,6 +205,9 @@ func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName str
 	if err := validateNames(make(map[string]bool), input); err != nil {
 		return err
 	}
+	if err := validateJoinModes(input); err != nil {
+		return err
+	}
 	var result error
 	pps.VisitInput(input, func(input *pps.Input) {
 		if err := func() error {
@@ -205,6 +220,9 @@ func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName str
 				case input.Pfs.Name == "out":
 					return errors.Errorf("input cannot be named \"out\", as pachyderm " +
 						"already creates /pfs/out to collect job output")
+				case input.Pfs.Name == client.PPSChangesDir || input.Pfs.Name == client.PPSPreviousOutputDir:
+					return errors.Errorf("input cannot be named %q, as pachyderm uses "+
+						"/pfs/%s for change lists", input.Pfs.Name, input.Pfs.Name)
 				case input.Pfs.Repo == "":
 					return errors.Errorf("input must specify a repo")
 				case input.Pfs.Repo == "out" && input.Pfs.Name == "":
@@ -259,6 +277,14 @@ func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName str
 					// them until we know how they should work
 					return errors.Errorf("S3 inputs in join expressions are not supported")
 				}
+				if ppsutil.ContainsWindowInputs(input) {
+					// A window input's datum spans several commits, so there's no
+					// single file to join on
+					return errors.Errorf("window inputs in join expressions are not supported")
+				}
+				if err := validateJoin(input.Join); err != nil {
+					return err
+				}
 			}
 			if input.Group != nil {
 				if set {
@@ -270,6 +296,9 @@ func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName str
 					// we know how they should work
 					return errors.Errorf("S3 inputs in group expressions are not supported")
 				}
+				if ppsutil.ContainsWindowInputs(input) {
+					return errors.Errorf("window inputs in group expressions are not supported")
+				}
 			}
 			if input.Union != nil {
 				if set {
@@ -290,8 +319,97 @@ func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName str
 				if len(input.Cron.Name) == 0 {
 					return errors.Errorf("input must specify a name")
 				}
-				if _, err := cron.ParseStandard(input.Cron.Spec); err != nil {
-					return errors.Wrapf(err, "error parsing cron-spec")
+				if _, _, err := cronSchedule(input.Cron); err != nil {
+					return err
+				}
+				switch input.Cron.CatchUp {
+				case "", cronCatchUpAll:
+				case cronCatchUpLatest, cronCatchUpNone:
+					if input.Cron.MaxCatchUp != 0 {
+						return errors.Errorf("cron input can only set 'max_catch_up' with the %q catch-up policy", cronCatchUpAll)
+					}
+				default:
+					return errors.Errorf("invalid cron catch-up policy %q (must be %q, %q or %q)",
+						input.Cron.CatchUp, cronCatchUpAll, cronCatchUpLatest, cronCatchUpNone)
+				}
+				if input.Cron.MaxCatchUp < 0 {
+					return errors.Errorf("cron input can't have a negative 'max_catch_up'")
+				}
+			}
+			if input.Window != nil {
+				if set {
+					return errors.Errorf("multiple input types set")
+				}
+				set = true
+				switch {
+				case len(input.Window.Name) == 0:
+					return errors.Errorf("input must specify a name")
+				case input.Window.Name == "out":
+					return errors.Errorf("input cannot be named \"out\", as pachyderm " +
+						"already creates /pfs/out to collect job output")
+				case input.Window.Repo == "":
+					return errors.Errorf("input must specify a repo")
+				case input.Window.Branch == "" && !job:
+					return errors.Errorf("input must specify a branch")
+				case input.Window.Commits < 0:
+					return errors.Errorf("window input can't have a negative number of commits")
+				case (input.Window.Commits == 0) == (input.Window.Duration == nil):
+					return errors.Errorf("window input must specify exactly one of 'commits' and 'duration'")
+				}
+				if input.Window.Duration != nil {
+					duration, err := types.DurationFromProto(input.Window.Duration)
+					if err != nil {
+						return errors.Wrapf(err, "invalid window duration")
+					}
+					if duration <= 0 {
+						return errors.Errorf("window duration must be positive")
+					}
+				}
+				if job && input.Window.Commit != "" {
+					if _, err := pachClient.InspectCommit(input.Window.Repo, input.Window.Commit); err != nil {
+						return err
+					}
+				} else if _, err := pachClient.InspectRepo(input.Window.Repo); err != nil {
+					return err
+				}
+			}
+			if input.ObjectStore != nil {
+				if set {
+					return errors.Errorf("multiple input types set")
+				}
+				set = true
+				switch {
+				case len(input.ObjectStore.Name) == 0:
+					return errors.Errorf("input must specify a name")
+				case input.ObjectStore.Name == "out":
+					return errors.Errorf("input cannot be named \"out\", as pachyderm " +
+						"already creates /pfs/out to collect job output")
+				case len(input.ObjectStore.Glob) == 0:
+					return errors.Errorf("input must specify a glob")
+				case input.ObjectStore.Interval == nil:
+					return errors.Errorf("object store input must specify an interval")
+				case input.ObjectStore.Secret == client.StorageSecretName:
+					return errors.Errorf("object store input cannot use Pachyderm's storage secret")
+				}
+				url, err := obj.ParseURL(input.ObjectStore.URL)
+				if err != nil {
+					return err
+				}
+				if url.Store == "local" {
+					// Local buckets need no credentials, but are confined to
+					// the configured root
+					if _, err := obj.LocalBucketPath(url, a.env.ObjectStoreInputLocalRoot); err != nil {
+						return err
+					}
+				} else if input.ObjectStore.Secret == "" {
+					return errors.Errorf("object store input must specify a secret with the credentials to read its bucket")
+				}
+				interval, err := types.DurationFromProto(input.ObjectStore.Interval)
+				if err != nil {
+					return errors.Wrapf(err, "invalid object store interval")
+				}
+				if interval <= 0 {
+					return errors.Errorf("object store interval must be positive")
 				}
 			}
 			if input.Git != nil {
@@ -315,6 +433,67 @@ func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName str
 	return result
 }
 
+// validateJoin checks that the join modes and keys of the inputs in 'join' are
+// consistent
+func validateJoin(join []*pps.Input) error {
+	keyParts := -1
+	var mounted bool
+	for _, input := range join {
+		if input.Pfs == nil {
+			mounted = true
+			continue
+		}
+		switch input.Pfs.JoinMode {
+		case pps.JoinMode_JOIN_INNER, pps.JoinMode_JOIN_OUTER:
+			mounted = true
+		default:
+			if input.Pfs.OuterJoin {
+				return errors.Errorf("input %q can't set both 'outer_join' and join mode %s", input.Pfs.Name, input.Pfs.JoinMode)
+			}
+		}
+		parts := 1
+		if len(input.Pfs.JoinKeys) > 0 {
+			if input.Pfs.JoinOn != "" {
+				return errors.Errorf("input %q can't set both 'join_on' and 'join_keys'", input.Pfs.Name)
+			}
+			parts = len(input.Pfs.JoinKeys)
+		}
+		if keyParts >= 0 && parts != keyParts {
+			return errors.Errorf("the inputs of a join must have the same number of join keys")
+		}
+		keyParts = parts
+	}
+	if !mounted {
+		return errors.Errorf("a join must have at least one input with join mode %s or %s",
+			pps.JoinMode_JOIN_INNER, pps.JoinMode_JOIN_OUTER)
+	}
+	return nil
+}
+
+// validateJoinModes checks that only the inputs of joins are semi- or
+// anti-joined, as those join modes mean nothing elsewhere
+func validateJoinModes(input *pps.Input) error {
+	joined := make(map[*pps.PFSInput]bool)
+	pps.VisitInput(input, func(input *pps.Input) {
+		for _, child := range input.Join {
+			if child.Pfs != nil {
+				joined[child.Pfs] = true
+			}
+		}
+	})
+	var result error
+	pps.VisitInput(input, func(input *pps.Input) {
+		if result != nil || input.Pfs == nil || joined[input.Pfs] {
+			return
+		}
+		switch input.Pfs.JoinMode {
+		case pps.JoinMode_JOIN_SEMI, pps.JoinMode_JOIN_ANTI:
+			result = errors.Errorf("input %q has join mode %s, but isn't an input of a join", input.Pfs.Name, input.Pfs.JoinMode)
+		}
+	})
+	return result
+}
+
 func validateTransform(transform *pps.Transform) error {
 	if transform == nil {
 		return errors.Errorf("pipeline must specify a transform")
@@ -454,9 +633,12 @@ func (a *apiServer) authorizePipelineOpInTransaction(txnCtx *txnenv.TransactionC
 		pps.VisitInput(input, func(in *pps.Input) {
 			var repo string
 
-			if in.Pfs != nil {
+			switch {
+			case in.Pfs != nil:
 				repo = in.Pfs.Repo
-			} else {
+			case in.Window != nil:
+				repo = in.Window.Repo
+			default:
 				return
 			}
 
@@ -572,7 +754,41 @@ func (a *apiServer) UpdateJobStateInTransaction(txnCtx *txnenv.TransactionContex
 	jobPtr.DataTotal = request.DataTotal
 	jobPtr.Stats = request.Stats
 
-	return ppsutil.UpdateJobState(a.pipelines.ReadWrite(txnCtx.Stm), jobs, jobPtr, request.State, request.Reason)
+	// The UpdateJobState RPC is called by the job's pipeline's workers
+	return ppsutil.UpdateJobState(a.pipelines.ReadWrite(txnCtx.Stm), jobs, jobPtr, request.State, request.Reason, ppsutil.ActorWorker)
+}
+
+// UpdatePipelineSpecInTransaction commits 'specTree' as the new spec of
+// 'pipeline', and points the pipeline at it, if its spec is still
+// 'prevSpecCommit'. PFS calls it to make the pipelines that read from a renamed
+// repo or branch follow the rename.
+func (a *apiServer) UpdatePipelineSpecInTransaction(txnCtx *txnenv.TransactionContext, pipeline string, prevSpecCommit *pfs.Commit, specTree *pfs.Object) error {
+	pipelines := a.pipelines.ReadWrite(txnCtx.Stm)
+	pipelinePtr := &pps.EtcdPipelineInfo{}
+	if err := pipelines.Get(pipeline, pipelinePtr); err != nil {
+		return err
+	}
+	if pipelinePtr.SpecCommit == nil || pipelinePtr.SpecCommit.ID != prevSpecCommit.ID {
+		return errors.Errorf("pipeline %q was updated concurrently; try again", pipeline)
+	}
+	// Only PPS may write to the spec repo
+	return a.sudoTransaction(txnCtx, func(superCtx *txnenv.TransactionContext) error {
+		commit, err := superCtx.Pfs().StartCommitInTransaction(superCtx, &pfs.StartCommitRequest{
+			Parent: client.NewCommit(ppsconsts.SpecRepo, ""),
+			Branch: pipeline,
+		}, nil)
+		if err != nil {
+			return err
+		}
+		if err := superCtx.Pfs().FinishCommitInTransaction(superCtx, &pfs.FinishCommitRequest{
+			Commit: commit,
+			Tree:   specTree,
+		}); err != nil {
+			return err
+		}
+		pipelinePtr.SpecCommit = commit
+		return pipelines.Put(pipeline, pipelinePtr)
+	})
 }
 
 // CreateJob implements the protobuf pps.CreateJob RPC
@@ -604,12 +820,18 @@ func (a *apiServer) CreateJob(ctx context.Context, request *pps.CreateJobRequest
 			StatsCommit:   request.StatsCommit,
 			Started:       request.Started,
 			Finished:      request.Finished,
+
+			NamedOutputCommits: request.NamedOutputCommits,
 		}
-		return ppsutil.UpdateJobState(a.pipelines.ReadWrite(stm), a.jobs.ReadWrite(stm), jobPtr, request.State, request.Reason)
+		// The CreateJob RPC is called by the job's pipeline's worker master
+		return ppsutil.UpdateJobState(a.pipelines.ReadWrite(stm), a.jobs.ReadWrite(stm), jobPtr, request.State, request.Reason, ppsutil.ActorWorker)
 	})
 	if err != nil {
 		return nil, err
 	}
+	if request.OutputCommit != nil {
+		go a.recordJobTrigger(job)
+	}
 	return job, nil
 }
 
@@ -808,6 +1030,20 @@ func (a *apiServer) listJob(pachClient *client.APIClient, pipeline *pps.Pipeline
 						}
 					}
 				}
+				if in.Window != nil {
+					for i, inputCommit := range inputCommits {
+						if in.Window.Commit == inputCommit.ID {
+							found[i] = true
+						}
+					}
+				}
+				if in.ObjectStore != nil {
+					for i, inputCommit := range inputCommits {
+						if in.ObjectStore.Commit == inputCommit.ID {
+							found[i] = true
+						}
+					}
+				}
 			})
 			for _, found := range found {
 				if !found {
@@ -859,6 +1095,13 @@ func (a *apiServer) jobInfoFromPtr(pachClient *client.APIClient, jobPtr *pps.Etc
 		Reason:        jobPtr.Reason,
 		Started:       jobPtr.Started,
 		Finished:      jobPtr.Finished,
+		ParentJob:     jobPtr.ParentJob,
+		Trigger:       jobPtr.Trigger,
+		Parameters:    jobPtr.Parameters,
+		Transitions:   jobPtr.Transitions,
+		Approval:      jobPtr.Approval,
+
+		NamedOutputCommits: jobPtr.NamedOutputCommits,
 	}
 	commitInfo, err := pachClient.InspectCommit(jobPtr.OutputCommit.Repo.Name, jobPtr.OutputCommit.ID)
 	if err != nil {
@@ -906,7 +1149,7 @@ func (a *apiServer) jobInfoFromPtr(pachClient *client.APIClient, jobPtr *pps.Etc
 		result.SidecarResourceLimits = pipelineInfo.SidecarResourceLimits
 		result.Input = ppsutil.JobInput(pipelineInfo, commitInfo)
 		result.EnableStats = pipelineInfo.EnableStats
-		result.Salt = pipelineInfo.Salt
+		result.Salt = ppsutil.JobSalt(pipelineInfo.Salt, jobPtr.Parameters)
 		result.ChunkSpec = pipelineInfo.ChunkSpec
 		result.DatumTimeout = pipelineInfo.DatumTimeout
 		result.JobTimeout = pipelineInfo.JobTimeout
@@ -1041,23 +1284,29 @@ func (a *apiServer) StopJob(ctx context.Context, request *pps.StopJobRequest) (r
 }
 
 func (a *apiServer) stopJob(ctx context.Context, pachClient *client.APIClient, job *pps.Job) error {
-	// Lookup jobInfo
-	jobPtr := &pps.EtcdJobInfo{}
-	if err := a.jobs.ReadOnly(ctx).Get(job.ID, jobPtr); err != nil {
-		return err
-	}
-	// Finish the job's output commit without a tree -- worker/master will mark
-	// the job 'killed'
-	if _, err := pachClient.PfsAPIClient.FinishCommit(ctx,
-		&pfs.FinishCommitRequest{
+	return a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		jobs := a.jobs.ReadWrite(txnCtx.Stm)
+		jobPtr := &pps.EtcdJobInfo{}
+		if err := jobs.Get(job.ID, jobPtr); err != nil {
+			return err
+		}
+		// Finish the job's output commit without a tree, and kill the job in the
+		// same transaction, so that the transition is the user's rather than
+		// that of the worker that would otherwise notice the finished commit
+		if err := txnCtx.Pfs().FinishCommitInTransaction(txnCtx, &pfs.FinishCommitRequest{
 			Commit: jobPtr.OutputCommit,
 			Empty:  true,
 		}); err != nil {
-		if !(pfsServer.IsCommitFinishedErr(err) || pfsServer.IsCommitNotFoundErr(err) || pfsServer.IsCommitDeletedErr(err)) {
-			return err
+			if !(pfsServer.IsCommitFinishedErr(err) || pfsServer.IsCommitNotFoundErr(err) || pfsServer.IsCommitDeletedErr(err)) {
+				return err
+			}
 		}
-	}
-	return nil
+		if ppsutil.IsTerminal(jobPtr.State) {
+			return nil
+		}
+		return ppsutil.UpdateJobState(a.pipelines.ReadWrite(txnCtx.Stm), jobs, jobPtr,
+			pps.JobState_JOB_KILLED, "job stopped", ppsutil.ActorUser)
+	})
 }
 
 // RestartDatum implements the protobuf pps.RestartDatum RPC
@@ -1134,9 +1383,23 @@ func (a *apiServer) listDatum(pachClient *client.APIClient, job *pps.Job, input
 				}
 				input.Pfs.Commit = ci.Commit.ID
 			}
+			if input.Window != nil {
+				ci, err := pachClient.InspectCommit(input.Window.Repo, input.Window.Branch)
+				if err != nil {
+					visitErr = err
+					return
+				}
+				input.Window.Commit = ci.Commit.ID
+			}
+			if input.Join != nil {
+				visitErr = validateJoin(input.Join)
+			}
 			if input.Cron != nil {
 				visitErr = errors.Errorf("can't list datums with a cron input, there will be no datums until the pipeline is created")
 			}
+			if input.ObjectStore != nil {
+				visitErr = errors.Errorf("can't list datums with an object store input, there will be no datums until the pipeline is created")
+			}
 		})
 		if visitErr != nil {
 			return nil, visitErr
@@ -1463,10 +1726,15 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 	pachClient := a.env.GetPachClient(apiGetLogsServer.Context())
 	ctx := pachClient.Ctx() // pachClient will propagate auth info
+	filter, err := newLogFilter(request)
+	if err != nil {
+		return err
+	}
 
 	// Authorize request and get list of pods containing logs we're interested in
 	// (based on pipeline and job filters)
 	var rcName, containerName string
+	var pipelineInfo *pps.PipelineInfo
 	if request.Pipeline == nil && request.Job == nil {
 		if len(request.DataFilters) > 0 || request.Datum != nil {
 			return errors.Errorf("must specify the Job or Pipeline that the datum is from to get logs for it")
@@ -1478,7 +1746,6 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 
 		// 1) Lookup the PipelineInfo for this pipeline/job, for auth and to get the
 		// RC name
-		var pipelineInfo *pps.PipelineInfo
 		var statsCommit *pfs.Commit
 		var err error
 		if request.Pipeline != nil {
@@ -1514,7 +1781,7 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 				return err
 			}
 			if ci.Finished != nil {
-				return a.getLogsFromStats(pachClient, request, apiGetLogsServer, statsCommit)
+				return a.getLogsFromStats(pachClient, filter, apiGetLogsServer, statsCommit)
 			}
 		}
 
@@ -1527,99 +1794,73 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 
 	// Get pods managed by the RC we're scraping (either pipeline or pachd)
 	pods, err := a.rcPods(rcName)
+	if (err != nil || len(pods) == 0) && pipelineInfo != nil && pipelineInfo.PersistLogs != nil {
+		// The pods are gone, but their logs were persisted. The live (and stats)
+		// logs are preferred while they exist, as persisted logs are only written
+		// periodically.
+		return a.getPersistedLogs(pachClient, filter, apiGetLogsServer, pipelineInfo)
+	}
 	if err != nil {
 		return errors.Wrapf(err, "could not get pods in rc \"%s\" containing logs", rcName)
 	}
 	if len(pods) == 0 {
 		return errors.Errorf("no pods belonging to the rc \"%s\" were found", rcName)
 	}
+	if request.Worker != "" {
+		var workerPods []v1.Pod
+		for _, pod := range pods {
+			if pod.ObjectMeta.Name == request.Worker {
+				workerPods = append(workerPods, pod)
+			}
+		}
+		if len(workerPods) == 0 {
+			return errors.Errorf("worker \"%s\" not found in the rc \"%s\"", request.Worker, rcName)
+		}
+		pods = workerPods
+	}
 
-	// Spawn one goroutine per pod. Each goro writes its pod's logs to a channel
-	// and channels are read into the output server in a stable order.
-	// (sort the pods to make sure that the order of log lines is stable)
+	// Spawn one goroutine per pod, each of which writes its pod's logs to a
+	// channel. When following, lines are sent as they arrive. Otherwise the
+	// pods' lines are merged in the order they were logged (sort the pods to
+	// make sure that the order of lines logged at the same time is stable).
 	sort.Sort(podSlice(pods))
-	logCh := make(chan *pps.LogMessage)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	logCh := make(chan *pps.LogMessage) // used when following
+	sources := make([]<-chan *pps.LogMessage, len(pods))
 	var eg errgroup.Group
-	var mu sync.Mutex
-	eg.Go(func() error {
-		for _, pod := range pods {
-			pod := pod
+	for i, pod := range pods {
+		pod := pod
+		podCh := logCh
+		if !request.Follow {
+			podCh = make(chan *pps.LogMessage)
+			sources[i] = podCh
+		}
+		eg.Go(func() error {
 			if !request.Follow {
-				mu.Lock()
-			}
-			eg.Go(func() (retErr error) {
-				if !request.Follow {
-					defer mu.Unlock()
-				}
-				tailLines := &request.Tail
-				if *tailLines <= 0 {
-					tailLines = nil
-				}
-				// Get full set of logs from pod i
-				stream, err := a.env.GetKubeClient().CoreV1().Pods(a.namespace).GetLogs(
-					pod.ObjectMeta.Name, &v1.PodLogOptions{
-						Container: containerName,
-						Follow:    request.Follow,
-						TailLines: tailLines,
-					}).Timeout(10 * time.Second).Stream()
-				if err != nil {
-					return err
-				}
-				defer func() {
-					if err := stream.Close(); err != nil && retErr == nil {
-						retErr = err
-					}
-				}()
-
-				// Parse pods' log lines, and filter out irrelevant ones
-				scanner := bufio.NewScanner(stream)
-				for scanner.Scan() {
-					msg := new(pps.LogMessage)
-					if containerName == "pachd" {
-						msg.Message = scanner.Text()
-					} else {
-						logBytes := scanner.Bytes()
-						if err := jsonpb.Unmarshal(bytes.NewReader(logBytes), msg); err != nil {
-							continue
-						}
-
-						// Filter out log lines that don't match on pipeline or job
-						if request.Pipeline != nil && request.Pipeline.Name != msg.PipelineName {
-							continue
-						}
-						if request.Job != nil && request.Job.ID != msg.JobID {
-							continue
-						}
-						if request.Datum != nil && request.Datum.ID != msg.DatumID {
-							continue
-						}
-						if request.Master != msg.Master {
-							continue
-						}
-						if !workercommon.MatchDatum(request.DataFilters, msg.Data) {
-							continue
-						}
-					}
-					msg.Message = strings.TrimSuffix(msg.Message, "\n")
-
-					// Log message passes all filters -- return it
-					select {
-					case logCh <- msg:
-					case <-ctx.Done():
-						return nil
-					}
+				defer close(podCh)
+			}
+			return a.getPodLogs(ctx, pod, containerName, filter, func(msg *pps.LogMessage) error {
+				select {
+				case podCh <- msg:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
 				}
-				return nil
 			})
+		})
+	}
+	if !request.Follow {
+		if err := mergeLogs(sources, apiGetLogsServer.Send); err != nil {
+			return err
 		}
-		return nil
-	})
+		return eg.Wait()
+	}
 	var egErr error
 	go func() {
 		egErr = eg.Wait()
 		close(logCh)
 	}()
-
 	for msg := range logCh {
 		if err := apiGetLogsServer.Send(msg); err != nil {
 			return err
@@ -1628,69 +1869,234 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 	return egErr
 }
 
-func (a *apiServer) getLogsFromStats(pachClient *client.APIClient, request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer, statsCommit *pfs.Commit) error {
+// getPodLogs sends the lines of the logs of 'containerName' in 'pod' that
+// match 'filter' to 'send', in the order they were logged. If the request
+// has a tail, only that many of the last matching lines are sent (before any
+// followed lines).
+func (a *apiServer) getPodLogs(ctx context.Context, pod v1.Pod, containerName string, filter *logFilter, send func(*pps.LogMessage) error) error {
+	request := filter.request
+	opts := &v1.PodLogOptions{
+		Container:  containerName,
+		Timestamps: true,
+	}
+	if !filter.since.IsZero() {
+		opts.SinceTime = &metav1.Time{Time: filter.since}
+	}
+	// Kubernetes can tail pachd's logs itself if no lines are filtered out
+	// (pachd's pods are already filtered by worker, and kubernetes applies
+	// 'since')
+	kubeTail := containerName == "pachd" && filter.until.IsZero() && filter.grep == nil && filter.level == logLevelDebug
+	if request.Tail <= 0 || kubeTail {
+		if request.Tail > 0 {
+			opts.TailLines = &request.Tail
+		}
+		opts.Follow = request.Follow
+		_, err := a.streamPodLogs(ctx, pod, opts, filter, nil, send)
+		return err
+	}
+
+	// Tail the matching lines, rather than the pod's last lines
+	tail := newLogTail(request.Tail)
+	last, err := a.streamPodLogs(ctx, pod, opts, filter, nil, tail.add)
+	if err != nil {
+		return err
+	}
+	for _, msg := range tail.messages() {
+		if err := send(msg); err != nil {
+			return err
+		}
+	}
+	if !request.Follow {
+		return nil
+	}
+	// Follow the lines logged after the last line read above
+	opts.Follow = true
+	if last == nil {
+		_, err = a.streamPodLogs(ctx, pod, opts, filter, nil, send)
+		return err
+	}
+	opts.SinceTime = &metav1.Time{Time: last.ts}
+	_, err = a.streamPodLogs(ctx, pod, opts, filter, last, send)
+	return err
+}
+
+// streamPodLogs reads the logs of 'pod' requested with 'opts', and sends the
+// lines that match 'filter' to 'send'. If 'after' is set, the lines up to and
+// including it are skipped. It returns the last line read, if any.
+func (a *apiServer) streamPodLogs(ctx context.Context, pod v1.Pod, opts *v1.PodLogOptions, filter *logFilter, after *kubeLogLine, send func(*pps.LogMessage) error) (_ *kubeLogLine, retErr error) {
+	stream, err := a.env.GetKubeClient().CoreV1().Pods(a.namespace).GetLogs(
+		pod.ObjectMeta.Name, opts).Timeout(10 * time.Second).Stream()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := stream.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+
+	// Parse pods' log lines, and filter out irrelevant ones
+	var last *kubeLogLine
+	skipping := after != nil
+	scanner := bufio.NewScanner(stream)
+	for scanner.Scan() {
+		ts, line := parseKubeLogLine(scanner.Text())
+		last = &kubeLogLine{ts: ts, line: line}
+		if skipping {
+			// Kubernetes' since time has a granularity of seconds, so lines up to
+			// and including 'after' may be read again
+			if !ts.After(after.ts) {
+				skipping = line != after.line
+				continue
+			}
+			skipping = false
+		}
+		msg := new(pps.LogMessage)
+		if opts.Container == "pachd" {
+			msg.Message = line
+			msg.WorkerID = pod.ObjectMeta.Name
+		} else {
+			if err := jsonpb.Unmarshal(strings.NewReader(line), msg); err != nil {
+				continue
+			}
+
+			// Filter out log lines that don't match on pipeline or job
+			if !filter.matchJob(msg) {
+				continue
+			}
+		}
+		if msg.Ts == nil && !ts.IsZero() {
+			msg.Ts, _ = types.TimestampProto(ts)
+		}
+		msg.Message = strings.TrimSuffix(msg.Message, "\n")
+		if !filter.match(msg) {
+			continue
+		}
+
+		// Log message passes all filters -- return it
+		if err := send(msg); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return last, nil
+			}
+			return nil, err
+		}
+	}
+	return last, scanner.Err()
+}
+
+func (a *apiServer) getLogsFromStats(pachClient *client.APIClient, filter *logFilter, apiGetLogsServer pps.API_GetLogsServer, statsCommit *pfs.Commit) error {
+	return a.getLogsFromFiles(pachClient, filter, apiGetLogsServer, statsCommit, "*/logs") // this is the path where logs reside
+}
+
+// getPersistedLogs sends the logs that match 'filter' from the logs branch of
+// the pipeline in 'pipelineInfo' (see pps.LogPersistence)
+func (a *apiServer) getPersistedLogs(pachClient *client.APIClient, filter *logFilter, apiGetLogsServer pps.API_GetLogsServer, pipelineInfo *pps.PipelineInfo) error {
+	job, dir := "*", "*"
+	if filter.request.Job != nil {
+		job = filter.request.Job.ID
+	}
+	switch {
+	case filter.request.Datum != nil:
+		dir = filter.request.Datum.ID
+	case filter.request.Master:
+		dir = "master"
+	}
+	commit := client.NewCommit(pipelineInfo.Pipeline.Name, ppsconsts.LogsBranch)
+	return a.getLogsFromFiles(pachClient, filter, apiGetLogsServer, commit, path.Join("/", job, dir, "*"))
+}
+
+// getLogsFromFiles sends the log messages that match 'filter' from the files
+// matching 'pattern' in 'commit', a file at a time, with each file's messages
+// in the order they were logged
+func (a *apiServer) getLogsFromFiles(pachClient *client.APIClient, filter *logFilter, apiGetLogsServer pps.API_GetLogsServer, commit *pfs.Commit, pattern string) error {
+	ctx, cancel := context.WithCancel(pachClient.Ctx())
+	defer cancel()
+	pachClient = pachClient.WithCtx(ctx)
 	pfsClient := pachClient.PfsAPIClient
 	fs, err := pfsClient.GlobFileStream(pachClient.Ctx(), &pfs.GlobFileRequest{
-		Commit:  statsCommit,
-		Pattern: "*/logs", // this is the path where logs reside
+		Commit:  commit,
+		Pattern: pattern,
 	})
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
 	}
 
-	limiter := limit.New(20)
-	var eg errgroup.Group
-	var mu sync.Mutex
-	for {
-		fileInfo, err := fs.Recv()
-		if errors.Is(err, io.EOF) {
-			break
-		}
-		eg.Go(func() error {
+	// Read up to 'logFileConcurrency' files at a time, but send their messages
+	// a file at a time, in order, so that only the files being read are held
+	// in memory
+	type fileLogs struct {
+		msgs []*pps.LogMessage
+		err  error
+	}
+	pending := make(chan chan fileLogs, logFileConcurrency)
+	go func() {
+		defer close(pending)
+		for {
+			result := make(chan fileLogs, 1)
+			fileInfo, err := fs.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
 			if err != nil {
-				return err
+				result <- fileLogs{err: grpcutil.ScrubGRPC(err)}
+			} else {
+				go func() {
+					msgs, err := readLogFile(pachClient, filter, fileInfo.File)
+					result <- fileLogs{msgs: msgs, err: err}
+				}()
 			}
-			limiter.Acquire()
-			defer limiter.Release()
-			var buf bytes.Buffer
-			if err := pachClient.GetFile(fileInfo.File.Commit.Repo.Name, fileInfo.File.Commit.ID, fileInfo.File.Path, 0, 0, &buf); err != nil {
+			select {
+			case pending <- result:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	for result := range pending {
+		logs := <-result
+		if logs.err != nil {
+			return logs.err
+		}
+		for _, msg := range logs.msgs {
+			if err := apiGetLogsServer.Send(msg); err != nil {
 				return err
 			}
-			// Parse pods' log lines, and filter out irrelevant ones
-			scanner := bufio.NewScanner(&buf)
-			for scanner.Scan() {
-				logBytes := scanner.Bytes()
-				msg := new(pps.LogMessage)
-				if err := jsonpb.Unmarshal(bytes.NewReader(logBytes), msg); err != nil {
-					continue
-				}
-				if request.Pipeline != nil && request.Pipeline.Name != msg.PipelineName {
-					continue
-				}
-				if request.Job != nil && request.Job.ID != msg.JobID {
-					continue
-				}
-				if request.Datum != nil && request.Datum.ID != msg.DatumID {
-					continue
-				}
-				if request.Master != msg.Master {
-					continue
-				}
-				if !workercommon.MatchDatum(request.DataFilters, msg.Data) {
-					continue
-				}
+		}
+	}
+	return nil
+}
 
-				mu.Lock()
-				if err := apiGetLogsServer.Send(msg); err != nil {
-					mu.Unlock()
-					return err
-				}
-				mu.Unlock()
-			}
-			return nil
-		})
+// readLogFile returns the log messages in 'file' that match 'filter' (only
+// the last of them, if the request has a tail)
+func readLogFile(pachClient *client.APIClient, filter *logFilter, file *pfs.File) ([]*pps.LogMessage, error) {
+	var buf bytes.Buffer
+	if err := pachClient.GetFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, 0, 0, &buf); err != nil {
+		return nil, err
+	}
+	var msgs []*pps.LogMessage
+	tail := newLogTail(filter.request.Tail)
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		msg := new(pps.LogMessage)
+		if err := jsonpb.Unmarshal(bytes.NewReader(scanner.Bytes()), msg); err != nil {
+			continue
+		}
+		if !filter.matchJob(msg) || !filter.match(msg) {
+			continue
+		}
+		if filter.request.Tail > 0 {
+			tail.add(msg)
+		} else {
+			msgs = append(msgs, msg)
+		}
+	}
+	if filter.request.Tail > 0 {
+		return tail.messages(), nil
 	}
-	return eg.Wait()
+	return msgs, nil
 }
 
 func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer) (retErr error) {
@@ -1705,15 +2111,36 @@ func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pp
 	if err != nil {
 		return err
 	}
+	filter, err := newLogFilter(request)
+	if err != nil {
+		return err
+	}
+	from, through := filter.since, filter.until
+	if through.IsZero() {
+		through = time.Now()
+	}
 	if request.Pipeline == nil && request.Job == nil {
 		if len(request.DataFilters) > 0 || request.Datum != nil {
 			return errors.Errorf("must specify the Job or Pipeline that the datum is from to get logs for it")
 		}
+		if request.Worker != "" {
+			return errors.Errorf("cannot filter pachd logs by worker with the Loki backend")
+		}
 		// no authorization is done to get logs from master
-		return lokiutil.QueryRange(loki, `{app="pachd"}`, time.Time{}, time.Now(), func(t time.Time, line string) error {
-			return apiGetLogsServer.Send(&pps.LogMessage{
+		query := `{app="pachd"}`
+		// pachd's log lines are raw messages, so grep can be applied by Loki
+		if request.Grep != "" {
+			query += matches(request.Grep)
+		}
+		return lokiutil.QueryRange(loki, query, from, through, func(t time.Time, line string) error {
+			msg := &pps.LogMessage{
 				Message: strings.TrimSuffix(line, "\n"),
-			})
+			}
+			msg.Ts, _ = types.TimestampProto(t)
+			if !filter.match(msg) {
+				return nil
+			}
+			return apiGetLogsServer.Send(msg)
 		})
 	}
 
@@ -1756,7 +2183,13 @@ func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pp
 	for _, filter := range request.DataFilters {
 		query += contains(filter)
 	}
-	return lokiutil.QueryRange(loki, query, time.Time{}, time.Now(), func(t time.Time, line string) error {
+	if request.Worker != "" {
+		query += contains(request.Worker)
+	}
+	// Worker log lines are JSON-encoded LogMessages, so a grep pattern can't
+	// be applied to them in the Loki request (it would match the encoded
+	// message and the other fields), and is only applied below
+	return lokiutil.QueryRange(loki, query, from, through, func(t time.Time, line string) error {
 		msg := &pps.LogMessage{}
 		// These filters are almost always unnecessary because we apply
 		// them in the Loki request, but many of them are just done with
@@ -1767,22 +2200,16 @@ func (a *apiServer) getLogsLoki(request *pps.GetLogsRequest, apiGetLogsServer pp
 		if err := jsonpb.Unmarshal(strings.NewReader(line), msg); err != nil {
 			return nil
 		}
-		if request.Pipeline != nil && request.Pipeline.Name != msg.PipelineName {
-			return nil
-		}
-		if request.Job != nil && request.Job.ID != msg.JobID {
-			return nil
-		}
-		if request.Datum != nil && request.Datum.ID != msg.DatumID {
+		if !filter.matchJob(msg) {
 			return nil
 		}
-		if request.Master != msg.Master {
-			return nil
+		if msg.Ts == nil {
+			msg.Ts, _ = types.TimestampProto(t)
 		}
-		if !workercommon.MatchDatum(request.DataFilters, msg.Data) {
+		msg.Message = strings.TrimSuffix(msg.Message, "\n")
+		if !filter.match(msg) {
 			return nil
 		}
-		msg.Message = strings.TrimSuffix(msg.Message, "\n")
 		return apiGetLogsServer.Send(msg)
 	})
 }
@@ -1791,6 +2218,10 @@ func contains(s string) string {
 	return fmt.Sprintf(" |= %q", s)
 }
 
+func matches(re string) string {
+	return fmt.Sprintf(" |~ %q", re)
+}
+
 func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest) error {
 	if request.Pipeline == nil {
 		return errors.New("invalid pipeline spec: request.Pipeline cannot be nil")
@@ -1816,9 +2247,70 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 	if request.S3Out && request.EnableStats {
 		return errors.New("stats are not supported for pipelines that output via Pachyderm's S3 gateway")
 	}
+	if request.Memoize && (request.S3Out || request.Service != nil || request.Spout != nil) {
+		return errors.New("memoization is not supported in spouts, services or pipelines that output via Pachyderm's S3 gateway")
+	}
+	if request.Memoize && ppsutil.ContainsChangesInputs(request.Input) {
+		// A datum's output depends on its change list, which the memoization
+		// cache isn't keyed by
+		return errors.New("memoization is not supported in pipelines with change-list inputs")
+	}
+	if request.DatumBatching && (request.Service != nil || request.Spout != nil) {
+		return errors.New("datum batching is not supported in spouts or services")
+	}
+	if request.PersistLogs != nil && request.PersistLogs.Retention != nil {
+		retention, err := types.DurationFromProto(request.PersistLogs.Retention)
+		if err != nil {
+			return errors.Wrapf(err, "invalid log retention")
+		}
+		if retention <= 0 {
+			return errors.Errorf("log retention must be positive, got %v", retention)
+		}
+	}
+	if request.Approval != nil {
+		if request.Service != nil || request.Spout != nil {
+			return errors.New("approval is not supported in spouts or services")
+		}
+		if request.Approval.Timeout != nil {
+			timeout, err := types.DurationFromProto(request.Approval.Timeout)
+			if err != nil {
+				return errors.Wrapf(err, "invalid approval timeout")
+			}
+			if timeout <= 0 {
+				return errors.Errorf("approval timeout must be positive, got %v", timeout)
+			}
+		}
+	}
+	if request.S3Out && ppsutil.ContainsChangesInputs(request.Input) {
+		return errors.New("change lists are not supported for pipelines that output via Pachyderm's S3 gateway")
+	}
 	if request.Transform == nil {
 		return errors.Errorf("pipeline must specify a transform")
 	}
+	for _, d := range []struct {
+		name    string
+		timeout *types.Duration
+	}{
+		{"setup", request.Transform.SetupTimeout},
+		{"teardown", request.Transform.TeardownTimeout},
+	} {
+		if d.timeout == nil {
+			continue
+		}
+		timeout, err := types.DurationFromProto(d.timeout)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s timeout", d.name)
+		}
+		if timeout <= 0 {
+			return errors.Errorf("%s timeout must be positive, got %v", d.name, timeout)
+		}
+	}
+	if err := validateSidecars(request.Sidecars); err != nil {
+		return err
+	}
+	if err := validateOutputs(request); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1858,6 +2350,33 @@ func (a *apiServer) validatePipeline(pachClient *client.APIClient, pipelineInfo
 		if pipelineInfo.Service != nil && pipelineInfo.ParallelismSpec.Constant != 1 {
 			return errors.New("services can only be run with a constant parallelism of 1")
 		}
+		if as := pipelineInfo.ParallelismSpec.Autoscaling; as != nil {
+			if pipelineInfo.ParallelismSpec.Constant != 0 ||
+				pipelineInfo.ParallelismSpec.Coefficient != 0 {
+				return errors.New("contradictory parallelism strategies: " +
+					"ParallelismSpec.Autoscaling cannot be set with ParallelismSpec.Constant " +
+					"or ParallelismSpec.Coefficient")
+			}
+			if pipelineInfo.Spout != nil {
+				return errors.New("spouts cannot use autoscaling")
+			}
+			if as.Min < 1 {
+				return errors.New("ParallelismSpec.Autoscaling.Min must be at least 1")
+			}
+			if as.Max < as.Min {
+				return errors.Errorf("ParallelismSpec.Autoscaling.Max (%d) cannot be less than ParallelismSpec.Autoscaling.Min (%d)", as.Max, as.Min)
+			}
+			for _, d := range []*types.Duration{as.ScaleUpCooldown, as.ScaleDownCooldown} {
+				if d == nil {
+					continue
+				}
+				if cooldown, err := types.DurationFromProto(d); err != nil {
+					return err
+				} else if cooldown < 0 {
+					return errors.New("autoscaling cooldowns cannot be negative")
+				}
+			}
+		}
 	}
 	if pipelineInfo.HashtreeSpec != nil {
 		if pipelineInfo.HashtreeSpec.Constant == 0 {
@@ -1928,6 +2447,12 @@ func branchProvenance(input *pps.Input) []*pfs.Branch {
 		if input.Git != nil {
 			result = append(result, client.NewBranch(input.Git.Name, input.Git.Branch))
 		}
+		if input.Window != nil {
+			result = append(result, client.NewBranch(input.Window.Repo, input.Window.Branch))
+		}
+		if input.ObjectStore != nil {
+			result = append(result, client.NewBranch(input.ObjectStore.Repo, "master"))
+		}
 	})
 	return result
 }
@@ -1954,7 +2479,17 @@ func (a *apiServer) hardStopPipeline(pachClient *client.APIClient, pipelineInfo
 			"stats",
 			nil,
 		); err != nil && !isNotFoundErr(err) {
-			return errors.Wrapf(err, "could not recreate original stats branch")
+			return errors.Wrapf(err, "could not recreate original stats branch")
+		}
+	}
+	for _, output := range pipelineInfo.Outputs {
+		if err := pachClient.CreateBranch(
+			output.Repo,
+			output.Branch,
+			output.Branch,
+			nil,
+		); err != nil && !isNotFoundErr(err) {
+			return errors.Wrapf(err, "could not recreate original branch for output %q", output.Name)
 		}
 	}
 
@@ -2071,6 +2606,24 @@ func (a *apiServer) makePipelineInfoCommit(pachClient *client.APIClient, pipelin
 	return commit, nil
 }
 
+// inputRepo returns the repo that 'input' reads, or "" if it's not an input
+// that reads a repo (e.g. a cross)
+func inputRepo(input *pps.Input) string {
+	switch {
+	case input.Pfs != nil:
+		return input.Pfs.Repo
+	case input.Cron != nil:
+		return input.Cron.Repo
+	case input.Git != nil:
+		return input.Git.Name
+	case input.Window != nil:
+		return input.Window.Repo
+	case input.ObjectStore != nil:
+		return input.ObjectStore.Repo
+	}
+	return ""
+}
+
 func (a *apiServer) fixPipelineInputRepoACLs(ctx context.Context, pipelineInfo *pps.PipelineInfo, prevPipelineInfo *pps.PipelineInfo) error {
 	return a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
 		return a.fixPipelineInputRepoACLsInTransaction(txnCtx, pipelineInfo, prevPipelineInfo)
@@ -2079,25 +2632,24 @@ func (a *apiServer) fixPipelineInputRepoACLs(ctx context.Context, pipelineInfo *
 
 func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txnenv.TransactionContext, pipelineInfo *pps.PipelineInfo, prevPipelineInfo *pps.PipelineInfo) error {
 	add := make(map[string]struct{})
+	addWriter := make(map[string]struct{}) // the repos of new named outputs
 	remove := make(map[string]struct{})
+	prevOutputRepos := make(map[string]struct{})
 	var pipelineName string
 	// Figure out which repos 'pipeline' might no longer be using
 	if prevPipelineInfo != nil {
 		pipelineName = prevPipelineInfo.Pipeline.Name
 		pps.VisitInput(prevPipelineInfo.Input, func(input *pps.Input) {
-			var repo string
-			switch {
-			case input.Pfs != nil:
-				repo = input.Pfs.Repo
-			case input.Cron != nil:
-				repo = input.Cron.Repo
-			case input.Git != nil:
-				repo = input.Git.Name
-			default:
+			repo := inputRepo(input)
+			if repo == "" {
 				return // no scope to set: input is not a repo
 			}
 			remove[repo] = struct{}{}
 		})
+		for _, repo := range ownOutputRepos(prevPipelineInfo) {
+			prevOutputRepos[repo] = struct{}{}
+			remove[repo] = struct{}{}
+		}
 	}
 
 	// Figure out which repos 'pipeline' is using
@@ -2114,23 +2666,24 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txnenv.Transac
 		// collect inputs (remove redundant inputs from 'remove', but don't
 		// bother authorizing 'pipeline' twice)
 		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
-			var repo string
-			switch {
-			case input.Pfs != nil:
-				repo = input.Pfs.Repo
-			case input.Cron != nil:
-				repo = input.Cron.Repo
-			case input.Git != nil:
-				repo = input.Git.Name
-			default:
+			repo := inputRepo(input)
+			if repo == "" {
 				return // no scope to set: input is not a repo
 			}
 			if _, ok := remove[repo]; ok {
 				delete(remove, repo)
-			} else {
-				add[repo] = struct{}{}
+				if _, wasOutput := prevOutputRepos[repo]; !wasOutput {
+					return // already a reader
+				}
 			}
+			add[repo] = struct{}{}
 		})
+		for _, repo := range ownOutputRepos(pipelineInfo) {
+			delete(remove, repo)
+			if _, ok := prevOutputRepos[repo]; !ok {
+				addWriter[repo] = struct{}{}
+			}
+		}
 	}
 	if pipelineName == "" {
 		return errors.Errorf("fixPipelineInputRepoACLs called with both current and " +
@@ -2172,10 +2725,14 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txnenv.Transac
 	}
 	// Add pipeline to its output repo's ACL as a WRITER if it's new
 	if prevPipelineInfo == nil {
+		addWriter[pipelineName] = struct{}{}
+	}
+	for repo := range addWriter {
+		repo := repo
 		eg.Go(func() error {
 			return a.sudoTransaction(txnCtx, func(superTxnCtx *txnenv.TransactionContext) error {
 				_, err := superTxnCtx.Auth().SetScopeInTransaction(superTxnCtx, &auth.SetScopeRequest{
-					Repo:     pipelineName,
+					Repo:     repo,
 					Username: auth.PipelinePrefix + pipelineName,
 					Scope:    auth.Scope_WRITER,
 				})
@@ -2194,6 +2751,10 @@ func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txnenv.Transac
 // that can be stored in EtcdPipelineInfo.Parallelism
 func getExpectedNumWorkers(kc *kube.Clientset, pipelineInfo *pps.PipelineInfo) (int, error) {
 	switch pspec := pipelineInfo.ParallelismSpec; {
+	case pspec.GetAutoscaling() != nil:
+		// Workers size their task pools (and the PPS master bounds the
+		// autoscaler) by the pipeline's maximum number of workers
+		return int(pspec.Autoscaling.Max), nil
 	case pspec == nil, pspec.Constant == 0 && pspec.Coefficient == 0:
 		return 1, nil
 	case pspec.Constant > 0 && pspec.Coefficient == 0:
@@ -2216,6 +2777,49 @@ func getExpectedNumWorkers(kc *kube.Clientset, pipelineInfo *pps.PipelineInfo) (
 	}
 }
 
+// newPipelineInfo returns the PipelineInfo of the first version of the
+// pipeline created by 'request', before defaults are set
+func newPipelineInfo(request *pps.CreatePipelineRequest) *pps.PipelineInfo {
+	return &pps.PipelineInfo{
+		Pipeline:              request.Pipeline,
+		Version:               1,
+		Transform:             request.Transform,
+		TFJob:                 request.TFJob,
+		ParallelismSpec:       request.ParallelismSpec,
+		HashtreeSpec:          request.HashtreeSpec,
+		Input:                 request.Input,
+		OutputBranch:          request.OutputBranch,
+		Egress:                request.Egress,
+		CreatedAt:             now(),
+		ResourceRequests:      request.ResourceRequests,
+		ResourceLimits:        request.ResourceLimits,
+		SidecarResourceLimits: request.SidecarResourceLimits,
+		Description:           request.Description,
+		CacheSize:             request.CacheSize,
+		EnableStats:           request.EnableStats,
+		Salt:                  request.Salt,
+		MaxQueueSize:          request.MaxQueueSize,
+		Service:               request.Service,
+		Spout:                 request.Spout,
+		ChunkSpec:             request.ChunkSpec,
+		DatumTimeout:          request.DatumTimeout,
+		JobTimeout:            request.JobTimeout,
+		Standby:               request.Standby,
+		DatumTries:            request.DatumTries,
+		SchedulingSpec:        request.SchedulingSpec,
+		PodSpec:               request.PodSpec,
+		PodPatch:              request.PodPatch,
+		S3Out:                 request.S3Out,
+		Metadata:              request.Metadata,
+		Memoize:               request.Memoize,
+		DatumBatching:         request.DatumBatching,
+		PersistLogs:           request.PersistLogs,
+		Approval:              request.Approval,
+		Sidecars:              request.Sidecars,
+		Outputs:               request.Outputs,
+	}
+}
+
 // CreatePipeline implements the protobuf pps.CreatePipeline RPC
 //
 // Implementation note:
@@ -2247,6 +2851,16 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	if err := a.validatePipelineRequest(request); err != nil {
 		return nil, err
 	}
+	if request.DryRun {
+		_, authErr, err := a.previewPipeline(a.env.GetPachClient(ctx), request)
+		if err != nil {
+			return nil, err
+		}
+		if authErr != nil {
+			return nil, authErr
+		}
+		return &types.Empty{}, nil
+	}
 
 	// Annotate current span with pipeline & persist any extended trace to etcd
 	span := opentracing.SpanFromContext(ctx)
@@ -2265,38 +2879,7 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	if request.Salt == "" || request.Reprocess {
 		request.Salt = uuid.NewWithoutDashes()
 	}
-	pipelineInfo := &pps.PipelineInfo{
-		Pipeline:              request.Pipeline,
-		Version:               1,
-		Transform:             request.Transform,
-		TFJob:                 request.TFJob,
-		ParallelismSpec:       request.ParallelismSpec,
-		HashtreeSpec:          request.HashtreeSpec,
-		Input:                 request.Input,
-		OutputBranch:          request.OutputBranch,
-		Egress:                request.Egress,
-		CreatedAt:             now(),
-		ResourceRequests:      request.ResourceRequests,
-		ResourceLimits:        request.ResourceLimits,
-		SidecarResourceLimits: request.SidecarResourceLimits,
-		Description:           request.Description,
-		CacheSize:             request.CacheSize,
-		EnableStats:           request.EnableStats,
-		Salt:                  request.Salt,
-		MaxQueueSize:          request.MaxQueueSize,
-		Service:               request.Service,
-		Spout:                 request.Spout,
-		ChunkSpec:             request.ChunkSpec,
-		DatumTimeout:          request.DatumTimeout,
-		JobTimeout:            request.JobTimeout,
-		Standby:               request.Standby,
-		DatumTries:            request.DatumTries,
-		SchedulingSpec:        request.SchedulingSpec,
-		PodSpec:               request.PodSpec,
-		PodPatch:              request.PodPatch,
-		S3Out:                 request.S3Out,
-		Metadata:              request.Metadata,
-	}
+	pipelineInfo := newPipelineInfo(request)
 	if err := setPipelineDefaults(pipelineInfo); err != nil {
 		return nil, err
 	}
@@ -2325,6 +2908,15 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 				visitErr = err
 			}
 		}
+		if input.ObjectStore != nil {
+			if _, err := pachClient.PfsAPIClient.CreateRepo(pachClient.Ctx(),
+				&pfs.CreateRepoRequest{
+					Repo:        client.NewRepo(input.ObjectStore.Repo),
+					Description: fmt.Sprintf("Object store input repo for pipeline %s.", request.Pipeline.Name),
+				}); err != nil && !isAlreadyExistsErr(err) {
+				visitErr = err
+			}
+		}
 	})
 	if visitErr != nil {
 		return nil, visitErr
@@ -2341,10 +2933,12 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	pipelineName := pipelineInfo.Pipeline.Name
 	pps.SortInput(pipelineInfo.Input) // Makes datum hashes comparable
 	update := false
+	var prevPipelineInfo *pps.PipelineInfo // the pipeline being updated, if any
 	if request.Update {
 		// inspect the pipeline to see if this is a real update
-		if _, err := a.inspectPipeline(pachClient, request.Pipeline.Name); err == nil {
+		if info, err := a.inspectPipeline(pachClient, request.Pipeline.Name); err == nil {
 			update = true
+			prevPipelineInfo = info
 		}
 	}
 	var (
@@ -2357,6 +2951,8 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		outputBranchHead *pfs.Commit
 		statsBranchHead  *pfs.Commit
 		markerBranchHead *pfs.Commit
+		// heads of the branches of the pipeline's named outputs, by branchKey
+		namedBranchHeads = make(map[string]*pfs.Commit)
 	)
 
 	// Get the expected number of workers for this pipeline
@@ -2382,6 +2978,14 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		if err := a.hardStopPipeline(pachClient, pipelineInfo); err != nil {
 			return nil, err
 		}
+		// Likewise for the branches of any named outputs that the update
+		// removes, which would otherwise keep getting commits (and jobs)
+		if err := detachRemovedOutputs(pachClient, prevPipelineInfo, pipelineInfo); err != nil {
+			return nil, err
+		}
+		if err := createOutputRepos(pachClient, pipelineInfo, prevPipelineInfo); err != nil {
+			return nil, err
+		}
 
 		// Look up existing pipelineInfo and update it, writing updated
 		// pipelineInfo back to PFS in a new commit. Do this inside an etcd
@@ -2429,11 +3033,14 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 				// Update pipelinePtr to point to new commit
 				pipelinePtr.SpecCommit = specCommit
 				// Reset pipeline state (PPS master/pipeline controller recreates RC)
+				pipelinePtr.Transitions = ppsutil.RecordStateTransition(pipelinePtr.Transitions,
+					pipelinePtr.State.String(), pps.PipelineState_PIPELINE_STARTING.String(), "pipeline updated", ppsutil.ActorUser)
 				pipelinePtr.State = pps.PipelineState_PIPELINE_STARTING
 				// Clear any failure reasons
 				pipelinePtr.Reason = ""
 				// Update pipeline parallelism
 				pipelinePtr.Parallelism = uint64(parallelism)
+				pipelinePtr.DesiredWorkers = pipelineInfo.ParallelismSpec.GetAutoscaling().GetMin()
 
 				// Generate new pipeline auth token (added due to & add pipeline to the ACLs of input/output
 				// repos
@@ -2473,6 +3080,15 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		}); err != nil {
 			return nil, err
 		}
+		// Delete the records that the previous version's workers kept for
+		// change lists, if this version won't use them
+		if prefix := ppsutil.StaleDatumRecordPrefix(oldPipelineInfo, pipelineInfo); prefix != "" {
+			if _, err := pachClient.DeleteObjDirect(ctx, &pfs.DeleteObjDirectRequest{
+				Prefix: prefix,
+			}); err != nil {
+				return nil, grpcutil.ScrubGRPC(err)
+			}
+		}
 
 		if !request.Reprocess {
 			// don't branch the output/stats/marker commit chain from the old pipeline (re-use old branch HEAD)
@@ -2498,6 +3114,16 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			} else if err == nil {
 				markerBranchHead = client.NewCommit(pipelineName, ppsconsts.SpoutMarkerBranch)
 			}
+
+			for _, output := range pipelineInfo.Outputs {
+				namedBranch := client.NewBranch(output.Repo, output.Branch)
+				_, err = pfsClient.InspectBranch(ctx, &pfs.InspectBranchRequest{Branch: namedBranch})
+				if err != nil && !isNotFoundErr(err) {
+					return nil, err
+				} else if err == nil {
+					namedBranchHeads[branchKey(namedBranch)] = client.NewCommit(output.Repo, output.Branch)
+				}
+			}
 		}
 
 		if pipelinePtr.AuthToken != "" {
@@ -2514,6 +3140,9 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			}); err != nil && !isAlreadyExistsErr(err) {
 			return nil, err
 		}
+		if err := createOutputRepos(pachClient, pipelineInfo, nil); err != nil {
+			return nil, err
+		}
 
 		// Must create spec commit before restoring output branch provenance, so
 		// that no commits are created with a missing spec commit
@@ -2529,6 +3158,9 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			// We also use the existing head for the branches, rather than making a new one.
 			outputBranchHead = client.NewCommit(pipelineName, pipelineInfo.OutputBranch)
 			statsBranchHead = client.NewCommit(pipelineName, "stats")
+			for _, output := range pipelineInfo.Outputs {
+				namedBranchHeads[branchKey(client.NewBranch(output.Repo, output.Branch))] = client.NewCommit(output.Repo, output.Branch)
+			}
 		} else {
 			var err error
 			commit, err = a.makePipelineInfoCommit(pachClient, pipelineInfo)
@@ -2540,9 +3172,12 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		// pipelinePtr will be written to etcd, pointing at 'commit'. May include an
 		// auth token
 		pipelinePtr := &pps.EtcdPipelineInfo{
-			SpecCommit:  commit,
-			State:       pps.PipelineState_PIPELINE_STARTING,
-			Parallelism: uint64(parallelism),
+			SpecCommit:     commit,
+			State:          pps.PipelineState_PIPELINE_STARTING,
+			Parallelism:    uint64(parallelism),
+			DesiredWorkers: pipelineInfo.ParallelismSpec.GetAutoscaling().GetMin(),
+			Transitions: ppsutil.RecordStateTransition(nil,
+				"", pps.PipelineState_PIPELINE_STARTING.String(), "pipeline created", ppsutil.ActorUser),
 		}
 
 		// Generate pipeline's auth token & add pipeline to the ACLs of input/output
@@ -2624,6 +3259,17 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			return nil, errors.Wrapf(err, "could not create/update stats branch")
 		}
 	}
+	// Named outputs, like stats, get a commit for every output commit
+	for _, output := range pipelineInfo.Outputs {
+		namedBranch := client.NewBranch(output.Repo, output.Branch)
+		if _, err := pfsClient.CreateBranch(ctx, &pfs.CreateBranchRequest{
+			Branch:     namedBranch,
+			Provenance: []*pfs.Branch{outputBranch},
+			Head:       namedBranchHeads[branchKey(namedBranch)],
+		}); err != nil {
+			return nil, errors.Wrapf(err, "could not create/update branch for output %q", output.Name)
+		}
+	}
 	if pipelineInfo.Spout != nil && pipelineInfo.Spout.Marker != "" {
 		if _, err := pfsClient.CreateBranch(ctx, &pfs.CreateBranchRequest{
 			Branch: markerBranch,
@@ -2646,6 +3292,10 @@ func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) error {
 		// Output branches default to master
 		pipelineInfo.OutputBranch = "master"
 	}
+	for _, output := range pipelineInfo.Outputs {
+		output.Branch = outputBranchName(pipelineInfo.Pipeline.Name, output)
+		output.Repo = outputRepoName(pipelineInfo.Pipeline.Name, output)
+	}
 	if pipelineInfo.CacheSize == "" {
 		pipelineInfo.CacheSize = "64M"
 	}
@@ -2687,6 +3337,14 @@ func setInputDefaults(pipelineName string, input *pps.Input) {
 				input.Pfs.Name = input.Pfs.Repo
 			}
 		}
+		if input.Window != nil {
+			if input.Window.Branch == "" {
+				input.Window.Branch = "master"
+			}
+			if input.Window.Name == "" {
+				input.Window.Name = input.Window.Repo
+			}
+		}
 		if input.Cron != nil {
 			if input.Cron.Start == nil {
 				start, _ := types.TimestampProto(now)
@@ -2695,6 +3353,17 @@ func setInputDefaults(pipelineName string, input *pps.Input) {
 			if input.Cron.Repo == "" {
 				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Cron.Name)
 			}
+			if input.Cron.CatchUp == "" {
+				input.Cron.CatchUp = cronCatchUpAll
+			}
+		}
+		if input.ObjectStore != nil {
+			if input.ObjectStore.Repo == "" {
+				input.ObjectStore.Repo = fmt.Sprintf("%s_%s", pipelineName, input.ObjectStore.Name)
+			}
+			if input.ObjectStore.Glob == "" {
+				input.ObjectStore.Glob = "/*"
+			}
 		}
 		if input.Git != nil {
 			if input.Git.Branch == "" {
@@ -2715,7 +3384,34 @@ func (a *apiServer) InspectPipeline(ctx context.Context, request *pps.InspectPip
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	pachClient := a.env.GetPachClient(ctx)
-	return a.inspectPipeline(pachClient, request.Pipeline.Name)
+	pipelineInfo, err := a.inspectPipeline(pachClient, request.Pipeline.Name)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+		if input.Cron == nil {
+			return
+		}
+		schedule, loc, err := cronSchedule(input.Cron)
+		if err != nil {
+			return
+		}
+		// The cron repo may not have been created yet
+		latestTime, err := getLatestCronTime(pachClient, input)
+		if err != nil {
+			return
+		}
+		next, err := types.TimestampProto(nextCronTick(schedule, loc, latestTime, now))
+		if err != nil {
+			return
+		}
+		if pipelineInfo.NextCronTicks == nil {
+			pipelineInfo.NextCronTicks = make(map[string]*types.Timestamp)
+		}
+		pipelineInfo.NextCronTicks[input.Cron.Name] = next
+	})
+	return pipelineInfo, nil
 }
 
 // inspectPipeline contains the functional implementation of InspectPipeline.
@@ -2806,6 +3502,9 @@ func (a *apiServer) inspectPipelineInTransaction(txnCtx *txnenv.TransactionConte
 	} else {
 		pipelineInfo.WorkersAvailable = int64(len(workerStatus))
 		pipelineInfo.WorkersRequested = int64(pipelinePtr.Parallelism)
+		if pipelineInfo.ParallelismSpec.GetAutoscaling() != nil {
+			pipelineInfo.WorkersRequested = int64(pipelinePtr.DesiredWorkers)
+		}
 	}
 	return pipelineInfo, nil
 }
@@ -3030,8 +3729,24 @@ func (a *apiServer) deletePipeline(pachClient *client.APIClient, request *pps.De
 			); err != nil {
 				return nil, err
 			}
+			for _, output := range pipelineInfo.Outputs {
+				if err := pachClient.CreateBranch(
+					output.Repo,
+					output.Branch,
+					output.Branch,
+					nil,
+				); err != nil && !isNotFoundErr(err) {
+					return nil, err
+				}
+			}
 		} else {
-			// delete the pipeline's output repo
+			// delete the repos of the pipeline's named outputs, which are
+			// downstream of its output repo, and then the output repo itself
+			for _, repo := range ownOutputRepos(pipelineInfo) {
+				if err := pachClient.DeleteRepo(repo, request.Force, request.SplitTransaction); err != nil && !isNotFoundErr(err) {
+					return nil, err
+				}
+			}
 			if err := pachClient.DeleteRepo(request.Pipeline.Name, request.Force, request.SplitTransaction); err != nil {
 				return nil, err
 			}
@@ -3101,7 +3816,7 @@ func (a *apiServer) deletePipeline(pachClient *client.APIClient, request *pps.De
 		}
 		return nil
 	})
-	// Delete cron input repos
+	// Delete cron and object store input repos
 	if !request.KeepRepo {
 		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
 			if input.Cron != nil {
@@ -3109,8 +3824,20 @@ func (a *apiServer) deletePipeline(pachClient *client.APIClient, request *pps.De
 					return pachClient.DeleteRepo(input.Cron.Repo, request.Force, request.SplitTransaction)
 				})
 			}
+			if input.ObjectStore != nil {
+				eg.Go(func() error {
+					return pachClient.DeleteRepo(input.ObjectStore.Repo, request.Force, request.SplitTransaction)
+				})
+			}
 		})
 	}
+	// Delete the records that the pipeline's workers keep for change lists
+	eg.Go(func() error {
+		_, err := pachClient.DeleteObjDirect(ctx, &pfs.DeleteObjDirectRequest{
+			Prefix: ppsutil.DatumRecordPrefix(request.Pipeline.Name),
+		})
+		return grpcutil.ScrubGRPC(err)
+	})
 	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
@@ -3140,7 +3867,7 @@ func (a *apiServer) StartPipeline(ctx context.Context, request *pps.StartPipelin
 	if err != nil {
 		return nil, err
 	}
-	if a.updatePipelineSpecCommit(pachClient, request.Pipeline.Name, commit); err != nil {
+	if err := a.updatePipelineSpecCommit(pachClient, request.Pipeline.Name, commit, false); err != nil {
 		return nil, err
 	}
 
@@ -3192,7 +3919,7 @@ func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineR
 	if err != nil {
 		return nil, err
 	}
-	if a.updatePipelineSpecCommit(pachClient, request.Pipeline.Name, commit); err != nil {
+	if err := a.updatePipelineSpecCommit(pachClient, request.Pipeline.Name, commit, true); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
@@ -3207,6 +3934,10 @@ func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineReq
 	pfsClient := pachClient.PfsAPIClient
 	ppsClient := pachClient.PpsAPIClient
 
+	parameters, err := validateRunParameters(request.Parameters)
+	if err != nil {
+		return nil, err
+	}
 	pipelineInfo, err := a.inspectPipeline(pachClient, request.Pipeline.Name)
 	if err != nil {
 		return nil, err
@@ -3333,24 +4064,30 @@ func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineReq
 	if _, ok := provenanceMap[key(specProvenance.Branch.Repo.Name, specProvenance.Branch.Name)]; !ok {
 		provenance = append(provenance, specProvenance)
 	}
-	if _, err := pachClient.ExecuteInTransaction(func(txnClient *client.APIClient) error {
-		newCommit, err := txnClient.PfsAPIClient.StartCommit(txnClient.Ctx(), &pfs.StartCommitRequest{
+	// Start the output commit (and the job, if it has run parameters) in a
+	// single write transaction, so that the pipeline's workers never see the
+	// commit without the job that it belongs to
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		newCommit, err := txnCtx.Pfs().StartCommitInTransaction(txnCtx, &pfs.StartCommitRequest{
 			Parent: &pfs.Commit{
 				Repo: &pfs.Repo{
 					Name: request.Pipeline.Name,
 				},
 			},
 			Provenance: provenance,
-		})
+		}, nil)
 		if err != nil {
 			return err
 		}
 
+		// the stats and named output commits need to additionally be provenant
+		// on the commit we just created
+		newCommitProv := client.NewCommitProvenance(newCommit.Repo.Name, "", newCommit.ID)
+
 		// if stats are enabled, then create a stats commit for the job as well
+		var statsCommit *pfs.Commit
 		if pipelineInfo.EnableStats {
-			// it needs to additionally be provenant on the commit we just created
-			newCommitProv := client.NewCommitProvenance(newCommit.Repo.Name, "", newCommit.ID)
-			_, err = txnClient.PfsAPIClient.StartCommit(txnClient.Ctx(), &pfs.StartCommitRequest{
+			statsCommit, err = txnCtx.Pfs().StartCommitInTransaction(txnCtx, &pfs.StartCommitRequest{
 				Parent: &pfs.Commit{
 					Repo: &pfs.Repo{
 						Name: request.Pipeline.Name,
@@ -3358,12 +4095,51 @@ func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineReq
 				},
 				Branch:     "stats",
 				Provenance: append(provenance, newCommitProv),
-			})
+			}, nil)
 			if err != nil {
 				return err
 			}
 		}
-		return nil
+
+		// likewise, create a commit for each of the pipeline's named outputs
+		var namedOutputCommits map[string]*pfs.Commit
+		for _, output := range pipelineInfo.Outputs {
+			namedCommit, err := txnCtx.Pfs().StartCommitInTransaction(txnCtx, &pfs.StartCommitRequest{
+				Parent: &pfs.Commit{
+					Repo: &pfs.Repo{
+						Name: output.Repo,
+					},
+				},
+				Branch:     output.Branch,
+				Provenance: append(provenance, newCommitProv),
+			}, nil)
+			if err != nil {
+				return err
+			}
+			if namedOutputCommits == nil {
+				namedOutputCommits = make(map[string]*pfs.Commit)
+			}
+			namedOutputCommits[output.Name] = namedCommit
+		}
+
+		// Without run parameters, the pipeline's workers create the job
+		// themselves when they see the new output commit
+		if parameters == nil {
+			return nil
+		}
+		jobPtr := &pps.EtcdJobInfo{
+			Job:          client.NewJob(uuid.NewWithoutDashes()),
+			OutputCommit: newCommit,
+			Pipeline:     request.Pipeline,
+			Stats:        &pps.ProcessStats{},
+			StatsCommit:  statsCommit,
+			Parameters:   parameters,
+			Trigger:      "run pipeline with parameters",
+
+			NamedOutputCommits: namedOutputCommits,
+		}
+		return ppsutil.UpdateJobState(a.pipelines.ReadWrite(txnCtx.Stm), a.jobs.ReadWrite(txnCtx.Stm),
+			jobPtr, pps.JobState_JOB_STARTING, "", ppsutil.ActorUser)
 	}); err != nil {
 		return nil, err
 	}
@@ -3371,6 +4147,23 @@ func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineReq
 	return &types.Empty{}, nil
 }
 
+// validateRunParameters checks the run parameters of a RunPipeline request,
+// and returns them, or nil if they don't change anything about the run
+func validateRunParameters(parameters *pps.RunParameters) (*pps.RunParameters, error) {
+	if parameters == nil || (len(parameters.Env) == 0 && parameters.Salt == "") {
+		return nil, nil
+	}
+	for name := range parameters.Env {
+		if name == "" {
+			return nil, errors.New("run parameter env vars must have a name")
+		}
+		if strings.Contains(name, "=") {
+			return nil, errors.Errorf("run parameter env var name %q cannot contain '='", name)
+		}
+	}
+	return parameters, nil
+}
+
 func (a *apiServer) RunCron(ctx context.Context, request *pps.RunCronRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -3407,7 +4200,13 @@ func (a *apiServer) RunCron(ctx context.Context, request *pps.RunCronRequest) (r
 	txnClient := pachClient.WithTransaction(txn)
 
 	// make a tick on each cron input
+	now := time.Now()
 	for _, cron := range crons {
+		// Name the tick in the cron's time zone, like the ticks of its schedule
+		_, loc, err := cronSchedule(cron)
+		if err != nil {
+			return nil, err
+		}
 		// Use a PutFileClient
 		pfc, err := txnClient.NewPutFileClient()
 		if err != nil {
@@ -3422,7 +4221,7 @@ func (a *apiServer) RunCron(ctx context.Context, request *pps.RunCronRequest) (r
 		}
 
 		// Put in an empty file named by the timestamp
-		_, err = pfc.PutFile(cron.Repo, "master", time.Now().Format(time.RFC3339), strings.NewReader(""))
+		_, err = pfc.PutFile(cron.Repo, "master", now.In(loc).Format(time.RFC3339), strings.NewReader(""))
 		if err != nil {
 			return nil, errors.Wrapf(err, "put error")
 		}
@@ -3440,6 +4239,98 @@ func (a *apiServer) RunCron(ctx context.Context, request *pps.RunCronRequest) (r
 	return &types.Empty{}, nil
 }
 
+// RollbackPipeline implements the protobuf pps.RollbackPipeline RPC
+func (a *apiServer) RollbackPipeline(ctx context.Context, request *pps.RollbackPipelineRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "RollbackPipeline")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+	if request.Pipeline == nil {
+		return nil, errors.New("request.Pipeline cannot be nil")
+	}
+	pachClient := a.env.GetPachClient(ctx)
+	if _, err := checkLoggedIn(pachClient); err != nil {
+		return nil, err
+	}
+
+	// Walk back through the pipeline's spec commits, to the most recent one
+	// with the requested version (StartPipeline and StopPipeline write spec
+	// commits without changing the version)
+	var current, target *pps.PipelineInfo
+	if err := a.listPipelinePtr(pachClient, request.Pipeline, -1, func(name string, ptr *pps.EtcdPipelineInfo) error {
+		pipelineInfo, err := ppsutil.GetPipelineInfo(pachClient, name, ptr)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			current = pipelineInfo
+		}
+		if pipelineInfo.Version == request.Version {
+			target = pipelineInfo
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil && !errors.Is(err, errutil.ErrBreak) {
+		return nil, err
+	}
+	if target == nil {
+		return nil, errors.Errorf("pipeline %q has no version %d", request.Pipeline.Name, request.Version)
+	}
+	if target == current {
+		return nil, errors.Errorf("pipeline %q is already at version %d", request.Pipeline.Name, request.Version)
+	}
+	if err := checkRollbackInputs(pachClient, current, target); err != nil {
+		return nil, err
+	}
+
+	// Re-apply the old spec as an update, which gives it a new version
+	createRequest := ppsutil.PipelineReqFromInfo(target)
+	createRequest.Update = true
+	createRequest.Reprocess = request.Reprocess
+	return a.CreatePipeline(ctx, createRequest)
+}
+
+// checkRollbackInputs returns an error if 'target' reads from a repo or branch
+// that no longer exists, e.g. because it was renamed after 'target' was
+// created. Renaming a repo or branch rewrites the pipeline's current spec, but
+// not its history, so re-applying 'target' would read from the old name.
+func checkRollbackInputs(pachClient *client.APIClient, current, target *pps.PipelineInfo) error {
+	currentBranches := make(map[string]bool)
+	pps.VisitInput(current.Input, func(input *pps.Input) {
+		if input.Pfs != nil {
+			currentBranches[input.Pfs.Repo+"@"+input.Pfs.Branch] = true
+		}
+	})
+	var checkErr error
+	pps.VisitInput(target.Input, func(input *pps.Input) {
+		repo := inputRepo(input)
+		if checkErr != nil || repo == "" || input.Git != nil {
+			return
+		}
+		if _, err := pachClient.InspectRepo(repo); err != nil {
+			if pfsServer.IsRepoNotFoundErr(err) {
+				err = errors.Errorf("version %d of pipeline %q reads from repo %q, which no longer exists (it may have been renamed); update the pipeline instead",
+					target.Version, target.Pipeline.Name, repo)
+			}
+			checkErr = err
+			return
+		}
+		// A branch that the current version doesn't read, and that doesn't
+		// exist, may have been renamed
+		if input.Pfs == nil || currentBranches[repo+"@"+input.Pfs.Branch] {
+			return
+		}
+		if _, err := pachClient.InspectBranch(repo, input.Pfs.Branch); err != nil {
+			if pfsServer.IsBranchNotFoundErr(err) {
+				err = errors.Errorf("version %d of pipeline %q reads from branch %s@%s, which no longer exists (it may have been renamed); update the pipeline instead",
+					target.Version, target.Pipeline.Name, repo, input.Pfs.Branch)
+			}
+			checkErr = err
+		}
+	})
+	return checkErr
+}
+
 // CreateSecret implements the protobuf pps.CreateSecret RPC
 func (a *apiServer) CreateSecret(ctx context.Context, request *pps.CreateSecretRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -3554,29 +4445,18 @@ func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (respon
 	ctx = pachClient.Ctx() // pachClient will propagate auth info
 
 	// check if the caller is authorized -- they must be an admin
-	if me, err := pachClient.WhoAmI(ctx, &auth.WhoAmIRequest{}); err == nil {
-		var isAdmin bool
-		for _, s := range me.ClusterRoles.Roles {
-			if s == auth.ClusterRole_SUPER {
-				isAdmin = true
-				break
-			}
-		}
-
-		if !isAdmin {
-			return nil, &auth.ErrNotAuthorized{
-				Subject: me.Username,
-				AdminOp: "DeleteAll",
-			}
-		}
-	} else if !auth.IsErrNotActivated(err) {
-		return nil, errors.Wrapf(err, "error during authorization check")
+	if err := checkClusterAdmin(pachClient, "DeleteAll"); err != nil {
+		return nil, err
 	}
 
 	if _, err := a.DeletePipeline(ctx, &pps.DeletePipelineRequest{All: true, Force: true}); err != nil {
 		return nil, err
 	}
 
+	if _, err := a.DeleteMemo(ctx, &pps.DeleteMemoRequest{All: true}); err != nil {
+		return nil, err
+	}
+
 	if err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{
 		LabelSelector: "secret-source=pachyderm-user",
 	}); err != nil {
@@ -3714,6 +4594,24 @@ func CollectActiveObjectsAndTags(ctx context.Context, pachClient *client.APIClie
 		return nil, err
 	}
 
+	// Datum outputs in the memoization cache outlive the pipelines that
+	// created them
+	tags, err := pachClient.ObjectAPIClient.ListTags(pachClient.Ctx(), &pfs.ListTagsRequest{
+		Prefix:        ppsconsts.MemoTagPrefix,
+		IncludeObject: true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing memoized objects")
+	}
+	for resp, err := tags.Recv(); !errors.Is(err, io.EOF); resp, err = tags.Recv() {
+		if err != nil {
+			return nil, err
+		}
+		result.Tags.AddString(resp.Tag.Name)
+		result.NTags++
+		addActiveObjects(resp.Object)
+	}
+
 	return result, nil
 }
 
@@ -3932,7 +4830,9 @@ func isNotFoundErr(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "not found")
 }
 
-func (a *apiServer) updatePipelineSpecCommit(pachClient *client.APIClient, pipelineName string, commit *pfs.Commit) error {
+// updatePipelineSpecCommit points 'pipelineName' at the spec commit 'commit',
+// written by StopPipeline or StartPipeline, which 'stopped' says was which
+func (a *apiServer) updatePipelineSpecCommit(pachClient *client.APIClient, pipelineName string, commit *pfs.Commit, stopped bool) error {
 	_, err := col.NewSTM(pachClient.Ctx(), a.env.GetEtcdClient(), func(stm col.STM) error {
 		pipelines := a.pipelines.ReadWrite(stm)
 		pipelinePtr := &pps.EtcdPipelineInfo{}
@@ -3940,6 +4840,24 @@ func (a *apiServer) updatePipelineSpecCommit(pachClient *client.APIClient, pipel
 			return err
 		}
 		pipelinePtr.SpecCommit = commit
+		// Move the pipeline into the state that the PPS master would move it
+		// into once it saw the new spec, so that the transition is the user's.
+		// The master then brings the pipeline's RC in line with the state.
+		to := pipelinePtr.State
+		switch {
+		case pipelinePtr.State == pps.PipelineState_PIPELINE_FAILURE:
+			// Only UpdatePipeline can bring a pipeline out of failure
+		case stopped:
+			to = pps.PipelineState_PIPELINE_PAUSED
+		case pipelinePtr.State == pps.PipelineState_PIPELINE_PAUSED:
+			to = pps.PipelineState_PIPELINE_RUNNING
+		}
+		if to != pipelinePtr.State {
+			pipelinePtr.Transitions = ppsutil.RecordStateTransition(pipelinePtr.Transitions,
+				pipelinePtr.State.String(), to.String(), "", ppsutil.ActorUser)
+			pipelinePtr.State = to
+			pipelinePtr.Reason = ""
+		}
 		return pipelines.Put(pipelineName, pipelinePtr)
 	})
 	if isNotFoundErr(err) {
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+)
+
+// ApproveJob implements the protobuf pps.ApproveJob RPC
+func (a *apiServer) ApproveJob(ctx context.Context, request *pps.ApproveJobRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.env.GetPachClient(ctx)
+	ctx, err := checkLoggedIn(pachClient)
+	if err != nil {
+		return nil, err
+	}
+	if request.Job == nil {
+		return nil, errors.New("request.Job cannot be nil")
+	}
+	jobInfo, err := a.InspectJob(ctx, &pps.InspectJobRequest{Job: request.Job})
+	if err != nil {
+		return nil, err
+	}
+	// Use the spec that the job is running with, in case the pipeline has
+	// since been updated
+	pipelineInfo, err := ppsutil.GetPipelineInfo(pachClient, jobInfo.Pipeline.Name, &pps.EtcdPipelineInfo{SpecCommit: jobInfo.SpecCommit})
+	if err != nil {
+		return nil, err
+	}
+	if pipelineInfo.Approval == nil {
+		return nil, errors.Errorf("pipeline %q doesn't require approval", jobInfo.Pipeline.Name)
+	}
+	user, err := a.authorizeJobApproval(pachClient, pipelineInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	approval := &pps.JobApproval{
+		Approved: !request.Reject,
+		User:     user,
+		Reason:   request.Reason,
+		Time:     types.TimestampNow(),
+	}
+	// The job's workers watch for the approval, and finish (or fail) the job.
+	// Until they do, the job stays in JOB_AWAITING_APPROVAL, with the approval
+	// as its reason.
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		jobs := a.jobs.ReadWrite(stm)
+		jobPtr := &pps.EtcdJobInfo{}
+		if err := jobs.Get(request.Job.ID, jobPtr); err != nil {
+			return err
+		}
+		if jobPtr.State != pps.JobState_JOB_AWAITING_APPROVAL {
+			return errors.Errorf("job %s is not awaiting approval (its state is %s)", request.Job.ID, jobPtr.State)
+		}
+		if jobPtr.Approval != nil {
+			return errors.Errorf("job %s has already been %s", request.Job.ID, ppsutil.ApprovalReason(jobPtr.Approval))
+		}
+		jobPtr.Approval = approval
+		return ppsutil.UpdateJobState(a.pipelines.ReadWrite(stm), jobs, jobPtr,
+			pps.JobState_JOB_AWAITING_APPROVAL, ppsutil.ApprovalReason(approval), ppsutil.ActorUser)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// authorizeJobApproval checks that the caller may approve or reject the jobs
+// of 'pipelineInfo', and returns the caller's username ("" if auth isn't
+// active, in which case anyone may)
+func (a *apiServer) authorizeJobApproval(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) (string, error) {
+	me, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{})
+	if auth.IsErrNotActivated(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	if me.IsAdmin || isApprover(pipelineInfo.Approval, me.Username) {
+		return me.Username, nil
+	}
+	if len(pipelineInfo.Approval.Approvers) > 0 {
+		return "", &auth.ErrNotAuthorized{
+			Subject: me.Username,
+			AdminOp: fmt.Sprintf("ApproveJob on pipeline %q without being one of its approvers", pipelineInfo.Pipeline.Name),
+		}
+	}
+	// Without a list of approvers, anyone who can write to the output repo may
+	// approve the pipeline's jobs
+	repo := pipelineInfo.Pipeline.Name
+	resp, err := pachClient.Authorize(pachClient.Ctx(), &auth.AuthorizeRequest{
+		Repo:  repo,
+		Scope: auth.Scope_WRITER,
+	})
+	if err != nil {
+		return "", err
+	}
+	if !resp.Authorized {
+		return "", &auth.ErrNotAuthorized{
+			Subject:  me.Username,
+			Repo:     repo,
+			Required: auth.Scope_WRITER,
+		}
+	}
+	return me.Username, nil
+}
+
+// isApprover returns true if 'username' is one of the approvers in 'approval'
+func isApprover(approval *pps.Approval, username string) bool {
+	for _, approver := range approval.Approvers {
+		if approver == username {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,16 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestIsApprover(t *testing.T) {
+	approval := &pps.Approval{Approvers: []string{"github:alice", "robot:ci"}}
+	require.True(t, isApprover(approval, "github:alice"))
+	require.True(t, isApprover(approval, "robot:ci"))
+	require.False(t, isApprover(approval, "github:bob"))
+	require.False(t, isApprover(&pps.Approval{}, "github:alice"))
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"math"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/work"
+)
+
+const (
+	// autoscaleInterval is how often the PPS master samples the work queue of
+	// each autoscaling pipeline
+	autoscaleInterval = 10 * time.Second
+
+	defaultScaleUpCooldown   = 30 * time.Second
+	defaultScaleDownCooldown = 5 * time.Minute
+
+	// defaultDrainTimeout is how long the workers of an autoscaling pipeline
+	// with no datum timeout have to finish their datums when scaled down
+	defaultDrainTimeout = 10 * time.Minute
+)
+
+// autoscaler decides how many workers an autoscaling pipeline should have,
+// based on successive samples of the pipeline's work queue. It's used by
+// monitorPipeline, which calls next() every autoscaleInterval.
+type autoscaler struct {
+	min, max          uint64
+	scaleUpCooldown   time.Duration
+	scaleDownCooldown time.Duration
+
+	lastSample time.Time // the time of the previous call to next()
+	lastDone   int64     // the number of finished subtasks at 'lastSample'
+	lastChange time.Time // the last time that next() changed the worker count
+
+	// rate is a moving average of the number of subtasks that each worker
+	// finishes per second, or 0 if it's not known yet
+	rate float64
+}
+
+func newAutoscaler(spec *pps.Autoscaling) *autoscaler {
+	s := &autoscaler{
+		min:               spec.Min,
+		max:               spec.Max,
+		scaleUpCooldown:   defaultScaleUpCooldown,
+		scaleDownCooldown: defaultScaleDownCooldown,
+	}
+	// The cooldowns are validated by CreatePipeline
+	if d, err := types.DurationFromProto(spec.ScaleUpCooldown); spec.ScaleUpCooldown != nil && err == nil {
+		s.scaleUpCooldown = d
+	}
+	if d, err := types.DurationFromProto(spec.ScaleDownCooldown); spec.ScaleDownCooldown != nil && err == nil {
+		s.scaleDownCooldown = d
+	}
+	return s
+}
+
+// next returns the number of workers that the pipeline should have at 'now',
+// given that it currently has 'current' workers and that its work queue is
+// described by 'stats'.
+//
+// The target is the number of workers that would finish the outstanding
+// subtasks within one scale-up cooldown at the throughput observed so far (or
+// one worker per outstanding subtask, if no throughput has been observed),
+// bounded by min and max. The worker count only changes if the relevant
+// cooldown has passed since the previous change.
+func (s *autoscaler) next(now time.Time, current uint64, stats *work.QueueStats) uint64 {
+	// Update the throughput estimate. 'Done' may drop when the subtasks of
+	// finished jobs are deleted, in which case this sample tells us nothing
+	if !s.lastSample.IsZero() && current > 0 && stats.Done > s.lastDone {
+		elapsed := now.Sub(s.lastSample).Seconds()
+		if elapsed > 0 {
+			observed := float64(stats.Done-s.lastDone) / float64(current) / elapsed
+			if s.rate == 0 {
+				s.rate = observed
+			} else {
+				s.rate = (s.rate + observed) / 2
+			}
+		}
+	}
+	s.lastSample, s.lastDone = now, stats.Done
+
+	target := s.min
+	if stats.Outstanding > 0 {
+		needed := float64(stats.Outstanding)
+		if s.rate > 0 && s.scaleUpCooldown > 0 {
+			needed = math.Ceil(needed / (s.rate * s.scaleUpCooldown.Seconds()))
+		}
+		if needed > float64(s.max) {
+			target = s.max
+		} else if uint64(needed) > target {
+			target = uint64(needed)
+		}
+	}
+	if target > s.max {
+		target = s.max
+	}
+
+	switch {
+	case current < s.min || current > s.max:
+		// The bounds changed (or the pipeline was just created)--move within
+		// them immediately
+	case target > current && now.Sub(s.lastChange) < s.scaleUpCooldown,
+		target < current && now.Sub(s.lastChange) < s.scaleDownCooldown:
+		return current
+	}
+	if target != current {
+		s.lastChange = now
+	}
+	return target
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/work"
+)
+
+func TestAutoscaler(t *testing.T) {
+	s := newAutoscaler(&pps.Autoscaling{
+		Min:               1,
+		Max:               10,
+		ScaleUpCooldown:   types.DurationProto(10 * time.Second),
+		ScaleDownCooldown: types.DurationProto(time.Minute),
+	})
+	start := time.Now()
+
+	// With no throughput observed, scale up to one worker per subtask
+	require.Equal(t, uint64(4), s.next(start, 1, &work.QueueStats{Outstanding: 4}))
+	// ...but not past max
+	require.Equal(t, uint64(4), s.next(start.Add(time.Second), 4, &work.QueueStats{Outstanding: 100}))
+	require.Equal(t, uint64(10), s.next(start.Add(11*time.Second), 4, &work.QueueStats{Outstanding: 100}))
+
+	// 10 workers finish 20 subtasks in 10s (0.2 subtasks/worker/s), so 6
+	// workers would finish the remaining 12 subtasks within the cooldown, but
+	// scaling down has to wait for the longer cooldown
+	require.Equal(t, uint64(10), s.next(start.Add(21*time.Second), 10, &work.QueueStats{Outstanding: 12, Done: 20}))
+	require.Equal(t, uint64(6), s.next(start.Add(72*time.Second), 10, &work.QueueStats{Outstanding: 12, Done: 20}))
+
+	// Once the queue is empty, scale down to min
+	require.Equal(t, uint64(6), s.next(start.Add(80*time.Second), 6, &work.QueueStats{Done: 32}))
+	require.Equal(t, uint64(1), s.next(start.Add(140*time.Second), 6, &work.QueueStats{Done: 32}))
+
+	// A worker count outside of the bounds is corrected immediately
+	require.Equal(t, uint64(1), s.next(start.Add(141*time.Second), 0, &work.QueueStats{}))
+	require.Equal(t, uint64(10), s.next(start.Add(142*time.Second), 12, &work.QueueStats{Outstanding: 1000}))
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"path"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/robfig/cron"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+)
+
+// The policies for the ticks of a cron input that were missed while pachd was
+// down
+const (
+	cronCatchUpAll    = "all"
+	cronCatchUpLatest = "latest"
+	cronCatchUpNone   = "none"
+)
+
+// cronSchedule parses the schedule of 'in', and loads the location that the
+// schedule is evaluated in.
+func cronSchedule(in *pps.CronInput) (cron.Schedule, *time.Location, error) {
+	schedule, err := cron.ParseStandard(in.Spec)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error parsing cron-spec")
+	}
+	loc := time.UTC
+	if in.Timezone != "" {
+		if loc, err = time.LoadLocation(in.Timezone); err != nil {
+			return nil, nil, errors.Wrapf(err, "error loading cron timezone")
+		}
+	}
+	return schedule, loc, nil
+}
+
+// getLatestCronTime returns the time of the latest tick committed to the repo
+// of the cron input 'in', or its start time if there are none.
+func getLatestCronTime(pachClient *client.APIClient, in *pps.Input) (time.Time, error) {
+	var latestTime time.Time
+	files, err := pachClient.ListFile(in.Cron.Repo, "master", "")
+	if err != nil && !pfsserver.IsNoHeadErr(err) {
+		return latestTime, err
+	} else if err != nil || len(files) == 0 {
+		// File not found, this happens the first time the pipeline is run
+		latestTime, err = types.TimestampFromProto(in.Cron.Start)
+		if err != nil {
+			return latestTime, err
+		}
+		return latestTime, nil
+	}
+	// Ticks are named by their timestamps, but as they may be in different
+	// UTC offsets (e.g. on either side of a DST change), their names can't be
+	// compared directly
+	for _, file := range files {
+		t, err := time.Parse(time.RFC3339, path.Base(file.File.Path))
+		if err != nil {
+			return latestTime, err
+		}
+		if t.After(latestTime) {
+			latestTime = t
+		}
+	}
+	return latestTime, nil
+}
+
+// missedCronTicks returns the ticks of 'schedule' after 'latest' and no later
+// than 'now' that should be committed under the catch-up policy 'catchUp'
+// (limited to the latest 'maxCatchUp', if it's set).
+func missedCronTicks(schedule cron.Schedule, latest, now time.Time, catchUp string, maxCatchUp int64) []time.Time {
+	if catchUp == cronCatchUpNone {
+		return nil
+	}
+	limit := maxCatchUp
+	if catchUp == cronCatchUpLatest {
+		limit = 1
+	}
+	var ticks []time.Time
+	// Next returns the zero time if the schedule never matches
+	for t := schedule.Next(latest); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		ticks = append(ticks, t)
+		if limit > 0 && int64(len(ticks)) > limit {
+			ticks = ticks[1:]
+		}
+	}
+	return ticks
+}
+
+// nextCronTick returns the time of the next tick of 'schedule' after the tick
+// at 'latest' that hasn't been missed at 'now', in 'loc'.
+func nextCronTick(schedule cron.Schedule, loc *time.Location, latest, now time.Time) time.Time {
+	if now.After(latest) {
+		latest = now
+	}
+	return schedule.Next(latest.In(loc))
+}
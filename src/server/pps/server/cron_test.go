@@ -0,0 +1,59 @@
+package server
+
+import (
+	"testing"
+	"time"
+	// Like pachd, load time zones from the embedded database if need be
+	_ "time/tzdata"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestMissedCronTicks(t *testing.T) {
+	schedule, _, err := cronSchedule(&pps.CronInput{Spec: "0 * * * *"})
+	require.NoError(t, err)
+	latest := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := latest.Add(5*time.Hour + 30*time.Minute)
+	hours := func(ticks []time.Time) []int {
+		var result []int
+		for _, tick := range ticks {
+			result = append(result, tick.Hour())
+		}
+		return result
+	}
+
+	require.Equal(t, []int{1, 2, 3, 4, 5}, hours(missedCronTicks(schedule, latest, now, cronCatchUpAll, 0)))
+	require.Equal(t, []int{4, 5}, hours(missedCronTicks(schedule, latest, now, cronCatchUpAll, 2)))
+	require.Equal(t, []int{5}, hours(missedCronTicks(schedule, latest, now, cronCatchUpLatest, 0)))
+	require.Equal(t, 0, len(missedCronTicks(schedule, latest, now, cronCatchUpNone, 0)))
+	require.Equal(t, 0, len(missedCronTicks(schedule, now, now, cronCatchUpAll, 0)))
+}
+
+func TestCronTimezone(t *testing.T) {
+	schedule, loc, err := cronSchedule(&pps.CronInput{Spec: "0 9 * * *", Timezone: "America/New_York"})
+	require.NoError(t, err)
+	// 9am in New York is 14:00 UTC in winter and 13:00 UTC in summer
+	winter := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2020, 1, 1, 14, 0, 0, 0, time.UTC), nextCronTick(schedule, loc, winter, winter).UTC())
+	summer := time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2020, 7, 1, 13, 0, 0, 0, time.UTC), nextCronTick(schedule, loc, summer, summer).UTC())
+
+	_, _, err = cronSchedule(&pps.CronInput{Spec: "0 9 * * *", Timezone: "Not/AZone"})
+	require.YesError(t, err)
+}
+
+func TestMissedCronTicksTimezone(t *testing.T) {
+	schedule, loc, err := cronSchedule(&pps.CronInput{Spec: "0 9 * * *", Timezone: "America/New_York"})
+	require.NoError(t, err)
+	// The ticks missed across the start of DST (2020-03-08) stay at 9am in New
+	// York, and are named with the offset in effect at each tick
+	latest := time.Date(2020, 3, 7, 9, 0, 0, 0, loc)
+	now := time.Date(2020, 3, 9, 12, 0, 0, 0, loc)
+	var names []string
+	for _, tick := range missedCronTicks(schedule, latest, now, cronCatchUpAll, 0) {
+		names = append(names, tick.Format(time.RFC3339))
+	}
+	require.Equal(t, []string{"2020-03-08T09:00:00-04:00", "2020-03-09T09:00:00-04:00"}, names)
+	require.Equal(t, "2020-03-07T09:00:00-05:00", latest.Format(time.RFC3339))
+}
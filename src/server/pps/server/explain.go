@@ -0,0 +1,277 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	workercommon "github.com/pachyderm/pachyderm/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/src/server/worker/datum"
+
+	"github.com/sirupsen/logrus"
+)
+
+// recordTriggerTimeout bounds how long recordJobTrigger may take
+const recordTriggerTimeout = 5 * time.Minute
+
+// ExplainJob implements the protobuf pps.ExplainJob RPC
+func (a *apiServer) ExplainJob(ctx context.Context, request *pps.ExplainJobRequest) (response *pps.JobExplanation, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.env.GetPachClient(ctx)
+	ctx, err := checkLoggedIn(pachClient)
+	if err != nil {
+		return nil, err
+	}
+	if request.Job == nil {
+		return nil, errors.New("request.Job cannot be nil")
+	}
+	jobInfo, err := a.InspectJob(ctx, &pps.InspectJobRequest{Job: request.Job})
+	if err != nil {
+		return nil, err
+	}
+	explanation, parentJobInfo, err := a.explainJobInputs(pachClient, jobInfo)
+	if err != nil {
+		return nil, err
+	}
+	explanation.Job = jobInfo.Job
+	explanation.DatumsRecovered = jobInfo.DataRecovered
+	if jobInfo.Input == nil {
+		return explanation, nil // spouts have no datums
+	}
+
+	// Classify the job's datums by whether the parent job skipped them (same
+	// hash), or processed the same input files (same record ID)
+	skipped := make(map[string]bool)
+	var parentDit datum.Iterator
+	if parentJobInfo != nil && parentJobInfo.Input != nil {
+		commitInfo, err := pachClient.InspectCommit(parentJobInfo.OutputCommit.Repo.Name, parentJobInfo.OutputCommit.ID)
+		if err != nil {
+			return nil, err
+		}
+		if commitInfo.Finished != nil {
+			if skipped, err = commitDatums(pachClient, commitInfo); err != nil {
+				return nil, err
+			}
+		}
+		if parentDit, err = datum.NewIterator(pachClient, parentJobInfo.Input); err != nil {
+			return nil, err
+		}
+	}
+	dit, err := datum.NewIterator(pachClient, jobInfo.Input)
+	if err != nil {
+		return nil, err
+	}
+	countDatums(explanation, jobInfo.Pipeline.Name, jobInfo.Salt, skipped, parentDit, dit)
+	return explanation, nil
+}
+
+// countDatums sets the datum counts of 'explanation' for a job with the
+// datums in 'dit', given the datums of its parent job in 'parentDit' (nil if
+// there isn't one) and the hashes of the datums that the job can skip.
+// Datums are matched with the parent job's by their input files' names and
+// paths, and then compared by content.
+func countDatums(explanation *pps.JobExplanation, pipelineName, salt string, skipped map[string]bool, parentDit, dit datum.Iterator) {
+	parentDatums := make(map[string]string) // record ID -> datum ID
+	if parentDit != nil {
+		for i := 0; i < parentDit.Len(); i++ {
+			inputs := parentDit.DatumN(i)
+			parentDatums[workercommon.RecordID(inputs)] = workercommon.DatumID(inputs)
+		}
+	}
+	explanation.DatumsTotal = int64(dit.Len())
+	for i := 0; i < dit.Len(); i++ {
+		inputs := dit.DatumN(i)
+		if skipped[workercommon.HashDatum(pipelineName, salt, inputs)] {
+			explanation.DatumsSkipped++
+			continue
+		}
+		parentID, ok := parentDatums[workercommon.RecordID(inputs)]
+		switch {
+		case !ok:
+			explanation.DatumsNew++
+		case parentID == workercommon.DatumID(inputs):
+			explanation.DatumsUnchanged++
+		default:
+			explanation.DatumsChanged++
+		}
+	}
+}
+
+// recordJobTrigger records the parent job of 'job' and why it ran (see
+// JobInfo.trigger) in its EtcdJobInfo.
+// Comparing the job's inputs with its parent's can be slow, so CreateJob runs
+// this asynchronously once the job exists. It's informational, so failures
+// are only logged.
+func (a *apiServer) recordJobTrigger(job *pps.Job) {
+	// The request that created the job is over, so use a context of our own
+	ctx, cancel := context.WithTimeout(context.Background(), recordTriggerTimeout)
+	defer cancel()
+	if err := a.sudo(a.env.GetPachClient(ctx), func(superUserClient *client.APIClient) error {
+		jobInfo, err := a.InspectJob(superUserClient.Ctx(), &pps.InspectJobRequest{Job: job})
+		if err != nil {
+			return err
+		}
+		explanation, _, err := a.explainJobInputs(superUserClient, jobInfo)
+		if err != nil {
+			return err
+		}
+		_, err = col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			jobPtr := &pps.EtcdJobInfo{}
+			return a.jobs.ReadWrite(stm).Update(job.ID, jobPtr, func() error {
+				jobPtr.ParentJob = explanation.ParentJob
+				jobPtr.Trigger = explanation.Trigger
+				return nil
+			})
+		})
+		return err
+	}); err != nil {
+		logrus.Errorf("could not explain job %s: %v", job.ID, err)
+	}
+}
+
+// explainJobInputs compares the output commit of the job 'jobInfo' with its
+// parent commit, and returns the parent commit's job (if any), the inputs
+// whose commits moved and whether the pipeline's spec or the job's salt
+// changed. The explanation's datum counts aren't set.
+func (a *apiServer) explainJobInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) (*pps.JobExplanation, *pps.JobInfo, error) {
+	explanation := &pps.JobExplanation{}
+	outputCommit := jobInfo.OutputCommit
+	commitInfo, err := pachClient.InspectCommit(outputCommit.Repo.Name, outputCommit.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	pipelineInfo, err := specCommitPipelineInfo(pachClient, commitInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+	var parentInfo *pfs.CommitInfo
+	var parentPipelineInfo *pps.PipelineInfo
+	if commitInfo.ParentCommit != nil {
+		if parentInfo, err = pachClient.InspectCommit(commitInfo.ParentCommit.Repo.Name, commitInfo.ParentCommit.ID); err != nil {
+			return nil, nil, err
+		}
+		if parentPipelineInfo, err = specCommitPipelineInfo(pachClient, parentInfo); err != nil {
+			return nil, nil, err
+		}
+	}
+	if parentPipelineInfo == nil {
+		explanation.Trigger = jobTrigger(explanation)
+		return explanation, nil, nil
+	}
+	parentJobInfo, err := a.outputCommitJob(pachClient, parentInfo.Commit)
+	if err != nil {
+		return nil, nil, err
+	}
+	if parentJobInfo != nil {
+		explanation.ParentJob = parentJobInfo.Job
+	}
+	explanation.SpecChanged = pipelineInfo != nil && pipelineInfo.Version != parentPipelineInfo.Version
+	// The jobs' salts include any salt they were run with, as well as the
+	// pipeline's
+	explanation.SaltChanged = parentJobInfo != nil && jobInfo.Salt != parentJobInfo.Salt
+	if pipelineInfo != nil {
+		explanation.InputChanges = inputChanges(
+			inputCommits(pipelineInfo.Input, commitInfo),
+			inputCommits(parentPipelineInfo.Input, parentInfo),
+		)
+	}
+	explanation.Trigger = jobTrigger(explanation)
+	return explanation, parentJobInfo, nil
+}
+
+// specCommitPipelineInfo returns the PipelineInfo in the spec commit in the
+// provenance of the output commit 'commitInfo', or nil if there isn't one
+func specCommitPipelineInfo(pachClient *client.APIClient, commitInfo *pfs.CommitInfo) (*pps.PipelineInfo, error) {
+	pipelineName := commitInfo.Commit.Repo.Name
+	for _, prov := range commitInfo.Provenance {
+		if prov.Commit.Repo.Name == ppsconsts.SpecRepo && prov.Branch.Name == pipelineName {
+			return ppsutil.GetPipelineInfo(pachClient, pipelineName, &pps.EtcdPipelineInfo{SpecCommit: prov.Commit})
+		}
+	}
+	return nil, nil
+}
+
+// inputCommits returns the commit of each of the input branches of 'input'
+// in the provenance of the output commit 'commitInfo', keyed by
+// "<repo>@<branch>"
+func inputCommits(input *pps.Input, commitInfo *pfs.CommitInfo) map[string]*pfs.CommitProvenance {
+	inputs := make(map[string]bool)
+	for _, branch := range branchProvenance(input) {
+		inputs[branchKey(branch)] = true
+	}
+	result := make(map[string]*pfs.CommitProvenance)
+	for _, prov := range commitInfo.Provenance {
+		if key := branchKey(prov.Branch); inputs[key] {
+			result[key] = prov
+		}
+	}
+	return result
+}
+
+func branchKey(branch *pfs.Branch) string {
+	return fmt.Sprintf("%s@%s", branch.Repo.Name, branch.Name)
+}
+
+// inputChanges returns the input branches whose commits differ between 'new'
+// and 'old' (as returned by inputCommits), sorted by branch
+func inputChanges(new, old map[string]*pfs.CommitProvenance) []*pps.InputChange {
+	keys := make(map[string]bool)
+	for k := range new {
+		keys[k] = true
+	}
+	for k := range old {
+		keys[k] = true
+	}
+	var result []*pps.InputChange
+	for _, k := range sortedKeys(keys) {
+		newProv, oldProv := new[k], old[k]
+		if newProv != nil && oldProv != nil && newProv.Commit.ID == oldProv.Commit.ID {
+			continue
+		}
+		change := &pps.InputChange{}
+		if oldProv != nil {
+			change.Branch, change.OldCommit = oldProv.Branch, oldProv.Commit
+		}
+		if newProv != nil {
+			change.Branch, change.NewCommit = newProv.Branch, newProv.Commit
+		}
+		result = append(result, change)
+	}
+	return result
+}
+
+// jobTrigger returns a short explanation of why the job described by
+// 'explanation' ran
+func jobTrigger(explanation *pps.JobExplanation) string {
+	var reasons []string
+	if explanation.SaltChanged {
+		reasons = append(reasons, "pipeline updated with reprocess")
+	} else if explanation.SpecChanged {
+		reasons = append(reasons, "pipeline updated")
+	}
+	if len(explanation.InputChanges) > 0 {
+		var branches []string
+		for _, change := range explanation.InputChanges {
+			branches = append(branches, branchKey(change.Branch))
+		}
+		sort.Strings(branches)
+		reasons = append(reasons, "new input commits in "+strings.Join(branches, ", "))
+	}
+	if len(reasons) == 0 {
+		if explanation.ParentJob == nil {
+			return "first job"
+		}
+		return "rerun"
+	}
+	return strings.Join(reasons, "; ")
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	workercommon "github.com/pachyderm/pachyderm/src/server/worker/common"
+)
+
+func TestInputChanges(t *testing.T) {
+	prov := func(repo, branch, commit string) *pfs.CommitProvenance {
+		return &pfs.CommitProvenance{
+			Commit: client.NewCommit(repo, commit),
+			Branch: client.NewBranch(repo, branch),
+		}
+	}
+	old := map[string]*pfs.CommitProvenance{
+		"a@master": prov("a", "master", "1"),
+		"b@master": prov("b", "master", "2"),
+		"c@master": prov("c", "master", "3"),
+	}
+	new := map[string]*pfs.CommitProvenance{
+		"a@master": prov("a", "master", "1"),
+		"b@master": prov("b", "master", "4"),
+		"d@dev":    prov("d", "dev", "5"),
+	}
+	changes := inputChanges(new, old)
+	require.Equal(t, 3, len(changes))
+	require.Equal(t, "b", changes[0].Branch.Repo.Name)
+	require.Equal(t, "2", changes[0].OldCommit.ID)
+	require.Equal(t, "4", changes[0].NewCommit.ID)
+	require.Equal(t, "c", changes[1].Branch.Repo.Name)
+	require.Nil(t, changes[1].NewCommit)
+	require.Equal(t, "d", changes[2].Branch.Repo.Name)
+	require.Nil(t, changes[2].OldCommit)
+
+	require.Equal(t, 0, len(inputChanges(old, old)))
+}
+
+func TestJobTrigger(t *testing.T) {
+	require.Equal(t, "first job", jobTrigger(&pps.JobExplanation{}))
+	require.Equal(t, "rerun", jobTrigger(&pps.JobExplanation{ParentJob: client.NewJob("parent")}))
+	changes := []*pps.InputChange{
+		{Branch: client.NewBranch("b", "master")},
+		{Branch: client.NewBranch("a", "dev")},
+	}
+	require.Equal(t, "new input commits in a@dev, b@master", jobTrigger(&pps.JobExplanation{
+		ParentJob:    client.NewJob("parent"),
+		InputChanges: changes,
+	}))
+	require.Equal(t, "pipeline updated", jobTrigger(&pps.JobExplanation{
+		ParentJob:   client.NewJob("parent"),
+		SpecChanged: true,
+	}))
+	require.Equal(t, "pipeline updated with reprocess; new input commits in a@dev, b@master", jobTrigger(&pps.JobExplanation{
+		ParentJob:    client.NewJob("parent"),
+		SpecChanged:  true,
+		SaltChanged:  true,
+		InputChanges: changes,
+	}))
+}
+
+// datumList is a datum.Iterator over a fixed list of datums
+type datumList [][]*workercommon.Input
+
+func (d datumList) Reset()                             {}
+func (d datumList) Len() int                           { return len(d) }
+func (d datumList) Next() bool                         { return false }
+func (d datumList) Datum() []*workercommon.Input       { return nil }
+func (d datumList) DatumN(i int) []*workercommon.Input { return d[i] }
+
+func TestCountDatums(t *testing.T) {
+	file := func(path, hash string) []*workercommon.Input {
+		return []*workercommon.Input{{
+			Name:     "in",
+			FileInfo: &pfs.FileInfo{File: client.NewFile("in", "master", path), Hash: []byte(hash)},
+		}}
+	}
+	parent := datumList{file("/a", "1"), file("/b", "2"), file("/c", "3")}
+	// '/a' is unchanged, '/b' has new content, '/c' was deleted and '/d' is new
+	current := datumList{file("/a", "1"), file("/b", "4"), file("/d", "5")}
+
+	// The parent job's datums are skipped if the pipeline didn't change...
+	skipped := map[string]bool{
+		workercommon.HashDatum("pipeline", "salt", parent[0]): true,
+		workercommon.HashDatum("pipeline", "salt", parent[1]): true,
+		workercommon.HashDatum("pipeline", "salt", parent[2]): true,
+	}
+	explanation := &pps.JobExplanation{}
+	countDatums(explanation, "pipeline", "salt", skipped, parent, current)
+	require.Equal(t, int64(3), explanation.DatumsTotal)
+	require.Equal(t, int64(1), explanation.DatumsSkipped)
+	require.Equal(t, int64(1), explanation.DatumsChanged)
+	require.Equal(t, int64(1), explanation.DatumsNew)
+	require.Equal(t, int64(0), explanation.DatumsUnchanged)
+
+	// ...but not if its salt changed
+	explanation = &pps.JobExplanation{}
+	countDatums(explanation, "pipeline", "new-salt", skipped, parent, current)
+	require.Equal(t, int64(0), explanation.DatumsSkipped)
+	require.Equal(t, int64(1), explanation.DatumsUnchanged)
+	require.Equal(t, int64(1), explanation.DatumsChanged)
+	require.Equal(t, int64(1), explanation.DatumsNew)
+
+	// Without a parent job, every datum is new
+	explanation = &pps.JobExplanation{}
+	countDatums(explanation, "pipeline", "salt", nil, nil, current)
+	require.Equal(t, int64(3), explanation.DatumsNew)
+}
@@ -0,0 +1,238 @@
+package server
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	workercommon "github.com/pachyderm/pachyderm/src/server/worker/common"
+)
+
+// The log levels that GetLogsRequest.Level may be set to, in increasing order
+// of severity
+const (
+	logLevelDebug = iota
+	logLevelInfo
+	logLevelWarning
+	logLevelError
+)
+
+var (
+	// logLevelFieldRe matches a level field in a structured log line, such as
+	// logrus' level=error or "level":"error" in JSON
+	logLevelFieldRe = regexp.MustCompile(`(?i)\blevel"?\s*[=:]\s*"?([a-z]+)`)
+	// logLevelPrefixRe matches a level at the start of a log line, optionally
+	// after a timestamp, such as "ERROR: ..." or "2020-01-01T00:00:00Z INFO ..."
+	logLevelPrefixRe = regexp.MustCompile(`(?i)^\s*(?:\d\S*\s+)?\[?([a-z]+)\b`)
+)
+
+// parseLogLevel returns the log level named 'name', and false if 'name' isn't
+// a log level
+func parseLogLevel(name string) (int, bool) {
+	switch strings.ToLower(name) {
+	case "trace", "debug":
+		return logLevelDebug, true
+	case "info":
+		return logLevelInfo, true
+	case "warn", "warning":
+		return logLevelWarning, true
+	case "err", "error", "fatal", "panic":
+		return logLevelError, true
+	}
+	return 0, false
+}
+
+// messageLogLevel returns the level at which 'message' was logged, which is
+// info unless the message says otherwise
+func messageLogLevel(message string) int {
+	for _, re := range []*regexp.Regexp{logLevelFieldRe, logLevelPrefixRe} {
+		if m := re.FindStringSubmatch(message); m != nil {
+			if level, ok := parseLogLevel(m[1]); ok {
+				return level
+			}
+		}
+	}
+	return logLevelInfo
+}
+
+// logFilter applies the filters in a GetLogsRequest to log lines
+type logFilter struct {
+	request      *pps.GetLogsRequest
+	since, until time.Time
+	grep         *regexp.Regexp
+	level        int
+}
+
+func newLogFilter(request *pps.GetLogsRequest) (*logFilter, error) {
+	f := &logFilter{request: request, level: logLevelDebug}
+	var err error
+	if request.Since != nil {
+		if f.since, err = types.TimestampFromProto(request.Since); err != nil {
+			return nil, errors.Wrapf(err, "invalid since time")
+		}
+	}
+	if request.Until != nil {
+		if request.Follow {
+			return nil, errors.New("cannot follow logs until a fixed time")
+		}
+		if f.until, err = types.TimestampFromProto(request.Until); err != nil {
+			return nil, errors.Wrapf(err, "invalid until time")
+		}
+	}
+	if request.Grep != "" {
+		if f.grep, err = regexp.Compile(request.Grep); err != nil {
+			return nil, errors.Wrapf(err, "invalid grep pattern %q", request.Grep)
+		}
+	}
+	if request.Level != "" {
+		var ok bool
+		if f.level, ok = parseLogLevel(request.Level); !ok {
+			return nil, errors.Errorf("invalid log level %q (must be one of debug, info, warning or error)", request.Level)
+		}
+	}
+	return f, nil
+}
+
+// matchJob returns true if 'msg', a log line from a worker, is from the
+// pipeline, job, datum and data that the request asks for
+func (f *logFilter) matchJob(msg *pps.LogMessage) bool {
+	request := f.request
+	if request.Pipeline != nil && request.Pipeline.Name != msg.PipelineName {
+		return false
+	}
+	if request.Job != nil && request.Job.ID != msg.JobID {
+		return false
+	}
+	if request.Datum != nil && request.Datum.ID != msg.DatumID {
+		return false
+	}
+	if request.Master != msg.Master {
+		return false
+	}
+	return workercommon.MatchDatum(request.DataFilters, msg.Data)
+}
+
+// match returns true if 'msg' passes the request's time, worker, grep and
+// level filters. Lines without a timestamp don't match a time filter.
+func (f *logFilter) match(msg *pps.LogMessage) bool {
+	if !f.since.IsZero() || !f.until.IsZero() {
+		if msg.Ts == nil {
+			return false
+		}
+		ts, err := types.TimestampFromProto(msg.Ts)
+		if err != nil {
+			return false
+		}
+		if !f.since.IsZero() && ts.Before(f.since) {
+			return false
+		}
+		if !f.until.IsZero() && !ts.Before(f.until) {
+			return false
+		}
+	}
+	if f.request.Worker != "" && f.request.Worker != msg.WorkerID {
+		return false
+	}
+	if f.grep != nil && !f.grep.MatchString(msg.Message) {
+		return false
+	}
+	return f.level == logLevelDebug || messageLogLevel(msg.Message) >= f.level
+}
+
+// parseKubeLogLine splits a line of pod logs requested with timestamps into
+// its timestamp and the logged line. If the line has no timestamp, the whole
+// line is returned.
+func parseKubeLogLine(line string) (time.Time, string) {
+	i := strings.IndexByte(line, ' ')
+	if i < 0 {
+		return time.Time{}, line
+	}
+	ts, err := time.Parse(time.RFC3339Nano, line[:i])
+	if err != nil {
+		return time.Time{}, line
+	}
+	return ts, line[i+1:]
+}
+
+// logFileConcurrency is how many log files GetLogs reads from PFS at a time
+const logFileConcurrency = 20
+
+// kubeLogLine is a line of pod logs, split by parseKubeLogLine
+type kubeLogLine struct {
+	ts   time.Time
+	line string
+}
+
+// logTail keeps the last messages added to it
+type logTail struct {
+	size int
+	msgs []*pps.LogMessage
+	next int // the index of the oldest message, once 'msgs' is full
+}
+
+// newLogTail returns a logTail that keeps the last 'size' messages
+func newLogTail(size int64) *logTail {
+	return &logTail{size: int(size)}
+}
+
+// add adds 'msg' to the tail, dropping the oldest message if it's full. It
+// matches the signature of the functions that log messages are sent to.
+func (t *logTail) add(msg *pps.LogMessage) error {
+	if t.size <= 0 {
+		return nil
+	}
+	if len(t.msgs) < t.size {
+		t.msgs = append(t.msgs, msg)
+		return nil
+	}
+	t.msgs[t.next] = msg
+	t.next = (t.next + 1) % t.size
+	return nil
+}
+
+// messages returns the messages in the tail, oldest first
+func (t *logTail) messages() []*pps.LogMessage {
+	result := make([]*pps.LogMessage, 0, len(t.msgs))
+	result = append(result, t.msgs[t.next:]...)
+	return append(result, t.msgs[:t.next]...)
+}
+
+// logMessageBefore returns true if 'a' was logged before 'b'. Lines without a
+// timestamp come first.
+func logMessageBefore(a, b *pps.LogMessage) bool {
+	if a.Ts == nil || b.Ts == nil {
+		return a.Ts == nil && b.Ts != nil
+	}
+	return a.Ts.Compare(b.Ts) < 0
+}
+
+// mergeLogs sends the messages from 'sources', each of which is in the order
+// its messages were logged, to 'send' in the order they were logged across
+// all sources, until every source is closed. Only the next message of each
+// source is held at a time. Messages logged at the same time are sent in the
+// order of their sources.
+func mergeLogs(sources []<-chan *pps.LogMessage, send func(*pps.LogMessage) error) error {
+	heads := make([]*pps.LogMessage, len(sources))
+	for i, source := range sources {
+		heads[i] = <-source
+	}
+	for {
+		next := -1
+		for i, head := range heads {
+			if head != nil && (next < 0 || logMessageBefore(head, heads[next])) {
+				next = i
+			}
+		}
+		if next < 0 {
+			return nil
+		}
+		if err := send(heads[next]); err != nil {
+			return err
+		}
+		heads[next] = <-sources[next]
+	}
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestMessageLogLevel(t *testing.T) {
+	require.Equal(t, logLevelError, messageLogLevel(`time="2020-01-01T00:00:00Z" level=error msg="oops"`))
+	require.Equal(t, logLevelWarning, messageLogLevel(`{"level":"warning","msg":"hmm"}`))
+	require.Equal(t, logLevelError, messageLogLevel("ERROR: something broke"))
+	require.Equal(t, logLevelDebug, messageLogLevel("2020-01-01T00:00:00Z DEBUG details"))
+	require.Equal(t, logLevelInfo, messageLogLevel("only a leading warning counts"))
+	require.Equal(t, logLevelInfo, messageLogLevel("processing datum"))
+}
+
+func TestLogFilter(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ts := func(d time.Duration) *types.Timestamp {
+		t, _ := types.TimestampProto(start.Add(d))
+		return t
+	}
+	request := &pps.GetLogsRequest{
+		Pipeline: client.NewPipeline("p"),
+		Since:    ts(time.Minute),
+		Until:    ts(time.Hour),
+		Grep:     "fail(ed|ure)",
+		Level:    "warning",
+		Worker:   "w1",
+	}
+	filter, err := newLogFilter(request)
+	require.NoError(t, err)
+	msg := func(d time.Duration, worker, message string) *pps.LogMessage {
+		return &pps.LogMessage{PipelineName: "p", WorkerID: worker, Ts: ts(d), Message: message}
+	}
+	require.True(t, filter.matchJob(msg(0, "w1", "")))
+	require.False(t, filter.matchJob(&pps.LogMessage{PipelineName: "q"}))
+
+	require.True(t, filter.match(msg(time.Minute, "w1", "ERROR: upload failed")))
+	require.True(t, filter.match(msg(2*time.Minute, "w1", "WARN failure")))
+	require.False(t, filter.match(msg(0, "w1", "ERROR: upload failed")))
+	require.False(t, filter.match(msg(time.Hour, "w1", "ERROR: upload failed")))
+	require.False(t, filter.match(msg(time.Minute, "w2", "ERROR: upload failed")))
+	require.False(t, filter.match(msg(time.Minute, "w1", "ERROR: upload timed out")))
+	require.False(t, filter.match(msg(time.Minute, "w1", "INFO retrying failed upload")))
+	require.False(t, filter.match(&pps.LogMessage{WorkerID: "w1", Message: "ERROR: failed"}))
+
+	_, err = newLogFilter(&pps.GetLogsRequest{Grep: "("})
+	require.YesError(t, err)
+	_, err = newLogFilter(&pps.GetLogsRequest{Level: "loud"})
+	require.YesError(t, err)
+	_, err = newLogFilter(&pps.GetLogsRequest{Until: ts(0), Follow: true})
+	require.YesError(t, err)
+}
+
+func TestParseKubeLogLine(t *testing.T) {
+	ts, line := parseKubeLogLine("2020-01-01T00:00:01.5Z {\"message\":\"hi there\"}")
+	require.Equal(t, time.Date(2020, 1, 1, 0, 0, 1, 500000000, time.UTC), ts.UTC())
+	require.Equal(t, `{"message":"hi there"}`, line)
+	ts, line = parseKubeLogLine("no timestamp here")
+	require.True(t, ts.IsZero())
+	require.Equal(t, "no timestamp here", line)
+}
+
+func TestLogTail(t *testing.T) {
+	messages := func(msgs []*pps.LogMessage) []string {
+		var result []string
+		for _, msg := range msgs {
+			result = append(result, msg.Message)
+		}
+		return result
+	}
+	tail := newLogTail(3)
+	require.Equal(t, 0, len(tail.messages()))
+	tail.add(&pps.LogMessage{Message: "a"})
+	tail.add(&pps.LogMessage{Message: "b"})
+	require.Equal(t, []string{"a", "b"}, messages(tail.messages()))
+	for _, m := range []string{"c", "d", "e"} {
+		tail.add(&pps.LogMessage{Message: m})
+	}
+	require.Equal(t, []string{"c", "d", "e"}, messages(tail.messages()))
+	tail.add(&pps.LogMessage{Message: "f"})
+	require.Equal(t, []string{"d", "e", "f"}, messages(tail.messages()))
+}
+
+func TestMergeLogs(t *testing.T) {
+	ts := func(sec int64) *types.Timestamp { return &types.Timestamp{Seconds: sec} }
+	source := func(msgs ...*pps.LogMessage) <-chan *pps.LogMessage {
+		ch := make(chan *pps.LogMessage)
+		go func() {
+			defer close(ch)
+			for _, msg := range msgs {
+				ch <- msg
+			}
+		}()
+		return ch
+	}
+	sources := []<-chan *pps.LogMessage{
+		source(&pps.LogMessage{Message: "a", Ts: ts(1)}, &pps.LogMessage{Message: "c", Ts: ts(3)}),
+		source(),
+		source(&pps.LogMessage{Message: "none"}, &pps.LogMessage{Message: "b1", Ts: ts(2)}, &pps.LogMessage{Message: "d", Ts: ts(4)}),
+		source(&pps.LogMessage{Message: "b2", Ts: ts(2)}),
+	}
+	var order []string
+	require.NoError(t, mergeLogs(sources, func(msg *pps.LogMessage) error {
+		order = append(order, msg.Message)
+		return nil
+	}))
+	require.Equal(t, []string{"none", "a", "b1", "b2", "c", "d"}, order)
+}
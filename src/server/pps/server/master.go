@@ -58,6 +58,9 @@ func (a *apiServer) master() {
 		// start pollPipelines in the background to regularly refresh pipelines
 		a.startPipelinePoller(pachClient)
 
+		// evict unused datums from the memoization cache in the background
+		go a.evictMemos(pachClient)
+
 		// TODO(msteffen) request only keys, since pipeline_controller.go reads
 		// fresh values for each event anyway
 		pipelineWatcher, err := a.pipelines.ReadOnly(ctx).Watch()
@@ -236,7 +239,7 @@ func (a *apiServer) setPipelineState(ctx context.Context, pipeline string, state
 		tracing.FinishAnySpan(span)
 	}()
 	return ppsutil.SetPipelineState(ctx, a.env.GetEtcdClient(), a.pipelines,
-		pipeline, nil, state, reason)
+		pipeline, nil, state, reason, ppsutil.ActorMaster)
 }
 
 // transitionPipelineState is similar to setPipelineState, except that it sets
@@ -250,5 +253,5 @@ func (a *apiServer) transitionPipelineState(ctx context.Context, pipeline string
 		tracing.FinishAnySpan(span)
 	}()
 	return ppsutil.SetPipelineState(ctx, a.env.GetEtcdClient(), a.pipelines,
-		pipeline, from, to, reason)
+		pipeline, from, to, reason, ppsutil.ActorMaster)
 }
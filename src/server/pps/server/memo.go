@@ -0,0 +1,212 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+)
+
+// memoEvictionInterval is how often the PPS master evicts entries from the
+// datum memoization cache
+const memoEvictionInterval = 10 * time.Minute
+
+// ListMemo implements the protobuf pps.ListMemo RPC
+func (a *apiServer) ListMemo(ctx context.Context, request *pps.ListMemoRequest) (response *pps.MemoInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.env.GetPachClient(ctx)
+	ctx, err := checkLoggedIn(pachClient)
+	if err != nil {
+		return nil, err
+	}
+	memoInfos, err := a.listMemo(ctx, request.Pipeline)
+	if err != nil {
+		return nil, err
+	}
+	return &pps.MemoInfos{MemoInfo: memoInfos}, nil
+}
+
+// listMemo returns the entries in the memoization cache that were created by
+// 'pipeline', or all entries if 'pipeline' is nil
+func (a *apiServer) listMemo(ctx context.Context, pipeline *pps.Pipeline) ([]*pps.MemoInfo, error) {
+	var memoInfos []*pps.MemoInfo
+	memoInfo := &pps.MemoInfo{}
+	f := func(string) error {
+		memoInfos = append(memoInfos, proto.Clone(memoInfo).(*pps.MemoInfo))
+		return nil
+	}
+	memos := a.memos.ReadOnly(ctx)
+	if pipeline != nil {
+		if err := memos.GetByIndex(ppsdb.MemosPipelineIndex, pipeline, memoInfo, col.DefaultOptions, f); err != nil {
+			return nil, err
+		}
+		return memoInfos, nil
+	}
+	if err := memos.List(memoInfo, col.DefaultOptions, f); err != nil {
+		return nil, err
+	}
+	return memoInfos, nil
+}
+
+// DeleteMemo implements the protobuf pps.DeleteMemo RPC
+func (a *apiServer) DeleteMemo(ctx context.Context, request *pps.DeleteMemoRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.env.GetPachClient(ctx)
+	ctx = pachClient.Ctx() // pachClient will propagate auth info
+
+	var memoInfos []*pps.MemoInfo
+	switch {
+	case request.Key != "" && request.Pipeline == nil && !request.All:
+		if err := checkClusterAdmin(pachClient, "DeleteMemo"); err != nil {
+			return nil, err
+		}
+		memoInfo := &pps.MemoInfo{}
+		if err := a.memos.ReadOnly(ctx).Get(request.Key, memoInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil, errors.Errorf("memo %q not found", request.Key)
+			}
+			return nil, err
+		}
+		memoInfos = append(memoInfos, memoInfo)
+	case request.Key == "" && request.Pipeline != nil && !request.All:
+		// Deleting a pipeline's entries requires the same permissions as
+		// deleting the pipeline
+		if err := a.authorizePipelineOp(pachClient, pipelineOpDelete, nil, request.Pipeline.Name); err != nil {
+			return nil, err
+		}
+		var err error
+		if memoInfos, err = a.listMemo(ctx, request.Pipeline); err != nil {
+			return nil, err
+		}
+	case request.Key == "" && request.Pipeline == nil && request.All:
+		if err := checkClusterAdmin(pachClient, "DeleteMemo"); err != nil {
+			return nil, err
+		}
+		var err error
+		if memoInfos, err = a.listMemo(ctx, nil); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("exactly one of key, pipeline and all must be set")
+	}
+	if err := a.deleteMemos(pachClient, memoInfos); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// deleteMemos removes 'memoInfos' from the memoization cache, and untags their
+// output hashtrees so that they can be garbage collected.
+func (a *apiServer) deleteMemos(pachClient *client.APIClient, memoInfos []*pps.MemoInfo) error {
+	if len(memoInfos) == 0 {
+		return nil
+	}
+	var tags []*pfs.Tag
+	if _, err := col.NewSTM(pachClient.Ctx(), a.env.GetEtcdClient(), func(stm col.STM) error {
+		tags = nil
+		memos := a.memos.ReadWrite(stm)
+		for _, memoInfo := range memoInfos {
+			if err := memos.Delete(memoInfo.Key); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+			tags = append(tags, client.NewTag(ppsconsts.MemoTagPrefix+memoInfo.Key))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	_, err := pachClient.ObjectAPIClient.DeleteTags(pachClient.Ctx(), &pfs.DeleteTagsRequest{Tags: tags})
+	return err
+}
+
+// evictMemos periodically deletes the entries in the memoization cache that
+// haven't been used within the TTL configured for pachd, and then the least
+// recently used entries in excess of its configured maximum. It's run by the
+// PPS master until pachClient's context is cancelled.
+func (a *apiServer) evictMemos(pachClient *client.APIClient) {
+	var ttl time.Duration
+	if a.env.MemoTTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(a.env.MemoTTL); err != nil {
+			log.Errorf("PPS master: invalid memo TTL %q, entries will not expire: %v", a.env.MemoTTL, err)
+			ttl = 0
+		}
+	}
+	ctx := pachClient.Ctx()
+	backoff.RetryUntilCancel(ctx, func() error {
+		memoInfos, err := a.listMemo(ctx, nil)
+		if err != nil {
+			return err
+		}
+		evict := memosToEvict(memoInfos, time.Now(), ttl, a.env.MemoMaxEntries)
+		if len(evict) > 0 {
+			log.Infof("PPS master: evicting %d of %d memoized datums", len(evict), len(memoInfos))
+			if err := a.deleteMemos(pachClient, evict); err != nil {
+				return err
+			}
+		}
+		return backoff.ErrContinue
+	}, backoff.NewConstantBackOff(memoEvictionInterval),
+		backoff.NotifyContinue("evictMemos"))
+}
+
+// memosToEvict returns the entries in 'memoInfos' that were last used more
+// than 'ttl' before 'now', followed by the least recently used of the rest
+// until no more than 'maxEntries' are left. A ttl or maxEntries of 0 is
+// ignored.
+func memosToEvict(memoInfos []*pps.MemoInfo, now time.Time, ttl time.Duration, maxEntries int) []*pps.MemoInfo {
+	lastUsed := func(memoInfo *pps.MemoInfo) time.Time {
+		t, err := types.TimestampFromProto(memoInfo.LastUsed)
+		if err != nil {
+			return time.Time{}
+		}
+		return t
+	}
+	sort.SliceStable(memoInfos, func(i, j int) bool {
+		return lastUsed(memoInfos[i]).Before(lastUsed(memoInfos[j]))
+	})
+	var n int
+	for ttl > 0 && n < len(memoInfos) && now.Sub(lastUsed(memoInfos[n])) > ttl {
+		n++
+	}
+	if maxEntries > 0 && len(memoInfos)-n > maxEntries {
+		n = len(memoInfos) - maxEntries
+	}
+	return memoInfos[:n]
+}
+
+// checkClusterAdmin returns an error if auth is active and the caller isn't
+// a cluster admin. 'op' names the operation in the error.
+func checkClusterAdmin(pachClient *client.APIClient, op string) error {
+	me, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsErrNotActivated(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "error during authorization check")
+	}
+	for _, s := range me.ClusterRoles.Roles {
+		if s == auth.ClusterRole_SUPER {
+			return nil
+		}
+	}
+	return &auth.ErrNotAuthorized{
+		Subject: me.Username,
+		AdminOp: op,
+	}
+}
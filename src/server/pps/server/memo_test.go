@@ -0,0 +1,43 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestMemosToEvict(t *testing.T) {
+	now := time.Now()
+	memo := func(key string, age time.Duration) *pps.MemoInfo {
+		lastUsed, err := types.TimestampProto(now.Add(-age))
+		require.NoError(t, err)
+		return &pps.MemoInfo{Key: key, LastUsed: lastUsed}
+	}
+	keys := func(memoInfos []*pps.MemoInfo) []string {
+		var result []string
+		for _, memoInfo := range memoInfos {
+			result = append(result, memoInfo.Key)
+		}
+		return result
+	}
+	memoInfos := func() []*pps.MemoInfo {
+		return []*pps.MemoInfo{
+			memo("a", time.Minute),
+			memo("b", 3*time.Hour),
+			memo("c", time.Hour),
+			memo("d", 2*time.Hour),
+		}
+	}
+
+	// Nothing is evicted without a ttl or maximum
+	require.Equal(t, 0, len(memosToEvict(memoInfos(), now, 0, 0)))
+	// Expired entries are evicted
+	require.Equal(t, []string{"b", "d"}, keys(memosToEvict(memoInfos(), now, 90*time.Minute, 0)))
+	// The least recently used entries in excess of the maximum are evicted
+	require.Equal(t, []string{"b"}, keys(memosToEvict(memoInfos(), now, 0, 3)))
+	require.Equal(t, []string{"b", "d", "c"}, keys(memosToEvict(memoInfos(), now, 150*time.Minute, 1)))
+}
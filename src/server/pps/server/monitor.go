@@ -17,16 +17,17 @@ package server
 // shouldn't call each other.
 
 import (
+	"bytes"
 	"context"
-	"path"
+	"encoding/json"
 	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/types"
 	opentracing "github.com/opentracing/opentracing-go"
-	"github.com/robfig/cron"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -36,8 +37,11 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/work"
 	workerserver "github.com/pachyderm/pachyderm/src/server/worker/server"
 )
 
@@ -188,7 +192,20 @@ func (a *apiServer) monitorPipeline(pachClient *client.APIClient, pipelineInfo *
 					backoff.NotifyCtx(pachClient.Ctx(), "cron for "+in.Cron.Name))
 			})
 		}
+		if in.ObjectStore != nil {
+			eg.Go(func() error {
+				return backoff.RetryNotify(func() error {
+					return a.makeObjectStoreCommits(pachClient, in)
+				}, backoff.NewInfiniteBackOff(),
+					backoff.NotifyCtx(pachClient.Ctx(), "object store for "+in.ObjectStore.Name))
+			})
+		}
 	})
+	if pipelineInfo.ParallelismSpec.GetAutoscaling() != nil {
+		eg.Go(func() error {
+			return a.autoscalePipeline(pachClient, pipelineInfo)
+		})
+	}
 	if pipelineInfo.Standby {
 		// Capacity 1 gives us a bit of buffer so we don't needlessly go into
 		// standby when SubscribeCommit takes too long to return.
@@ -339,6 +356,45 @@ func (a *apiServer) monitorPipeline(pachClient *client.APIClient, pipelineInfo *
 	}
 }
 
+// autoscalePipeline periodically samples the work queue of 'pipelineInfo' and
+// stores the number of workers that the pipeline should have in its
+// EtcdPipelineInfo, which causes the pipeline controller to resize its RC.
+func (a *apiServer) autoscalePipeline(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) error {
+	pipeline := pipelineInfo.Pipeline.Name
+	ctx := pachClient.Ctx()
+	scaler := newAutoscaler(pipelineInfo.ParallelismSpec.Autoscaling)
+	return backoff.RetryUntilCancel(ctx, func() error {
+		pipelinePtr := &pps.EtcdPipelineInfo{}
+		if err := a.pipelines.ReadOnly(ctx).Get(pipeline, pipelinePtr); err != nil {
+			return err
+		}
+		if pipelinePtr.State != pps.PipelineState_PIPELINE_RUNNING &&
+			pipelinePtr.State != pps.PipelineState_PIPELINE_CRASHING {
+			return backoff.ErrContinue // the pipeline has no workers to resize
+		}
+		stats, err := work.Stats(ctx, a.env.GetEtcdClient(), a.etcdPrefix, ppsutil.WorkNamespace(pipelineInfo))
+		if err != nil {
+			return errors.Wrap(err, "could not read work queue")
+		}
+		desired := scaler.next(time.Now(), pipelinePtr.DesiredWorkers, stats)
+		if desired == pipelinePtr.DesiredWorkers {
+			return backoff.ErrContinue
+		}
+		log.Infof("PPS master: autoscaling %q from %d to %d workers (%d outstanding subtasks)",
+			pipeline, pipelinePtr.DesiredWorkers, desired, stats.Outstanding)
+		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			return a.pipelines.ReadWrite(stm).Update(pipeline, pipelinePtr, func() error {
+				pipelinePtr.DesiredWorkers = desired
+				return nil
+			})
+		}); err != nil {
+			return errors.Wrap(err, "could not update desired workers")
+		}
+		return backoff.ErrContinue
+	}, backoff.NewConstantBackOff(autoscaleInterval),
+		backoff.NotifyContinue("autoscalePipeline for "+pipeline))
+}
+
 // allWorkersUp is a helper used by monitorCrashingPipeline
 func (a *apiServer) allWorkersUp(ctx context.Context, parallelism64 uint64, pipelineInfo *pps.PipelineInfo) (bool, error) {
 	parallelism := int(parallelism64)
@@ -381,33 +437,10 @@ func (a *apiServer) monitorCrashingPipeline(pachClient *client.APIClient, parall
 	}
 }
 
-func (a *apiServer) getLatestCronTime(pachClient *client.APIClient, in *pps.Input) (time.Time, error) {
-	var latestTime time.Time
-	files, err := pachClient.ListFile(in.Cron.Repo, "master", "")
-	if err != nil && !pfsserver.IsNoHeadErr(err) {
-		return latestTime, err
-	} else if err != nil || len(files) == 0 {
-		// File not found, this happens the first time the pipeline is run
-		latestTime, err = types.TimestampFromProto(in.Cron.Start)
-		if err != nil {
-			return latestTime, err
-		}
-	} else {
-		// Take the name of the most recent file as the latest timestamp
-		// ListFile returns the files in lexicographical order, and the RFC3339 format goes
-		// from largest unit of time to smallest, so the most recent file will be the last one
-		latestTime, err = time.Parse(time.RFC3339, path.Base(files[len(files)-1].File.Path))
-		if err != nil {
-			return latestTime, err
-		}
-	}
-	return latestTime, nil
-}
-
 // makeCronCommits makes commits to a single cron input's repo. It's
 // a helper function called by monitorPipeline.
 func (a *apiServer) makeCronCommits(pachClient *client.APIClient, in *pps.Input) error {
-	schedule, err := cron.ParseStandard(in.Cron.Spec)
+	schedule, loc, err := cronSchedule(in.Cron)
 	if err != nil {
 		return err // Shouldn't happen, as the input is validated in CreatePipeline
 	}
@@ -422,10 +455,23 @@ func (a *apiServer) makeCronCommits(pachClient *client.APIClient, in *pps.Input)
 		}
 	}
 
-	latestTime, err := a.getLatestCronTime(pachClient, in)
+	latestTime, err := getLatestCronTime(pachClient, in)
 	if err != nil {
 		return err
 	}
+	latestTime = latestTime.In(loc)
+
+	// Apply the catch-up policy to the ticks that were missed while the
+	// pipeline wasn't being monitored
+	now := time.Now().In(loc)
+	for _, tick := range missedCronTicks(schedule, latestTime, now, in.Cron.CatchUp, in.Cron.MaxCatchUp) {
+		if err := makeCronCommit(pachClient, in.Cron, tick); err != nil {
+			return err
+		}
+	}
+	if now.After(latestTime) {
+		latestTime = now
+	}
 
 	for {
 		// get the time of the next time from the latest time using the cron schedule
@@ -436,35 +482,124 @@ func (a *apiServer) makeCronCommits(pachClient *client.APIClient, in *pps.Input)
 		case <-pachClient.Ctx().Done():
 			return pachClient.Ctx().Err()
 		}
-		if err != nil {
+		if err := makeCronCommit(pachClient, in.Cron, next); err != nil {
 			return err
 		}
 
-		// We need the DeleteFile and the PutFile to happen in the same commit
-		_, err = pachClient.StartCommit(in.Cron.Repo, "master")
-		if err != nil {
-			return err
-		}
-		if in.Cron.Overwrite {
-			// get rid of any files, so the new file "overwrites" previous runs
-			err = pachClient.DeleteFile(in.Cron.Repo, "master", "")
-			if err != nil && !isNotFoundErr(err) && !pfsserver.IsNoHeadErr(err) {
-				return errors.Wrapf(err, "delete error")
-			}
+		// set latestTime to the next time
+		latestTime = next
+	}
+}
+
+// makeCronCommit commits the tick at 'tick' to the repo of the cron input
+// 'in'. It's a helper function called by makeCronCommits.
+func makeCronCommit(pachClient *client.APIClient, in *pps.CronInput, tick time.Time) error {
+	// We need the DeleteFile and the PutFile to happen in the same commit
+	if _, err := pachClient.StartCommit(in.Repo, "master"); err != nil {
+		return err
+	}
+	if in.Overwrite {
+		// get rid of any files, so the new file "overwrites" previous runs
+		err := pachClient.DeleteFile(in.Repo, "master", "")
+		if err != nil && !isNotFoundErr(err) && !pfsserver.IsNoHeadErr(err) {
+			return errors.Wrapf(err, "delete error")
 		}
+	}
 
-		// Put in an empty file named by the timestamp
-		_, err = pachClient.PutFile(in.Cron.Repo, "master", next.Format(time.RFC3339), strings.NewReader(""))
-		if err != nil {
-			return errors.Wrapf(err, "put error")
+	// Put in an empty file named by the timestamp
+	if _, err := pachClient.PutFile(in.Repo, "master", tick.Format(time.RFC3339), strings.NewReader("")); err != nil {
+		return errors.Wrapf(err, "put error")
+	}
+
+	return pachClient.FinishCommit(in.Repo, "master")
+}
+
+// objectStoreClient returns a client for the bucket of an object store input.
+// Local buckets are read under the configured root, and other buckets with the
+// credentials in the secret that the pipeline's creator named, never with
+// pachd's own.
+func (a *apiServer) objectStoreClient(url *obj.ObjectStoreURL, secretName string) (obj.Client, error) {
+	if url.Store == "local" {
+		return obj.NewLocalClientFromURL(url, a.env.ObjectStoreInputLocalRoot)
+	}
+	secret, err := a.env.GetKubeClient().CoreV1().Secrets(a.namespace).Get(secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read the credentials secret %q", secretName)
+	}
+	return obj.NewClientFromURLAndCredentials(url, secret.Data)
+}
+
+// makeObjectStoreCommits polls the bucket of a single object store input, and
+// commits references to its new and changed objects to the input's repo. It's
+// a helper function called by monitorPipeline.
+func (a *apiServer) makeObjectStoreCommits(pachClient *client.APIClient, in *pps.Input) error {
+	interval, err := types.DurationFromProto(in.ObjectStore.Interval)
+	if err != nil {
+		return err // Shouldn't happen, as the input is validated in CreatePipeline
+	}
+	url, err := obj.ParseURL(in.ObjectStore.URL)
+	if err != nil {
+		return err
+	}
+	objClient, err := a.objectStoreClient(url, in.ObjectStore.Secret)
+	if err != nil {
+		return err
+	}
+	repo := in.ObjectStore.Repo
+	// make sure there isn't an unfinished commit on the branch
+	commitInfo, err := pachClient.InspectCommit(repo, "master")
+	if err != nil && !pfsserver.IsNoHeadErr(err) {
+		return err
+	} else if commitInfo != nil && commitInfo.Finished == nil {
+		// and if there is, delete it
+		if err = pachClient.DeleteCommit(repo, commitInfo.Commit.ID); err != nil {
+			return err
 		}
+	}
+	committed, err := committedObjectRefs(pachClient, repo)
+	if err != nil {
+		return err
+	}
 
-		err = pachClient.FinishCommit(in.Cron.Repo, "master")
+	for {
+		refs, err := listObjectRefs(pachClient.Ctx(), objClient, in.ObjectStore.URL, url.Object, committed)
 		if err != nil {
 			return err
 		}
+		put, deleted := diffObjectRefs(committed, refs)
+		if len(put) > 0 || len(deleted) > 0 {
+			if _, err := pachClient.StartCommit(repo, "master"); err != nil {
+				return err
+			}
+			for _, p := range deleted {
+				if err := pachClient.DeleteFile(repo, "master", p); err != nil {
+					return errors.Wrapf(err, "delete error")
+				}
+			}
+			for _, p := range put {
+				data, err := json.Marshal(refs[p])
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				if _, err := pachClient.PutFileOverwrite(repo, "master", p, bytes.NewReader(data), 0); err != nil {
+					return errors.Wrapf(err, "put error")
+				}
+			}
+			if err := pachClient.FinishCommit(repo, "master"); err != nil {
+				return err
+			}
+			log.Infof("PPS master: committed %d new or changed and %d deleted objects from %s",
+				len(put), len(deleted), in.ObjectStore.URL)
+		}
+		// Keep the latest metadata even if nothing was committed, so that
+		// objects whose metadata changed without their content aren't re-read
+		// on every poll
+		committed = refs
 
-		// set latestTime to the next time
-		latestTime = next
+		select {
+		case <-time.After(interval):
+		case <-pachClient.Ctx().Done():
+			return pachClient.Ctx().Err()
+		}
 	}
 }
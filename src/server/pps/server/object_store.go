@@ -0,0 +1,141 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+)
+
+// listObjectRefs returns references to the objects in 'objClient' under
+// 'prefix', keyed by their paths relative to 'prefix'. 'url' is the URL of the
+// object store input that the references are for. Objects are only read, to
+// hash them, if their listed metadata differs from that of their references in
+// 'committed', so that polling an unchanged bucket only costs a listing.
+func listObjectRefs(ctx context.Context, objClient obj.Client, url string, prefix string, committed map[string]*ppsutil.ObjectRef) (map[string]*ppsutil.ObjectRef, error) {
+	refs := make(map[string]*ppsutil.ObjectRef)
+	if err := objClient.WalkInfo(ctx, prefix, func(info *obj.ObjectInfo) error {
+		name := filepath.ToSlash(info.Name)
+		relPath := strings.TrimPrefix(name, prefix)
+		switch {
+		case relPath == "":
+			// 'prefix' is a single object
+			relPath = path.Base(name)
+		case prefix != "" && !strings.HasSuffix(prefix, "/") && !strings.HasPrefix(relPath, "/"):
+			// e.g. "data2/file" under the prefix "data"
+			return nil
+		}
+		p := path.Clean("/" + relPath)
+		ref := &ppsutil.ObjectRef{
+			URL:     url,
+			Object:  name,
+			Size:    info.Size,
+			ETag:    info.ETag,
+			ModTime: info.ModTime,
+		}
+		if old, ok := committed[p]; ok && old.Object == name && sameObjectVersion(old, ref) {
+			ref.Hash = old.Hash
+		} else {
+			hash, err := hashObject(ctx, objClient, name)
+			if err != nil {
+				return err
+			}
+			ref.Hash = hash
+		}
+		refs[p] = ref
+		return nil
+	}); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return refs, nil
+}
+
+// sameObjectVersion returns true if the metadata in 'a' and 'b' shows that
+// they refer to the same version of an object. Object stores change an
+// object's ETag whenever its content changes; for those that don't have
+// ETags, the size and modification time have to do.
+func sameObjectVersion(a, b *ppsutil.ObjectRef) bool {
+	if a.Size != b.Size {
+		return false
+	}
+	if a.ETag != "" || b.ETag != "" {
+		return a.ETag == b.ETag
+	}
+	return !a.ModTime.IsZero() && a.ModTime.Equal(b.ModTime)
+}
+
+// hashObject returns the SHA256 hash of the content of the object 'name'.
+// The hash, rather than the object's metadata, identifies the object's
+// content in datums, as metadata isn't comparable across object stores.
+func hashObject(ctx context.Context, objClient obj.Client, name string) (retHash string, retErr error) {
+	r, err := objClient.Reader(ctx, name, 0, 0)
+	if err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, r); err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// committedObjectRefs returns the object references in the head of the master
+// branch of 'repo', keyed by path.
+func committedObjectRefs(pachClient *client.APIClient, repo string) (map[string]*ppsutil.ObjectRef, error) {
+	refs := make(map[string]*ppsutil.ObjectRef)
+	if err := pachClient.Walk(repo, "master", "/", func(fi *pfs.FileInfo) error {
+		if fi.FileType == pfs.FileType_DIR {
+			return nil
+		}
+		buf := &bytes.Buffer{}
+		if err := pachClient.GetFile(repo, "master", fi.File.Path, 0, 0, buf); err != nil {
+			return err
+		}
+		ref := &ppsutil.ObjectRef{}
+		if err := json.Unmarshal(buf.Bytes(), ref); err != nil {
+			return errors.Wrapf(err, "malformed object reference %s", fi.File.Path)
+		}
+		refs[fi.File.Path] = ref
+		return nil
+	}); err != nil && !pfsserver.IsNoHeadErr(err) {
+		return nil, errors.EnsureStack(err)
+	}
+	return refs, nil
+}
+
+// diffObjectRefs returns the paths in 'refs' that are new or whose hashes
+// differ from those in 'committed', and the paths in 'committed' that are no
+// longer in 'refs', both sorted.
+func diffObjectRefs(committed map[string]*ppsutil.ObjectRef, refs map[string]*ppsutil.ObjectRef) (put []string, deleted []string) {
+	for p, ref := range refs {
+		if old, ok := committed[p]; !ok || old.Hash != ref.Hash || old.Object != ref.Object {
+			put = append(put, p)
+		}
+	}
+	for p := range committed {
+		if _, ok := refs[p]; !ok {
+			deleted = append(deleted, p)
+		}
+	}
+	sort.Strings(put)
+	sort.Strings(deleted)
+	return put, deleted
+}
@@ -0,0 +1,217 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
+)
+
+func TestObjectStoreRefs(t *testing.T) {
+	root, err := ioutil.TempDir("", "object-store-input")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	write := func(name, content string) {
+		p := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0777))
+		require.NoError(t, ioutil.WriteFile(p, []byte(content), 0666))
+	}
+	write("data/a", "foo")
+	write("data/dir/b", "bar")
+	write("data2/c", "baz")
+	objClient, err := obj.NewLocalClient(root)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	refs, err := listObjectRefs(ctx, objClient, "local://bucket/data", "data", nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(refs))
+	require.Equal(t, "data/a", refs["/a"].Object)
+	require.Equal(t, "data/dir/b", refs["/dir/b"].Object)
+	require.Equal(t, "local://bucket/data", refs["/a"].URL)
+
+	committed := refs
+	put, deleted := diffObjectRefs(committed, refs)
+	require.Equal(t, 0, len(put))
+	require.Equal(t, 0, len(deleted))
+
+	// Change one object, add one and delete one
+	write("data/a", "quxx")
+	write("data/d", "quux")
+	require.NoError(t, os.Remove(filepath.Join(root, "data/dir/b")))
+	refs, err = listObjectRefs(ctx, objClient, "local://bucket/data", "data", committed)
+	require.NoError(t, err)
+	put, deleted = diffObjectRefs(committed, refs)
+	require.ElementsEqual(t, []string{"/a", "/d"}, put)
+	require.ElementsEqual(t, []string{"/dir/b"}, deleted)
+}
+
+// countingClient counts the objects read through it
+type countingClient struct {
+	obj.Client
+	reads map[string]int
+}
+
+func (c *countingClient) Reader(ctx context.Context, name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	c.reads[name]++
+	return c.Client.Reader(ctx, name, offset, size)
+}
+
+func TestObjectStoreRefsOnlyReadChangedObjects(t *testing.T) {
+	root, err := ioutil.TempDir("", "object-store-input")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	write := func(name, content string) {
+		p := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0777))
+		require.NoError(t, ioutil.WriteFile(p, []byte(content), 0666))
+	}
+	write("data/a", "foo")
+	write("data/b", "bar")
+	localClient, err := obj.NewLocalClient(root)
+	require.NoError(t, err)
+	objClient := &countingClient{Client: localClient, reads: make(map[string]int)}
+	ctx := context.Background()
+
+	committed, err := listObjectRefs(ctx, objClient, "local://bucket/data", "data/", nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"data/a": 1, "data/b": 1}, objClient.reads)
+
+	// Polling again doesn't read anything
+	refs, err := listObjectRefs(ctx, objClient, "local://bucket/data", "data/", committed)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"data/a": 1, "data/b": 1}, objClient.reads)
+	require.Equal(t, committed["/a"].Hash, refs["/a"].Hash)
+
+	// Only the changed object is read again
+	write("data/b", "barbaz")
+	refs, err = listObjectRefs(ctx, objClient, "local://bucket/data", "data/", committed)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"data/a": 1, "data/b": 2}, objClient.reads)
+	put, deleted := diffObjectRefs(committed, refs)
+	require.ElementsEqual(t, []string{"/b"}, put)
+	require.Equal(t, 0, len(deleted))
+}
+
+func TestMakeObjectStoreCommitsLocal(t *testing.T) {
+	root, err := ioutil.TempDir("", "object-store-input")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	write := func(name, content string) {
+		p := filepath.Join(root, "bucket", name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0777))
+		require.NoError(t, ioutil.WriteFile(p, []byte(content), 0666))
+	}
+	write("data/a", "foo")
+	write("data/dir/b", "bar")
+	write("other/c", "baz")
+
+	require.NoError(t, testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		a := &apiServer{env: &serviceenv.ServiceEnv{
+			Configuration: serviceenv.NewConfiguration(&serviceenv.GlobalConfiguration{ObjectStoreInputLocalRoot: root}),
+		}}
+		repo := tu.UniqueString("TestMakeObjectStoreCommitsLocal")
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		// CreatePipeline creates the input repo's branch
+		require.NoError(t, env.PachClient.CreateBranch(repo, "master", "", nil))
+		// Local buckets need no secret
+		in := &pps.Input{ObjectStore: &pps.ObjectStoreInput{
+			Name:     "in",
+			URL:      "local://bucket/data",
+			Repo:     repo,
+			Glob:     "/*",
+			Interval: types.DurationProto(10 * time.Millisecond),
+		}}
+
+		ctx, cancel := context.WithCancel(env.PachClient.Ctx())
+		done := make(chan error, 1)
+		go func() { done <- a.makeObjectStoreCommits(env.PachClient.WithCtx(ctx), in) }()
+		defer func() {
+			// The poll loop only stops when its context is canceled
+			cancel()
+			require.YesError(t, <-done)
+		}()
+
+		// files returns the objects referred to by the files in the repo's head
+		files := func() map[string]string {
+			result := make(map[string]string)
+			fis, err := env.PachClient.GlobFile(repo, "master", "**")
+			if err != nil {
+				return nil
+			}
+			for _, fi := range fis {
+				buf := &bytes.Buffer{}
+				require.NoError(t, env.PachClient.GetFile(repo, "master", fi.File.Path, 0, 0, buf))
+				ref := &ppsutil.ObjectRef{}
+				if json.Unmarshal(buf.Bytes(), ref) == nil && ref.Object != "" {
+					result[fi.File.Path] = ref.Object
+				}
+			}
+			return result
+		}
+		waitFor := func(expected map[string]string) {
+			require.NoError(t, backoff.Retry(func() error {
+				if actual := files(); !reflect.DeepEqual(expected, actual) {
+					return errors.Errorf("expected %v, got %v", expected, actual)
+				}
+				return nil
+			}, backoff.RetryEvery(10*time.Millisecond).For(10*time.Second)))
+		}
+		waitFor(map[string]string{"/a": "data/a", "/dir/b": "data/dir/b"})
+
+		// New and deleted objects are committed on the next poll
+		write("data/d", "quux")
+		require.NoError(t, os.Remove(filepath.Join(root, "bucket", "data/a")))
+		waitFor(map[string]string{"/d": "data/d", "/dir/b": "data/dir/b"})
+		return nil
+	}))
+}
+
+func TestLocalObjectStoreRoot(t *testing.T) {
+	root, err := ioutil.TempDir("", "object-store-input")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "bucket"), 0777))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, "secret"), []byte("foo"), 0666))
+
+	u, err := obj.ParseURL("local://bucket")
+	require.NoError(t, err)
+	// Local buckets are disabled without a root
+	_, err = obj.NewLocalClientFromURL(u, "")
+	require.YesError(t, err)
+	objClient, err := obj.NewLocalClientFromURL(u, root)
+	require.NoError(t, err)
+	ctx := context.Background()
+	for _, name := range []string{"../secret", filepath.Join(root, "secret")} {
+		_, err = objClient.Reader(ctx, name, 0, 0)
+		require.YesError(t, err)
+	}
+	require.YesError(t, objClient.Walk(ctx, "..", func(string) error { return nil }))
+	_, err = objClient.Writer(ctx, "a")
+	require.YesError(t, err)
+
+	// Neither the bucket nor the prefix can leave the root
+	for _, url := range []string{"local://../secret", "local://bucket/../../secret", "local://./secret"} {
+		u, err := obj.ParseURL(url)
+		require.NoError(t, err)
+		_, err = obj.LocalBucketPath(u, root)
+		require.YesError(t, err, url)
+	}
+}
@@ -0,0 +1,182 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+)
+
+// validateOutputs checks the named outputs of the pipeline created by
+// 'request'
+func validateOutputs(request *pps.CreatePipelineRequest) error {
+	if len(request.Outputs) == 0 {
+		return nil
+	}
+	if request.Service != nil || request.Spout != nil || request.S3Out {
+		return errors.New("named outputs are not supported in spouts, services or pipelines that output via Pachyderm's S3 gateway")
+	}
+	var pipelineName string
+	if request.Pipeline != nil {
+		pipelineName = request.Pipeline.Name
+	}
+	outputBranch := request.OutputBranch
+	if outputBranch == "" {
+		outputBranch = "master"
+	}
+	// The branches (of the output repo) that Pachyderm uses for other things
+	branches := make(map[string]bool)
+	for _, branch := range []string{outputBranch, "stats", ppsconsts.SpoutMarkerBranch, ppsconsts.LogsBranch} {
+		branches[branchKey(client.NewBranch(pipelineName, branch))] = true
+	}
+	inputRepos := make(map[string]bool)
+	pps.VisitInput(request.Input, func(input *pps.Input) {
+		if repo := inputRepo(input); repo != "" {
+			inputRepos[repo] = true
+		}
+	})
+	names := make(map[string]bool)
+	for _, output := range request.Outputs {
+		if output == nil {
+			return errors.New("outputs cannot be null")
+		}
+		if err := ancestry.ValidateName(output.Name); err != nil {
+			return errors.Wrapf(err, "invalid output name")
+		}
+		if names[output.Name] {
+			return errors.Errorf("there are multiple outputs named %q", output.Name)
+		}
+		names[output.Name] = true
+		repo := outputRepoName(pipelineName, output)
+		if repo != pipelineName {
+			if err := ancestry.ValidateName(repo); err != nil {
+				return errors.Wrapf(err, "invalid repo for output %q", output.Name)
+			}
+			if repo == ppsconsts.SpecRepo {
+				return errors.Errorf("output %q can't use the repo %q", output.Name, repo)
+			}
+			if inputRepos[repo] {
+				return errors.Errorf("output %q can't use the repo %q, which is an input of the pipeline", output.Name, repo)
+			}
+		}
+		branch := outputBranchName(pipelineName, output)
+		if err := ancestry.ValidateName(branch); err != nil {
+			return errors.Wrapf(err, "invalid branch for output %q", output.Name)
+		}
+		key := branchKey(client.NewBranch(repo, branch))
+		if branches[key] {
+			return errors.Errorf("output %q can't use the branch %s, which is already used by the pipeline", output.Name, key)
+		}
+		branches[key] = true
+	}
+	return nil
+}
+
+// outputRepoName returns the repo that 'output', a named output of the
+// pipeline 'pipelineName', is committed to
+func outputRepoName(pipelineName string, output *pps.Output) string {
+	if output.Repo != "" {
+		return output.Repo
+	}
+	return pipelineName
+}
+
+// outputBranchName returns the branch that 'output', a named output of the
+// pipeline 'pipelineName', is committed to
+func outputBranchName(pipelineName string, output *pps.Output) string {
+	if output.Branch != "" {
+		return output.Branch
+	}
+	if outputRepoName(pipelineName, output) != pipelineName {
+		return "master"
+	}
+	return output.Name
+}
+
+// ownOutputRepos returns the repos, other than its output repo, that the
+// named outputs of 'pipelineInfo' are committed to. These are created and
+// deleted along with the pipeline.
+func ownOutputRepos(pipelineInfo *pps.PipelineInfo) []string {
+	var repos []string
+	seen := make(map[string]bool)
+	for _, output := range pipelineInfo.Outputs {
+		repo := outputRepoName(pipelineInfo.Pipeline.Name, output)
+		if repo == pipelineInfo.Pipeline.Name || seen[repo] {
+			continue
+		}
+		seen[repo] = true
+		repos = append(repos, repo)
+	}
+	return repos
+}
+
+// createOutputRepos creates the repos of the named outputs of 'pipelineInfo'
+// that 'prevPipelineInfo' (its previous version, if any) didn't already have.
+// Unlike the pipeline's output repo, these must not exist yet, as the
+// pipeline deletes them when it's deleted.
+func createOutputRepos(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, prevPipelineInfo *pps.PipelineInfo) error {
+	existing := make(map[string]bool)
+	if prevPipelineInfo != nil {
+		for _, repo := range ownOutputRepos(prevPipelineInfo) {
+			existing[repo] = true
+		}
+	}
+	for _, repo := range ownOutputRepos(pipelineInfo) {
+		if existing[repo] {
+			continue
+		}
+		if _, err := pachClient.PfsAPIClient.CreateRepo(pachClient.Ctx(), &pfs.CreateRepoRequest{
+			Repo:        client.NewRepo(repo),
+			Description: fmt.Sprintf("Output repo for pipeline %s.", pipelineInfo.Pipeline.Name),
+		}); err != nil {
+			if isAlreadyExistsErr(err) {
+				return errors.Errorf("the output repo %q of pipeline %q already exists", repo, pipelineInfo.Pipeline.Name)
+			}
+			return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not create output repo %q", repo)
+		}
+	}
+	return nil
+}
+
+// removedOutputs returns the named outputs of 'prevPipelineInfo' whose
+// branches aren't used by any of the named outputs of 'pipelineInfo', its
+// next version
+func removedOutputs(prevPipelineInfo *pps.PipelineInfo, pipelineInfo *pps.PipelineInfo) []*pps.Output {
+	if prevPipelineInfo == nil {
+		return nil
+	}
+	branches := make(map[string]bool)
+	for _, output := range pipelineInfo.Outputs {
+		branches[branchKey(client.NewBranch(output.Repo, output.Branch))] = true
+	}
+	var result []*pps.Output
+	for _, output := range prevPipelineInfo.Outputs {
+		if !branches[branchKey(client.NewBranch(output.Repo, output.Branch))] {
+			result = append(result, output)
+		}
+	}
+	return result
+}
+
+// detachRemovedOutputs removes the provenance of the branches of the named
+// outputs that 'pipelineInfo' removes from 'prevPipelineInfo', so that they
+// stop getting commits. The branches (and the repos of the outputs that had
+// their own) are kept, along with the data already committed to them.
+func detachRemovedOutputs(pachClient *client.APIClient, prevPipelineInfo *pps.PipelineInfo, pipelineInfo *pps.PipelineInfo) error {
+	for _, output := range removedOutputs(prevPipelineInfo, pipelineInfo) {
+		if err := pachClient.CreateBranch(
+			output.Repo,
+			output.Branch,
+			output.Branch,
+			nil,
+		); err != nil && !isNotFoundErr(err) {
+			return errors.Wrapf(err, "could not detach the branch of removed output %q", output.Name)
+		}
+	}
+	return nil
+}
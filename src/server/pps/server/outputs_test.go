@@ -0,0 +1,101 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+)
+
+func TestValidateOutputs(t *testing.T) {
+	outputs := func(outputs ...*pps.Output) *pps.CreatePipelineRequest {
+		return &pps.CreatePipelineRequest{Outputs: outputs}
+	}
+	require.NoError(t, validateOutputs(outputs()))
+	require.NoError(t, validateOutputs(outputs(
+		&pps.Output{Name: "models"},
+		&pps.Output{Name: "metrics", Branch: "latest-metrics"},
+	)))
+	require.YesError(t, validateOutputs(outputs(&pps.Output{})))
+	require.YesError(t, validateOutputs(outputs(&pps.Output{Name: "bad/name"})))
+	require.YesError(t, validateOutputs(outputs(
+		&pps.Output{Name: "models"},
+		&pps.Output{Name: "models", Branch: "other"},
+	)))
+	// Outputs can't share a branch, or use one that the pipeline already uses
+	require.YesError(t, validateOutputs(outputs(
+		&pps.Output{Name: "models"},
+		&pps.Output{Name: "metrics", Branch: "models"},
+	)))
+	require.YesError(t, validateOutputs(outputs(&pps.Output{Name: "master"})))
+	require.YesError(t, validateOutputs(outputs(&pps.Output{Name: "models", Branch: "stats"})))
+	require.NoError(t, validateOutputs(&pps.CreatePipelineRequest{
+		OutputBranch: "results",
+		Outputs:      []*pps.Output{{Name: "master"}},
+	}))
+	require.YesError(t, validateOutputs(&pps.CreatePipelineRequest{
+		OutputBranch: "results",
+		Outputs:      []*pps.Output{{Name: "models", Branch: "results"}},
+	}))
+	require.YesError(t, validateOutputs(&pps.CreatePipelineRequest{
+		S3Out:   true,
+		Outputs: []*pps.Output{{Name: "models"}},
+	}))
+	// Outputs with their own repo default to its master branch
+	withRepos := func(outputs ...*pps.Output) *pps.CreatePipelineRequest {
+		return &pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline("train"),
+			Input:    client.NewPFSInput("data", "/*"),
+			Outputs:  outputs,
+		}
+	}
+	require.NoError(t, validateOutputs(withRepos(
+		&pps.Output{Name: "models", Repo: "models"},
+		&pps.Output{Name: "metrics", Repo: "models", Branch: "metrics"},
+		&pps.Output{Name: "master", Repo: "train", Branch: "latest"},
+	)))
+	require.YesError(t, validateOutputs(withRepos(
+		&pps.Output{Name: "models", Repo: "models"},
+		&pps.Output{Name: "metrics", Repo: "models"},
+	)))
+	require.YesError(t, validateOutputs(withRepos(&pps.Output{Name: "models", Repo: "train", Branch: "master"})))
+	require.YesError(t, validateOutputs(withRepos(&pps.Output{Name: "models", Repo: "bad/repo"})))
+	require.YesError(t, validateOutputs(withRepos(&pps.Output{Name: "models", Repo: ppsconsts.SpecRepo})))
+	require.YesError(t, validateOutputs(withRepos(&pps.Output{Name: "models", Repo: "data"})))
+}
+
+func TestOutputDefaults(t *testing.T) {
+	require.Equal(t, "train", outputRepoName("train", &pps.Output{Name: "models"}))
+	require.Equal(t, "models", outputBranchName("train", &pps.Output{Name: "models"}))
+	require.Equal(t, "models", outputRepoName("train", &pps.Output{Name: "models", Repo: "models"}))
+	require.Equal(t, "master", outputBranchName("train", &pps.Output{Name: "models", Repo: "models"}))
+	require.Equal(t, "latest", outputBranchName("train", &pps.Output{Name: "models", Repo: "models", Branch: "latest"}))
+	require.Equal(t, []string{"models", "metrics"}, ownOutputRepos(&pps.PipelineInfo{
+		Pipeline: client.NewPipeline("train"),
+		Outputs: []*pps.Output{
+			{Name: "a", Repo: "models", Branch: "master"},
+			{Name: "b", Repo: "train", Branch: "b"},
+			{Name: "c", Repo: "metrics", Branch: "master"},
+			{Name: "d", Repo: "models", Branch: "d"},
+		},
+	}))
+}
+
+func TestRemovedOutputs(t *testing.T) {
+	pipelineInfo := func(outputs ...*pps.Output) *pps.PipelineInfo {
+		return &pps.PipelineInfo{Pipeline: client.NewPipeline("train"), Outputs: outputs}
+	}
+	metrics := &pps.Output{Name: "metrics", Repo: "train", Branch: "metrics"}
+	models := &pps.Output{Name: "models", Repo: "models", Branch: "master"}
+	require.Equal(t, 0, len(removedOutputs(nil, pipelineInfo(metrics))))
+	require.Equal(t, 0, len(removedOutputs(pipelineInfo(metrics, models), pipelineInfo(models, metrics))))
+	require.Equal(t, []*pps.Output{metrics, models}, removedOutputs(pipelineInfo(metrics, models), pipelineInfo()))
+	// Renaming an output keeps its branch
+	require.Equal(t, 0, len(removedOutputs(pipelineInfo(metrics),
+		pipelineInfo(&pps.Output{Name: "stats", Repo: "train", Branch: "metrics"}))))
+	// Moving an output to another branch removes the old one
+	require.Equal(t, []*pps.Output{models}, removedOutputs(pipelineInfo(models),
+		pipelineInfo(&pps.Output{Name: "models", Repo: "models", Branch: "latest"})))
+}
@@ -444,7 +444,13 @@ func (op *pipelineOp) startPipelineMonitor() {
 }
 
 func (op *pipelineOp) startCrashingPipelineMonitor() {
-	op.apiServer.startCrashingMonitor(op.masterClient, op.ptr.Parallelism, op.pipelineInfo)
+	parallelism := op.ptr.Parallelism
+	if as := op.pipelineInfo.ParallelismSpec.GetAutoscaling(); as != nil {
+		// The autoscaler may not raise the pipeline's target until its workers
+		// are healthy, so only wait for the minimum
+		parallelism = as.Min
+	}
+	op.apiServer.startCrashingMonitor(op.masterClient, parallelism, op.pipelineInfo)
 }
 
 func (op *pipelineOp) stopPipelineMonitor() {
@@ -582,8 +588,12 @@ func (op *pipelineOp) scaleUpPipeline() (retErr error) {
 		tracing.FinishAnySpan(span)
 	}()
 
-	// compute target pipeline parallelism
+	// compute target pipeline parallelism (for autoscaling pipelines, this is
+	// chosen by the autoscaler in monitorPipeline)
 	parallelism := int(op.ptr.Parallelism)
+	if op.pipelineInfo.ParallelismSpec.GetAutoscaling() != nil {
+		parallelism = int(op.ptr.DesiredWorkers)
+	}
 	if parallelism == 0 {
 		log.Errorf("PPS master: error getting number of workers (defaulting to 1 worker)")
 		parallelism = 1
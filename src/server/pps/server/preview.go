@@ -0,0 +1,284 @@
+package server
+
+import (
+	"context"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	pfsServer "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	workercommon "github.com/pachyderm/pachyderm/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/src/server/worker/datum"
+)
+
+// PreviewPipeline implements the protobuf pps.PreviewPipeline RPC
+func (a *apiServer) PreviewPipeline(ctx context.Context, request *pps.CreatePipelineRequest) (response *pps.PipelinePreview, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.validatePipelineRequest(request); err != nil {
+		return nil, err
+	}
+	preview, authErr, err := a.previewPipeline(a.env.GetPachClient(ctx), request)
+	if err != nil {
+		return nil, err
+	}
+	if authErr != nil {
+		preview.AuthError = authErr.Error()
+	}
+	return preview, nil
+}
+
+// previewPipeline returns what CreatePipeline would change if it were called
+// with 'request', which isn't modified, and the error, if any, that
+// authorizing the request returns.
+func (a *apiServer) previewPipeline(pachClient *client.APIClient, request *pps.CreatePipelineRequest) (_ *pps.PipelinePreview, authErr error, retErr error) {
+	request = proto.Clone(request).(*pps.CreatePipelineRequest)
+	pipelineName := request.Pipeline.Name
+	// As in CreatePipeline, a new salt is only kept if the pipeline is new or
+	// its datums are reprocessed
+	if request.Salt == "" || request.Reprocess {
+		request.Salt = "new"
+	}
+	pipelineInfo := newPipelineInfo(request)
+	if err := setPipelineDefaults(pipelineInfo); err != nil {
+		return nil, nil, err
+	}
+	if err := a.validatePipeline(pachClient, pipelineInfo); err != nil {
+		return nil, nil, err
+	}
+	pps.SortInput(pipelineInfo.Input)
+
+	preview := &pps.PipelinePreview{Pipeline: request.Pipeline}
+	oldPipelineInfo, err := a.inspectPipeline(pachClient, pipelineName)
+	if err == nil {
+		if !request.Update {
+			return nil, nil, errors.Errorf("pipeline %q already exists", pipelineName)
+		}
+		if oldPipelineInfo.EnableStats && !pipelineInfo.EnableStats {
+			return nil, nil, newErrPipelineUpdate(pipelineName, "cannot disable stats")
+		}
+		preview.Update = true
+		if !request.Reprocess {
+			pipelineInfo.Salt = oldPipelineInfo.Salt
+		}
+		preview.DatumHashesChanged = pipelineInfo.Salt != oldPipelineInfo.Salt
+		if preview.SpecChanges, err = ppsutil.SpecChanges(
+			ppsutil.PipelineReqFromInfo(oldPipelineInfo),
+			ppsutil.PipelineReqFromInfo(pipelineInfo),
+		); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		oldPipelineInfo = nil
+	}
+
+	operation := pipelineOpCreate
+	if preview.Update {
+		operation = pipelineOpUpdate
+	}
+	authErr = a.authorizePipelineOp(pachClient, operation, pipelineInfo.Input, pipelineName)
+
+	var oldInput *pps.Input
+	if oldPipelineInfo != nil {
+		oldInput = oldPipelineInfo.Input
+	}
+	preview.AddedInputs, preview.RemovedInputs = diffBranches(branchProvenance(oldInput), branchProvenance(pipelineInfo.Input))
+	preview.AclChanges = aclChanges(pipelineName, oldInput, pipelineInfo.Input, oldPipelineInfo == nil)
+
+	if err := a.previewDatums(pachClient, preview, pipelineInfo, oldPipelineInfo); err != nil {
+		return nil, nil, err
+	}
+	return preview, authErr, nil
+}
+
+// previewDatums counts the datums of 'pipelineInfo' at the heads of its input
+// branches into 'preview', and how many of them are already in the output of
+// 'oldPipelineInfo' (which is nil if the pipeline is new). If an input branch
+// has no commits, there are no datums to count.
+func (a *apiServer) previewDatums(pachClient *client.APIClient, preview *pps.PipelinePreview, pipelineInfo, oldPipelineInfo *pps.PipelineInfo) error {
+	input := proto.Clone(pipelineInfo.Input).(*pps.Input)
+	var missing bool
+	var visitErr error
+	pps.VisitInput(input, func(input *pps.Input) {
+		head := func(repo, branch string) string {
+			if visitErr != nil || missing {
+				return ""
+			}
+			ci, err := pachClient.InspectCommit(repo, branch)
+			if err != nil {
+				if isNotFoundErr(err) || pfsServer.IsNoHeadErr(err) {
+					missing = true
+				} else {
+					visitErr = err
+				}
+				return ""
+			}
+			return ci.Commit.ID
+		}
+		switch {
+		case input.Pfs != nil:
+			input.Pfs.Commit = head(input.Pfs.Repo, input.Pfs.Branch)
+		case input.Window != nil:
+			input.Window.Commit = head(input.Window.Repo, input.Window.Branch)
+		case input.Cron != nil:
+			input.Cron.Commit = head(input.Cron.Repo, "master")
+		case input.Git != nil:
+			missing = true // git inputs' repos are named after the input
+		case input.ObjectStore != nil:
+			input.ObjectStore.Commit = head(input.ObjectStore.Repo, "master")
+		}
+	})
+	if visitErr != nil {
+		return visitErr
+	}
+	if missing {
+		return nil
+	}
+
+	var processed map[string]bool
+	if oldPipelineInfo != nil && !preview.DatumHashesChanged {
+		var err error
+		if processed, err = outputDatums(pachClient, oldPipelineInfo); err != nil {
+			return err
+		}
+	}
+	dit, err := datum.NewIterator(pachClient, input)
+	if err != nil {
+		return err
+	}
+	for dit.Next() {
+		preview.DatumsTotal++
+		if processed[workercommon.HashDatum(pipelineInfo.Pipeline.Name, pipelineInfo.Salt, dit.Datum())] {
+			preview.DatumsSkipped++
+		} else {
+			preview.DatumsProcessed++
+		}
+	}
+	return nil
+}
+
+// outputDatums returns the hashes of the datums in the latest finished output
+// commit of the pipeline described by 'pipelineInfo', which a new job of the
+// pipeline would skip.
+func outputDatums(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) (map[string]bool, error) {
+	commitInfo, err := pachClient.InspectCommit(pipelineInfo.Pipeline.Name, pipelineInfo.OutputBranch)
+	if err != nil {
+		if isNotFoundErr(err) || pfsServer.IsNoHeadErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// Walk back to a finished commit with output, as the worker does
+	for commitInfo.Finished == nil || commitInfo.Trees == nil {
+		if commitInfo.ParentCommit == nil {
+			return nil, nil
+		}
+		if commitInfo, err = pachClient.InspectCommit(commitInfo.ParentCommit.Repo.Name, commitInfo.ParentCommit.ID); err != nil {
+			return nil, err
+		}
+	}
+	return commitDatums(pachClient, commitInfo)
+}
+
+// commitDatums returns the hashes of the datums whose output is in the finished
+// output commit 'commitInfo'
+func commitDatums(pachClient *client.APIClient, commitInfo *pfs.CommitInfo) (_ map[string]bool, retErr error) {
+	if commitInfo.Datums == nil {
+		return nil, nil
+	}
+	r, err := pachClient.GetObjectReader(commitInfo.Datums.Hash)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	datums := make(map[string]bool)
+	pbr := pbutil.NewReader(r)
+	for {
+		k, err := pbr.ReadBytes()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return datums, nil
+			}
+			return nil, err
+		}
+		datums[string(k)] = true
+	}
+}
+
+// diffBranches returns the branches in 'new' that aren't in 'old', and the
+// branches in 'old' that aren't in 'new'
+func diffBranches(old, new []*pfs.Branch) (added, removed []*pfs.Branch) {
+	key := func(b *pfs.Branch) string { return b.Repo.Name + "@" + b.Name }
+	oldKeys, newKeys := make(map[string]bool), make(map[string]bool)
+	for _, b := range old {
+		oldKeys[key(b)] = true
+	}
+	for _, b := range new {
+		newKeys[key(b)] = true
+		if !oldKeys[key(b)] {
+			added = append(added, b)
+			oldKeys[key(b)] = true // don't report a branch twice
+		}
+	}
+	for _, b := range old {
+		if !newKeys[key(b)] {
+			removed = append(removed, b)
+			newKeys[key(b)] = true
+		}
+	}
+	return added, removed
+}
+
+// aclChanges returns the changes that fixPipelineInputRepoACLs would make to
+// the ACLs of the repos read by 'oldInput' and 'newInput' (and the pipeline's
+// output repo, if 'create' is set).
+func aclChanges(pipelineName string, oldInput, newInput *pps.Input, create bool) []*pps.ACLChange {
+	oldRepos, newRepos := make(map[string]bool), make(map[string]bool)
+	pps.VisitInput(oldInput, func(input *pps.Input) {
+		if repo := inputRepo(input); repo != "" {
+			oldRepos[repo] = true
+		}
+	})
+	pps.VisitInput(newInput, func(input *pps.Input) {
+		if repo := inputRepo(input); repo != "" {
+			newRepos[repo] = true
+		}
+	})
+	var result []*pps.ACLChange
+	if create {
+		result = append(result, &pps.ACLChange{Repo: pipelineName, Scope: auth.Scope_WRITER.String()})
+	}
+	for _, repo := range sortedKeys(newRepos) {
+		if !oldRepos[repo] {
+			result = append(result, &pps.ACLChange{Repo: repo, Scope: auth.Scope_READER.String()})
+		}
+	}
+	for _, repo := range sortedKeys(oldRepos) {
+		if !newRepos[repo] {
+			result = append(result, &pps.ACLChange{Repo: repo, Scope: auth.Scope_NONE.String()})
+		}
+	}
+	return result
+}
+
+func sortedKeys(m map[string]bool) []string {
+	var result []string
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
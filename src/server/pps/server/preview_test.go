@@ -0,0 +1,69 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+)
+
+func TestSpecChanges(t *testing.T) {
+	old := &pps.CreatePipelineRequest{
+		Pipeline:  client.NewPipeline("p"),
+		Transform: &pps.Transform{Image: "ubuntu:18.04", Cmd: []string{"sh"}},
+		Input:     client.NewPFSInput("in", "/*"),
+		Salt:      "abc",
+	}
+	new := &pps.CreatePipelineRequest{
+		Pipeline:    client.NewPipeline("p"),
+		Transform:   &pps.Transform{Image: "ubuntu:20.04", Cmd: []string{"sh"}},
+		Input:       client.NewPFSInput("in", "/"),
+		Salt:        "abc",
+		Description: "new",
+		Update:      true,
+		Reprocess:   true,
+	}
+	changes, err := ppsutil.SpecChanges(old, new)
+	require.NoError(t, err)
+	require.Equal(t, []*pps.SpecChange{
+		{Field: "description", New: `"new"`},
+		{Field: "input.pfs.glob", Old: `"/*"`, New: `"/"`},
+		{Field: "transform.image", Old: `"ubuntu:18.04"`, New: `"ubuntu:20.04"`},
+	}, changes)
+
+	changes, err = ppsutil.SpecChanges(old, old)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(changes))
+}
+
+func TestPreviewInputChanges(t *testing.T) {
+	old := client.NewCrossInput(
+		client.NewPFSInput("a", "/*"),
+		client.NewPFSInput("b", "/*"),
+	)
+	new := client.NewCrossInput(
+		client.NewPFSInput("b", "/*"),
+		client.NewPFSInputOpts("c", "c", "dev", "/*", "", "", false, false, nil),
+	)
+	setInputDefaults("p", old)
+	setInputDefaults("p", new)
+
+	added, removed := diffBranches(branchProvenance(old), branchProvenance(new))
+	require.Equal(t, 1, len(added))
+	require.Equal(t, "c", added[0].Repo.Name)
+	require.Equal(t, "dev", added[0].Name)
+	require.Equal(t, 1, len(removed))
+	require.Equal(t, "a", removed[0].Repo.Name)
+
+	require.Equal(t, []*pps.ACLChange{
+		{Repo: "c", Scope: "READER"},
+		{Repo: "a", Scope: "NONE"},
+	}, aclChanges("p", old, new, false))
+	require.Equal(t, []*pps.ACLChange{
+		{Repo: "p", Scope: "WRITER"},
+		{Repo: "a", Scope: "READER"},
+		{Repo: "b", Scope: "READER"},
+	}, aclChanges("p", nil, old, true))
+}
@@ -57,6 +57,7 @@ func NewAPIServer(
 		workerUsesRoot:         workerUsesRoot,
 		pipelines:              ppsdb.Pipelines(env.GetEtcdClient(), etcdPrefix),
 		jobs:                   ppsdb.Jobs(env.GetEtcdClient(), etcdPrefix),
+		memos:                  ppsdb.Memos(env.GetEtcdClient(), etcdPrefix),
 		monitorCancels:         make(map[string]func()),
 		crashingMonitorCancels: make(map[string]func()),
 		workerGrpcPort:         workerGrpcPort,
@@ -95,6 +96,7 @@ func NewSidecarAPIServer(
 		workerUsesRoot: true,
 		pipelines:      ppsdb.Pipelines(env.GetEtcdClient(), etcdPrefix),
 		jobs:           ppsdb.Jobs(env.GetEtcdClient(), etcdPrefix),
+		memos:          ppsdb.Memos(env.GetEtcdClient(), etcdPrefix),
 		workerGrpcPort: workerGrpcPort,
 		httpPort:       httpPort,
 		peerPort:       peerPort,
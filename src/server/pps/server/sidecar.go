@@ -0,0 +1,82 @@
+package server
+
+import (
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+)
+
+// validateSidecars checks that the user's sidecar containers can be added to
+// the pipeline's worker pods
+func validateSidecars(sidecars []*pps.Sidecar) error {
+	names := map[string]bool{
+		"init":                               true,
+		client.PPSWorkerUserContainerName:    true,
+		client.PPSWorkerSidecarContainerName: true,
+	}
+	for _, sidecar := range sidecars {
+		if sidecar == nil {
+			return errors.New("sidecars cannot be null")
+		}
+		if errs := k8svalidation.IsDNS1123Label(sidecar.Name); len(errs) > 0 {
+			return errors.Errorf("invalid sidecar name %q: %s", sidecar.Name, strings.Join(errs, "; "))
+		}
+		if names[sidecar.Name] {
+			return errors.Errorf("sidecar name %q is already used by another container in the worker pods", sidecar.Name)
+		}
+		names[sidecar.Name] = true
+		if sidecar.Image == "" {
+			return errors.Errorf("sidecar %q must specify an image", sidecar.Name)
+		}
+	}
+	return nil
+}
+
+// sidecarContainers returns the k8s containers for the user's sidecars
+func sidecarContainers(sidecars []*pps.Sidecar, pullPolicy string) ([]v1.Container, error) {
+	if pullPolicy == "" {
+		pullPolicy = "IfNotPresent"
+	}
+	var result []v1.Container
+	for _, sidecar := range sidecars {
+		container := v1.Container{
+			Name:            sidecar.Name,
+			Image:           sidecar.Image,
+			Command:         sidecar.Cmd,
+			ImagePullPolicy: v1.PullPolicy(pullPolicy),
+		}
+		// Sort the env vars, so that the worker pods' spec doesn't change
+		// each time the RC is generated
+		var names []string
+		for name := range sidecar.Env {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			container.Env = append(container.Env, v1.EnvVar{Name: name, Value: sidecar.Env[name]})
+		}
+		if sidecar.ResourceRequests != nil {
+			requests, err := ppsutil.GetRequestsResourceList(sidecar.ResourceRequests)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not determine resource request of sidecar %q", sidecar.Name)
+			}
+			container.Resources.Requests = *requests
+		}
+		if sidecar.ResourceLimits != nil {
+			limits, err := ppsutil.GetLimitsResourceList(sidecar.ResourceLimits)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not determine resource limit of sidecar %q", sidecar.Name)
+			}
+			container.Resources.Limits = *limits
+		}
+		result = append(result, container)
+	}
+	return result, nil
+}
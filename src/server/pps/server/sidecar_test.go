@@ -0,0 +1,43 @@
+package server
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestValidateSidecars(t *testing.T) {
+	require.NoError(t, validateSidecars(nil))
+	require.NoError(t, validateSidecars([]*pps.Sidecar{
+		{Name: "redis", Image: "redis"},
+		{Name: "cache-warmer", Image: "warmer:1.0"},
+	}))
+	require.YesError(t, validateSidecars([]*pps.Sidecar{{Name: "Redis", Image: "redis"}}))
+	require.YesError(t, validateSidecars([]*pps.Sidecar{{Name: "redis"}}))
+	require.YesError(t, validateSidecars([]*pps.Sidecar{{Name: "user", Image: "redis"}}))
+	require.YesError(t, validateSidecars([]*pps.Sidecar{
+		{Name: "redis", Image: "redis"},
+		{Name: "redis", Image: "redis:6"},
+	}))
+}
+
+func TestSidecarContainers(t *testing.T) {
+	containers, err := sidecarContainers([]*pps.Sidecar{{
+		Name:           "redis",
+		Image:          "redis",
+		Cmd:            []string{"redis-server", "--save", ""},
+		Env:            map[string]string{"B": "2", "A": "1"},
+		ResourceLimits: &pps.ResourceSpec{Memory: "1G"},
+	}}, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(containers))
+	require.Equal(t, "redis", containers[0].Name)
+	require.Equal(t, []string{"redis-server", "--save", ""}, containers[0].Command)
+	require.Equal(t, v1.PullPolicy("IfNotPresent"), containers[0].ImagePullPolicy)
+	require.Equal(t, []v1.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}}, containers[0].Env)
+	memory := containers[0].Resources.Limits[v1.ResourceMemory]
+	require.Equal(t, "1G", memory.String())
+}
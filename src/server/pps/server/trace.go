@@ -0,0 +1,367 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	ppath "github.com/pachyderm/pachyderm/src/server/pkg/path"
+	workercommon "github.com/pachyderm/pachyderm/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/src/server/worker/datum"
+)
+
+// TraceFile implements the protobuf pps.TraceFile RPC
+func (a *apiServer) TraceFile(ctx context.Context, request *pps.TraceFileRequest) (response *pps.FileTrace, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.env.GetPachClient(ctx)
+	if _, err := checkLoggedIn(pachClient); err != nil {
+		return nil, err
+	}
+	if request.File == nil || request.File.Commit == nil || request.File.Commit.Repo == nil {
+		return nil, errors.New("request.File cannot be nil")
+	}
+	return a.traceFile(pachClient, request.File, request.Recursive)
+}
+
+// traceFile finds the datums that wrote 'file', and, if 'recursive', the
+// datums that wrote their inputs, and so on.
+func (a *apiServer) traceFile(pachClient *client.APIClient, file *pfs.File, recursive bool) (*pps.FileTrace, error) {
+	commitInfo, err := pachClient.InspectCommit(file.Commit.Repo.Name, file.Commit.ID)
+	if err != nil {
+		return nil, err
+	}
+	file = client.NewFile(commitInfo.Commit.Repo.Name, commitInfo.Commit.ID, file.Path)
+	if _, err := pachClient.InspectFile(file.Commit.Repo.Name, file.Commit.ID, file.Path); err != nil {
+		return nil, err
+	}
+	return newTracer(&pachTraceSource{a: a, pachClient: pachClient}, recursive).traceFile(file)
+}
+
+// traceSource is what a tracer reads jobs, their datums and files from
+type traceSource interface {
+	// outputCommitJob returns the job that wrote 'commit', as its output commit
+	// or as the commit of one of its named outputs, and the name of that output
+	// ("" for the output commit), or nil if there isn't one
+	outputCommitJob(commit *pfs.Commit) (*pps.JobInfo, string, error)
+	// jobDatums returns the datums of the job 'jobInfo'
+	jobDatums(jobInfo *pps.JobInfo) (datum.Iterator, error)
+	// datumOutput calls 'f' with the path of each node in the output hashtree
+	// of the datum with hash 'tag', if it has one
+	datumOutput(tag string, f func(path string) error) error
+	// datumJob returns the job that processed the datum 'datumID' (with hash
+	// 'tag') of the job 'jobInfo' (see apiServer.datumJob)
+	datumJob(jobInfo *pps.JobInfo, datumID string, tag string) (*pps.Job, error)
+	// walkFiles calls 'f' with each regular file at or under 'file'
+	walkFiles(file *pfs.File, f func(*pfs.File) error) error
+}
+
+// tracer traces files for a single TraceFile request. It memoizes the jobs
+// and datums it has traced, so that each job's datum hashtrees are read once
+// however many of its files are traced, and each datum is traced once however
+// many files it wrote.
+type tracer struct {
+	source    traceSource
+	recursive bool
+
+	jobs   map[string]*tracedJob      // keyed by output commit ID
+	datums map[string]*pps.DatumTrace // keyed by job ID and datum ID
+}
+
+// tracedJob is a job whose output files are being traced
+type tracedJob struct {
+	info *pps.JobInfo // nil if the commit wasn't written by a pipeline
+	dit  datum.Iterator
+	// dir is the directory of the job's output that the commit holds, i.e.
+	// "/<name>" for the commit of a named output, or "" for the output commit
+	dir string
+	// files maps the path of each node in the job's output to the indexes of
+	// the datums that wrote it, i.e. the job's output hashtree, merged from
+	// its datums' hashtrees, annotated with the datums
+	files map[string][]int
+}
+
+func newTracer(source traceSource, recursive bool) *tracer {
+	return &tracer{
+		source:    source,
+		recursive: recursive,
+		jobs:      make(map[string]*tracedJob),
+		datums:    make(map[string]*pps.DatumTrace),
+	}
+}
+
+// traceFile finds the datums that wrote 'file' (whose commit must be an ID),
+// by looking it up in the output of the job that created the file's commit
+func (t *tracer) traceFile(file *pfs.File) (*pps.FileTrace, error) {
+	trace := &pps.FileTrace{File: file}
+	job, err := t.job(file.Commit)
+	if err != nil {
+		return nil, err
+	}
+	if job.info == nil {
+		return trace, nil // not written by a pipeline
+	}
+	for _, i := range job.files[ppath.Join(job.dir, file.Path)] {
+		datumTrace, err := t.traceDatum(job, i)
+		if err != nil {
+			return nil, err
+		}
+		trace.Datums = append(trace.Datums, datumTrace)
+	}
+	if len(trace.Datums) == 0 {
+		return nil, errors.Errorf("no datum of job %s wrote %s@%s:%s (the job may not be finished)",
+			job.info.Job.ID, file.Commit.Repo.Name, file.Commit.ID, file.Path)
+	}
+	return trace, nil
+}
+
+// job returns the job that wrote 'commit', indexing its output by datum the
+// first time it's asked for
+func (t *tracer) job(commit *pfs.Commit) (*tracedJob, error) {
+	if job, ok := t.jobs[commit.ID]; ok {
+		return job, nil
+	}
+	jobInfo, name, err := t.source.outputCommitJob(commit)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		// The commit of a named output holds a directory of its job's output,
+		// which is indexed once for all of them
+		outputJob, err := t.job(jobInfo.OutputCommit)
+		if err != nil {
+			return nil, err
+		}
+		job := &tracedJob{info: outputJob.info, dit: outputJob.dit, dir: "/" + name, files: outputJob.files}
+		t.jobs[commit.ID] = job
+		return job, nil
+	}
+	job := &tracedJob{info: jobInfo, files: make(map[string][]int)}
+	if jobInfo != nil {
+		if job.dit, err = t.source.jobDatums(jobInfo); err != nil {
+			return nil, err
+		}
+		for i := 0; i < job.dit.Len(); i++ {
+			tag := workercommon.HashDatum(jobInfo.Pipeline.Name, jobInfo.Salt, job.dit.DatumN(i))
+			if err := t.source.datumOutput(tag, func(path string) error {
+				path = ppath.Clean(path)
+				job.files[path] = append(job.files[path], i)
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	t.jobs[commit.ID] = job
+	return job, nil
+}
+
+// traceDatum traces the datum at index 'i' of 'job', and, if the tracer is
+// recursive, its inputs
+func (t *tracer) traceDatum(job *tracedJob, i int) (*pps.DatumTrace, error) {
+	inputs := job.dit.DatumN(i)
+	datumID := workercommon.DatumID(inputs)
+	key := path.Join(job.info.Job.ID, datumID)
+	if datumTrace, ok := t.datums[key]; ok {
+		return datumTrace, nil
+	}
+	tag := workercommon.HashDatum(job.info.Pipeline.Name, job.info.Salt, inputs)
+	processedBy, err := t.source.datumJob(job.info, datumID, tag)
+	if err != nil {
+		return nil, err
+	}
+	datumTrace := &pps.DatumTrace{Datum: &pps.Datum{ID: datumID, Job: processedBy}}
+	for _, input := range inputs {
+		datumTrace.Inputs = append(datumTrace.Inputs, input.FileInfo)
+		if !t.recursive {
+			continue
+		}
+		upstream, err := t.traceInput(input.FileInfo)
+		if err != nil {
+			return nil, err
+		}
+		datumTrace.Upstream = append(datumTrace.Upstream, upstream...)
+	}
+	t.datums[key] = datumTrace
+	return datumTrace, nil
+}
+
+// traceInput traces the files in 'fileInfo', the input file of a datum, if
+// they were written by a pipeline
+func (t *tracer) traceInput(fileInfo *pfs.FileInfo) ([]*pps.FileTrace, error) {
+	job, err := t.job(fileInfo.File.Commit)
+	if err != nil {
+		return nil, err
+	}
+	if job.info == nil {
+		return nil, nil // a source repo
+	}
+	var result []*pps.FileTrace
+	if err := t.source.walkFiles(fileInfo.File, func(file *pfs.File) error {
+		trace, err := t.traceFile(file)
+		if err != nil {
+			return err
+		}
+		result = append(result, trace)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// pachTraceSource is the traceSource that TraceFile uses, which reads from
+// PFS and PPS
+type pachTraceSource struct {
+	a          *apiServer
+	pachClient *client.APIClient
+}
+
+func (s *pachTraceSource) outputCommitJob(commit *pfs.Commit) (*pps.JobInfo, string, error) {
+	jobInfo, err := s.a.outputCommitJob(s.pachClient, commit)
+	if err != nil || jobInfo != nil {
+		return jobInfo, "", err
+	}
+	return s.a.namedOutputCommitJob(s.pachClient, commit)
+}
+
+func (s *pachTraceSource) jobDatums(jobInfo *pps.JobInfo) (datum.Iterator, error) {
+	return datum.NewIterator(s.pachClient, jobInfo.Input)
+}
+
+func (s *pachTraceSource) datumOutput(tag string, f func(path string) error) error {
+	// Datums that failed, or haven't been processed yet, have no hashtree
+	if _, err := s.pachClient.InspectTag(s.pachClient.Ctx(), client.NewTag(tag)); err != nil {
+		if isNotFoundErr(err) {
+			return nil
+		}
+		return err
+	}
+	var buf bytes.Buffer
+	if err := s.pachClient.GetTag(tag, &buf); err != nil {
+		return err
+	}
+	return walkHashtree(&buf, f)
+}
+
+func (s *pachTraceSource) datumJob(jobInfo *pps.JobInfo, datumID string, tag string) (*pps.Job, error) {
+	return s.a.datumJob(s.pachClient, jobInfo, datumID, tag)
+}
+
+func (s *pachTraceSource) walkFiles(file *pfs.File, f func(*pfs.File) error) error {
+	return s.pachClient.Walk(file.Commit.Repo.Name, file.Commit.ID, file.Path, func(fi *pfs.FileInfo) error {
+		if fi.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		return f(fi.File)
+	})
+}
+
+// outputCommitJob returns the job whose output commit is 'commit', or nil if
+// there isn't one (e.g. 'commit' is in a source repo)
+func (a *apiServer) outputCommitJob(pachClient *client.APIClient, commit *pfs.Commit) (*pps.JobInfo, error) {
+	var result *pps.JobInfo
+	if err := a.listJob(pachClient, nil, commit, nil, -1, true, "", func(ji *pps.JobInfo) error {
+		if result != nil {
+			return errors.Errorf("internal error, more than 1 Job has output commit: %v (this is likely a bug)", commit)
+		}
+		result = ji
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// namedOutputCommitJob returns the job that 'commit' is the commit of a named
+// output of, and the name of that output, or nil if there isn't one. Named
+// output commits are created with their job's output commit as provenance.
+func (a *apiServer) namedOutputCommitJob(pachClient *client.APIClient, commit *pfs.Commit) (*pps.JobInfo, string, error) {
+	commitInfo, err := pachClient.InspectCommit(commit.Repo.Name, commit.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, prov := range commitInfo.Provenance {
+		jobInfo, err := a.outputCommitJob(pachClient, prov.Commit)
+		if err != nil {
+			return nil, "", err
+		}
+		if jobInfo == nil {
+			continue
+		}
+		for name, namedCommit := range jobInfo.NamedOutputCommits {
+			if namedCommit.ID == commit.ID {
+				return jobInfo, name, nil
+			}
+		}
+	}
+	return nil, "", nil
+}
+
+// walkHashtree calls 'f' with the path of each node in the serialized
+// hashtree in 'r'
+func walkHashtree(r io.Reader, f func(path string) error) error {
+	return hashtree.Walk([]io.ReadCloser{ioutil.NopCloser(r)}, "/", func(path string, _ *hashtree.NodeProto) error {
+		return f(path)
+	})
+}
+
+// datumJob returns the job that processed the datum 'datumID' (with hash
+// 'tag') of the job 'jobInfo', which is an earlier job if the datum was
+// skipped. The datum's stats record the job when stats are enabled; otherwise
+// the job is the oldest of the consecutive jobs whose output commits include
+// the datum.
+func (a *apiServer) datumJob(pachClient *client.APIClient, jobInfo *pps.JobInfo, datumID string, tag string) (*pps.Job, error) {
+	if jobInfo.StatsCommit != nil {
+		fileInfos, err := pachClient.GlobFile(jobInfo.StatsCommit.Repo.Name, jobInfo.StatsCommit.ID, fmt.Sprintf("/%v/job:*", datumID))
+		if err != nil && !isNotFoundErr(err) {
+			return nil, err
+		}
+		if len(fileInfos) == 1 {
+			return client.NewJob(strings.Split(fileInfos[0].File.Path, ":")[1]), nil
+		}
+	}
+	job := jobInfo.Job
+	commit := jobInfo.OutputCommit
+	for {
+		commitInfo, err := pachClient.InspectCommit(commit.Repo.Name, commit.ID)
+		if err != nil {
+			return nil, err
+		}
+		if commitInfo.ParentCommit == nil {
+			return job, nil
+		}
+		parentInfo, err := pachClient.InspectCommit(commitInfo.ParentCommit.Repo.Name, commitInfo.ParentCommit.ID)
+		if err != nil {
+			return nil, err
+		}
+		if parentInfo.Finished == nil {
+			return job, nil
+		}
+		datums, err := commitDatums(pachClient, parentInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !datums[tag] {
+			return job, nil
+		}
+		parentJob, err := a.outputCommitJob(pachClient, parentInfo.Commit)
+		if err != nil {
+			return nil, err
+		}
+		if parentJob == nil {
+			return job, nil
+		}
+		job, commit = parentJob.Job, parentInfo.Commit
+	}
+}
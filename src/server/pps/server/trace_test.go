@@ -0,0 +1,213 @@
+package server
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	workercommon "github.com/pachyderm/pachyderm/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/src/server/worker/datum"
+)
+
+func TestWalkHashtree(t *testing.T) {
+	// Datum output hashtrees are ordered hashtrees, as written by the worker
+	tree := hashtree.NewOrdered("/")
+	tree.PutDir("/dir")
+	tree.PutFile("/dir/out.csv", pfs.NewHash().Sum(nil), 1, &hashtree.FileNodeProto{})
+	var buf bytes.Buffer
+	require.NoError(t, tree.Serialize(&buf))
+
+	var paths []string
+	require.NoError(t, walkHashtree(&buf, func(path string) error {
+		paths = append(paths, path)
+		return nil
+	}))
+	sort.Strings(paths)
+	require.Equal(t, []string{"/", "/dir", "/dir/out.csv"}, paths)
+}
+
+// fakeTraceSource is a traceSource over fixed jobs, which counts how often
+// each datum's output is read
+type fakeTraceSource struct {
+	jobs    map[string]*pps.JobInfo // keyed by output commit ID
+	named   map[string]string       // named output commit ID -> output commit ID
+	datums  map[string]datumList    // keyed by job ID
+	outputs map[string][]string     // datum hash -> output paths
+	files   map[string][]string     // "<commit>:<dir>" -> files under it
+	reads   map[string]int          // datum hash -> number of reads
+}
+
+func (s *fakeTraceSource) outputCommitJob(commit *pfs.Commit) (*pps.JobInfo, string, error) {
+	if outputCommit, ok := s.named[commit.ID]; ok {
+		jobInfo := s.jobs[outputCommit]
+		for name, namedCommit := range jobInfo.NamedOutputCommits {
+			if namedCommit.ID == commit.ID {
+				return jobInfo, name, nil
+			}
+		}
+	}
+	return s.jobs[commit.ID], "", nil
+}
+
+func (s *fakeTraceSource) jobDatums(jobInfo *pps.JobInfo) (datum.Iterator, error) {
+	return s.datums[jobInfo.Job.ID], nil
+}
+
+func (s *fakeTraceSource) datumOutput(tag string, f func(path string) error) error {
+	s.reads[tag]++
+	for _, path := range s.outputs[tag] {
+		if err := f(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fakeTraceSource) datumJob(jobInfo *pps.JobInfo, datumID string, tag string) (*pps.Job, error) {
+	return jobInfo.Job, nil
+}
+
+func (s *fakeTraceSource) walkFiles(file *pfs.File, f func(*pfs.File) error) error {
+	paths, ok := s.files[file.Commit.ID+":"+file.Path]
+	if !ok {
+		paths = []string{file.Path}
+	}
+	for _, path := range paths {
+		if err := f(client.NewFile(file.Commit.Repo.Name, file.Commit.ID, path)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestTraceFile(t *testing.T) {
+	input := func(name, repo, commit, path string) *workercommon.Input {
+		return &workercommon.Input{
+			Name:     name,
+			FileInfo: &pfs.FileInfo{File: client.NewFile(repo, commit, path), Hash: []byte(repo + path)},
+		}
+	}
+	// 'split' splits each file in the source repo 'in' into two parts (one
+	// datum per file), and 'count' counts each directory of parts written by
+	// 'split' (one datum per directory)
+	splitJob := &pps.JobInfo{Job: client.NewJob("split-job"), Pipeline: client.NewPipeline("split")}
+	countJob := &pps.JobInfo{Job: client.NewJob("count-job"), Pipeline: client.NewPipeline("count")}
+	splitDatums := datumList{
+		{input("in", "in", "in-commit", "/a")},
+		{input("in", "in", "in-commit", "/b")},
+	}
+	countDatums := datumList{
+		{input("split", "split", "split-commit", "/a")},
+		{input("split", "split", "split-commit", "/b")},
+	}
+	tag := func(jobInfo *pps.JobInfo, inputs []*workercommon.Input) string {
+		return workercommon.HashDatum(jobInfo.Pipeline.Name, jobInfo.Salt, inputs)
+	}
+	source := &fakeTraceSource{
+		jobs: map[string]*pps.JobInfo{
+			"split-commit": splitJob,
+			"count-commit": countJob,
+		},
+		datums: map[string]datumList{
+			"split-job": splitDatums,
+			"count-job": countDatums,
+		},
+		outputs: map[string][]string{
+			tag(splitJob, splitDatums[0]): {"/a", "/a/1", "/a/2"},
+			tag(splitJob, splitDatums[1]): {"/b", "/b/1", "/b/2"},
+			tag(countJob, countDatums[0]): {"/a"},
+			tag(countJob, countDatums[1]): {"/b"},
+		},
+		files: map[string][]string{
+			"split-commit:/a": {"/a/1", "/a/2"},
+			"split-commit:/b": {"/b/1", "/b/2"},
+		},
+		reads: make(map[string]int),
+	}
+
+	trace, err := newTracer(source, true).traceFile(client.NewFile("count", "count-commit", "/b"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trace.Datums))
+	countDatum := trace.Datums[0]
+	require.Equal(t, "count-job", countDatum.Datum.Job.ID)
+	require.Equal(t, workercommon.DatumID(countDatums[1]), countDatum.Datum.ID)
+	require.Equal(t, "/b", countDatum.Inputs[0].File.Path)
+
+	// The count datum's input directory is traced, file by file, back to the
+	// split datum that wrote it, whose input is in a source repo
+	require.Equal(t, 2, len(countDatum.Upstream))
+	for _, upstream := range countDatum.Upstream {
+		require.True(t, strings.HasPrefix(upstream.File.Path, "/b/"))
+		require.Equal(t, 1, len(upstream.Datums))
+		splitDatum := upstream.Datums[0]
+		require.Equal(t, "split-job", splitDatum.Datum.Job.ID)
+		require.Equal(t, workercommon.DatumID(splitDatums[1]), splitDatum.Datum.ID)
+		require.Equal(t, "/b", splitDatum.Inputs[0].File.Path)
+		require.Equal(t, 0, len(splitDatum.Upstream))
+	}
+	// Both files were written by the same datum, which was traced once
+	require.True(t, countDatum.Upstream[0].Datums[0] == countDatum.Upstream[1].Datums[0])
+
+	// Each datum's output was read once, however many files were traced
+	for tag, reads := range source.reads {
+		require.Equal(t, 1, reads, tag)
+	}
+	require.Equal(t, 4, len(source.reads))
+
+	// Files that no datum wrote can't be traced
+	_, err = newTracer(source, false).traceFile(client.NewFile("count", "count-commit", "/c"))
+	require.YesError(t, err)
+}
+
+func TestTraceNamedOutputFile(t *testing.T) {
+	// 'train' writes one model per file in the source repo 'in' to its named
+	// output 'models', and a summary to its output commit
+	trainJob := &pps.JobInfo{
+		Job:                client.NewJob("train-job"),
+		Pipeline:           client.NewPipeline("train"),
+		OutputCommit:       client.NewCommit("train", "train-commit"),
+		NamedOutputCommits: map[string]*pfs.Commit{"models": client.NewCommit("models", "models-commit")},
+	}
+	trainDatums := datumList{
+		{{Name: "in", FileInfo: &pfs.FileInfo{File: client.NewFile("in", "in-commit", "/a"), Hash: []byte("a")}}},
+		{{Name: "in", FileInfo: &pfs.FileInfo{File: client.NewFile("in", "in-commit", "/b"), Hash: []byte("b")}}},
+	}
+	tag := func(inputs []*workercommon.Input) string {
+		return workercommon.HashDatum(trainJob.Pipeline.Name, trainJob.Salt, inputs)
+	}
+	source := &fakeTraceSource{
+		jobs:   map[string]*pps.JobInfo{"train-commit": trainJob},
+		named:  map[string]string{"models-commit": "train-commit"},
+		datums: map[string]datumList{"train-job": trainDatums},
+		outputs: map[string][]string{
+			tag(trainDatums[0]): {"/models", "/models/a", "/summary"},
+			tag(trainDatums[1]): {"/models", "/models/b", "/summary"},
+		},
+		reads: make(map[string]int),
+	}
+	tracer := newTracer(source, false)
+
+	// A file in a named output is traced to the datum that wrote it under the
+	// output's directory
+	trace, err := tracer.traceFile(client.NewFile("models", "models-commit", "/b"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trace.Datums))
+	require.Equal(t, "train-job", trace.Datums[0].Datum.Job.ID)
+	require.Equal(t, workercommon.DatumID(trainDatums[1]), trace.Datums[0].Datum.ID)
+	_, err = tracer.traceFile(client.NewFile("models", "models-commit", "/summary"))
+	require.YesError(t, err)
+
+	// The output commit shares the job's index
+	trace, err = tracer.traceFile(client.NewFile("train", "train-commit", "/summary"))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(trace.Datums))
+	for tag, reads := range source.reads {
+		require.Equal(t, 1, reads, tag)
+	}
+}
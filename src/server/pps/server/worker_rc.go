@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/gogo/protobuf/types"
 	client "github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/enterprise"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
@@ -56,6 +57,14 @@ type workerOptions struct {
 	podSpec               string
 	podPatch              string
 
+	// The user's sidecar containers, which run next to the user container
+	sidecars []v1.Container
+
+	// How long k8s waits for a worker to drain before killing it (workers
+	// are killed immediately unless the pipeline autoscales or has a
+	// teardown_cmd)
+	terminationGracePeriod int64
+
 	// Secrets that we mount in the worker container (e.g. for reading/writing to
 	// s3)
 	imagePullSecrets []v1.LocalObjectReference
@@ -93,6 +102,11 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 	}, {
 		Name:  "PEER_PORT",
 		Value: strconv.FormatUint(uint64(a.peerPort), 10),
+	}, {
+		// The sidecar waits for the worker to stop serving on this port before
+		// exiting, when the pod is terminated
+		Name:  client.PPSWorkerPortEnv,
+		Value: strconv.FormatUint(uint64(a.workerGrpcPort), 10),
 	}, {
 		Name:  client.PPSSpecCommitEnv,
 		Value: options.specCommit,
@@ -195,6 +209,7 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: "LOKI_LOGGING", Value: "true"})
 		workerEnv = append(workerEnv, v1.EnvVar{Name: "LOKI_LOGGING", Value: "true"})
 	}
+	workerEnv = append(workerEnv, v1.EnvVar{Name: ppsutil.ObjectStoreInputLocalRootEnv, Value: a.env.ObjectStoreInputLocalRoot})
 
 	// This only happens in local deployment.  We want the workers to be
 	// able to read from/write to the hostpath volume as well.
@@ -336,7 +351,7 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		RestartPolicy:                 "Always",
 		Volumes:                       options.volumes,
 		ImagePullSecrets:              options.imagePullSecrets,
-		TerminationGracePeriodSeconds: &zeroVal,
+		TerminationGracePeriodSeconds: &options.terminationGracePeriod,
 		SecurityContext:               securityContext,
 	}
 	if options.schedulingSpec != nil {
@@ -375,6 +390,8 @@ func (a *apiServer) workerPodSpec(options *workerOptions, pipelineInfo *pps.Pipe
 		}
 	}
 
+	podSpec.Containers = append(podSpec.Containers, options.sidecars...)
+
 	if options.podSpec != "" || options.podPatch != "" {
 		jsonPodSpec, err := json.Marshal(&podSpec)
 		if err != nil {
@@ -518,6 +535,31 @@ func (a *apiServer) getWorkerOptions(ptr *pps.EtcdPipelineInfo, pipelineInfo *pp
 		}
 	}
 
+	// Mount the credentials that the worker reads the buckets of object store
+	// inputs with
+	var objectStoreSecrets int
+	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+		// Local buckets may be read without a secret
+		if input.ObjectStore == nil || input.ObjectStore.Secret == "" {
+			return
+		}
+		name := "object-store-secret-" + strconv.Itoa(objectStoreSecrets)
+		objectStoreSecrets++
+		volumes = append(volumes, v1.Volume{
+			Name: name,
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{
+					SecretName: input.ObjectStore.Secret,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, v1.VolumeMount{
+			Name:      name,
+			MountPath: ppsutil.ObjectStoreSecretPath(input.ObjectStore.Name),
+			ReadOnly:  true,
+		})
+	})
+
 	volumes = append(volumes, v1.Volume{
 		Name: "pach-bin",
 		VolumeSource: v1.VolumeSource{
@@ -588,6 +630,29 @@ func (a *apiServer) getWorkerOptions(ptr *pps.EtcdPipelineInfo, pipelineInfo *pp
 		s3GatewayPort = int32(a.env.S3GatewayPort)
 	}
 
+	// Give the workers of autoscaling pipelines time to finish their datums
+	// when they're scaled down
+	var terminationGracePeriod int64
+	if pipelineInfo.ParallelismSpec.GetAutoscaling() != nil {
+		terminationGracePeriod = int64(defaultDrainTimeout.Seconds())
+		if pipelineInfo.DatumTimeout != nil {
+			if d, err := types.DurationFromProto(pipelineInfo.DatumTimeout); err == nil {
+				terminationGracePeriod = int64(d.Seconds())
+			}
+		}
+	}
+
+	// Give the workers time to run the pipeline's teardown_cmd when they're
+	// shut down, after they've drained
+	if len(transform.TeardownCmd) > 0 {
+		terminationGracePeriod += int64(ppsutil.TeardownTimeout(transform).Seconds())
+	}
+
+	sidecars, err := sidecarContainers(pipelineInfo.Sidecars, a.workerImagePullPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate options for new RC
 	return &workerOptions{
 		rcName:                rcName,
@@ -609,6 +674,9 @@ func (a *apiServer) getWorkerOptions(ptr *pps.EtcdPipelineInfo, pipelineInfo *pp
 		schedulingSpec:        pipelineInfo.SchedulingSpec,
 		podSpec:               pipelineInfo.PodSpec,
 		podPatch:              pipelineInfo.PodPatch,
+		sidecars:              sidecars,
+
+		terminationGracePeriod: terminationGracePeriod,
 	}, nil
 }
 
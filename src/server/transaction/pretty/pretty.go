@@ -121,6 +121,8 @@ func sprintUpdateJobState(request *pps.UpdateJobStateRequest) string {
 			return "KILLED"
 		case pps.JobState_JOB_MERGING:
 			return "MERGING"
+		case pps.JobState_JOB_AWAITING_APPROVAL:
+			return "AWAITING_APPROVAL"
 		default:
 			return "<unknown state>"
 		}
@@ -5,7 +5,13 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 )
@@ -49,6 +55,102 @@ func HashDatum(pipelineName string, pipelineSalt string, inputs []*Input) string
 	return client.DatumTagPrefix(pipelineSalt) + hex.EncodeToString(hash.Sum(nil))
 }
 
+// MemoKey computes the key of a datum in the cluster-wide memoization cache,
+// from the fingerprint of the pipeline's transform and the datum's inputs.
+// Unlike HashDatum, it doesn't depend on the pipeline's name or salt, so that
+// different pipelines can share memoized datums.
+func MemoKey(fingerprint string, inputs []*Input) string {
+	hash := sha256.New()
+	hash.Write([]byte(fingerprint))
+	for _, input := range inputs {
+		hash.Write([]byte(input.Name))
+		hash.Write([]byte(input.FileInfo.File.Path))
+		hash.Write(input.FileInfo.Hash)
+		if input.EmptyFiles {
+			hash.Write([]byte("empty_files"))
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// RecordID computes the id of a datum's DatumRecord. Unlike DatumID, it
+// doesn't depend on the contents of the datum's inputs, only on their paths,
+// so that it identifies the same datum across input commits.
+func RecordID(inputs []*Input) string {
+	hash := sha256.New()
+	for _, input := range inputs {
+		hash.Write([]byte(input.Name))
+		hash.Write([]byte(input.FileInfo.File.Path))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// HasChanges returns true if any of 'inputs' has change lists enabled.
+func HasChanges(inputs []*Input) bool {
+	for _, input := range inputs {
+		if input.Changes {
+			return true
+		}
+	}
+	return false
+}
+
+// InputEnv returns the environment variables that tell the pipeline's code
+// where the datum with 'inputs' is, given that its inputs are laid out under
+// 'inputDir'. It's shared by the worker and the local runner, which lay datums
+// out the same way.
+func InputEnv(inputDir string, inputs []*Input) []string {
+	var result []string
+	var windowNames []string
+	windows := make(map[string][]*Input)
+	for _, input := range inputs {
+		if input.Subdir != "" {
+			// The commits of a window input share one directory
+			if _, ok := windows[input.Name]; !ok {
+				windowNames = append(windowNames, input.Name)
+			}
+			windows[input.Name] = append(windows[input.Name], input)
+			continue
+		}
+		result = append(result, fmt.Sprintf("%s=%s", input.Name, filepath.Join(inputDir, input.Name, input.FileInfo.File.Path)))
+		result = append(result, fmt.Sprintf("%s_COMMIT=%s", input.Name, input.FileInfo.File.Commit.ID))
+		if input.Changes {
+			result = append(result, fmt.Sprintf("%s_CHANGES=%s", input.Name, filepath.Join(inputDir, client.PPSChangesDir, input.Name)))
+		}
+	}
+	for _, name := range windowNames {
+		result = append(result, fmt.Sprintf("%s=%s", name, filepath.Join(inputDir, name)))
+		result = append(result, fmt.Sprintf("%s_COMMITS=%s", name, strings.Join(windowCommits(windows[name]), " ")))
+	}
+	if HasChanges(inputs) {
+		result = append(result, fmt.Sprintf("%s=%s", client.PreviousOutputEnv, filepath.Join(inputDir, client.PPSPreviousOutputDir)))
+	}
+	return result
+}
+
+// windowCommits returns the IDs of the commits of a window input's 'inputs',
+// newest first (like ListCommit), by when they were finished.
+func windowCommits(inputs []*Input) []string {
+	// Datums hold a window's commits oldest first, so reversing them first
+	// keeps commits that finished at the same time newest first too
+	sorted := make([]*Input, len(inputs))
+	for i, input := range inputs {
+		sorted[len(inputs)-1-i] = input
+	}
+	finished := func(input *Input) time.Time {
+		t, _ := types.TimestampFromProto(input.FileInfo.Committed)
+		return t
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return finished(sorted[i]).After(finished(sorted[j]))
+	})
+	commits := make([]string, len(sorted))
+	for i, input := range sorted {
+		commits[i] = input.FileInfo.File.Commit.ID
+	}
+	return commits
+}
+
 // MatchDatum checks if a datum matches a filter.  To match each string in
 // filter must correspond match at least 1 datum's Path or Hash. Order of
 // filter and inputs is irrelevant.
@@ -35,6 +35,9 @@ type Input struct {
 	GitURL               string        `protobuf:"bytes,6,opt,name=git_url,json=gitUrl,proto3" json:"git_url,omitempty"`
 	EmptyFiles           bool          `protobuf:"varint,7,opt,name=empty_files,json=emptyFiles,proto3" json:"empty_files,omitempty"`
 	S3                   bool          `protobuf:"varint,9,opt,name=s3,proto3" json:"s3,omitempty"`
+	Changes              bool          `protobuf:"varint,11,opt,name=changes,proto3" json:"changes,omitempty"`
+	Subdir               string        `protobuf:"bytes,12,opt,name=subdir,proto3" json:"subdir,omitempty"`
+	ObjectStore          bool          `protobuf:"varint,13,opt,name=object_store,json=objectStore,proto3" json:"object_store,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -143,36 +146,144 @@ func (m *Input) GetS3() bool {
 	return false
 }
 
+func (m *Input) GetChanges() bool {
+	if m != nil {
+		return m.Changes
+	}
+	return false
+}
+
+func (m *Input) GetSubdir() string {
+	if m != nil {
+		return m.Subdir
+	}
+	return ""
+}
+
+func (m *Input) GetObjectStore() bool {
+	if m != nil {
+		return m.ObjectStore
+	}
+	return false
+}
+
+// DatumRecord describes the last processing of a datum whose inputs have
+// change lists enabled.
+type DatumRecord struct {
+	// tag is the datum tag of the output of the processing
+	Tag    string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Inputs []*Input `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// output_commit is the output commit of the job that processed the datum.
+	// The record only describes a successful processing if that job succeeded.
+	OutputCommit *pfs.Commit `protobuf:"bytes,3,opt,name=output_commit,json=outputCommit,proto3" json:"output_commit,omitempty"`
+	// previous is the last record of the datum whose job succeeded, if this
+	// one's job hasn't (yet)
+	Previous             *DatumRecord `protobuf:"bytes,4,opt,name=previous,proto3" json:"previous,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *DatumRecord) Reset()         { *m = DatumRecord{} }
+func (m *DatumRecord) String() string { return proto.CompactTextString(m) }
+func (*DatumRecord) ProtoMessage()    {}
+func (*DatumRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91fb6c79ddd9db74, []int{1}
+}
+func (m *DatumRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatumRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatumRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DatumRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatumRecord.Merge(m, src)
+}
+func (m *DatumRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatumRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatumRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatumRecord proto.InternalMessageInfo
+
+func (m *DatumRecord) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *DatumRecord) GetInputs() []*Input {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *DatumRecord) GetOutputCommit() *pfs.Commit {
+	if m != nil {
+		return m.OutputCommit
+	}
+	return nil
+}
+
+func (m *DatumRecord) GetPrevious() *DatumRecord {
+	if m != nil {
+		return m.Previous
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Input)(nil), "common.Input")
+	proto.RegisterType((*DatumRecord)(nil), "common.DatumRecord")
 }
 
 func init() { proto.RegisterFile("server/worker/common/common.proto", fileDescriptor_91fb6c79ddd9db74) }
 
 var fileDescriptor_91fb6c79ddd9db74 = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x86, 0x95, 0xdc, 0x36, 0x49, 0x4f, 0x6f, 0xef, 0x60, 0x55, 0x17, 0xd3, 0xa1, 0x2d, 0xb0,
-	0x54, 0x0c, 0x0d, 0xa2, 0x03, 0x7b, 0x11, 0xa0, 0x4a, 0x48, 0x48, 0x91, 0xba, 0xb0, 0x44, 0x49,
-	0x70, 0x52, 0x43, 0x62, 0x47, 0xb6, 0x03, 0x0a, 0x2f, 0xc5, 0x6b, 0x30, 0xf2, 0x04, 0x08, 0xe5,
-	0x49, 0x90, 0xed, 0x0e, 0x0c, 0x0c, 0x96, 0xbf, 0xff, 0x3f, 0xc7, 0xbf, 0x75, 0x6c, 0x38, 0x92,
-	0x44, 0x3c, 0x13, 0x11, 0xbe, 0x70, 0xf1, 0x44, 0x44, 0x98, 0xf1, 0xaa, 0xe2, 0x6c, 0xbf, 0x2d,
-	0x6b, 0xc1, 0x15, 0x47, 0x9e, 0x55, 0x93, 0x71, 0x56, 0x52, 0xc2, 0x54, 0x58, 0xe7, 0x52, 0x2f,
-	0x5b, 0x9d, 0x8c, 0x0b, 0x5e, 0x70, 0x83, 0xa1, 0x26, 0xeb, 0x1e, 0xbf, 0xb9, 0xd0, 0xdf, 0xb0,
-	0xba, 0x51, 0xe8, 0x14, 0x06, 0x39, 0x2d, 0x49, 0x4c, 0x59, 0xce, 0xb1, 0x33, 0x77, 0x16, 0xc3,
-	0xf3, 0xd1, 0x52, 0x1f, 0xbf, 0xa6, 0x25, 0xd9, 0xb0, 0x9c, 0x47, 0x41, 0xbe, 0x27, 0x74, 0x06,
-	0xa3, 0x3a, 0x11, 0x84, 0xa9, 0x58, 0x5f, 0x49, 0x15, 0xee, 0x9b, 0xfe, 0xa1, 0xe9, 0xbf, 0x34,
-	0x56, 0xf4, 0xd7, 0x76, 0x58, 0x85, 0x10, 0xf4, 0x58, 0x52, 0x11, 0xec, 0xce, 0x9d, 0xc5, 0x20,
-	0x32, 0x8c, 0x0e, 0xc0, 0x7f, 0xe4, 0x94, 0xc5, 0x9c, 0xe1, 0xc0, 0xd8, 0x9e, 0x96, 0x77, 0x0c,
-	0x1d, 0x42, 0x50, 0x08, 0xde, 0xd4, 0x71, 0xda, 0x62, 0x30, 0x15, 0xdf, 0xe8, 0x75, 0xab, 0x73,
-	0xca, 0xe4, 0xb5, 0xc5, 0x7f, 0xe6, 0xce, 0x22, 0x88, 0x0c, 0xa3, 0xff, 0xe0, 0xa5, 0x22, 0x61,
-	0xd9, 0x0e, 0xf7, 0x6c, 0x8c, 0x55, 0xe8, 0x04, 0xfc, 0x82, 0xaa, 0xb8, 0x11, 0x25, 0xf6, 0x74,
-	0x61, 0x0d, 0xdd, 0xe7, 0xcc, 0xbb, 0xa1, 0x6a, 0x1b, 0xdd, 0x46, 0x5e, 0x41, 0xd5, 0x56, 0x94,
-	0x68, 0x06, 0x43, 0x52, 0xd5, 0xaa, 0x8d, 0xf5, 0x70, 0x12, 0xfb, 0x26, 0x17, 0x8c, 0xa5, 0x07,
-	0x97, 0xe8, 0x1f, 0xb8, 0x72, 0x85, 0x07, 0xc6, 0x77, 0xe5, 0x6a, 0x7d, 0xf5, 0xde, 0x4d, 0x9d,
-	0x8f, 0x6e, 0xea, 0x7c, 0x75, 0x53, 0xe7, 0xfe, 0xa2, 0xa0, 0x6a, 0xd7, 0xa4, 0xcb, 0x8c, 0x57,
-	0x61, 0x9d, 0x64, 0xbb, 0xf6, 0x81, 0x88, 0x9f, 0x24, 0x45, 0x16, 0xfe, 0xf6, 0x73, 0xa9, 0x67,
-	0xde, 0x7f, 0xf5, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x9e, 0xf6, 0x08, 0xd8, 0x01, 0x00, 0x00,
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x95, 0x93, 0xd6, 0x76, 0xc6, 0x09, 0x42, 0x4b, 0x05, 0x4b, 0x0f, 0x69, 0x5a, 0x84, 0x14,
+	0x71, 0xa8, 0x51, 0x73, 0xe0, 0x1e, 0xbe, 0x54, 0x09, 0x09, 0x69, 0x51, 0x2f, 0x5c, 0x2c, 0xdb,
+	0x59, 0x3b, 0x5b, 0xec, 0x5d, 0x6b, 0x77, 0x5d, 0x64, 0xce, 0xfc, 0x19, 0xfe, 0x09, 0x47, 0x7e,
+	0x01, 0x42, 0xf9, 0x25, 0x68, 0x67, 0xd3, 0xaa, 0x87, 0x1e, 0x2c, 0xbf, 0xf7, 0xe6, 0xcd, 0x78,
+	0xd6, 0x6f, 0xe1, 0xd4, 0x70, 0x7d, 0xc3, 0x75, 0xfa, 0x5d, 0xe9, 0x6f, 0x5c, 0xa7, 0xa5, 0x6a,
+	0x5b, 0x25, 0xf7, 0xaf, 0xf3, 0x4e, 0x2b, 0xab, 0x48, 0xe8, 0xd9, 0xf1, 0x51, 0xd9, 0x08, 0x2e,
+	0x6d, 0xda, 0x55, 0xc6, 0x3d, 0xbe, 0x7a, 0x7c, 0x54, 0xab, 0x5a, 0x21, 0x4c, 0x1d, 0xf2, 0xea,
+	0xd9, 0xcf, 0x31, 0x1c, 0x5e, 0xca, 0xae, 0xb7, 0xe4, 0x15, 0x4c, 0x2a, 0xd1, 0xf0, 0x4c, 0xc8,
+	0x4a, 0xd1, 0x60, 0x11, 0x2c, 0x93, 0x8b, 0xd9, 0xb9, 0x6b, 0xff, 0x20, 0x1a, 0x7e, 0x29, 0x2b,
+	0xc5, 0xe2, 0x6a, 0x8f, 0xc8, 0x6b, 0x98, 0x75, 0xb9, 0xe6, 0xd2, 0x66, 0xee, 0x93, 0xc2, 0xd2,
+	0x43, 0xf4, 0x27, 0xe8, 0x7f, 0x8b, 0x12, 0x9b, 0x7a, 0x87, 0x67, 0x84, 0xc0, 0x81, 0xcc, 0x5b,
+	0x4e, 0x47, 0x8b, 0x60, 0x39, 0x61, 0x88, 0xc9, 0x33, 0x88, 0xae, 0x95, 0x90, 0x99, 0x92, 0x34,
+	0x46, 0x39, 0x74, 0xf4, 0xb3, 0x24, 0xcf, 0x21, 0xae, 0xb5, 0xea, 0xbb, 0xac, 0x18, 0x28, 0x60,
+	0x25, 0x42, 0xbe, 0x1e, 0xdc, 0x9c, 0x26, 0xff, 0x31, 0xd0, 0xf1, 0x22, 0x58, 0xc6, 0x0c, 0x31,
+	0x79, 0x0a, 0x61, 0xa1, 0x73, 0x59, 0x6e, 0xe9, 0x81, 0x1f, 0xe3, 0x19, 0x79, 0x01, 0x51, 0x2d,
+	0x6c, 0xd6, 0xeb, 0x86, 0x86, 0xae, 0xb0, 0x86, 0xdd, 0xdf, 0x93, 0xf0, 0xa3, 0xb0, 0x57, 0xec,
+	0x13, 0x0b, 0x6b, 0x61, 0xaf, 0x74, 0x43, 0x4e, 0x20, 0xe1, 0x6d, 0x67, 0x87, 0xcc, 0x1d, 0xce,
+	0xd0, 0x08, 0xe7, 0x02, 0x4a, 0xee, 0xe0, 0x86, 0x3c, 0x82, 0x91, 0x59, 0xd1, 0x09, 0xea, 0x23,
+	0xb3, 0x22, 0x14, 0xa2, 0x72, 0x9b, 0xcb, 0x9a, 0x1b, 0x9a, 0xa0, 0x78, 0x4b, 0xdd, 0x1e, 0xa6,
+	0x2f, 0x36, 0x42, 0xd3, 0xa9, 0xdf, 0xc3, 0x33, 0x72, 0x0a, 0x53, 0x55, 0x5c, 0xf3, 0xd2, 0x66,
+	0xc6, 0x2a, 0xcd, 0xe9, 0x0c, 0xdb, 0x12, 0xaf, 0x7d, 0x71, 0xd2, 0xd9, 0xaf, 0x00, 0x92, 0x77,
+	0xb9, 0xed, 0x5b, 0xc6, 0x4b, 0xa5, 0x37, 0xe4, 0x31, 0x8c, 0x6d, 0x5e, 0x63, 0x0c, 0x13, 0xe6,
+	0x20, 0x79, 0x09, 0xa1, 0x70, 0x39, 0x19, 0x3a, 0x5a, 0x8c, 0x31, 0x9b, 0x7d, 0xf6, 0x98, 0x1e,
+	0xdb, 0x17, 0x5d, 0x32, 0xaa, 0xb7, 0x5d, 0x7f, 0x97, 0xcc, 0xf8, 0x81, 0x64, 0xbc, 0xc3, 0x33,
+	0x92, 0x42, 0xdc, 0x69, 0x7e, 0x23, 0x54, 0x6f, 0xf0, 0xff, 0x25, 0x17, 0x4f, 0x6e, 0x47, 0xdf,
+	0xdb, 0x88, 0xdd, 0x99, 0xd6, 0xef, 0x7f, 0xef, 0xe6, 0xc1, 0x9f, 0xdd, 0x3c, 0xf8, 0xb7, 0x9b,
+	0x07, 0x5f, 0xdf, 0xd4, 0xc2, 0x6e, 0xfb, 0xc2, 0xb5, 0xa4, 0x5d, 0x5e, 0x6e, 0x87, 0x0d, 0xd7,
+	0xf7, 0x91, 0xd1, 0x65, 0xfa, 0xd0, 0xd5, 0x2d, 0x42, 0xbc, 0x80, 0xab, 0xff, 0x03, 0x00, 0x64,
+	0x7e, 0xfb, 0xef, 0xd9, 0x02, 0x00, 0x00,
 }
 
 func (m *Input) Marshal() (dAtA []byte, err error) {
@@ -199,6 +310,33 @@ func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ObjectStore {
+		i--
+		if m.ObjectStore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Subdir) > 0 {
+		i -= len(m.Subdir)
+		copy(dAtA[i:], m.Subdir)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Subdir)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Changes {
+		i--
+		if m.Changes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.GroupBy) > 0 {
 		i -= len(m.GroupBy)
 		copy(dAtA[i:], m.GroupBy)
@@ -291,6 +429,78 @@ func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DatumRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatumRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Previous != nil {
+		{
+			size, err := m.Previous.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OutputCommit != nil {
+		{
+			size, err := m.OutputCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCommon(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommon(v)
 	base := offset
@@ -345,6 +555,46 @@ func (m *Input) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommon(uint64(l))
 	}
+	if m.Changes {
+		n += 2
+	}
+	l = len(m.Subdir)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.ObjectStore {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DatumRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovCommon(uint64(l))
+		}
+	}
+	if m.OutputCommit != nil {
+		l = m.OutputCommit.Size()
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.Previous != nil {
+		l = m.Previous.Size()
+		n += 1 + l + sovCommon(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -678,6 +928,270 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			}
 			m.GroupBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Changes = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subdir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectStore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ObjectStore = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &Input{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
+			}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Previous == nil {
+				m.Previous = &DatumRecord{}
+			}
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
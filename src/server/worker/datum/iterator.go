@@ -3,6 +3,10 @@ package datum
 import (
 	"io"
 	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
 
 	glob "github.com/pachyderm/ohmyglob"
 
@@ -10,6 +14,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/path"
 	"github.com/pachyderm/pachyderm/src/server/worker/common"
 
@@ -61,6 +66,15 @@ func newPFSIterator(pachClient *client.APIClient, input *pps.PFSInput) (Iterator
 			return nil, err
 		}
 		joinOn := g.Replace(fileInfo.File.Path, input.JoinOn)
+		if len(input.JoinKeys) > 0 {
+			// Separate the parts of the key with a byte that can't be in a path,
+			// so that different parts can't run together
+			var parts []string
+			for _, key := range input.JoinKeys {
+				parts = append(parts, g.Replace(fileInfo.File.Path, key))
+			}
+			joinOn = strings.Join(parts, "\x00")
+		}
 		groupBy := g.Replace(fileInfo.File.Path, input.GroupBy)
 		result.inputs = append(result.inputs, &common.Input{
 			FileInfo:   fileInfo,
@@ -71,6 +85,7 @@ func newPFSIterator(pachClient *client.APIClient, input *pps.PFSInput) (Iterator
 			Branch:     input.Branch,
 			EmptyFiles: input.EmptyFiles,
 			S3:         input.S3,
+			Changes:    input.Changes,
 		})
 	}
 	// We sort the inputs so that the order is deterministic. Note that it's
@@ -417,21 +432,35 @@ func newJoinIterator(pachClient *client.APIClient, join []*pps.Input) (Iterator,
 	iter := om.IterFunc()
 	for kv, ok := iter(); ok; kv, ok = iter() {
 		tuple := kv.Value.([][]*common.Input)
-		missing := false
-		var filteredTuple [][]*common.Input
+		missing, excluded := false, false
+		var mounted, filteredTuple [][]*common.Input
 		for i, inputs := range tuple {
+			switch joinMode(join[i]) {
+			case pps.JoinMode_JOIN_SEMI:
+				// Semi-joined inputs only filter the datums
+				excluded = excluded || len(inputs) == 0
+				continue
+			case pps.JoinMode_JOIN_ANTI:
+				excluded = excluded || len(inputs) > 0
+				continue
+			case pps.JoinMode_JOIN_OUTER:
+				if len(inputs) > 0 {
+					filteredTuple = append(filteredTuple, inputs)
+				}
+			}
 			if len(inputs) == 0 {
 				missing = true
 				continue
 			}
-			if join[i].Pfs != nil && join[i].Pfs.OuterJoin {
-				filteredTuple = append(filteredTuple, inputs)
-			}
+			mounted = append(mounted, inputs)
+		}
+		if excluded {
+			continue
 		}
 		if missing {
-			tuple = filteredTuple
+			mounted = filteredTuple
 		}
-		cross, err := newCrossListIterator(pachClient, tuple)
+		cross, err := newCrossListIterator(pachClient, mounted)
 		if err != nil {
 			return nil, err
 		}
@@ -443,6 +472,17 @@ func newJoinIterator(pachClient *client.APIClient, join []*pps.Input) (Iterator,
 	return result, nil
 }
 
+// joinMode returns the mode in which 'input' takes part in a join
+func joinMode(input *pps.Input) pps.JoinMode {
+	switch {
+	case input.Pfs == nil:
+		return pps.JoinMode_JOIN_INNER
+	case input.Pfs.OuterJoin:
+		return pps.JoinMode_JOIN_OUTER
+	}
+	return input.Pfs.JoinMode
+}
+
 func (d *joinIterator) Reset() {
 	d.location = -1
 }
@@ -528,6 +568,102 @@ func (d *gitIterator) DatumN(n int) []*common.Input {
 	return d.Datum()
 }
 
+type windowIterator struct {
+	inputs   []*common.Input
+	location int
+}
+
+// newWindowIterator creates an iterator with a single datum, made of the
+// commits in the window that ends at 'input.Commit' (oldest first).
+func newWindowIterator(pachClient *client.APIClient, input *pps.WindowInput) (Iterator, error) {
+	result := &windowIterator{}
+	defer result.Reset()
+	if input.Commit == "" {
+		// this can happen if a pipeline with multiple inputs has been triggered
+		// before all commits have inputs
+		return result, nil
+	}
+	var number uint64
+	if input.Commits > 0 {
+		number = uint64(input.Commits)
+	}
+	var oldest time.Time
+	if input.Duration != nil {
+		duration, err := types.DurationFromProto(input.Duration)
+		if err != nil {
+			return nil, err
+		}
+		newest, err := pachClient.InspectCommit(input.Repo, input.Commit)
+		if err != nil {
+			return nil, err
+		}
+		started, err := types.TimestampFromProto(newest.Started)
+		if err != nil {
+			return nil, err
+		}
+		oldest = started.Add(-duration)
+	}
+	var inputs []*common.Input
+	if err := pachClient.ListCommitF(input.Repo, input.Commit, "", number, false, func(ci *pfs.CommitInfo) error {
+		if !oldest.IsZero() {
+			started, err := types.TimestampFromProto(ci.Started)
+			if err != nil {
+				return err
+			}
+			if started.Before(oldest) {
+				return errutil.ErrBreak
+			}
+		}
+		fileInfo, err := pachClient.InspectFile(input.Repo, ci.Commit.ID, "/")
+		if err != nil {
+			return err
+		}
+		inputs = append(inputs, &common.Input{
+			FileInfo: fileInfo,
+			Name:     input.Name,
+			Lazy:     input.Lazy,
+			Branch:   input.Branch,
+			Subdir:   ci.Commit.ID,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	// ListCommit returns the newest commit first
+	for i, j := 0, len(inputs)-1; i < j; i, j = i+1, j-1 {
+		inputs[i], inputs[j] = inputs[j], inputs[i]
+	}
+	result.inputs = inputs
+	return result, nil
+}
+
+func (d *windowIterator) Reset() {
+	d.location = -1
+}
+
+func (d *windowIterator) Len() int {
+	if len(d.inputs) == 0 {
+		return 0
+	}
+	return 1
+}
+
+func (d *windowIterator) Datum() []*common.Input {
+	return d.inputs
+}
+
+func (d *windowIterator) Next() bool {
+	if d.location < d.Len() {
+		d.location++
+	}
+	return d.location < d.Len()
+}
+
+func (d *windowIterator) DatumN(n int) []*common.Input {
+	d.location = n
+	return d.Datum()
+}
+
 func newCronIterator(pachClient *client.APIClient, input *pps.CronInput) (Iterator, error) {
 	return newPFSIterator(pachClient, &pps.PFSInput{
 		Name:   input.Name,
@@ -538,6 +674,24 @@ func newCronIterator(pachClient *client.APIClient, input *pps.CronInput) (Iterat
 	})
 }
 
+func newObjectStoreIterator(pachClient *client.APIClient, input *pps.ObjectStoreInput) (Iterator, error) {
+	result, err := newPFSIterator(pachClient, &pps.PFSInput{
+		Name:   input.Name,
+		Repo:   input.Repo,
+		Branch: "master",
+		Commit: input.Commit,
+		Glob:   input.Glob,
+		Lazy:   input.Lazy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, input := range result.(*pfsIterator).inputs {
+		input.ObjectStore = true
+	}
+	return result, nil
+}
+
 // NewIterator creates an Iterator for an input.
 func NewIterator(pachClient *client.APIClient, input *pps.Input) (Iterator, error) {
 	switch {
@@ -555,6 +709,10 @@ func NewIterator(pachClient *client.APIClient, input *pps.Input) (Iterator, erro
 		return newCronIterator(pachClient, input.Cron)
 	case input.Git != nil:
 		return newGitIterator(pachClient, input.Git)
+	case input.Window != nil:
+		return newWindowIterator(pachClient, input.Window)
+	case input.ObjectStore != nil:
+		return newObjectStoreIterator(pachClient, input.ObjectStore)
 	}
 	return nil, errors.Errorf("unrecognized input type: %v", input)
 }
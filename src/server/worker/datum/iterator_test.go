@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
@@ -408,6 +409,115 @@ func TestJoinTrailingSlash(t *testing.T) {
 	}))
 }
 
+func TestJoinModes(t *testing.T) {
+	require.NoError(t, testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		repo := []string{
+			tu.UniqueString(t.Name() + "_0"),
+			tu.UniqueString(t.Name() + "_1"),
+		}
+		files := [][]string{
+			{"a-1", "a-2", "a-3", "1_x", "2_y"},
+			{"b-2", "b-3", "b-4", "x.1", "y.2", "x.2"},
+		}
+		var commits []string
+		for i := range repo {
+			require.NoError(t, c.CreateRepo(repo[i]))
+			commit, err := c.StartCommit(repo[i], "master")
+			require.NoError(t, err)
+			for _, file := range files[i] {
+				_, err = c.PutFile(repo[i], commit.ID, file, strings.NewReader("foo"))
+				require.NoError(t, err)
+			}
+			require.NoError(t, c.FinishCommit(repo[i], commit.ID))
+			commits = append(commits, commit.ID)
+		}
+		input := func(i int, glob string, mode pps.JoinMode) *pps.Input {
+			in := client.NewPFSInputOpts("", repo[i], "", glob, "$1", "", false, false, nil)
+			in.Pfs.Commit = commits[i]
+			in.Pfs.JoinMode = mode
+			return in
+		}
+
+		// Files in the first repo with no match in the second
+		itr, err := NewIterator(c, client.NewJoinInput(
+			input(0, "/*-(*)", pps.JoinMode_JOIN_INNER),
+			input(1, "/*-(*)", pps.JoinMode_JOIN_ANTI),
+		))
+		require.NoError(t, err)
+		validateDI(t, itr, "/a-1")
+
+		// Files in the first repo with a match in the second, which isn't
+		// part of the datums
+		itr, err = NewIterator(c, client.NewJoinInput(
+			input(0, "/*-(*)", pps.JoinMode_JOIN_INNER),
+			input(1, "/*-(*)", pps.JoinMode_JOIN_SEMI),
+		))
+		require.NoError(t, err)
+		validateDI(t, itr, "/a-2", "/a-3")
+
+		// Composite keys, with the capture groups in different orders
+		in0, in1 := input(0, "/(*)_(*)", pps.JoinMode_JOIN_INNER), input(1, "/(*).(*)", pps.JoinMode_JOIN_INNER)
+		in0.Pfs.JoinOn, in1.Pfs.JoinOn = "", ""
+		in0.Pfs.JoinKeys = []string{"$1", "$2"}
+		in1.Pfs.JoinKeys = []string{"$2", "$1"}
+		itr, err = NewIterator(c, client.NewJoinInput(in0, in1))
+		require.NoError(t, err)
+		validateDI(t, itr, "/1_x/x.1", "/2_y/y.2")
+		return nil
+	}))
+}
+
+func TestWindowIterator(t *testing.T) {
+	require.NoError(t, testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		dataRepo := tu.UniqueString(t.Name() + "_data")
+		require.NoError(t, c.CreateRepo(dataRepo))
+
+		var commits []string
+		for i := 0; i < 4; i++ {
+			commit, err := c.StartCommit(dataRepo, "master")
+			require.NoError(t, err)
+			_, err = c.PutFile(dataRepo, commit.ID, fmt.Sprintf("day%v", i), strings.NewReader("bar"))
+			require.NoError(t, err)
+			require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+			commits = append(commits, commit.ID)
+		}
+
+		// The window holds the last 3 commits, oldest first, in one datum
+		in := client.NewWindowInput(dataRepo, 3)
+		in.Window.Name = dataRepo
+		in.Window.Commit = commits[3]
+		itr, err := NewIterator(c, in)
+		require.NoError(t, err)
+		require.Equal(t, 1, itr.Len())
+		require.True(t, itr.Next())
+		var subdirs []string
+		for _, input := range itr.Datum() {
+			require.Equal(t, "/", input.FileInfo.File.Path)
+			require.Equal(t, input.Subdir, input.FileInfo.File.Commit.ID)
+			subdirs = append(subdirs, input.Subdir)
+		}
+		require.Equal(t, commits[1:], subdirs)
+		require.False(t, itr.Next())
+
+		// A window ending at the first commit only holds that commit
+		in.Window.Commit = commits[0]
+		itr, err = NewIterator(c, in)
+		require.NoError(t, err)
+		require.Equal(t, 1, itr.Len())
+		require.Equal(t, 1, len(itr.DatumN(0)))
+
+		// A duration window holds every commit started within the duration
+		in = client.NewWindowInputOpts(dataRepo, dataRepo, "master", 0, time.Hour, false)
+		in.Window.Commit = commits[3]
+		itr, err = NewIterator(c, in)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(itr.DatumN(0)))
+		return nil
+	}))
+}
+
 func validateDI(t testing.TB, dit Iterator, datums ...string) {
 	t.Helper()
 	i := 0
@@ -0,0 +1,233 @@
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+)
+
+// batchDatum is a datum that's been handed to the user process of a pipeline
+// with datum batching enabled. It's what the process receives from
+// GET /datum.
+type batchDatum struct {
+	ID string `json:"id"`
+	// Env holds the environment variables that the pipeline's code would
+	// have been run with if datum batching were disabled, other than those
+	// that the user process already has.
+	Env map[string]string `json:"env"`
+
+	result chan error
+}
+
+// datumBatcher runs the long-running user process of a pipeline with datum
+// batching enabled, and serves the process datums over HTTP:
+//
+//	GET  /datum              blocks until a datum is ready, and returns it (or
+//	                         returns the datum it last returned again, if that
+//	                         hasn't been reported on yet)
+//	POST /datum/<id>/done    reports that the datum was processed successfully
+//	POST /datum/<id>/failed  reports that the datum failed; the request body
+//	                         is used as the error message
+//
+// When the process fetches a datum, its data is already in /pfs, as it would
+// be for a process started for that datum alone. It's shared by all copies of
+// a driver.
+type datumBatcher struct {
+	mu     sync.Mutex
+	url    string        // the URL that the user process talks to, once started
+	exited chan struct{} // closed when the current user process exits
+	cmd    *exec.Cmd
+	logger logs.TaggedLogger // the logger of the datum being processed, if any
+
+	datums  chan *batchDatum
+	pending map[string]*batchDatum // datums fetched but not yet acknowledged
+}
+
+func newDatumBatcher() *datumBatcher {
+	return &datumBatcher{
+		datums:  make(chan *batchDatum),
+		pending: make(map[string]*batchDatum),
+	}
+}
+
+// Write sends the user process's output to the logger of the datum it's
+// processing, so that it shows up in that datum's logs.
+func (b *datumBatcher) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	logger := b.logger
+	b.mu.Unlock()
+	if logger == nil {
+		return len(p), nil
+	}
+	return logger.WithUserCode().Write(p)
+}
+
+func (b *datumBatcher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == http.MethodGet && len(parts) == 1 && parts[0] == "datum":
+		// The process is fed datums one at a time, so if it asks for a datum
+		// while one is pending, it never got the response that carried that
+		// one (e.g. the connection dropped), which is handed out again rather
+		// than waited on forever
+		var datum *batchDatum
+		b.mu.Lock()
+		for _, pending := range b.pending {
+			datum = pending
+		}
+		b.mu.Unlock()
+		if datum == nil {
+			select {
+			case datum = <-b.datums:
+				b.mu.Lock()
+				b.pending[datum.ID] = datum
+				b.mu.Unlock()
+			case <-r.Context().Done():
+				return
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(datum)
+	case r.Method == http.MethodPost && len(parts) == 3 && parts[0] == "datum" &&
+		(parts[2] == "done" || parts[2] == "failed"):
+		b.mu.Lock()
+		datum, ok := b.pending[parts[1]]
+		delete(b.pending, parts[1])
+		b.mu.Unlock()
+		if !ok {
+			http.Error(w, fmt.Sprintf("datum %q isn't being processed", parts[1]), http.StatusNotFound)
+			return
+		}
+		if parts[2] == "done" {
+			datum.result <- nil
+			return
+		}
+		msg, err := ioutil.ReadAll(r.Body)
+		if err != nil || len(msg) == 0 {
+			msg = []byte("user code reported failure")
+		}
+		datum.result <- errors.New(string(msg))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// start starts the user process, and the HTTP server that it talks to if it
+// isn't running yet. It returns a channel that's closed when the process
+// exits. b.mu must be held.
+func (b *datumBatcher) start(d *driver) (chan struct{}, error) {
+	if b.cmd != nil {
+		return b.exited, nil
+	}
+	if b.url == "" {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		go http.Serve(listener, b)
+		b.url = "http://" + listener.Addr().String()
+	}
+	// The process outlives the datums (and jobs) that start it, so it isn't
+	// bound to any of their contexts
+	cmd := exec.Command(d.pipelineInfo.Transform.Cmd[0], d.pipelineInfo.Transform.Cmd[1:]...)
+	if d.pipelineInfo.Transform.Stdin != nil {
+		cmd.Stdin = strings.NewReader(strings.Join(d.pipelineInfo.Transform.Stdin, "\n") + "\n")
+	}
+	cmd.Stdout = b
+	cmd.Stderr = b
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", client.DatumBatchURLEnv, b.url))
+	if d.uid != nil && d.gid != nil {
+		cmd.SysProcAttr = makeCmdCredentials(*d.uid, *d.gid)
+	}
+	cmd.Dir = filepath.Join(d.rootDir, d.pipelineInfo.Transform.WorkingDir)
+	if err := cmd.Start(); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	exited := make(chan struct{})
+	b.cmd, b.exited = cmd, exited
+	go func() {
+		err := cmd.Wait()
+		logs.NewStatlessLogger(d.pipelineInfo).Logf("batched user code exited: %v", err)
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		// Datums that the process fetched can't be acknowledged anymore
+		b.pending = make(map[string]*batchDatum)
+		b.cmd = nil
+		close(exited)
+	}()
+	return exited, nil
+}
+
+// runBatchedDatum hands the active datum, whose user code environment is
+// 'environ', to the long-running user process (starting it if necessary), and
+// waits for the process to acknowledge it. If 'ctx' is done first, the
+// process is killed, as a process started for the datum alone would be.
+func (d *driver) runBatchedDatum(ctx context.Context, logger logs.TaggedLogger, environ []string) error {
+	b := d.datumBatcher
+	b.mu.Lock()
+	exited, err := b.start(d)
+	if err != nil {
+		b.mu.Unlock()
+		return err
+	}
+	cmd := b.cmd
+	b.logger = logger
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		b.logger = nil
+		b.mu.Unlock()
+	}()
+
+	base := make(map[string]bool)
+	for _, kv := range os.Environ() {
+		base[kv] = true
+	}
+	datum := &batchDatum{
+		ID:     uuid.NewWithoutDashes(),
+		Env:    make(map[string]string),
+		result: make(chan error, 1),
+	}
+	for _, kv := range environ {
+		if parts := strings.SplitN(kv, "=", 2); len(parts) == 2 && !base[kv] {
+			datum.Env[parts[0]] = parts[1]
+		}
+	}
+	kill := func() {
+		if err := cmd.Process.Kill(); err != nil {
+			logger.Logf("could not kill batched user code: %v", err)
+		}
+		<-exited
+	}
+
+	select {
+	case b.datums <- datum:
+	case <-exited:
+		return errors.New("batched user code exited before fetching the datum")
+	case <-ctx.Done():
+		kill()
+		return errors.EnsureStack(ctx.Err())
+	}
+	select {
+	case err := <-datum.result:
+		return err
+	case <-exited:
+		return errors.New("batched user code exited while processing the datum")
+	case <-ctx.Done():
+		kill()
+		return errors.EnsureStack(ctx.Err())
+	}
+}
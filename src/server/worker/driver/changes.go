@@ -0,0 +1,327 @@
+package driver
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+)
+
+// changeLists holds the paths in an input that changed since a datum was last
+// processed successfully.
+type changeLists struct {
+	added, modified, deleted []string
+}
+
+// changesDirs returns the directories, in the scratch space of a datum with
+// 'inputs', that WithActiveData needs to move into the input directory in
+// addition to the inputs themselves.
+func changesDirs(inputs []*common.Input) []string {
+	if !common.HasChanges(inputs) {
+		return nil
+	}
+	return []string{client.PPSChangesDir, client.PPSPreviousOutputDir}
+}
+
+// recordPath returns the path, in object storage, of the DatumRecord of the
+// datum with 'inputs'.
+func (d *driver) recordPath(inputs []*common.Input) string {
+	return ppsutil.DatumRecordSaltPrefix(d.pipelineInfo.Pipeline.Name, d.pipelineInfo.Salt) + common.RecordID(inputs)
+}
+
+// readRecord returns the DatumRecord of the datum with 'inputs', or nil if the
+// datum hasn't been processed yet.
+func (d *driver) readRecord(logger logs.TaggedLogger, inputs []*common.Input) (_ *common.DatumRecord, retErr error) {
+	reader, err := d.pachClient.DirectObjReader(d.recordPath(inputs))
+	if err != nil {
+		if pfsserver.IsDirectObjNotFoundErr(err) {
+			logger.Logf("no record of the datum's previous processing")
+			return nil, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := reader.Close(); err != nil && retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		if pfsserver.IsDirectObjNotFoundErr(err) {
+			logger.Logf("no record of the datum's previous processing")
+			return nil, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	record := &common.DatumRecord{}
+	if err := record.Unmarshal(data); err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return record, nil
+}
+
+// lastSuccessfulRecord returns the first of 'record' and its previous record
+// whose job succeeded, or nil if neither did.
+func (d *driver) lastSuccessfulRecord(record *common.DatumRecord) (*common.DatumRecord, error) {
+	for ; record != nil; record = record.Previous {
+		if record.OutputCommit == nil {
+			continue
+		}
+		jobInfo, err := d.pachClient.InspectJobOutputCommit(record.OutputCommit.Repo.Name, record.OutputCommit.ID, false)
+		if err != nil {
+			// The job's output commit has been deleted, along with its output
+			if pfsserver.IsCommitNotFoundErr(err) || pfsserver.IsCommitDeletedErr(err) {
+				continue
+			}
+			return nil, errors.EnsureStack(err)
+		}
+		if jobInfo.State == pps.JobState_JOB_SUCCESS {
+			return record, nil
+		}
+	}
+	return nil, nil
+}
+
+// WriteRecord records that the datum with 'inputs' was processed by the job
+// with 'outputCommit', with the output tagged with 'tag', if any of 'inputs'
+// has change lists enabled. As that job may yet fail, the record keeps the
+// datum's last record whose job succeeded, which is used in its place if so.
+// Jobs run with 'parameters' don't record their datums, so that their output
+// isn't the baseline of the pipeline's next regular job.
+func (d *driver) WriteRecord(outputCommit *pfs.Commit, inputs []*common.Input, parameters *pps.RunParameters, tag string) (retErr error) {
+	if !common.HasChanges(inputs) || parameters != nil {
+		return nil
+	}
+	previous, err := d.readRecord(logs.NewStatlessLogger(d.pipelineInfo), inputs)
+	if err != nil {
+		return err
+	}
+	// A datum that's processed again by the same job replaces its record
+	if previous != nil && previous.OutputCommit != nil && previous.OutputCommit.ID == outputCommit.ID {
+		previous = previous.Previous
+	}
+	previous, err = d.lastSuccessfulRecord(previous)
+	if err != nil {
+		return err
+	}
+	if previous != nil {
+		previous.Previous = nil
+	}
+	data, err := (&common.DatumRecord{
+		Tag:          tag,
+		Inputs:       inputs,
+		OutputCommit: outputCommit,
+		Previous:     previous,
+	}).Marshal()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	writer, err := d.pachClient.DirectObjWriter(d.recordPath(inputs))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := writer.Close(); err != nil && retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	_, err = writer.Write(data)
+	return errors.EnsureStack(err)
+}
+
+// downloadChanges writes the change list of each input in 'inputs' that has
+// change lists enabled to <scratchPath>/.changes/<input>/{added,modified,deleted},
+// and the output of the datum's previous successful processing to
+// <scratchPath>/.previous. If the datum hasn't been processed before, or its
+// job was run with 'parameters', all of its files are added and the previous
+// output is empty.
+func (d *driver) downloadChanges(logger logs.TaggedLogger, scratchPath string, inputs []*common.Input, parameters *pps.RunParameters) error {
+	if !common.HasChanges(inputs) {
+		return nil
+	}
+	var record *common.DatumRecord
+	if parameters == nil {
+		var err error
+		record, err = d.readRecord(logger, inputs)
+		if err != nil {
+			return err
+		}
+		// Only the processing of a job that succeeded counts
+		record, err = d.lastSuccessfulRecord(record)
+		if err != nil {
+			return err
+		}
+	}
+	previous := make(map[string]*common.Input)
+	if record != nil {
+		for _, input := range record.Inputs {
+			previous[input.Name+":"+input.FileInfo.File.Path] = input
+		}
+	}
+
+	// Inputs in a group may share a name, in which case their changes go in
+	// the same lists
+	changes := make(map[string]*changeLists)
+	for _, input := range inputs {
+		if !input.Changes {
+			continue
+		}
+		lists, ok := changes[input.Name]
+		if !ok {
+			lists = &changeLists{}
+			changes[input.Name] = lists
+		}
+		file := input.FileInfo.File
+		prev, ok := previous[input.Name+":"+file.Path]
+		if !ok {
+			if err := d.pachClient.Walk(file.Commit.Repo.Name, file.Commit.ID, file.Path, func(fi *pfs.FileInfo) error {
+				if fi.FileType != pfs.FileType_DIR {
+					lists.added = append(lists.added, fi.File.Path)
+				}
+				return nil
+			}); err != nil {
+				return errors.EnsureStack(err)
+			}
+			continue
+		}
+		prevFile := prev.FileInfo.File
+		newFiles, oldFiles, err := d.pachClient.DiffFile(
+			file.Commit.Repo.Name, file.Commit.ID, file.Path,
+			prevFile.Commit.Repo.Name, prevFile.Commit.ID, prevFile.Path,
+			false,
+		)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		old := make(map[string]bool)
+		for _, fi := range oldFiles {
+			if fi.FileType != pfs.FileType_DIR {
+				old[fi.File.Path] = true
+			}
+		}
+		for _, fi := range newFiles {
+			if fi.FileType == pfs.FileType_DIR {
+				continue
+			}
+			if old[fi.File.Path] {
+				lists.modified = append(lists.modified, fi.File.Path)
+				delete(old, fi.File.Path)
+			} else {
+				lists.added = append(lists.added, fi.File.Path)
+			}
+		}
+		for p := range old {
+			lists.deleted = append(lists.deleted, p)
+		}
+	}
+	for name, lists := range changes {
+		dir := filepath.Join(scratchPath, client.PPSChangesDir, name)
+		if err := os.MkdirAll(dir, 0777); err != nil {
+			return errors.EnsureStack(err)
+		}
+		for _, list := range []struct {
+			name  string
+			paths []string
+		}{
+			{"added", lists.added},
+			{"modified", lists.modified},
+			{"deleted", lists.deleted},
+		} {
+			if err := writeChangeList(filepath.Join(dir, list.name), list.paths); err != nil {
+				return err
+			}
+		}
+	}
+
+	previousPath := filepath.Join(scratchPath, client.PPSPreviousOutputDir)
+	if err := os.MkdirAll(previousPath, 0777); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if record == nil || record.Tag == "" {
+		return nil
+	}
+	return d.downloadPreviousOutput(previousPath, record.Tag)
+}
+
+// writeChangeList writes 'paths' to 'name', sorted and one per line
+func writeChangeList(name string, paths []string) error {
+	sort.Strings(paths)
+	var data []byte
+	if len(paths) > 0 {
+		data = []byte(strings.Join(paths, "\n") + "\n")
+	}
+	return errors.EnsureStack(ioutil.WriteFile(name, data, 0666))
+}
+
+// downloadPreviousOutput writes the files in the datum hashtree tagged with
+// 'tag' to 'dir'.
+func (d *driver) downloadPreviousOutput(dir string, tag string) error {
+	buf := &bytes.Buffer{}
+	if err := d.pachClient.GetTag(tag, buf); err != nil {
+		// The datum was processed, but its output has since been deleted
+		// (e.g. if the pipeline's outputs were reset)
+		return nil
+	}
+	return hashtree.Walk([]io.ReadCloser{ioutil.NopCloser(buf)}, "/", func(path string, node *hashtree.NodeProto) error {
+		target := filepath.Join(dir, path)
+		if node.DirNode != nil {
+			return errors.EnsureStack(os.MkdirAll(target, 0777))
+		}
+		if node.FileNode == nil {
+			return nil
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0777); err != nil {
+			return errors.EnsureStack(err)
+		}
+		if node.FileNode.SymlinkTarget != "" {
+			return errors.EnsureStack(os.Symlink(node.FileNode.SymlinkTarget, target))
+		}
+		mode := os.FileMode(pfs.DefaultFileMode)
+		if node.FileNode.Mode != 0 {
+			mode = os.FileMode(node.FileNode.Mode)
+		}
+		f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		defer f.Close()
+		if len(node.FileNode.BlockRefs) > 0 {
+			getBlocksClient, err := d.pachClient.ObjectAPIClient.GetBlocks(
+				d.pachClient.Ctx(),
+				&pfs.GetBlocksRequest{
+					BlockRefs: node.FileNode.BlockRefs,
+					TotalSize: uint64(node.SubtreeSize),
+				},
+			)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			if err := grpcutil.WriteFromStreamingBytesClient(getBlocksClient, f); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+		} else if len(node.FileNode.Objects) > 0 {
+			var hashes []string
+			for _, object := range node.FileNode.Objects {
+				hashes = append(hashes, object.Hash)
+			}
+			if err := d.pachClient.GetObjects(hashes, 0, 0, uint64(node.SubtreeSize), f); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+		return errors.EnsureStack(f.Close())
+	})
+}
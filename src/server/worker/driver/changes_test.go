@@ -0,0 +1,95 @@
+package driver
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/enterprise"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
+	"github.com/pachyderm/pachyderm/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+)
+
+func TestDatumRecords(t *testing.T) {
+	require.NoError(t, testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		env.MockPachd.Enterprise.GetState.Use(func(context.Context, *enterprise.GetStateRequest) (*enterprise.GetStateResponse, error) {
+			return &enterprise.GetStateResponse{State: enterprise.State_NONE}, nil
+		})
+		jobStates := make(map[string]pps.JobState)
+		env.MockPachd.PPS.InspectJob.Use(func(_ context.Context, req *pps.InspectJobRequest) (*pps.JobInfo, error) {
+			return &pps.JobInfo{OutputCommit: req.OutputCommit, State: jobStates[req.OutputCommit.ID]}, nil
+		})
+		d, err := NewDriver(
+			testPipelineInfo(),
+			env.PachClient,
+			env.EtcdClient,
+			tu.UniqueString("driverTest"),
+			filepath.Clean(filepath.Join(env.Directory, "hashtrees")),
+			filepath.Clean(filepath.Join(env.Directory, "pfs")),
+			"namespace",
+		)
+		require.NoError(t, err)
+		driver := d.(*driver)
+		logger := logs.NewMockLogger()
+		inputs := []*common.Input{{
+			Name:     "in",
+			Changes:  true,
+			FileInfo: &pfs.FileInfo{File: client.NewFile("in", "commit", "/file")},
+		}}
+		lastSuccessful := func() *common.DatumRecord {
+			record, err := driver.readRecord(logger, inputs)
+			require.NoError(t, err)
+			record, err = driver.lastSuccessfulRecord(record)
+			require.NoError(t, err)
+			return record
+		}
+
+		// A datum without a record hasn't been processed
+		record, err := driver.readRecord(logger, inputs)
+		require.NoError(t, err)
+		require.Nil(t, record)
+
+		// A record only counts once its job succeeds
+		first := client.NewCommit("out", "first")
+		jobStates[first.ID] = pps.JobState_JOB_RUNNING
+		require.NoError(t, driver.WriteRecord(first, inputs, nil, "first-tag"))
+		require.Nil(t, lastSuccessful())
+		jobStates[first.ID] = pps.JobState_JOB_SUCCESS
+		require.Equal(t, "first-tag", lastSuccessful().Tag)
+
+		// If a later job fails, the record of the first job is used
+		second := client.NewCommit("out", "second")
+		jobStates[second.ID] = pps.JobState_JOB_FAILURE
+		require.NoError(t, driver.WriteRecord(second, inputs, nil, "second-tag"))
+		require.Equal(t, "first-tag", lastSuccessful().Tag)
+
+		// ...and kept by the records of the jobs after it, even if a job
+		// processes the datum more than once
+		third := client.NewCommit("out", "third")
+		jobStates[third.ID] = pps.JobState_JOB_RUNNING
+		require.NoError(t, driver.WriteRecord(third, inputs, nil, "third-tag"))
+		require.NoError(t, driver.WriteRecord(third, inputs, nil, "third-tag"))
+		record, err = driver.readRecord(logger, inputs)
+		require.NoError(t, err)
+		require.Equal(t, third.ID, record.OutputCommit.ID)
+		require.Equal(t, first.ID, record.Previous.OutputCommit.ID)
+		require.Equal(t, "first-tag", lastSuccessful().Tag)
+		jobStates[third.ID] = pps.JobState_JOB_SUCCESS
+		require.Equal(t, "third-tag", lastSuccessful().Tag)
+
+		// A job run with parameters doesn't replace the record of the last
+		// regular job, so the next regular job still sees it
+		parameterized := client.NewCommit("out", "parameterized")
+		jobStates[parameterized.ID] = pps.JobState_JOB_SUCCESS
+		parameters := &pps.RunParameters{Env: map[string]string{"MODE": "experiment"}}
+		require.NoError(t, driver.WriteRecord(parameterized, inputs, parameters, "parameterized-tag"))
+		require.Equal(t, "third-tag", lastSuccessful().Tag)
+		return nil
+	}))
+}
@@ -60,7 +60,7 @@ var (
 )
 
 func workNamespace(pipelineInfo *pps.PipelineInfo) string {
-	return fmt.Sprintf("/pipeline-%s/v%d", pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	return ppsutil.WorkNamespace(pipelineInfo)
 }
 
 // Driver provides an interface for common functions needed by worker code, and
@@ -72,6 +72,8 @@ type Driver interface {
 	Jobs() col.Collection
 	Pipelines() col.Collection
 
+	Memos() col.Collection
+
 	NewTaskWorker() *work.Worker
 	NewTaskQueue() (*work.TaskQueue, error)
 
@@ -101,8 +103,9 @@ type Driver interface {
 	// WithData prepares the current node the code is running on to run a piece
 	// of user code by downloading the specified data, and cleans up afterwards.
 	// The temporary scratch directory that the data is stored in will be passed
-	// to the given callback.
-	WithData([]*common.Input, *hashtree.Ordered, logs.TaggedLogger, func(string, *pps.ProcessStats) error) (*pps.ProcessStats, error)
+	// to the given callback. The run parameters of the datum's job, if any,
+	// keep it from reading the datum's record.
+	WithData([]*common.Input, *pps.RunParameters, *hashtree.Ordered, logs.TaggedLogger, func(string, *pps.ProcessStats) error) (*pps.ProcessStats, error)
 
 	// WithActiveData swaps the given scratch directory into the 'active' input
 	// directory used when running user code. This also locks a mutex so that no
@@ -110,8 +113,9 @@ type Driver interface {
 	WithActiveData([]*common.Input, string, func() error) error
 
 	// UserCodeEnv returns the set of environment variables to construct when
-	// launching the configured user process.
-	UserCodeEnv(string, *pfs.Commit, []*common.Input) []string
+	// launching the configured user process, including the overrides in the
+	// job's run parameters, if any.
+	UserCodeEnv(string, *pfs.Commit, []*common.Input, *pps.RunParameters) []string
 
 	// RunUserCode links a specific scratch space for the active input/output
 	// data, then runs the pipeline's configured code. It uses a mutex to enforce
@@ -121,6 +125,14 @@ type Driver interface {
 	// RunUserErrorHandlingCode runs the pipeline's configured error handling code
 	RunUserErrorHandlingCode(logs.TaggedLogger, []string, *pps.ProcessStats, *types.Duration) error
 
+	// RunUserSetupCode runs the pipeline's setup_cmd, if it has one. Each worker
+	// runs it once, before it processes any datums.
+	RunUserSetupCode(logs.TaggedLogger) error
+
+	// RunUserTeardownCode runs the pipeline's teardown_cmd, if it has one, when
+	// the worker is shut down.
+	RunUserTeardownCode(logs.TaggedLogger) error
+
 	// TODO: provide a more generic interface for modifying jobs, and
 	// some quality-of-life functions for common operations.
 	DeleteJob(col.STM, *pps.EtcdJobInfo) error
@@ -130,6 +142,17 @@ type Driver interface {
 	// storage and returns a buffer of the serialized hashtree
 	UploadOutput(string, string, logs.TaggedLogger, []*common.Input, *pps.ProcessStats, *hashtree.Ordered) ([]byte, error)
 
+	// MemoKey returns the key of the datum with the given inputs in the
+	// cluster-wide memoization cache, or "" if the pipeline's datums aren't
+	// memoized
+	MemoKey([]*common.Input) string
+
+	// WriteRecord records the processing of the datum with the given inputs
+	// by the job with the given output commit and run parameters, and whose
+	// output has the given tag, so that later jobs can give user code the
+	// datum's change lists if that job succeeds
+	WriteRecord(*pfs.Commit, []*common.Input, *pps.RunParameters, string) error
+
 	// TODO: figure out how to not expose this
 	ReportUploadStats(time.Time, *pps.ProcessStats, logs.TaggedLogger)
 
@@ -161,6 +184,12 @@ type driver struct {
 
 	pipelines col.Collection
 
+	memos col.Collection
+
+	memoizer *memoizer
+
+	datumBatcher *datumBatcher
+
 	numShards int64
 
 	namespace string
@@ -239,6 +268,9 @@ func NewDriver(
 		activeDataMutex:  &sync.Mutex{},
 		jobs:             ppsdb.Jobs(etcdClient, etcdPrefix),
 		pipelines:        ppsdb.Pipelines(etcdClient, etcdPrefix),
+		memos:            ppsdb.Memos(etcdClient, etcdPrefix),
+		memoizer:         &memoizer{},
+		datumBatcher:     newDatumBatcher(),
 		numShards:        numShards,
 		rootDir:          rootPath,
 		inputDir:         pfsPath,
@@ -371,6 +403,10 @@ func (d *driver) Pipelines() col.Collection {
 	return d.pipelines
 }
 
+func (d *driver) Memos() col.Collection {
+	return d.memos
+}
+
 func (d *driver) NewTaskWorker() *work.Worker {
 	return work.NewWorker(d.etcdClient, d.etcdPrefix, workNamespace(d.pipelineInfo))
 }
@@ -457,6 +493,7 @@ func (d *driver) NewSTM(cb func(col.STM) error) (*etcd.TxnResponse, error) {
 
 func (d *driver) WithData(
 	inputs []*common.Input,
+	parameters *pps.RunParameters,
 	inputTree *hashtree.Ordered,
 	logger logs.TaggedLogger,
 	cb func(string, *pps.ProcessStats) error,
@@ -466,7 +503,7 @@ func (d *driver) WithData(
 
 	// Download input data into a temporary directory
 	// This can be interrupted via the pachClient using driver.WithContext
-	dir, err := d.downloadData(logger, inputs, puller, stats, inputTree)
+	dir, err := d.downloadData(logger, inputs, parameters, puller, stats, inputTree)
 	// We run these cleanup functions no matter what, so that if
 	// downloadData partially succeeded, we still clean up the resources.
 	defer func() {
@@ -524,6 +561,7 @@ func (d *driver) WithData(
 func (d *driver) downloadData(
 	logger logs.TaggedLogger,
 	inputs []*common.Input,
+	parameters *pps.RunParameters,
 	puller *filesync.Puller,
 	stats *pps.ProcessStats,
 	statsTree *hashtree.Ordered,
@@ -597,6 +635,14 @@ func (d *driver) downloadData(
 		if err := os.MkdirAll(outPath, 0777); err != nil {
 			return "", errors.Wrapf(err, "couldn't create %q", outPath)
 		}
+		// Each named output is a directory in the output commit, which is
+		// copied into the output's own commit when the job finishes
+		for _, output := range d.PipelineInfo().Outputs {
+			namedOutPath := filepath.Join(outPath, output.Name)
+			if err := os.MkdirAll(namedOutPath, 0777); err != nil {
+				return "", errors.Wrapf(err, "couldn't create %q", namedOutPath)
+			}
+		}
 	}
 	for _, input := range inputs {
 		if input.GitURL != "" {
@@ -605,14 +651,20 @@ func (d *driver) downloadData(
 			}
 			continue
 		}
+		if input.ObjectStore {
+			if err := d.downloadObjectStoreData(scratchPath, input, puller); err != nil {
+				return "", err
+			}
+			continue
+		}
 		if input.S3 {
 			continue // don't download any data
 		}
 		file := input.FileInfo.File
-		fullInputPath := filepath.Join(scratchPath, input.Name, file.Path)
+		fullInputPath := filepath.Join(scratchPath, input.Name, input.Subdir, file.Path)
 		var statsRoot string
 		if statsTree != nil {
-			statsRoot = filepath.Join(input.Name, file.Path)
+			statsRoot = filepath.Join(input.Name, input.Subdir, file.Path)
 			parent, _ := filepath.Split(statsRoot)
 			statsTree.MkdirAll(parent)
 		}
@@ -631,6 +683,9 @@ func (d *driver) downloadData(
 			return "", errors.EnsureStack(err)
 		}
 	}
+	if err := d.downloadChanges(logger, scratchPath, inputs, parameters); err != nil {
+		return "", err
+	}
 	return scratchPath, nil
 }
 
@@ -728,6 +783,9 @@ func (d *driver) RunUserCode(
 	if len(d.pipelineInfo.Transform.Cmd) == 0 {
 		return errors.New("invalid pipeline transform, no command specified")
 	}
+	if d.pipelineInfo.DatumBatching {
+		return d.runBatchedDatum(ctx, logger, environ)
+	}
 
 	// Run user code
 	cmd := exec.CommandContext(ctx, d.pipelineInfo.Transform.Cmd[0], d.pipelineInfo.Transform.Cmd[1:]...)
@@ -849,13 +907,77 @@ func (d *driver) RunUserErrorHandlingCode(logger logs.TaggedLogger, environ []st
 	return nil
 }
 
+func (d *driver) RunUserSetupCode(logger logs.TaggedLogger) error {
+	transform := d.pipelineInfo.Transform
+	if len(transform.SetupCmd) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(d.pachClient.Ctx(), ppsutil.SetupTimeout(transform))
+	defer cancel()
+	return d.runUserLifecycleCode(ctx, logger, "setup", transform.SetupCmd, transform.SetupStdin)
+}
+
+func (d *driver) RunUserTeardownCode(logger logs.TaggedLogger) error {
+	transform := d.pipelineInfo.Transform
+	if len(transform.TeardownCmd) == 0 {
+		return nil
+	}
+	// The worker's context may already be canceled when it's shut down
+	ctx, cancel := context.WithTimeout(context.Background(), ppsutil.TeardownTimeout(transform))
+	defer cancel()
+	return d.runUserLifecycleCode(ctx, logger, "teardown", transform.TeardownCmd, transform.TeardownStdin)
+}
+
+// runUserLifecycleCode runs 'args', the pipeline's setup or teardown code
+// (named 'name'), until it exits or 'ctx' is done
+func (d *driver) runUserLifecycleCode(ctx context.Context, logger logs.TaggedLogger, name string, args []string, stdin []string) (retErr error) {
+	logger.Logf("beginning to run user %s code", name)
+	defer func(start time.Time) {
+		if retErr != nil {
+			logger.Logf("errored running user %s code after %v: %v", name, time.Since(start), retErr)
+		} else {
+			logger.Logf("finished running user %s code after %v", name, time.Since(start))
+		}
+	}(time.Now())
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	if stdin != nil {
+		cmd.Stdin = strings.NewReader(strings.Join(stdin, "\n") + "\n")
+	}
+	cmd.Stdout = logger.WithUserCode()
+	cmd.Stderr = logger.WithUserCode()
+	cmd.Env = os.Environ()
+	if d.uid != nil && d.gid != nil {
+		cmd.SysProcAttr = makeCmdCredentials(*d.uid, *d.gid)
+	}
+	cmd.Dir = filepath.Join(d.rootDir, d.pipelineInfo.Transform.WorkingDir)
+	if err := cmd.Start(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	state, err := cmd.Process.Wait()
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if common.IsDone(ctx) {
+		if err = ctx.Err(); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	// See RunUserCode for why we call WaitIO rather than cmd.Wait()
+	err = cmd.WaitIO(state, err)
+	if err != nil && !strings.Contains(err.Error(), "broken pipe") {
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
 func (d *driver) UpdateJobState(jobID string, state pps.JobState, reason string) error {
 	_, err := d.NewSTM(func(stm col.STM) error {
 		jobPtr := &pps.EtcdJobInfo{}
 		if err := d.Jobs().ReadWrite(stm).Get(jobID, jobPtr); err != nil {
 			return errors.EnsureStack(err)
 		}
-		return errors.EnsureStack(ppsutil.UpdateJobState(d.Pipelines().ReadWrite(stm), d.Jobs().ReadWrite(stm), jobPtr, state, reason))
+		return errors.EnsureStack(ppsutil.UpdateJobState(d.Pipelines().ReadWrite(stm), d.Jobs().ReadWrite(stm), jobPtr, state, reason, ppsutil.ActorWorker))
 	})
 	return errors.EnsureStack(err)
 }
@@ -1104,13 +1226,19 @@ func (d *driver) UploadOutput(
 			// exists in PFS.
 			if strings.HasPrefix(realPath, d.InputDir()) {
 				if pathWithInput, err := filepath.Rel(dir, realPath); err == nil {
-					// The name of the input
-					inputName := strings.Split(pathWithInput, string(os.PathSeparator))[0]
+					// The name of the input, and the commit's subdirectory for
+					// window inputs, whose commits share the input's directory
+					fields := strings.Split(pathWithInput, string(os.PathSeparator))
 					var input *common.Input
 					for _, i := range inputs {
-						if i.Name == inputName {
-							input = i
+						if i.Name != fields[0] {
+							continue
+						}
+						if i.Subdir != "" && (len(fields) < 2 || fields[1] != i.Subdir) {
+							continue
 						}
+						input = i
+						break
 					}
 					// this changes realPath from `/pfs/input/...` to `/scratch/<id>/input/...`
 					realPath = filepath.Join(dir, pathWithInput)
@@ -1125,7 +1253,7 @@ func (d *driver) UploadOutput(
 							}
 							subRelPath := filepath.Join(relPath, rel)
 							// The path of the input file
-							pfsPath, err := filepath.Rel(filepath.Join(dir, input.Name), filePath)
+							pfsPath, err := filepath.Rel(filepath.Join(dir, input.Name, input.Subdir), filePath)
 							if err != nil {
 								return errors.EnsureStack(err)
 							}
@@ -1160,6 +1288,17 @@ func (d *driver) UploadOutput(
 					}
 				}
 			}
+			// Symlinks to other output files are stored as symlinks, any other
+			// symlink is uploaded as the content it points to.
+			if target, ok := d.outputSymlinkTarget(outputPath, filePath, realPath); ok {
+				n := &hashtree.FileNodeProto{SymlinkTarget: target}
+				hash := pfs.NewHash().Sum(nil)
+				tree.PutFile(relPath, hash, 0, n)
+				if statsTree != nil {
+					statsTree.PutFile(relPath, hash, 0, n)
+				}
+				return nil
+			}
 		}
 		// Open local file that is being uploaded
 		f, err := os.Open(filePath)
@@ -1207,6 +1346,7 @@ func (d *driver) UploadOutput(
 				},
 			},
 		}
+		n.Mode = pfs.FileModeToRecord(info.Mode().Perm())
 		hash := h.Sum(nil)
 		tree.PutFile(relPath, hash, size, n)
 		if statsTree != nil {
@@ -1242,17 +1382,41 @@ func (d *driver) UploadOutput(
 	return b.Bytes(), nil
 }
 
+// outputSymlinkTarget returns the target of the symlink at 'filePath' as a
+// path relative to the symlink, if it points to another location in the
+// output directory. 'target' is the raw target of the symlink.
+func (d *driver) outputSymlinkTarget(outputPath, filePath, target string) (string, bool) {
+	// Absolute targets into the output repo are rewritten to point into the
+	// scratch space
+	if pfsOut := filepath.Join(d.InputDir(), "out"); target == pfsOut || strings.HasPrefix(target, pfsOut+"/") {
+		target = filepath.Join(outputPath, strings.TrimPrefix(target, pfsOut))
+	}
+	if filepath.IsAbs(target) {
+		var err error
+		target, err = filepath.Rel(filepath.Dir(filePath), target)
+		if err != nil {
+			return "", false
+		}
+	}
+	rel, err := filepath.Rel(outputPath, filepath.Join(filepath.Dir(filePath), target))
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", false
+	}
+	return filepath.ToSlash(target), true
+}
+
 func (d *driver) UserCodeEnv(
 	jobID string,
 	outputCommit *pfs.Commit,
 	inputs []*common.Input,
+	parameters *pps.RunParameters,
 ) []string {
 	result := os.Environ()
+	// The run parameters override the pipeline's environment variables (which
+	// are set on the worker), but not the variables that Pachyderm sets below
+	result = append(result, ppsutil.RunParametersEnv(parameters)...)
 
-	for _, input := range inputs {
-		result = append(result, fmt.Sprintf("%s=%s", input.Name, filepath.Join(d.InputDir(), input.Name, input.FileInfo.File.Path)))
-		result = append(result, fmt.Sprintf("%s_COMMIT=%s", input.Name, input.FileInfo.File.Commit.ID))
-	}
+	result = append(result, common.InputEnv(d.InputDir(), inputs)...)
 
 	if jobID != "" {
 		result = append(result, fmt.Sprintf("%s=%s", client.JobIDEnv, jobID))
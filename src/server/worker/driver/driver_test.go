@@ -5,7 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -22,8 +25,10 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/enterprise"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
 	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
 	"github.com/pachyderm/pachyderm/src/server/worker/common"
@@ -354,6 +359,7 @@ func TestWithDataEmpty(t *testing.T) {
 			_, err := env.driver.WithData(
 				[]*common.Input{},
 				nil,
+				nil,
 				logger,
 				func(dir string, stats *pps.ProcessStats) error {
 					requireContents(t, dir, []*inputData{})
@@ -376,6 +382,7 @@ func TestWithDataSpout(t *testing.T) {
 			_, err := env.driver.WithData(
 				[]*common.Input{},
 				nil,
+				nil,
 				logger,
 				func(dir string, stats *pps.ProcessStats) error {
 					// A spout pipeline should have created a 'pfs/out` fifo for the user
@@ -429,6 +436,7 @@ func TestWithDataCancel(t *testing.T) {
 			_, err := driver.WithData(
 				[]*common.Input{newInput("repo", "input.txt")},
 				nil,
+				nil,
 				logger,
 				func(dir string, stats *pps.ProcessStats) error {
 					require.True(t, false, "Should have been canceled before the callback")
@@ -465,6 +473,7 @@ func TestWithDataDownload(t *testing.T) {
 			_, err := env.driver.WithData(
 				[]*common.Input{newInput("repoA", "input.txt"), newInput("repoB", "input.md")},
 				nil,
+				nil,
 				logger,
 				func(dir string, stats *pps.ProcessStats) error {
 					requireContents(t, dir, []*inputData{
@@ -500,6 +509,7 @@ func TestWithActiveDataCleanup(t *testing.T) {
 			_, err := env.driver.WithData(
 				[]*common.Input{},
 				nil,
+				nil,
 				logger,
 				func(dir string, stats *pps.ProcessStats) error {
 					requireContents(t, dir, []*inputData{})
@@ -589,6 +599,7 @@ func TestWithDataGit(t *testing.T) {
 			_, err := env.driver.WithData(
 				[]*common.Input{newGitInput("artifacts", inputGitRepo)},
 				nil,
+				nil,
 				logger,
 				func(dir string, stats *pps.ProcessStats) error {
 					requireContents(t, dir, []*inputData{newInputDataRegex("artifacts/readme.md", "Test Artifacts")})
@@ -614,6 +625,7 @@ func TestWithDataGitHookError(t *testing.T) {
 			_, err := env.driver.WithData(
 				[]*common.Input{newGitInput("artifacts", inputGitRepo)},
 				nil,
+				nil,
 				logger,
 				func(dir string, stats *pps.ProcessStats) error {
 					require.True(t, false, "Should have errored before calling WithData callback")
@@ -638,6 +650,7 @@ func TestWithDataGitRepoMissing(t *testing.T) {
 			_, err := env.driver.WithData(
 				[]*common.Input{newGitInput("artifacts", inputGitRepo)},
 				nil,
+				nil,
 				logger,
 				func(dir string, stats *pps.ProcessStats) error {
 					require.True(t, false, "Should have errored before calling WithData callback")
@@ -662,6 +675,7 @@ func TestWithDataGitInvalidSHA(t *testing.T) {
 			_, err := env.driver.WithData(
 				[]*common.Input{newGitInput("artifacts", inputGitRepo)},
 				nil,
+				nil,
 				logger,
 				func(dir string, stats *pps.ProcessStats) error {
 					require.True(t, false, "Should have errored before calling WithData callback")
@@ -762,6 +776,7 @@ func TestRunUserCodeWithData(t *testing.T) {
 			_, err := env.driver.WithData(
 				inputs,
 				nil,
+				nil,
 				logger,
 				func(dir string, stats *pps.ProcessStats) error {
 					requireContents(t, dir, []*inputData{
@@ -802,3 +817,174 @@ func TestRunUserCodeWithData(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+// Test that a pipeline with datum batching runs one process for many datums
+func TestRunUserCodeDatumBatching(t *testing.T) {
+	t.Parallel()
+	script := `
+echo started
+while true; do
+  datum=$(curl -s "$PACH_DATUM_BATCH_URL/datum")
+  id=$(echo "$datum" | sed 's/.*"id":"\([^"]*\)".*/\1/')
+  echo "processing $datum"
+  if echo "$datum" | grep -q '"FOO":"bad"'; then
+    curl -s -X POST --data "bad datum" "$PACH_DATUM_BATCH_URL/datum/$id/failed"
+  else
+    curl -s -X POST "$PACH_DATUM_BATCH_URL/datum/$id/done"
+  fi
+done
+`
+	err := withTestEnv(func(env *testEnv) {
+		env.driver.pipelineInfo.Transform.Cmd = []string{"bash", "-c", script}
+		env.driver.pipelineInfo.DatumBatching = true
+		env.driver.pipelineInfo.Transform.WorkingDir = ""
+		require.NoError(t, os.MkdirAll(env.driver.rootDir, 0777))
+		defer func() {
+			env.driver.datumBatcher.mu.Lock()
+			if cmd := env.driver.datumBatcher.cmd; cmd != nil {
+				cmd.Process.Kill()
+			}
+			env.driver.datumBatcher.mu.Unlock()
+		}()
+
+		requireLogs(t, []string{"started", `"FOO":"good"`}, func(logger logs.TaggedLogger) {
+			err := env.driver.RunUserCode(logger, []string{"FOO=good"}, &pps.ProcessStats{}, nil)
+			require.NoError(t, err)
+		})
+		logs := collectLogs(func(logger logs.TaggedLogger) {
+			err := env.driver.RunUserCode(logger, []string{"FOO=bad"}, &pps.ProcessStats{}, nil)
+			require.YesError(t, err)
+			require.Matches(t, "bad datum", err.Error())
+		})
+		for _, line := range logs {
+			require.False(t, strings.Contains(line, "started"), "user code was restarted: %s", line)
+		}
+	})
+	require.NoError(t, err)
+}
+
+// Test that a batched datum whose response the user process never got is
+// handed out again, rather than waited on forever
+func TestDatumBatcherRedelivery(t *testing.T) {
+	t.Parallel()
+	b := newDatumBatcher()
+	server := httptest.NewServer(b)
+	defer server.Close()
+	datum := &batchDatum{ID: "datum", result: make(chan error, 1)}
+	go func() { b.datums <- datum }()
+	get := func() string {
+		resp, err := http.Get(server.URL + "/datum")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		fetched := &batchDatum{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(fetched))
+		return fetched.ID
+	}
+	require.Equal(t, "datum", get())
+	// The response was lost, so the process asks again
+	require.Equal(t, "datum", get())
+	resp, err := http.Post(server.URL+"/datum/datum/done", "", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.NoError(t, <-datum.result)
+}
+
+// Test that an output file symlinked to a window input's file refers to the
+// file in the commit that it was linked to, rather than the window's last
+// commit
+func TestUploadOutputWindowSymlink(t *testing.T) {
+	require.NoError(t, testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		env.MockPachd.Enterprise.GetState.Use(func(context.Context, *enterprise.GetStateRequest) (*enterprise.GetStateResponse, error) {
+			return &enterprise.GetStateResponse{State: enterprise.State_NONE}, nil
+		})
+		d, err := NewDriver(
+			testPipelineInfo(),
+			env.PachClient,
+			env.EtcdClient,
+			tu.UniqueString("driverTest"),
+			filepath.Clean(filepath.Join(env.Directory, "hashtrees")),
+			filepath.Clean(filepath.Join(env.Directory, "pfs")),
+			"namespace",
+		)
+		require.NoError(t, err)
+		driver := d.(*driver)
+		c := env.PachClient
+		repo := tu.UniqueString("TestUploadOutputWindowSymlink")
+		require.NoError(t, c.CreateRepo(repo))
+
+		// Lay out a window over two commits that both hold 'file', as
+		// downloadData would
+		dir := filepath.Join(driver.InputDir(), client.PPSScratchSpace, "datum")
+		var inputs []*common.Input
+		for _, content := range []string{"foo", "bar"} {
+			commit, err := c.StartCommit(repo, "master")
+			require.NoError(t, err)
+			_, err = c.PutFile(repo, commit.ID, "file", strings.NewReader(content))
+			require.NoError(t, err)
+			require.NoError(t, c.FinishCommit(repo, commit.ID))
+			fileInfo, err := c.InspectFile(repo, commit.ID, "/")
+			require.NoError(t, err)
+			inputs = append(inputs, &common.Input{FileInfo: fileInfo, Name: "in", Subdir: commit.ID})
+			p := filepath.Join(dir, "in", commit.ID, "file")
+			require.NoError(t, os.MkdirAll(filepath.Dir(p), 0777))
+			require.NoError(t, ioutil.WriteFile(p, []byte(content), 0666))
+		}
+		first := inputs[0].FileInfo.File.Commit.ID
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "out"), 0777))
+		// The link has been rewritten to point into the scratch space, as
+		// rewriteSymlinks does
+		require.NoError(t, os.Symlink(filepath.Join(dir, "in", first, "file"), filepath.Join(dir, "out", "link")))
+
+		var buf []byte
+		requireLogs(t, []string{"finished uploading output"}, func(logger logs.TaggedLogger) {
+			buf, err = driver.UploadOutput(dir, tu.UniqueString("tag"), logger, inputs, &pps.ProcessStats{}, nil)
+			require.NoError(t, err)
+		})
+		// The datum's hashtree is a sequence of paths and their nodes
+		var node *hashtree.NodeProto
+		r := pbutil.NewReader(bytes.NewReader(buf))
+		for {
+			if _, err := r.ReadBytes(); err != nil {
+				require.True(t, errors.Is(err, io.EOF))
+				break
+			}
+			n := &hashtree.NodeProto{}
+			require.NoError(t, r.Read(n))
+			if n.Name == "link" {
+				node = n
+			}
+		}
+		require.NotNil(t, node)
+		fileInfo, err := c.InspectFile(repo, first, "file")
+		require.NoError(t, err)
+		require.Equal(t, fileInfo.Hash, node.Hash)
+		require.Equal(t, int64(3), node.SubtreeSize)
+		return nil
+	}))
+}
+
+// Test that a window input's commits are listed newest first, whatever their
+// order in the datum
+func TestUserCodeEnvWindow(t *testing.T) {
+	t.Parallel()
+	err := withTestEnv(func(env *testEnv) {
+		input := func(commit string, finished int64) *common.Input {
+			return &common.Input{
+				Name:   "in",
+				Subdir: commit,
+				FileInfo: &pfs.FileInfo{
+					File:      client.NewFile("in", commit, "/"),
+					Committed: &types.Timestamp{Seconds: finished},
+				},
+			}
+		}
+		for _, inputs := range [][]*common.Input{
+			{input("a", 1), input("b", 2), input("c", 3)},
+			{input("c", 3), input("a", 1), input("b", 2)},
+		} {
+			envVars := env.driver.UserCodeEnv("", nil, inputs, nil)
+			require.OneOfEquals(t, "in_COMMITS=c b a", envVars)
+		}
+	})
+	require.NoError(t, err)
+}
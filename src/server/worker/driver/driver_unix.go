@@ -126,6 +126,12 @@ func (d *driver) linkData(inputs []*common.Input, dir string) error {
 		}
 	}
 
+	for _, name := range changesDirs(inputs) {
+		if err := os.Symlink(filepath.Join(dir, name), filepath.Join(d.InputDir(), name)); err != nil {
+			return err
+		}
+	}
+
 	if d.PipelineInfo().Spout != nil && d.PipelineInfo().Spout.Marker != "" {
 		if err := os.Symlink(
 			filepath.Join(dir, d.PipelineInfo().Spout.Marker),
@@ -71,6 +71,12 @@ func (d *driver) moveData(inputs []*common.Input, dir string) error {
 		}
 	}
 
+	for _, name := range changesDirs(inputs) {
+		if err := os.Rename(filepath.Join(dir, name), filepath.Join(d.InputDir(), name)); err != nil {
+			return err
+		}
+	}
+
 	if d.PipelineInfo().Spout != nil && d.PipelineInfo().Spout.Marker != "" {
 		src := filepath.Join(dir, d.PipelineInfo().Spout.Marker)
 		dst := filepath.Join(d.InputDir(), d.PipelineInfo().Spout.Marker)
@@ -0,0 +1,156 @@
+package driver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+)
+
+// memoizer computes the keys of a memoizing pipeline's datums in the
+// cluster-wide memoization cache. It's shared by all copies of a driver.
+type memoizer struct {
+	once        sync.Once
+	fingerprint string // "" if the pipeline's datums can't be memoized
+}
+
+func (d *driver) MemoKey(inputs []*common.Input) string {
+	if !d.pipelineInfo.Memoize {
+		return ""
+	}
+	d.memoizer.once.Do(func() {
+		logger := logs.NewStatlessLogger(d.pipelineInfo)
+		digest, err := imageDigest(d.pipelineInfo.Transform.Image)
+		if err != nil {
+			logger.Logf("not memoizing datums, could not resolve the digest of %q: %v", d.pipelineInfo.Transform.Image, err)
+			return
+		}
+		var sidecarDigests []string
+		for _, sidecar := range d.pipelineInfo.Sidecars {
+			sidecarDigest, err := imageDigest(sidecar.Image)
+			if err != nil {
+				logger.Logf("not memoizing datums, could not resolve the digest of sidecar %q: %v", sidecar.Name, err)
+				return
+			}
+			sidecarDigests = append(sidecarDigests, sidecarDigest)
+		}
+		fingerprint, err := transformFingerprint(digest, d.pipelineInfo.Transform, d.pipelineInfo.Sidecars, sidecarDigests)
+		if err != nil {
+			logger.Logf("not memoizing datums, could not fingerprint the pipeline's secrets: %v", err)
+			return
+		}
+		d.memoizer.fingerprint = fingerprint
+	})
+	if d.memoizer.fingerprint == "" {
+		return ""
+	}
+	return common.MemoKey(d.memoizer.fingerprint, inputs)
+}
+
+// imageDigest returns the digest of 'image', which is either part of the
+// image reference or, if the image is referred to by a tag, looked up through
+// the node's docker daemon. A tag alone can't identify the image's contents,
+// so this returns an error if there's no docker daemon to ask.
+func imageDigest(image string) (string, error) {
+	if i := strings.LastIndex(image, "@"); i >= 0 {
+		return image[i+1:], nil
+	}
+	if _, err := os.Stat("/var/run/docker.sock"); err != nil {
+		return "", errors.Errorf("%q isn't pinned to a digest and the docker socket isn't available", image)
+	}
+	client, err := docker.NewClientFromEnv()
+	if err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	imageInfo, err := client.InspectImage(image)
+	if err != nil {
+		return "", errors.Wrapf(err, "error inspecting image %s", image)
+	}
+	return imageInfo.ID, nil
+}
+
+// transformFingerprint hashes everything other than a datum's inputs that
+// determines what the pipeline's code outputs for it: the image digest, the
+// command and its environment, the setup command, the pipeline's sidecars
+// (whose images are identified by 'sidecarDigests'), and the values of the
+// pipeline's secrets (which the worker can read because they're set in its own
+// container).
+func transformFingerprint(digest string, transform *pps.Transform, sidecars []*pps.Sidecar, sidecarDigests []string) (string, error) {
+	hash := sha256.New()
+	write := func(ss ...string) {
+		for _, s := range ss {
+			// Length-prefix each string so that fields can't run together
+			hash.Write([]byte{byte(len(s) >> 24), byte(len(s) >> 16), byte(len(s) >> 8), byte(len(s))})
+			hash.Write([]byte(s))
+		}
+	}
+	write(digest, transform.User, transform.WorkingDir)
+	write("cmd")
+	write(transform.Cmd...)
+	write("stdin")
+	write(transform.Stdin...)
+	write("setup")
+	write(transform.SetupCmd...)
+	write("setup stdin")
+	write(transform.SetupStdin...)
+	writeEnv := func(env map[string]string) {
+		write("env")
+		var keys []string
+		for k := range env {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			write(k, env[k])
+		}
+	}
+	writeEnv(transform.Env)
+	for i, sidecar := range sidecars {
+		write("sidecar", sidecar.Name, sidecarDigests[i])
+		write(sidecar.Cmd...)
+		writeEnv(sidecar.Env)
+	}
+	write("accept")
+	for _, code := range transform.AcceptReturnCode {
+		write(strconv.FormatInt(code, 10))
+	}
+	for _, secret := range transform.Secrets {
+		write("secret", secret.Name, secret.Key, secret.EnvVar, secret.MountPath)
+		if secret.EnvVar != "" {
+			write(os.Getenv(secret.EnvVar))
+		}
+		if secret.MountPath != "" {
+			if err := filepath.Walk(secret.MountPath, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				if info.IsDir() {
+					return nil
+				}
+				write(path)
+				f, err := os.Open(path)
+				if err != nil {
+					return errors.EnsureStack(err)
+				}
+				defer f.Close()
+				_, err = io.Copy(hash, f)
+				return errors.EnsureStack(err)
+			}); err != nil {
+				return "", err
+			}
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,150 @@
+package driver
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	filesync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
+	"github.com/pachyderm/pachyderm/src/server/worker/common"
+)
+
+// downloadObjectStoreData fetches the objects referred to by the files in
+// 'input', which is part of an object store input, to the same paths under
+// <scratchPath>/<input name> that a PFS input's files would have. If the input
+// is lazy, the objects are fetched through named pipes when they're read.
+func (d *driver) downloadObjectStoreData(scratchPath string, input *common.Input, puller *filesync.Puller) error {
+	var inputURL string
+	pps.VisitInput(d.pipelineInfo.Input, func(in *pps.Input) {
+		if in.ObjectStore != nil && in.ObjectStore.Name == input.Name {
+			inputURL = in.ObjectStore.URL
+		}
+	})
+	if inputURL == "" {
+		return errors.Errorf("pipeline has no object store input named %q", input.Name)
+	}
+	url, err := obj.ParseURL(inputURL)
+	if err != nil {
+		return err
+	}
+	// The client is only created (and the credentials only read, for buckets
+	// that aren't local) once a datum refers to an object
+	var objClient obj.Client
+	file := input.FileInfo.File
+	return d.pachClient.Walk(file.Commit.Repo.Name, file.Commit.ID, file.Path, func(fi *pfs.FileInfo) error {
+		if fi.FileType == pfs.FileType_DIR {
+			return nil
+		}
+		buf := &bytes.Buffer{}
+		if err := d.pachClient.GetFile(file.Commit.Repo.Name, file.Commit.ID, fi.File.Path, 0, 0, buf); err != nil {
+			return errors.EnsureStack(err)
+		}
+		ref := &ppsutil.ObjectRef{}
+		if err := json.Unmarshal(buf.Bytes(), ref); err != nil {
+			return errors.Wrapf(err, "malformed object reference %s", fi.File.Path)
+		}
+		if err := checkObjectRef(inputURL, url.Object, ref); err != nil {
+			return errors.Wrapf(err, "invalid object reference %s", fi.File.Path)
+		}
+		if objClient == nil {
+			var err error
+			if url.Store == "local" {
+				objClient, err = obj.NewLocalClientFromURL(url, os.Getenv(ppsutil.ObjectStoreInputLocalRootEnv))
+			} else {
+				var creds map[string][]byte
+				if creds, err = readObjectStoreCredentials(ppsutil.ObjectStoreSecretPath(input.Name)); err != nil {
+					return err
+				}
+				objClient, err = obj.NewClientFromURLAndCredentials(url, creds)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		ctx := d.pachClient.Ctx()
+		return puller.PullFunc(filepath.Join(scratchPath, input.Name, fi.File.Path), input.Lazy, func(w io.Writer) error {
+			return fetchObject(ctx, objClient, ref, w)
+		})
+	})
+}
+
+// checkObjectRef returns an error if 'ref' doesn't refer to an object under
+// 'prefix' in the bucket at 'inputURL', which are those of the object store
+// input that it was read from. References are files in the input repo, which
+// anyone who can write to it can forge, so the worker doesn't trust them to
+// say which bucket (or, for local buckets, which directory) to read.
+func checkObjectRef(inputURL, prefix string, ref *ppsutil.ObjectRef) error {
+	if ref.URL != inputURL {
+		return errors.Errorf("it refers to %q rather than the input's URL %q", ref.URL, inputURL)
+	}
+	object := ref.Object
+	if object == "" || path.Clean(object) != object || object == ".." || strings.HasPrefix(object, "../") || strings.HasPrefix(object, "/") {
+		return errors.Errorf("malformed object name %q", object)
+	}
+	switch {
+	case prefix == "", object == prefix, strings.HasSuffix(prefix, "/") && strings.HasPrefix(object, prefix),
+		strings.HasPrefix(object, prefix+"/"):
+		return nil
+	}
+	return errors.Errorf("object %q isn't under the input's prefix %q", object, prefix)
+}
+
+// readObjectStoreCredentials reads the credentials secret of an object store
+// input, which is mounted at 'dir'.
+func readObjectStoreCredentials(dir string) (map[string][]byte, error) {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read object store credentials")
+	}
+	creds := make(map[string][]byte)
+	for _, fi := range fis {
+		// Secret volumes hold each key in a symlink to a hidden directory
+		if strings.HasPrefix(fi.Name(), ".") {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		creds[fi.Name()] = data
+	}
+	return creds, nil
+}
+
+// fetchObject writes the content of the object referred to by 'ref' to 'w'.
+// It fails if the content's hash doesn't match the reference's (i.e. the
+// object has changed since it was committed), as the datum's ID wouldn't
+// match the data that it processed.
+func fetchObject(ctx context.Context, objClient obj.Client, ref *ppsutil.ObjectRef, w io.Writer) (retErr error) {
+	r, err := objClient.Reader(ctx, ref.Object, 0, 0)
+	if err != nil {
+		return errors.Wrapf(err, "error fetching object %s", ref.Object)
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(w, hash), r); err != nil {
+		return errors.EnsureStack(err)
+	}
+	if h := hex.EncodeToString(hash.Sum(nil)); h != ref.Hash {
+		return errors.Errorf("object %s has changed since it was committed to the input repo (its hash is %s, expected %s); it will be processed when the input is next polled",
+			ref.Object, h, ref.Hash)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package driver
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	filesync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
+)
+
+func TestFetchObject(t *testing.T) {
+	root, err := ioutil.TempDir("", "object-store-input")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, "a"), []byte("foo"), 0666))
+	objClient, err := obj.NewLocalClient(root)
+	require.NoError(t, err)
+	hash := sha256.Sum256([]byte("foo"))
+	ref := &ppsutil.ObjectRef{Object: "a", Hash: hex.EncodeToString(hash[:])}
+	fetch := func(w io.Writer) error {
+		return fetchObject(context.Background(), objClient, ref, w)
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, fetch(buf))
+	require.Equal(t, "foo", buf.String())
+
+	// Lazy inputs fetch the object when its pipe is read
+	puller := filesync.NewPuller()
+	target := filepath.Join(root, "out", "a")
+	require.NoError(t, puller.PullFunc(target, true, fetch))
+	fi, err := os.Lstat(target)
+	require.NoError(t, err)
+	require.True(t, fi.Mode()&os.ModeNamedPipe != 0)
+	data, err := ioutil.ReadFile(target)
+	require.NoError(t, err)
+	require.Equal(t, "foo", string(data))
+	_, err = puller.CleanUp()
+	require.NoError(t, err)
+
+	// The object changed after it was committed
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, "a"), []byte("bar"), 0666))
+	err = fetch(&bytes.Buffer{})
+	require.YesError(t, err)
+	require.Matches(t, "has changed since it was committed", err.Error())
+}
+
+func TestObjectStoreCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "object-store-secret")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	// Lay the credentials out like a mounted secret volume
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "..data"), 0777))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "..data", "google-cred"), []byte("{}"), 0666))
+	require.NoError(t, os.Symlink(filepath.Join("..data", "google-cred"), filepath.Join(dir, "google-cred")))
+	creds, err := readObjectStoreCredentials(dir)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"google-cred": []byte("{}")}, creds)
+
+	// Buckets are only read with the given credentials, and local URLs, which
+	// are only read under the configured root, are rejected
+	for _, url := range []string{"s3://bucket", "as://container", "local://tmp"} {
+		u, err := obj.ParseURL(url)
+		require.NoError(t, err)
+		_, err = obj.NewClientFromURLAndCredentials(u, creds)
+		require.YesError(t, err)
+	}
+}
+
+func TestCheckObjectRef(t *testing.T) {
+	ref := func(url, object string) *ppsutil.ObjectRef {
+		return &ppsutil.ObjectRef{URL: url, Object: object}
+	}
+	require.NoError(t, checkObjectRef("s3://bucket/data", "data", ref("s3://bucket/data", "data/a")))
+	require.NoError(t, checkObjectRef("s3://bucket/data", "data", ref("s3://bucket/data", "data")))
+	require.NoError(t, checkObjectRef("s3://bucket", "", ref("s3://bucket", "a/b")))
+
+	// References can't point at another bucket, or outside the input's prefix
+	for _, r := range []*ppsutil.ObjectRef{
+		ref("s3://other/data", "data/a"),
+		ref("local://etc", "data/a"),
+		ref("s3://bucket/data", "data2/a"),
+		ref("s3://bucket/data", "other/a"),
+		ref("s3://bucket/data", "data/../other/a"),
+		ref("s3://bucket/data", ""),
+	} {
+		require.YesError(t, checkObjectRef("s3://bucket/data", "data", r))
+	}
+	require.YesError(t, checkObjectRef("local://bucket", "", ref("local://bucket", "../../etc/passwd")))
+	require.YesError(t, checkObjectRef("local://bucket", "", ref("local://bucket", "/etc/passwd")))
+}
@@ -0,0 +1,184 @@
+package local
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	globlib "github.com/pachyderm/ohmyglob"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+)
+
+// DirCommit is the ID of the only commit in the repos served by ServeDir. Any
+// branch or commit ID refers to it.
+const DirCommit = "local"
+
+// ServeDir serves the directory tree at 'dir' as a read-only PFS, in which
+// each subdirectory of 'dir' is a repo with a single commit that holds the
+// subdirectory's contents. This lets Run process data on the local machine,
+// without a cluster. The returned client is closed, and the server stopped,
+// when 'ctx' is cancelled.
+func ServeDir(ctx context.Context, dir string) (*client.APIClient, error) {
+	if info, err := os.Stat(dir); err != nil {
+		return nil, errors.EnsureStack(err)
+	} else if !info.IsDir() {
+		return nil, errors.Errorf("%q is not a directory", dir)
+	}
+	server, err := grpcutil.NewServer(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	pfs.RegisterAPIServer(server.Server, &dirAPIServer{dir: dir})
+	listener, err := server.ListenTCP("localhost", 0)
+	if err != nil {
+		return nil, err
+	}
+	pachClient, err := client.NewFromAddress(listener.Addr().String())
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		pachClient.Close()
+	}()
+	return pachClient.WithCtx(ctx), nil
+}
+
+// dirAPIServer implements the parts of the PFS API that Run uses, over the
+// directory tree at 'dir'
+type dirAPIServer struct {
+	pfs.UnimplementedAPIServer
+	dir string
+}
+
+// repoDir returns the directory holding the contents of 'repo', which must
+// exist
+func (a *dirAPIServer) repoDir(repo *pfs.Repo) (string, error) {
+	if repo == nil || repo.Name == "" || strings.ContainsAny(repo.Name, `/\`) || repo.Name == "." || repo.Name == ".." {
+		return "", errors.Errorf("invalid repo %v", repo)
+	}
+	dir := filepath.Join(a.dir, repo.Name)
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return "", errors.Errorf("repo %s not found in %s", repo.Name, a.dir)
+	}
+	return dir, nil
+}
+
+// fileInfo returns the FileInfo of 'p' (a path in 'repo'), whose local
+// counterpart is described by 'info'
+func fileInfo(repo string, p string, info os.FileInfo) *pfs.FileInfo {
+	fi := &pfs.FileInfo{
+		File:      client.NewFile(repo, DirCommit, p),
+		FileType:  pfs.FileType_FILE,
+		SizeBytes: uint64(info.Size()),
+		Mode:      uint32(info.Mode().Perm()),
+	}
+	if info.IsDir() {
+		fi.FileType = pfs.FileType_DIR
+		fi.SizeBytes = 0
+	}
+	return fi
+}
+
+// walk calls 'f' with every file and directory under 'p' (a path in 'repo'),
+// in lexicographical order
+func (a *dirAPIServer) walk(repo *pfs.Repo, p string, f func(*pfs.FileInfo) error) error {
+	dir, err := a.repoDir(repo)
+	if err != nil {
+		return err
+	}
+	root := filepath.Join(dir, filepath.FromSlash(path.Clean("/"+p)))
+	return errors.EnsureStack(filepath.Walk(root, func(local string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, local)
+		if err != nil {
+			return err
+		}
+		return f(fileInfo(repo.Name, path.Clean("/"+filepath.ToSlash(rel)), info))
+	}))
+}
+
+func (a *dirAPIServer) InspectCommit(ctx context.Context, request *pfs.InspectCommitRequest) (*pfs.CommitInfo, error) {
+	if request.Commit == nil {
+		return nil, errors.New("commit cannot be nil")
+	}
+	if _, err := a.repoDir(request.Commit.Repo); err != nil {
+		return nil, err
+	}
+	return &pfs.CommitInfo{Commit: client.NewCommit(request.Commit.Repo.Name, DirCommit)}, nil
+}
+
+func (a *dirAPIServer) InspectFile(ctx context.Context, request *pfs.InspectFileRequest) (*pfs.FileInfo, error) {
+	if request.File == nil || request.File.Commit == nil {
+		return nil, errors.New("file cannot be nil")
+	}
+	dir, err := a.repoDir(request.File.Commit.Repo)
+	if err != nil {
+		return nil, err
+	}
+	p := path.Clean("/" + request.File.Path)
+	info, err := os.Stat(filepath.Join(dir, filepath.FromSlash(p)))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	return fileInfo(request.File.Commit.Repo.Name, p, info), nil
+}
+
+func (a *dirAPIServer) GlobFileStream(request *pfs.GlobFileRequest, server pfs.API_GlobFileStreamServer) error {
+	if request.Commit == nil {
+		return errors.New("commit cannot be nil")
+	}
+	glob := "/" + strings.Trim(request.Pattern, "/")
+	g, err := globlib.Compile(glob, '/')
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return a.walk(request.Commit.Repo, "/", func(fi *pfs.FileInfo) error {
+		// As in PFS, the root only matches the glob "/"
+		if fi.File.Path == "/" && glob != "/" || fi.File.Path != "/" && !g.Match(fi.File.Path) {
+			return nil
+		}
+		return errors.EnsureStack(server.Send(fi))
+	})
+}
+
+func (a *dirAPIServer) WalkFile(request *pfs.WalkFileRequest, server pfs.API_WalkFileServer) error {
+	if request.File == nil || request.File.Commit == nil {
+		return errors.New("file cannot be nil")
+	}
+	return a.walk(request.File.Commit.Repo, request.File.Path, func(fi *pfs.FileInfo) error {
+		return errors.EnsureStack(server.Send(fi))
+	})
+}
+
+func (a *dirAPIServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileServer) error {
+	if request.File == nil || request.File.Commit == nil {
+		return errors.New("file cannot be nil")
+	}
+	dir, err := a.repoDir(request.File.Commit.Repo)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(filepath.Join(dir, filepath.FromSlash(path.Clean("/"+request.File.Path))))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer f.Close()
+	if _, err := f.Seek(request.OffsetBytes, 0); err != nil {
+		return errors.EnsureStack(err)
+	}
+	var r io.Reader = f
+	if request.SizeBytes > 0 {
+		r = io.LimitReader(f, request.SizeBytes)
+	}
+	return grpcutil.WriteToStreamingBytesServer(r, server)
+}
@@ -0,0 +1,532 @@
+// Package local runs a pipeline's transform on the local machine, as a local
+// process rather than in a Kubernetes pod, so that pipeline code can be
+// tested (e.g. in CI) without deploying it.
+package local
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	filesync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
+	"github.com/pachyderm/pachyderm/src/server/worker/common"
+	"github.com/pachyderm/pachyderm/src/server/worker/datum"
+)
+
+// concurrency is the number of files downloaded in parallel for each datum
+const concurrency = 100
+
+// Options configures Run.
+type Options struct {
+	// OutputDir, if set, is the directory that the output of every datum is
+	// written to. As in an output commit, files that several datums write to
+	// the same path are concatenated.
+	OutputDir string
+	// OutputBranch, if set, is the branch of the pipeline's output repo (which
+	// is created if it doesn't exist) that a commit holding the output of
+	// every datum is made on. The commit is only finished if all datums
+	// succeed; otherwise it's deleted.
+	OutputBranch string
+	// ScratchDir is where each datum's inputs are downloaded. A temporary
+	// directory is used if it's unset.
+	ScratchDir string
+	// Logs receives the output of the pipeline's code. It's discarded if Logs
+	// is nil.
+	Logs io.Writer
+}
+
+// DatumResult is the outcome of processing one datum.
+type DatumResult struct {
+	ID       string
+	Inputs   []*common.Input
+	Duration time.Duration
+	// Err is nil if the datum was processed successfully
+	Err error
+}
+
+// Result is the outcome of Run.
+type Result struct {
+	Datums []*DatumResult
+	// OutputCommit is the commit holding the datums' output, if
+	// Options.OutputBranch was set and all datums succeeded
+	OutputCommit *pfs.Commit
+}
+
+// Failed returns the number of datums that failed.
+func (r *Result) Failed() int {
+	var n int
+	for _, d := range r.Datums {
+		if d.Err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Run processes the datums of the pipeline described by 'request', whose
+// inputs are read through 'pachClient' (which may be connected to a cluster,
+// or to a directory served by ServeDir), by running its transform command as
+// a local process for each datum. The pipeline's image is ignored: the
+// command must be runnable on the local machine.
+//
+// Each datum is laid out in its own scratch directory as the worker would lay
+// it out under "/": its inputs are in pfs/<input> and its output is read from
+// pfs/out. The command is run in the scratch directory, so it should refer to
+// its data through relative paths or the environment variables that name each
+// input, rather than through "/pfs".
+//
+// The pipeline's setup_cmd and teardown_cmd, if any, are run once in the
+// scratch directory, before the first datum and after the last one.
+//
+// Inputs that don't name a commit are read from the head of their branch.
+// Errors in the pipeline's code are reported in the returned Result, rather
+// than as an error.
+func Run(pachClient *client.APIClient, request *pps.CreatePipelineRequest, opts *Options) (_ *Result, retErr error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if err := validate(request); err != nil {
+		return nil, err
+	}
+	input := proto.Clone(request.Input).(*pps.Input)
+	if err := resolveInput(pachClient, request.Pipeline.Name, input); err != nil {
+		return nil, err
+	}
+	scratchDir := opts.ScratchDir
+	if scratchDir == "" {
+		var err error
+		if scratchDir, err = ioutil.TempDir("", "pachyderm-local-"); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		defer os.RemoveAll(scratchDir)
+	}
+	if opts.OutputDir != "" {
+		if err := checkOutputDir(opts.OutputDir); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &Result{}
+	var outputCommit *pfs.Commit
+	if opts.OutputBranch != "" {
+		repo := request.Pipeline.Name
+		if _, err := pachClient.InspectRepo(repo); err != nil {
+			if err := pachClient.CreateRepo(repo); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+		}
+		var err error
+		if outputCommit, err = pachClient.StartCommit(repo, opts.OutputBranch); err != nil {
+			return nil, errors.EnsureStack(err)
+		}
+		defer func() {
+			if retErr != nil || result.Failed() > 0 {
+				if err := pachClient.DeleteCommit(repo, outputCommit.ID); err != nil && retErr == nil {
+					retErr = errors.EnsureStack(err)
+				}
+				return
+			}
+			if err := pachClient.FinishCommit(repo, outputCommit.ID); err != nil && retErr == nil {
+				retErr = errors.EnsureStack(err)
+			}
+			result.OutputCommit = outputCommit
+		}()
+	}
+
+	// The setup and teardown commands don't see any datum's inputs
+	transform, lifecycleEnv := request.Transform, env(request, "", nil, nil)
+	if err := runLifecycleCommand(opts, scratchDir, lifecycleEnv, transform.SetupCmd, transform.SetupStdin, transform.SetupTimeout); err != nil {
+		return nil, errors.Wrapf(err, "error running setup_cmd")
+	}
+	defer func() {
+		if err := runLifecycleCommand(opts, scratchDir, lifecycleEnv, transform.TeardownCmd, transform.TeardownStdin, transform.TeardownTimeout); err != nil && retErr == nil {
+			retErr = errors.Wrapf(err, "error running teardown_cmd")
+		}
+	}()
+
+	it, err := datum.NewIterator(pachClient, input)
+	if err != nil {
+		return nil, err
+	}
+	for it.Next() {
+		inputs := it.Datum()
+		d := &DatumResult{
+			ID:     common.DatumID(inputs),
+			Inputs: inputs,
+		}
+		start := time.Now()
+		d.Err = runDatum(pachClient, request, opts, filepath.Join(scratchDir, d.ID), outputCommit, inputs)
+		d.Duration = time.Since(start)
+		result.Datums = append(result.Datums, d)
+	}
+	return result, nil
+}
+
+// validate returns an error if the pipeline described by 'request' can't be
+// run locally
+func validate(request *pps.CreatePipelineRequest) error {
+	switch {
+	case request.Pipeline == nil || request.Pipeline.Name == "":
+		return errors.New("pipeline must have a name")
+	case request.Transform == nil || len(request.Transform.Cmd) == 0:
+		return errors.New("pipeline must have a transform command")
+	case request.Input == nil:
+		return errors.New("pipeline must have an input")
+	case request.Spout != nil || request.Service != nil:
+		return errors.New("spouts and services can't be run locally")
+	case request.S3Out:
+		return errors.New("pipelines with s3_out can't be run locally")
+	}
+	var err error
+	pps.VisitInput(request.Input, func(input *pps.Input) {
+		switch {
+		case err != nil:
+		case input.Git != nil, input.ObjectStore != nil:
+			err = errors.Errorf("git and object store inputs can't be run locally")
+		case input.Pfs != nil && input.Pfs.S3:
+			err = errors.Errorf("input %s is an s3 input, which can't be run locally", input.Pfs.Name)
+		}
+	})
+	return err
+}
+
+// resolveInput sets the defaults that CreatePipeline would set in 'input',
+// and pins each input without a commit to the head of its branch
+func resolveInput(pachClient *client.APIClient, pipelineName string, input *pps.Input) error {
+	head := func(repo, branch string) (string, error) {
+		commitInfo, err := pachClient.InspectCommit(repo, branch)
+		if err != nil {
+			return "", errors.EnsureStack(err)
+		}
+		return commitInfo.Commit.ID, nil
+	}
+	var err error
+	pps.VisitInput(input, func(input *pps.Input) {
+		if err != nil {
+			return
+		}
+		switch {
+		case input.Pfs != nil:
+			if input.Pfs.Branch == "" {
+				input.Pfs.Branch = "master"
+			}
+			if input.Pfs.Name == "" {
+				input.Pfs.Name = input.Pfs.Repo
+			}
+			if input.Pfs.Commit == "" {
+				input.Pfs.Commit, err = head(input.Pfs.Repo, input.Pfs.Branch)
+			}
+		case input.Window != nil:
+			if input.Window.Branch == "" {
+				input.Window.Branch = "master"
+			}
+			if input.Window.Name == "" {
+				input.Window.Name = input.Window.Repo
+			}
+			if input.Window.Commit == "" {
+				input.Window.Commit, err = head(input.Window.Repo, input.Window.Branch)
+			}
+		case input.Cron != nil:
+			if input.Cron.Repo == "" {
+				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineName, input.Cron.Name)
+			}
+			if input.Cron.Commit == "" {
+				input.Cron.Commit, err = head(input.Cron.Repo, "master")
+			}
+		}
+	})
+	return err
+}
+
+// runDatum downloads the datum with 'inputs' to 'scratchPath', runs the
+// pipeline's code on it, and writes its output where 'opts' says to
+func runDatum(
+	pachClient *client.APIClient,
+	request *pps.CreatePipelineRequest,
+	opts *Options,
+	scratchPath string,
+	outputCommit *pfs.Commit,
+	inputs []*common.Input,
+) (retErr error) {
+	defer os.RemoveAll(scratchPath)
+	pfsPath := filepath.Join(scratchPath, client.PPSInputPrefix)
+	outPath := filepath.Join(pfsPath, "out")
+	if err := os.MkdirAll(outPath, 0777); err != nil {
+		return errors.EnsureStack(err)
+	}
+	for _, output := range request.Outputs {
+		if err := os.MkdirAll(filepath.Join(outPath, output.Name), 0777); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	puller := filesync.NewPuller()
+	for _, input := range inputs {
+		file := input.FileInfo.File
+		if err := puller.Pull(
+			pachClient,
+			filepath.Join(pfsPath, input.Name, input.Subdir, file.Path),
+			file.Commit.Repo.Name,
+			file.Commit.ID,
+			file.Path,
+			false,
+			input.EmptyFiles,
+			concurrency,
+			nil,
+			"",
+		); err != nil {
+			return errors.EnsureStack(err)
+		}
+	}
+	if err := writeChanges(pachClient, pfsPath, inputs); err != nil {
+		return err
+	}
+	if err := runCommand(request, opts, scratchPath, env(request, pfsPath, outputCommit, inputs)); err != nil {
+		return err
+	}
+	return writeOutput(pachClient, opts, outPath, outputCommit)
+}
+
+// checkOutputDir creates the output directory 'dir', or checks that it's
+// empty if it exists, as datums' output is appended to the files in it
+func checkOutputDir(dir string) error {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errors.EnsureStack(os.MkdirAll(dir, 0777))
+		}
+		return errors.EnsureStack(err)
+	}
+	if len(entries) > 0 {
+		return errors.Errorf("output directory %q is not empty", dir)
+	}
+	return nil
+}
+
+// writeChanges writes the change lists of the inputs in 'inputs' that have
+// change lists enabled, and the (empty) previous output, under 'pfsPath', as
+// the worker does for a datum that hasn't been processed before: a local run
+// has no earlier runs, so all of a datum's files are added.
+func writeChanges(pachClient *client.APIClient, pfsPath string, inputs []*common.Input) error {
+	if !common.HasChanges(inputs) {
+		return nil
+	}
+	added := make(map[string][]string)
+	for _, input := range inputs {
+		if !input.Changes {
+			continue
+		}
+		// Inputs in a group may share a name, in which case their changes go
+		// in the same lists
+		file, paths := input.FileInfo.File, added[input.Name]
+		if err := pachClient.Walk(file.Commit.Repo.Name, file.Commit.ID, file.Path, func(fi *pfs.FileInfo) error {
+			if fi.FileType != pfs.FileType_DIR {
+				paths = append(paths, fi.File.Path)
+			}
+			return nil
+		}); err != nil {
+			return errors.EnsureStack(err)
+		}
+		added[input.Name] = paths
+	}
+	for name, paths := range added {
+		dir := filepath.Join(pfsPath, client.PPSChangesDir, name)
+		if err := os.MkdirAll(dir, 0777); err != nil {
+			return errors.EnsureStack(err)
+		}
+		sort.Strings(paths)
+		for list, paths := range map[string][]string{"added": paths, "modified": nil, "deleted": nil} {
+			var data []byte
+			if len(paths) > 0 {
+				data = []byte(strings.Join(paths, "\n") + "\n")
+			}
+			if err := ioutil.WriteFile(filepath.Join(dir, list), data, 0666); err != nil {
+				return errors.EnsureStack(err)
+			}
+		}
+	}
+	return errors.EnsureStack(os.MkdirAll(filepath.Join(pfsPath, client.PPSPreviousOutputDir), 0777))
+}
+
+// env returns the environment that the pipeline's code is run with for the
+// datum with 'inputs', which matches the worker's
+func env(request *pps.CreatePipelineRequest, pfsPath string, outputCommit *pfs.Commit, inputs []*common.Input) []string {
+	result := os.Environ()
+	for k, v := range request.Transform.Env {
+		result = append(result, fmt.Sprintf("%s=%s", k, v))
+	}
+	result = append(result, common.InputEnv(pfsPath, inputs)...)
+	if outputCommit != nil {
+		result = append(result, fmt.Sprintf("%s=%s", client.OutputCommitIDEnv, outputCommit.ID))
+	}
+	return result
+}
+
+// runCommand runs the pipeline's code in 'dir' with 'environ', applying the
+// pipeline's datum timeout and accepted return codes
+func runCommand(request *pps.CreatePipelineRequest, opts *Options, dir string, environ []string) error {
+	ctx := context.Background()
+	if request.DatumTimeout != nil {
+		timeout, err := types.DurationFromProto(request.DatumTimeout)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	logs := opts.Logs
+	if logs == nil {
+		logs = ioutil.Discard
+	}
+	transform := request.Transform
+	cmd := exec.CommandContext(ctx, transform.Cmd[0], transform.Cmd[1:]...)
+	if transform.Stdin != nil {
+		cmd.Stdin = strings.NewReader(strings.Join(transform.Stdin, "\n") + "\n")
+	}
+	cmd.Stdout = logs
+	cmd.Stderr = logs
+	cmd.Env = environ
+	cmd.Dir = dir
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return errors.EnsureStack(ctx.Err())
+		}
+		exitErr := &exec.ExitError{}
+		if errors.As(err, &exitErr) {
+			for _, code := range transform.AcceptReturnCode {
+				if int(code) == exitErr.ExitCode() {
+					return nil
+				}
+			}
+		}
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+// runLifecycleCommand runs the pipeline's setup or teardown command 'args'
+// (if any) in 'dir' with 'environ', as the worker runs it once before and
+// after processing datums
+func runLifecycleCommand(opts *Options, dir string, environ, args, stdin []string, rawTimeout *types.Duration) error {
+	if len(args) == 0 {
+		return nil
+	}
+	ctx := context.Background()
+	if rawTimeout != nil {
+		timeout, err := types.DurationFromProto(rawTimeout)
+		if err != nil {
+			return errors.EnsureStack(err)
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	logs := opts.Logs
+	if logs == nil {
+		logs = ioutil.Discard
+	}
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	if stdin != nil {
+		cmd.Stdin = strings.NewReader(strings.Join(stdin, "\n") + "\n")
+	}
+	cmd.Stdout = logs
+	cmd.Stderr = logs
+	cmd.Env = environ
+	cmd.Dir = dir
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return errors.EnsureStack(ctx.Err())
+		}
+		return errors.EnsureStack(err)
+	}
+	return nil
+}
+
+// writeOutput appends the files in 'outPath' to the output directory and
+// output commit, if any
+func writeOutput(pachClient *client.APIClient, opts *Options, outPath string, outputCommit *pfs.Commit) error {
+	return errors.EnsureStack(filepath.Walk(outPath, func(local string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(outPath, local)
+		if err != nil {
+			return err
+		}
+		if opts.OutputDir != "" {
+			if err := appendFile(filepath.Join(opts.OutputDir, rel), local, info.Mode()); err != nil {
+				return err
+			}
+		}
+		if outputCommit != nil {
+			f, err := os.Open(local)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if _, err := pachClient.PutFile(outputCommit.Repo.Name, outputCommit.ID, path.Clean("/"+filepath.ToSlash(rel)), f); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// appendFile appends the contents of 'src' to 'dst', creating it with 'mode'
+// if it doesn't exist
+func appendFile(dst, src string, mode os.FileMode) (retErr error) {
+	if err := os.MkdirAll(filepath.Dir(dst), 0777); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_APPEND, mode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := out.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// DatumInfo returns 'd' in the form that ListDatum returns datums in, so that
+// it can be printed the same way.
+func (d *DatumResult) DatumInfo() *pps.DatumInfo {
+	datumInfo := &pps.DatumInfo{
+		Datum: &pps.Datum{ID: d.ID},
+		State: pps.DatumState_SUCCESS,
+		Stats: &pps.ProcessStats{ProcessTime: types.DurationProto(d.Duration)},
+	}
+	if d.Err != nil {
+		datumInfo.State = pps.DatumState_FAILED
+	}
+	for _, input := range d.Inputs {
+		datumInfo.Data = append(datumInfo.Data, input.FileInfo)
+	}
+	return datumInfo
+}
@@ -0,0 +1,141 @@
+package local
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestRunDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "local-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	inputDir, outputDir := filepath.Join(dir, "input"), filepath.Join(dir, "output")
+	for name, data := range map[string]string{"a": "foo\n", "b": "bar\n", "bad": "baz\n"} {
+		path := filepath.Join(inputDir, "data", "dir", name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0777))
+		require.NoError(t, ioutil.WriteFile(path, []byte(data), 0666))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pachClient, err := ServeDir(ctx, inputDir)
+	require.NoError(t, err)
+	request := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline("copy"),
+		Transform: &pps.Transform{
+			Cmd: []string{"bash", "-c", `[ "$(basename $data)" != bad ] && cp -r pfs/data/dir/* pfs/out/ && cat pfs/data/dir/* > pfs/out/all`},
+		},
+		Input: client.NewPFSInput("data", "/dir/*"),
+	}
+	result, err := Run(pachClient, request, &Options{OutputDir: outputDir})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(result.Datums))
+	require.Equal(t, 1, result.Failed())
+	for _, d := range result.Datums {
+		require.Equal(t, 1, len(d.Inputs))
+		if d.Inputs[0].FileInfo.File.Path == "/dir/bad" {
+			require.YesError(t, d.Err)
+		} else {
+			require.NoError(t, d.Err)
+		}
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(outputDir, "a"))
+	require.NoError(t, err)
+	require.Equal(t, "foo\n", string(data))
+	data, err = ioutil.ReadFile(filepath.Join(outputDir, "all"))
+	require.NoError(t, err)
+	require.True(t, string(data) == "foo\nbar\n" || string(data) == "bar\nfoo\n")
+	_, err = os.Stat(filepath.Join(outputDir, "bad"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestSetupAndTeardown(t *testing.T) {
+	dir, err := ioutil.TempDir("", "local-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	inputDir, outputDir := filepath.Join(dir, "input"), filepath.Join(dir, "output")
+	for _, name := range []string{"a", "b"} {
+		path := filepath.Join(inputDir, "data", name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0777))
+		require.NoError(t, ioutil.WriteFile(path, []byte(name), 0666))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pachClient, err := ServeDir(ctx, inputDir)
+	require.NoError(t, err)
+	teardownFile := filepath.Join(dir, "teardown")
+	request := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline("setup"),
+		Transform: &pps.Transform{
+			// Each datum's scratch directory is in the directory that setup runs in
+			Cmd:         []string{"bash", "-c", `cp ../model pfs/out/$(basename $data)`},
+			SetupCmd:    []string{"bash", "-c", `echo ready > model`},
+			TeardownCmd: []string{"bash", "-c", `echo done > $TEARDOWN_FILE`},
+			Env:         map[string]string{"TEARDOWN_FILE": teardownFile},
+		},
+		Input: client.NewPFSInput("data", "/*"),
+	}
+	result, err := Run(pachClient, request, &Options{OutputDir: outputDir})
+	require.NoError(t, err)
+	require.Equal(t, 0, result.Failed())
+	data, err := ioutil.ReadFile(filepath.Join(outputDir, "a"))
+	require.NoError(t, err)
+	require.Equal(t, "ready\n", string(data))
+	data, err = ioutil.ReadFile(teardownFile)
+	require.NoError(t, err)
+	require.Equal(t, "done\n", string(data))
+
+	// A failing setup stops the run
+	request.Transform.SetupCmd = []string{"false"}
+	_, err = Run(pachClient, request, &Options{OutputDir: filepath.Join(dir, "output2")})
+	require.YesError(t, err)
+}
+
+func TestChanges(t *testing.T) {
+	dir, err := ioutil.TempDir("", "local-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	inputDir, outputDir := filepath.Join(dir, "input"), filepath.Join(dir, "output")
+	for _, name := range []string{"a/1", "a/2"} {
+		path := filepath.Join(inputDir, "data", name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0777))
+		require.NoError(t, ioutil.WriteFile(path, []byte(name), 0666))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pachClient, err := ServeDir(ctx, inputDir)
+	require.NoError(t, err)
+	input := client.NewPFSInput("data", "/*")
+	input.Pfs.Changes = true
+	request := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline("changes"),
+		Transform: &pps.Transform{
+			// A local run has no earlier runs, so all files are added
+			Cmd: []string{"bash", "-c", `cp $data_CHANGES/added pfs/out/added && ls $PACH_PREVIOUS_OUTPUT > pfs/out/previous`},
+		},
+		Input: input,
+	}
+	result, err := Run(pachClient, request, &Options{OutputDir: outputDir})
+	require.NoError(t, err)
+	require.Equal(t, 0, result.Failed())
+	data, err := ioutil.ReadFile(filepath.Join(outputDir, "added"))
+	require.NoError(t, err)
+	require.Equal(t, "/a/1\n/a/2\n", string(data))
+	data, err = ioutil.ReadFile(filepath.Join(outputDir, "previous"))
+	require.NoError(t, err)
+	require.Equal(t, "", string(data))
+
+	// Output isn't appended to the output of an earlier run
+	_, err = Run(pachClient, request, &Options{OutputDir: outputDir})
+	require.YesError(t, err)
+}
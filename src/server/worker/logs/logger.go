@@ -33,8 +33,9 @@ type TaggedLogger interface {
 	// user code's subprocess stdout/stderr and still provide the same guarantees
 	io.Writer
 
-	// Logf logs to stdout and object storage (if stats are enabled), including
-	// metadata about the current pipeline and job
+	// Logf logs to stdout, object storage (if stats are enabled) and the
+	// pipeline's logs branch (if it persists logs), including metadata about
+	// the current pipeline and job
 	Logf(formatString string, args ...interface{})
 	// Errf logs only to stderr
 	Errf(formatString string, args ...interface{})
@@ -67,6 +68,9 @@ type taggedLogger struct {
 	msgCh        chan string
 	buffer       bytes.Buffer
 	eg           errgroup.Group
+
+	// Used for persisting log statements to the pipeline's logs branch
+	persister *persister
 }
 
 func newLogger(pipelineInfo *pps.PipelineInfo) *taggedLogger {
@@ -83,6 +87,7 @@ func newLogger(pipelineInfo *pps.PipelineInfo) *taggedLogger {
 		stderrLog: log.New(os.Stderr, "", log.LstdFlags|log.Llongfile),
 		marshaler: &jsonpb.Marshaler{},
 		msgCh:     make(chan string, logBuffer),
+		persister: getPersister(pipelineInfo),
 	}
 }
 
@@ -193,6 +198,7 @@ func (logger *taggedLogger) clone() *taggedLogger {
 		marshaler:    &jsonpb.Marshaler{},
 		putObjClient: logger.putObjClient,
 		msgCh:        logger.msgCh,
+		persister:    logger.persister,
 	}
 }
 
@@ -217,6 +223,11 @@ func (logger *taggedLogger) Logf(formatString string, args ...interface{}) {
 	if logger.putObjClient != nil {
 		logger.msgCh <- msg + "\n"
 	}
+	if logger.persister != nil {
+		if path := LogPath(&logger.template); path != "" {
+			logger.persister.add(path, msg+"\n")
+		}
+	}
 }
 
 // LogStep will log before and after the given callback function runs, using
@@ -237,6 +248,23 @@ func (logger *taggedLogger) LogStep(name string, cb func() error) (retErr error)
 // not go to a persistent log.
 func (logger *taggedLogger) Errf(formatString string, args ...interface{}) {
 	logger.stderrLog.Printf(formatString, args...)
+	// Errors are persisted along with the job's other messages
+	if logger.persister == nil {
+		return
+	}
+	msg := logger.template
+	msg.Message = strings.TrimSuffix(fmt.Sprintf(formatString, args...), "\n")
+	path := LogPath(&msg)
+	if path == "" {
+		return
+	}
+	var err error
+	if msg.Ts, err = types.TimestampProto(time.Now()); err != nil {
+		return
+	}
+	if line, err := logger.marshaler.MarshalToString(&msg); err == nil {
+		logger.persister.add(path, line+"\n")
+	}
 }
 
 // This is provided so that taggedLogger can be used as a io.Writer for stdout
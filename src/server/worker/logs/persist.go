@@ -0,0 +1,283 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+)
+
+const (
+	// persistInterval is how often buffered log messages are written to the
+	// logs branch
+	persistInterval = 10 * time.Second
+
+	// finalFlushTimeout bounds the flush done once the persister's context is
+	// done, which can't use that context
+	finalFlushTimeout = 30 * time.Second
+
+	// masterLogDir and workerLogDir hold the persisted messages of a job that
+	// were logged by the master, and by workers but not about a datum
+	masterLogDir = "master"
+	workerLogDir = "worker"
+)
+
+// persister buffers the log messages of a pipeline's jobs and periodically
+// appends them to the files in the pipeline's logs branch
+type persister struct {
+	pachClient *client.APIClient
+	repo       string
+	logger     TaggedLogger
+
+	flushMu sync.Mutex // serializes flushes, so messages are appended in order
+
+	mu      sync.Mutex
+	buffers map[string]*bytes.Buffer // keyed by path in the logs branch
+}
+
+var (
+	persistMu sync.Mutex
+	persist   *persister
+)
+
+// PersistLogs makes the loggers created for 'pipelineInfo' in this process
+// from now on also persist their jobs' messages to the pipeline's logs branch
+// (see ppsconsts.LogsBranch), until the context of 'pachClient' is done, at
+// which point the remaining messages are flushed. It does nothing if the
+// pipeline doesn't persist its logs.
+func PersistLogs(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) {
+	if pipelineInfo.PersistLogs == nil {
+		return
+	}
+	p := &persister{
+		pachClient: pachClient,
+		repo:       pipelineInfo.Pipeline.Name,
+		// Messages that aren't about a job aren't persisted, so reporting flush
+		// errors through this logger doesn't feed back into the persister
+		logger:  NewStatlessLogger(pipelineInfo),
+		buffers: make(map[string]*bytes.Buffer),
+	}
+	persistMu.Lock()
+	persist = p
+	persistMu.Unlock()
+	go func() {
+		ticker := time.NewTicker(persistInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.flushOrReport(p.pachClient)
+			case <-pachClient.Ctx().Done():
+				persistMu.Lock()
+				if persist == p {
+					persist = nil
+				}
+				persistMu.Unlock()
+				ctx, cancel := context.WithTimeout(context.Background(), finalFlushTimeout)
+				p.flushOrReport(p.pachClient.WithCtx(ctx))
+				cancel()
+				return
+			}
+		}
+	}()
+}
+
+// FlushLogs immediately writes the buffered messages of 'pipelineInfo' to its
+// logs branch, e.g. so that a job's logs are complete once it has finished.
+// Failures are reported through the worker's logger, and the messages are
+// retried on the next flush.
+func FlushLogs(pipelineInfo *pps.PipelineInfo) {
+	if p := getPersister(pipelineInfo); p != nil {
+		p.flushOrReport(p.pachClient)
+	}
+}
+
+// getPersister returns the persister that loggers for 'pipelineInfo' should
+// use, if any
+func getPersister(pipelineInfo *pps.PipelineInfo) *persister {
+	persistMu.Lock()
+	defer persistMu.Unlock()
+	if persist == nil || pipelineInfo == nil || persist.repo != pipelineInfo.Pipeline.Name {
+		return nil
+	}
+	return persist
+}
+
+// LogPath returns the path in the logs branch that 'msg' is persisted to, or
+// "" if it isn't about a job
+func LogPath(msg *pps.LogMessage) string {
+	if msg.JobID == "" {
+		return ""
+	}
+	dir := workerLogDir
+	switch {
+	case msg.Master:
+		dir = masterLogDir
+	case msg.DatumID != "":
+		dir = msg.DatumID
+	}
+	worker := msg.WorkerID
+	if worker == "" {
+		worker = "unknown"
+	}
+	return path.Join("/", msg.JobID, dir, worker)
+}
+
+// add buffers 'line', a marshalled log message, to be appended to 'path'
+func (p *persister) add(path string, line string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	buf, ok := p.buffers[path]
+	if !ok {
+		buf = &bytes.Buffer{}
+		p.buffers[path] = buf
+	}
+	buf.WriteString(line)
+}
+
+// flushOrReport flushes the buffered messages using 'pachClient', reporting
+// any error through the persister's logger
+func (p *persister) flushOrReport(pachClient *client.APIClient) {
+	if err := p.flush(pachClient); err != nil {
+		p.logger.Errf("could not persist logs: %v", err)
+	}
+}
+
+// flush appends the buffered messages to their files, in a single commit. If
+// that fails, the messages are put back in front of those buffered since, to
+// be retried by the next flush.
+func (p *persister) flush(pachClient *client.APIClient) (retErr error) {
+	p.flushMu.Lock()
+	defer p.flushMu.Unlock()
+	p.mu.Lock()
+	buffers := p.buffers
+	p.buffers = make(map[string]*bytes.Buffer)
+	p.mu.Unlock()
+	if len(buffers) == 0 {
+		return nil
+	}
+	defer func() {
+		if retErr != nil {
+			p.requeue(buffers)
+		}
+	}()
+	pfc, err := pachClient.NewPutFileClient()
+	if err != nil {
+		return err
+	}
+	for path, buf := range buffers {
+		// PutFile drains the buffer it reads from, so give it a reader over the
+		// contents instead, leaving them to be requeued if the flush fails
+		if _, err := pfc.PutFile(p.repo, ppsconsts.LogsBranch, path, bytes.NewReader(buf.Bytes())); err != nil {
+			pfc.Close()
+			return err
+		}
+	}
+	return pfc.Close()
+}
+
+// requeue puts 'buffers', which a failed flush took, back in front of the
+// messages buffered since
+func (p *persister) requeue(buffers map[string]*bytes.Buffer) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for path, buf := range buffers {
+		if newer, ok := p.buffers[path]; ok {
+			buf.Write(newer.Bytes())
+		}
+		p.buffers[path] = buf
+	}
+}
+
+// PruneLogs deletes the persisted logs of the jobs of 'pipelineInfo' that
+// finished longer ago than its log retention, or that no longer exist. As
+// the logs branch's older commits still hold the deleted logs (and each flush
+// adds a commit), it also deletes every commit of the branch before its head,
+// so that the pruned logs can be garbage collected.
+func PruneLogs(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) error {
+	if pipelineInfo.PersistLogs == nil || pipelineInfo.PersistLogs.Retention == nil {
+		return nil
+	}
+	retention, err := types.DurationFromProto(pipelineInfo.PersistLogs.Retention)
+	if err != nil {
+		return err
+	}
+	repo := pipelineInfo.Pipeline.Name
+	fileInfos, err := pachClient.ListFile(repo, ppsconsts.LogsBranch, "/")
+	if err != nil {
+		if errutil.IsNotFoundError(err) || pfsserver.IsNoHeadErr(err) {
+			return nil // no logs have been persisted yet
+		}
+		return err
+	}
+	var expired []string
+	for _, fi := range fileInfos {
+		jobInfo, err := pachClient.InspectJob(path.Base(fi.File.Path), false)
+		if err != nil {
+			if !errutil.IsNotFoundError(err) {
+				return err
+			}
+			expired = append(expired, fi.File.Path)
+			continue
+		}
+		if jobInfo.Finished == nil {
+			continue
+		}
+		finished, err := types.TimestampFromProto(jobInfo.Finished)
+		if err != nil {
+			return err
+		}
+		if time.Since(finished) > retention {
+			expired = append(expired, fi.File.Path)
+		}
+	}
+	if len(expired) > 0 {
+		pfc, err := pachClient.NewPutFileClient()
+		if err != nil {
+			return err
+		}
+		for _, p := range expired {
+			if err := pfc.DeleteFile(repo, ppsconsts.LogsBranch, p); err != nil {
+				pfc.Close()
+				return err
+			}
+		}
+		if err := pfc.Close(); err != nil {
+			return err
+		}
+	}
+	return deleteOldLogCommits(pachClient, repo)
+}
+
+// deleteOldLogCommits deletes the commits of the logs branch of 'repo' before
+// its head. Commits that flushes add in the meantime become children of the
+// head, so they're unaffected. If a flush is writing to the head, nothing is
+// deleted, as finishing the head's commit needs its parent.
+func deleteOldLogCommits(pachClient *client.APIClient, repo string) error {
+	head, err := pachClient.InspectCommit(repo, ppsconsts.LogsBranch)
+	if err != nil {
+		return err
+	}
+	if head.Finished == nil || head.ParentCommit == nil {
+		return nil
+	}
+	commitInfos, err := pachClient.ListCommit(repo, head.ParentCommit.ID, "", 0)
+	if err != nil {
+		return err
+	}
+	for _, ci := range commitInfos {
+		if err := pachClient.DeleteCommit(repo, ci.Commit.ID); err != nil && !errutil.IsNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
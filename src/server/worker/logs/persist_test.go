@@ -0,0 +1,103 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+)
+
+func TestLogPath(t *testing.T) {
+	require.Equal(t, "", LogPath(&pps.LogMessage{WorkerID: "w"}))
+	require.Equal(t, "/j/master/w", LogPath(&pps.LogMessage{JobID: "j", WorkerID: "w", Master: true}))
+	require.Equal(t, "/j/worker/w", LogPath(&pps.LogMessage{JobID: "j", WorkerID: "w"}))
+	require.Equal(t, "/j/d/w", LogPath(&pps.LogMessage{JobID: "j", DatumID: "d", WorkerID: "w", User: true}))
+	require.Equal(t, "/j/worker/unknown", LogPath(&pps.LogMessage{JobID: "j"}))
+}
+
+func TestLoggerPersists(t *testing.T) {
+	p := &persister{repo: "pipeline", buffers: make(map[string]*bytes.Buffer)}
+	logger := newLogger(nil)
+	logger.template.WorkerID = "w"
+	logger.persister = p
+
+	logger.Logf("not about a job")
+	jobLogger := logger.WithJob("j")
+	jobLogger.Logf("first")
+	jobLogger.Logf("second")
+	jobLogger.Errf("third\n")
+	require.Equal(t, 1, len(p.buffers))
+
+	var messages []string
+	for _, line := range strings.Split(strings.TrimSpace(p.buffers["/j/worker/w"].String()), "\n") {
+		msg := &pps.LogMessage{}
+		require.NoError(t, jsonpb.UnmarshalString(line, msg))
+		require.Equal(t, "j", msg.JobID)
+		messages = append(messages, msg.Message)
+	}
+	require.Equal(t, []string{"first", "second", "third"}, messages)
+}
+
+func TestRequeueKeepsOrder(t *testing.T) {
+	p := &persister{repo: "pipeline", buffers: make(map[string]*bytes.Buffer)}
+	p.add("/j/worker/w", "first\n")
+	p.add("/j/master/w", "master\n")
+	failed := p.buffers
+	p.buffers = make(map[string]*bytes.Buffer)
+	p.add("/j/worker/w", "second\n")
+
+	p.requeue(failed)
+	require.Equal(t, 2, len(p.buffers))
+	require.Equal(t, "first\nsecond\n", p.buffers["/j/worker/w"].String())
+	require.Equal(t, "master\n", p.buffers["/j/master/w"].String())
+}
+
+func TestPruneLogs(t *testing.T) {
+	pipelineInfo := &pps.PipelineInfo{
+		Pipeline:    client.NewPipeline("pipeline"),
+		PersistLogs: &pps.LogPersistence{Retention: types.DurationProto(time.Nanosecond)},
+	}
+	require.NoError(t, testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		require.NoError(t, c.CreateRepo("pipeline"))
+		env.MockPachd.PPS.InspectJob.Use(func(_ context.Context, request *pps.InspectJobRequest) (*pps.JobInfo, error) {
+			switch request.Job.ID {
+			case "expired":
+				return &pps.JobInfo{Finished: types.TimestampNow()}, nil
+			case "running":
+				return &pps.JobInfo{}, nil
+			}
+			return nil, errors.Errorf("job %s not found", request.Job.ID)
+		})
+		for _, job := range []string{"expired", "running", "deleted"} {
+			_, err := c.PutFile("pipeline", ppsconsts.LogsBranch, "/"+job+"/worker/w", strings.NewReader("msg\n"))
+			require.NoError(t, err)
+		}
+
+		// The logs of expired and deleted jobs are deleted, and so are the
+		// commits that still held them
+		require.NoError(t, PruneLogs(c, pipelineInfo))
+		fileInfos, err := c.ListFile("pipeline", ppsconsts.LogsBranch, "/")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(fileInfos))
+		require.Equal(t, "/running", fileInfos[0].File.Path)
+		commitInfos, err := c.ListCommit("pipeline", ppsconsts.LogsBranch, "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(commitInfos))
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile("pipeline", ppsconsts.LogsBranch, "/running/worker/w", 0, 0, &buf))
+		require.Equal(t, "msg\n", buf.String())
+		return nil
+	}))
+}
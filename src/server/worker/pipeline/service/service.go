@@ -88,7 +88,7 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 		logger = logger.WithData(inputs)
 
 		// TODO: do something with stats? - this isn't an output repo so there's nowhere to put them
-		_, err = driver.WithData(inputs, nil, logger, func(dir string, stats *pps.ProcessStats) error {
+		_, err = driver.WithData(inputs, nil, nil, logger, func(dir string, stats *pps.ProcessStats) error {
 			if err := driver.UpdateJobState(job.ID, pps.JobState_JOB_RUNNING, ""); err != nil {
 				logger.Logf("error updating job state: %+v", err)
 			}
@@ -29,7 +29,7 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 	})
 
 	// TODO: do something with stats?
-	_, err := driver.WithData(nil, nil, logger, func(dir string, stats *pps.ProcessStats) error {
+	_, err := driver.WithData(nil, nil, nil, logger, func(dir string, stats *pps.ProcessStats) error {
 		inputs := []*common.Input{} // Spouts take no inputs
 		return driver.WithActiveData(inputs, dir, func() error {
 			eg, serviceCtx := errgroup.WithContext(pachClient.Ctx())
@@ -20,7 +20,8 @@ type DatumHasher interface {
 
 // JobData is an interface which is used as a key to refer to a job within the
 // JobChain. It must provide a constructor for the datum iterator used by the
-// chain to produce the JobDatumIterator.
+// chain to produce the JobDatumIterator. If it also implements DatumHasher, the
+// job's datums are hashed with it rather than with the chain's hasher.
 type JobData interface {
 	// Iterator constructs the datum.Iterator associated with the job
 	Iterator() (datum.Iterator, error)
@@ -94,8 +95,9 @@ type JobChain interface {
 type DatumSet map[string]int64
 
 type jobDatumIterator struct {
-	data JobData
-	jc   *jobChain
+	data   JobData
+	jc     *jobChain
+	hasher DatumHasher
 
 	// TODO: lower memory consumption - all these datumsets might result in a
 	// really large memory footprint. See if we can do a streaming interface to
@@ -223,6 +225,7 @@ func (jc *jobChain) Start(jd JobData) (JobDatumIterator, error) {
 	jdi := &jobDatumIterator{
 		data:      jd,
 		jc:        jc,
+		hasher:    jobHasher(jd, jc.hasher),
 		yielding:  make(DatumSet),
 		yielded:   make(DatumSet),
 		allDatums: make(DatumSet),
@@ -235,7 +238,7 @@ func (jc *jobChain) Start(jd JobData) (JobDatumIterator, error) {
 	jdi.dit.Reset()
 	for jdi.dit.Next() {
 		inputs := jdi.dit.Datum()
-		hash := jc.hasher.Hash(inputs)
+		hash := jdi.hasher.Hash(inputs)
 		jdi.allDatums[hash]++
 	}
 	jdi.dit.Reset()
@@ -249,6 +252,15 @@ func (jc *jobChain) Start(jd JobData) (JobDatumIterator, error) {
 	return jdi, nil
 }
 
+// jobHasher returns the hasher for the datums of 'jd': 'jd' itself if it's a
+// DatumHasher, and otherwise 'hasher'
+func jobHasher(jd JobData, hasher DatumHasher) DatumHasher {
+	if h, ok := jd.(DatumHasher); ok {
+		return h
+	}
+	return hasher
+}
+
 func (jc *jobChain) indexOf(jd JobData) (int, error) {
 	for i, x := range jc.jobs {
 		if x.data == jd {
@@ -393,7 +405,7 @@ func (jdi *jobDatumIterator) NextDatum() ([]*common.Input, int64) {
 	jdi.ditIndex++
 	for jdi.ditIndex < jdi.dit.Len() {
 		inputs := jdi.dit.DatumN(jdi.ditIndex)
-		hash := jdi.jc.hasher.Hash(inputs)
+		hash := jdi.hasher.Hash(inputs)
 		if count, ok := jdi.yielding[hash]; ok {
 			if count == 1 {
 				delete(jdi.yielding, hash)
@@ -261,6 +261,34 @@ func TestAdditiveSubtractiveOnBase(t *testing.T) {
 	requireChainEmpty(t, chain, jobDatums)
 }
 
+type saltedTestJob struct {
+	testJob
+	salt string
+}
+
+func (tj *saltedTestJob) Hash(inputs []*common.Input) string {
+	return common.HashDatum("", tj.salt, inputs)
+}
+
+// A job with its own hasher doesn't skip the datums of jobs that were hashed
+// differently, and later jobs don't skip its datums
+func TestJobHasher(t *testing.T) {
+	jobDatums := []string{"a", "b"}
+	chain := newTestChain(t, jobDatums)
+	saltedJob := &saltedTestJob{testJob: testJob{dit: newTestIterator(jobDatums)}, salt: "run"}
+	jdi, err := chain.Start(saltedJob)
+	require.NoError(t, err)
+	requireIteratorContents(t, jdi, jobDatums)
+	require.NoError(t, chain.Succeed(saltedJob))
+
+	job := newTestJob(jobDatums)
+	jdi, err = chain.Start(job)
+	require.NoError(t, err)
+	requireIteratorContents(t, jdi, jobDatums)
+	require.NoError(t, chain.Succeed(job))
+	requireChainEmpty(t, chain, jobDatums)
+}
+
 // Read from a channel until we have the expected datums, then verify they
 // are correct, then make sure the channel doesn't have anything else.
 func requireDatums(t *testing.T, datumChan <-chan string, expected []string) {
@@ -33,10 +33,11 @@ func (jc *noSkipJobChain) Start(jd JobData) (JobDatumIterator, error) {
 	}
 
 	allDatums := make(DatumSet)
+	hasher := jobHasher(jd, jc.hasher)
 
 	dit.Reset()
 	for dit.Next() {
-		allDatums[jc.hasher.Hash(dit.Datum())]++
+		allDatums[hasher.Hash(dit.Datum())]++
 	}
 	dit.Reset()
 
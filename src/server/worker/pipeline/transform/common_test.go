@@ -69,6 +69,9 @@ func (td *testDriver) Jobs() col.Collection {
 func (td *testDriver) Pipelines() col.Collection {
 	return td.inner.Pipelines()
 }
+func (td *testDriver) Memos() col.Collection {
+	return td.inner.Memos()
+}
 func (td *testDriver) NewTaskWorker() *work.Worker {
 	return td.inner.NewTaskWorker()
 }
@@ -96,14 +99,14 @@ func (td *testDriver) NumShards() int64 {
 func (td *testDriver) WithContext(ctx context.Context) driver.Driver {
 	return &testDriver{td.inner.WithContext(ctx)}
 }
-func (td *testDriver) WithData(inputs []*common.Input, tree *hashtree.Ordered, logger logs.TaggedLogger, cb func(string, *pps.ProcessStats) error) (*pps.ProcessStats, error) {
-	return td.inner.WithData(inputs, tree, logger, cb)
+func (td *testDriver) WithData(inputs []*common.Input, parameters *pps.RunParameters, tree *hashtree.Ordered, logger logs.TaggedLogger, cb func(string, *pps.ProcessStats) error) (*pps.ProcessStats, error) {
+	return td.inner.WithData(inputs, parameters, tree, logger, cb)
 }
 func (td *testDriver) WithActiveData(inputs []*common.Input, dir string, cb func() error) error {
 	return td.inner.WithActiveData(inputs, dir, cb)
 }
-func (td *testDriver) UserCodeEnv(job string, commit *pfs.Commit, inputs []*common.Input) []string {
-	return td.inner.UserCodeEnv(job, commit, inputs)
+func (td *testDriver) UserCodeEnv(job string, commit *pfs.Commit, inputs []*common.Input, parameters *pps.RunParameters) []string {
+	return td.inner.UserCodeEnv(job, commit, inputs, parameters)
 }
 func (td *testDriver) RunUserCode(logger logs.TaggedLogger, env []string, stats *pps.ProcessStats, d *types.Duration) error {
 	return td.inner.RunUserCode(logger, env, stats, d)
@@ -111,6 +114,12 @@ func (td *testDriver) RunUserCode(logger logs.TaggedLogger, env []string, stats
 func (td *testDriver) RunUserErrorHandlingCode(logger logs.TaggedLogger, env []string, stats *pps.ProcessStats, d *types.Duration) error {
 	return td.inner.RunUserErrorHandlingCode(logger, env, stats, d)
 }
+func (td *testDriver) RunUserSetupCode(logger logs.TaggedLogger) error {
+	return td.inner.RunUserSetupCode(logger)
+}
+func (td *testDriver) RunUserTeardownCode(logger logs.TaggedLogger) error {
+	return td.inner.RunUserTeardownCode(logger)
+}
 func (td *testDriver) DeleteJob(stm col.STM, ji *pps.EtcdJobInfo) error {
 	return td.inner.DeleteJob(stm, ji)
 }
@@ -120,6 +129,12 @@ func (td *testDriver) UpdateJobState(job string, state pps.JobState, reason stri
 func (td *testDriver) UploadOutput(dir string, tag string, logger logs.TaggedLogger, input []*common.Input, stats *pps.ProcessStats, tree *hashtree.Ordered) ([]byte, error) {
 	return td.inner.UploadOutput(dir, tag, logger, input, stats, tree)
 }
+func (td *testDriver) MemoKey(inputs []*common.Input) string {
+	return td.inner.MemoKey(inputs)
+}
+func (td *testDriver) WriteRecord(outputCommit *pfs.Commit, inputs []*common.Input, parameters *pps.RunParameters, tag string) error {
+	return td.inner.WriteRecord(outputCommit, inputs, parameters, tag)
+}
 func (td *testDriver) ReportUploadStats(t time.Time, stats *pps.ProcessStats, logger logs.TaggedLogger) {
 	td.inner.ReportUploadStats(t, stats, logger)
 }
@@ -0,0 +1,129 @@
+package transform
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/worker/driver"
+	"github.com/pachyderm/pachyderm/src/server/worker/logs"
+)
+
+// reuseMemoizedDatum looks up the datum with memoization key 'key' in the
+// cluster-wide memoization cache. If it's there, its output hashtree is
+// tagged with 'tag' (so that later jobs of this pipeline skip the datum
+// without consulting the cache) and returned. reuseMemoizedDatum returns nil
+// if the datum isn't in the cache.
+func reuseMemoizedDatum(
+	driver driver.Driver,
+	logger logs.TaggedLogger,
+	key string,
+	tag string,
+) ([]byte, error) {
+	pachClient := driver.PachClient()
+	memoInfo := &pps.MemoInfo{}
+	if err := driver.Memos().ReadOnly(pachClient.Ctx()).Get(key, memoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err := pachClient.GetObject(memoInfo.Tree.Hash, buf); err != nil {
+		// The entry may have been evicted since we read it
+		logger.Logf("could not read memoized datum %s: %v", key, err)
+		return nil, nil
+	}
+	if err := pachClient.TagObject(memoInfo.Tree.Hash, tag); err != nil {
+		return nil, err
+	}
+	// Recording the use only affects eviction, so it's best-effort
+	if _, err := driver.NewSTM(func(stm col.STM) error {
+		return driver.Memos().ReadWrite(stm).Update(key, memoInfo, func() error {
+			memoInfo.LastUsed = types.TimestampNow()
+			memoInfo.Hits++
+			return nil
+		})
+	}); err != nil {
+		logger.Logf("could not record use of memoized datum %s: %v", key, err)
+	}
+	logger.Logf("reused output of memoized datum %s (from pipeline %s)", key, memoInfo.Pipeline.Name)
+	return buf.Bytes(), nil
+}
+
+// memoizeDatum adds the datum output 'datumHashtree' to the cluster-wide
+// memoization cache under 'key', unless another worker has already added it.
+// Memoization is an optimization, so errors are only logged.
+func memoizeDatum(
+	driver driver.Driver,
+	logger logs.TaggedLogger,
+	key string,
+	datumHashtree []byte,
+	sizeBytes uint64,
+) {
+	pachClient := driver.PachClient()
+	object, _, err := pachClient.PutObject(bytes.NewReader(datumHashtree))
+	if err != nil {
+		logger.Logf("could not memoize datum %s: %v", key, err)
+		return
+	}
+	now := types.TimestampNow()
+	var added bool
+	if _, err := driver.NewSTM(func(stm col.STM) error {
+		added = false
+		memos := driver.Memos().ReadWrite(stm)
+		if err := memos.Get(key, &pps.MemoInfo{}); err == nil {
+			return nil // already memoized
+		} else if !col.IsErrNotFound(err) {
+			return err
+		}
+		added = true
+		return memos.Put(key, &pps.MemoInfo{
+			Key:       key,
+			Pipeline:  driver.PipelineInfo().Pipeline,
+			Job:       client.NewJob(logger.JobID()),
+			Tree:      object,
+			SizeBytes: sizeBytes,
+			Created:   now,
+			LastUsed:  now,
+		})
+	}); err != nil {
+		logger.Logf("could not memoize datum %s: %v", key, err)
+		return
+	}
+	if !added {
+		return
+	}
+	// Only the worker whose entry won tags its tree, which keeps it from being
+	// garbage collected until the entry is evicted. Without the tag, the tree
+	// of the entry could be collected, so the entry is removed.
+	if err := pachClient.TagObject(object.Hash, ppsconsts.MemoTagPrefix+key); err != nil {
+		logger.Logf("could not memoize datum %s: %v", key, err)
+		if _, err := driver.NewSTM(func(stm col.STM) error {
+			return driver.Memos().ReadWrite(stm).Delete(key)
+		}); err != nil {
+			logger.Logf("could not remove memoized datum %s: %v", key, err)
+		}
+	}
+}
+
+// putMemoizedOutput adds the files in the memoized datum output
+// 'datumHashtree' to 'outputTree', the output tree of the datum's stats.
+func putMemoizedOutput(outputTree *hashtree.Ordered, datumHashtree []byte) error {
+	rs := []io.ReadCloser{ioutil.NopCloser(bytes.NewReader(datumHashtree))}
+	return hashtree.Walk(rs, "/", func(path string, node *hashtree.NodeProto) error {
+		if node.DirNode != nil {
+			outputTree.PutDir(path)
+		} else if node.FileNode != nil {
+			outputTree.PutFile(path, node.Hash, node.SubtreeSize, node.FileNode)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,71 @@
+package transform
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+)
+
+func serializeTree(t *testing.T, tree *hashtree.Ordered) []byte {
+	buf := &bytes.Buffer{}
+	require.NoError(t, tree.Serialize(buf))
+	return buf.Bytes()
+}
+
+func TestMemoizeDatum(t *testing.T) {
+	pi := defaultPipelineInfo()
+	pi.Memoize = true
+	require.NoError(t, withTestEnv(pi, func(env *testEnv) error {
+		c := env.PachClient
+		logger := env.logger.WithJob("job")
+		first := hashtree.NewOrdered("/")
+		first.PutFile("/file", []byte("foo"), 3, &hashtree.FileNodeProto{})
+		firstBytes := serializeTree(t, first)
+		second := hashtree.NewOrdered("/")
+		second.PutFile("/other", []byte("bar"), 3, &hashtree.FileNodeProto{})
+
+		// Nothing is reused before the datum is memoized
+		memoized, err := reuseMemoizedDatum(env.driver, logger, "key", "tag")
+		require.NoError(t, err)
+		require.Nil(t, memoized)
+
+		// Only the first worker to memoize a datum adds (and tags) its output
+		memoizeDatum(env.driver, logger, "key", firstBytes, 3)
+		memoizeDatum(env.driver, logger, "key", serializeTree(t, second), 3)
+		memoInfo := &pps.MemoInfo{}
+		require.NoError(t, env.driver.Memos().ReadOnly(c.Ctx()).Get("key", memoInfo))
+		buf := &bytes.Buffer{}
+		require.NoError(t, c.GetObject(memoInfo.Tree.Hash, buf))
+		require.Equal(t, firstBytes, buf.Bytes())
+		buf.Reset()
+		require.NoError(t, c.GetTag(ppsconsts.MemoTagPrefix+"key", buf))
+		require.Equal(t, firstBytes, buf.Bytes())
+
+		// Reusing the datum returns its output, and tags it for the pipeline
+		memoized, err = reuseMemoizedDatum(env.driver, logger, "key", "tag")
+		require.NoError(t, err)
+		require.Equal(t, firstBytes, memoized)
+		buf.Reset()
+		require.NoError(t, c.GetTag("tag", buf))
+		require.Equal(t, firstBytes, buf.Bytes())
+		require.NoError(t, env.driver.Memos().ReadOnly(c.Ctx()).Get("key", memoInfo))
+		require.Equal(t, uint64(1), memoInfo.Hits)
+
+		// The reused output is added to the stats of the datum
+		outputTree := hashtree.NewOrdered("/datum/pfs/out")
+		require.NoError(t, putMemoizedOutput(outputTree, memoized))
+		var paths []string
+		require.NoError(t, hashtree.Walk([]io.ReadCloser{ioutil.NopCloser(bytes.NewReader(serializeTree(t, outputTree)))}, "/datum/pfs/out", func(path string, node *hashtree.NodeProto) error {
+			paths = append(paths, path)
+			return nil
+		}))
+		require.Equal(t, []string{"/datum/pfs/out", "/datum/pfs/out/file"}, paths)
+		return nil
+	}))
+}
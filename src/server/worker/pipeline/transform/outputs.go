@@ -0,0 +1,198 @@
+package transform
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+)
+
+// isNamedOutputBranch returns true if 'branch' is the branch of one of the
+// pipeline's named outputs. Commits on these branches are created by
+// provenance alongside the pipeline's output commits, so they don't get jobs
+// of their own.
+func isNamedOutputBranch(pipelineInfo *pps.PipelineInfo, branch *pfs.Branch) bool {
+	if branch == nil {
+		return false
+	}
+	for _, output := range pipelineInfo.Outputs {
+		if output.Repo == branch.Repo.Name && output.Branch == branch.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// getOutputCommits returns the stats commit and the named output commits (keyed
+// by output name) that were created alongside the output commit 'commitInfo'
+func getOutputCommits(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, commitInfo *pfs.CommitInfo) (*pfs.Commit, map[string]*pfs.Commit, error) {
+	if len(pipelineInfo.Outputs) == 0 {
+		// The stats commit is the only commit in the output repo that's
+		// provenant on the output commit
+		for _, commitRange := range commitInfo.Subvenance {
+			if commitRange.Lower.Repo.Name == pipelineInfo.Pipeline.Name && commitRange.Upper.Repo.Name == pipelineInfo.Pipeline.Name {
+				return commitRange.Lower, nil, nil
+			}
+		}
+		return nil, nil, nil
+	}
+	// The names of the outputs, by "repo@branch"
+	names := make(map[string]string)
+	repos := map[string]bool{pipelineInfo.Pipeline.Name: true}
+	for _, output := range pipelineInfo.Outputs {
+		names[output.Repo+"@"+output.Branch] = output.Name
+		repos[output.Repo] = true
+	}
+	var statsCommit *pfs.Commit
+	namedCommits := make(map[string]*pfs.Commit)
+	for _, commitRange := range commitInfo.Subvenance {
+		// Skip the commits of downstream pipelines
+		if commitRange.Lower.Repo.Name != commitRange.Upper.Repo.Name || !repos[commitRange.Lower.Repo.Name] {
+			continue
+		}
+		ci, err := pachClient.InspectCommit(commitRange.Lower.Repo.Name, commitRange.Lower.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ci.Branch == nil {
+			continue
+		}
+		if ci.Branch.Repo.Name == pipelineInfo.Pipeline.Name && ci.Branch.Name == "stats" {
+			statsCommit = ci.Commit
+		} else if name, ok := names[ci.Branch.Repo.Name+"@"+ci.Branch.Name]; ok {
+			namedCommits[name] = ci.Commit
+		}
+	}
+	return statsCommit, namedCommits, nil
+}
+
+// namedOutputTree writes the hashtree of the named output 'name', which holds
+// the contents of "/<name>" in the output hashtrees 'trees', and returns it
+// along with the size of its files. The output commit keeps its copy, which
+// shares the same underlying objects.
+func namedOutputTree(pachClient *client.APIClient, trees []*pfs.Object, name string) (_ *pfs.Object, _ uint64, retErr error) {
+	var rs []io.ReadCloser
+	defer func() {
+		for _, r := range rs {
+			if err := r.Close(); err != nil && retErr == nil {
+				retErr = errors.EnsureStack(err)
+			}
+		}
+	}()
+	for _, tree := range trees {
+		r, err := pachClient.GetObjectReader(tree.Hash)
+		if err != nil {
+			return nil, 0, errors.EnsureStack(err)
+		}
+		rs = append(rs, r)
+	}
+	root := "/" + name
+	namedTree := hashtree.NewOrdered("/")
+	if err := hashtree.Walk(rs, root, func(path string, node *hashtree.NodeProto) error {
+		if path == root {
+			return nil
+		}
+		path = strings.TrimPrefix(path, root)
+		if node.DirNode != nil {
+			namedTree.PutDir(path)
+		} else if node.FileNode != nil {
+			namedTree.PutFile(path, node.Hash, node.SubtreeSize, node.FileNode)
+		}
+		return nil
+	}); err != nil {
+		return nil, 0, err
+	}
+	buf := &bytes.Buffer{}
+	if err := namedTree.Serialize(buf); err != nil {
+		return nil, 0, err
+	}
+	objW, err := pachClient.PutObjectAsync(nil)
+	if err != nil {
+		return nil, 0, errors.EnsureStack(err)
+	}
+	w := hashtree.NewWriter(objW)
+	if err := w.Copy(hashtree.NewReader(buf, nil)); err != nil {
+		objW.Close()
+		return nil, 0, err
+	}
+	if err := objW.Close(); err != nil {
+		return nil, 0, errors.EnsureStack(err)
+	}
+	tree, err := objW.Object()
+	if err != nil {
+		return nil, 0, errors.EnsureStack(err)
+	}
+	indexData, err := w.Index()
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := writeIndex(pachClient, tree, indexData); err != nil {
+		return nil, 0, err
+	}
+	return tree, w.Size(), nil
+}
+
+// namedOutputRequest returns the request that finishes the commit of the named
+// output 'name' with that output's contents in the output hashtrees 'trees',
+// or leaves it empty if 'trees' is nil (as for a job that didn't succeed)
+func namedOutputRequest(pachClient *client.APIClient, trees []*pfs.Object, name string, namedCommit *pfs.Commit) (*pfs.FinishCommitRequest, error) {
+	if trees == nil {
+		return &pfs.FinishCommitRequest{Commit: namedCommit, Empty: true}, nil
+	}
+	tree, size, err := namedOutputTree(pachClient, trees, name)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.FinishCommitRequest{
+		Commit:    namedCommit,
+		Trees:     []*pfs.Object{tree},
+		SizeBytes: size,
+	}, nil
+}
+
+// namedOutputRequests returns the requests that finish all of the named output
+// commits 'namedCommits' (keyed by output name), as namedOutputRequest does
+func namedOutputRequests(pachClient *client.APIClient, trees []*pfs.Object, namedCommits map[string]*pfs.Commit) ([]*pfs.FinishCommitRequest, error) {
+	var requests []*pfs.FinishCommitRequest
+	for name, namedCommit := range namedCommits {
+		request, err := namedOutputRequest(pachClient, trees, name, namedCommit)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not commit named output %q", name)
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+// finishNamedOutputs finishes those of the named output commits 'namedCommits'
+// (keyed by output name) that still exist and are open, as namedOutputRequest
+// does. It's used to bring the named outputs in line with an output commit that
+// was finished without them.
+func finishNamedOutputs(pachClient *client.APIClient, trees []*pfs.Object, namedCommits map[string]*pfs.Commit) error {
+	for name, namedCommit := range namedCommits {
+		ci, err := pachClient.InspectCommit(namedCommit.Repo.Name, namedCommit.ID)
+		if err != nil {
+			if pfsserver.IsCommitNotFoundErr(err) || pfsserver.IsCommitDeletedErr(err) {
+				continue
+			}
+			return err
+		}
+		if ci.Finished != nil {
+			continue
+		}
+		request, err := namedOutputRequest(pachClient, trees, name, namedCommit)
+		if err != nil {
+			return err
+		}
+		if _, err := pachClient.PfsAPIClient.FinishCommit(pachClient.Ctx(), request); err != nil && !pfsserver.IsCommitFinishedErr(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package transform
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs/server"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+)
+
+func TestNamedOutputs(t *testing.T) {
+	pi := defaultPipelineInfo()
+	pi.Outputs = []*pps.Output{
+		{Name: "metrics", Repo: pi.Pipeline.Name, Branch: "metrics"},
+		{Name: "models", Repo: "models", Branch: "master"},
+	}
+	require.NoError(t, testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		c := env.PachClient
+		// Set env vars that the object storage layer expects in the env, so the
+		// indexes of output hashtrees can be written
+		require.NoError(t, os.Setenv(obj.StorageBackendEnvVar, obj.Local))
+		require.NoError(t, os.MkdirAll(env.LocalStorageDirectory, 0777))
+		require.NoError(t, os.Setenv(pfsserver.PachRootEnvVar, env.LocalStorageDirectory))
+
+		// Set up the branches that CreatePipeline would, plus a downstream
+		// pipeline that takes one of the named outputs as an input
+		outputBranch := client.NewBranch(pi.Pipeline.Name, "master")
+		for _, repo := range []string{"inputRepo", pi.Pipeline.Name, "models", "downstream"} {
+			require.NoError(t, c.CreateRepo(repo))
+		}
+		for _, b := range []struct {
+			branch     *pfs.Branch
+			provenance *pfs.Branch
+		}{
+			{outputBranch, client.NewBranch("inputRepo", "master")},
+			{client.NewBranch(pi.Pipeline.Name, "stats"), outputBranch},
+			{client.NewBranch(pi.Pipeline.Name, "metrics"), outputBranch},
+			{client.NewBranch("models", "master"), outputBranch},
+			{client.NewBranch("downstream", "master"), client.NewBranch("models", "master")},
+		} {
+			require.NoError(t, c.CreateBranch(b.branch.Repo.Name, b.branch.Name, "", []*pfs.Branch{b.provenance}))
+		}
+		require.True(t, isNamedOutputBranch(pi, client.NewBranch("models", "master")))
+		require.True(t, isNamedOutputBranch(pi, client.NewBranch(pi.Pipeline.Name, "metrics")))
+		require.False(t, isNamedOutputBranch(pi, outputBranch))
+		require.False(t, isNamedOutputBranch(pi, client.NewBranch("downstream", "master")))
+
+		getOutputCommit := func() (*pfs.Commit, *pfs.Commit, map[string]*pfs.Commit) {
+			_, err := c.PutFile("inputRepo", "master", "file", strings.NewReader("foo"))
+			require.NoError(t, err)
+			ci, err := c.InspectCommit(pi.Pipeline.Name, "master")
+			require.NoError(t, err)
+			statsCommit, namedCommits, err := getOutputCommits(c, pi, ci)
+			require.NoError(t, err)
+			return ci.Commit, statsCommit, namedCommits
+		}
+
+		// Each output's directory in the output hashtrees is committed on its own
+		outputCommit, statsCommit, namedCommits := getOutputCommit()
+		require.NotNil(t, statsCommit)
+		require.Equal(t, "stats", mustInspectCommit(t, c, statsCommit).Branch.Name)
+		require.Equal(t, 2, len(namedCommits))
+		require.Equal(t, "metrics", mustInspectCommit(t, c, namedCommits["metrics"]).Branch.Name)
+		require.Equal(t, "models", namedCommits["models"].Repo.Name)
+		object, _, err := c.PutObject(strings.NewReader("bar"))
+		require.NoError(t, err)
+		objectInfo, err := c.InspectObject(object.Hash)
+		require.NoError(t, err)
+		bar := &hashtree.FileNodeProto{BlockRefs: []*pfs.BlockRef{objectInfo.BlockRef}}
+		outputTree := hashtree.NewOrdered("/")
+		outputTree.PutDir("/models")
+		outputTree.PutDir("/models/checkpoints")
+		outputTree.PutFile("/models/checkpoints/1", []byte("bar"), 3, bar)
+		outputTree.PutFile("/models/model", []byte("bar"), 3, bar)
+		outputTree.PutFile("/other", []byte("baz"), 3, bar)
+		trees := []*pfs.Object{putTree(t, c, outputTree)}
+		requests, err := namedOutputRequests(c, trees, namedCommits)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(requests))
+		for _, request := range requests {
+			require.False(t, request.Empty)
+			require.Equal(t, 1, len(request.Trees))
+			if request.Commit.Repo.Name == "models" {
+				require.Equal(t, uint64(6), request.SizeBytes)
+			} else {
+				require.Equal(t, uint64(0), request.SizeBytes)
+			}
+		}
+		require.NoError(t, finishNamedOutputs(c, trees, namedCommits))
+		// Already finished commits are skipped
+		require.NoError(t, finishNamedOutputs(c, trees, namedCommits))
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile("models", namedCommits["models"].ID, "/model", 0, 0, &buf))
+		require.Equal(t, "bar", buf.String())
+		buf.Reset()
+		require.NoError(t, c.GetFile("models", namedCommits["models"].ID, "/checkpoints/1", 0, 0, &buf))
+		require.Equal(t, "bar", buf.String())
+		for _, namedCommit := range namedCommits {
+			require.NotNil(t, mustInspectCommit(t, c, namedCommit).Finished)
+		}
+		fis, err := c.ListFile(pi.Pipeline.Name, namedCommits["metrics"].ID, "/")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(fis))
+		require.NoError(t, c.FinishCommit(pi.Pipeline.Name, outputCommit.ID))
+
+		// The named outputs of a job that didn't succeed are left empty
+		outputCommit, _, namedCommits = getOutputCommit()
+		requests, err = namedOutputRequests(c, nil, namedCommits)
+		require.NoError(t, err)
+		for _, request := range requests {
+			require.True(t, request.Empty)
+		}
+		require.NoError(t, c.FinishCommit(pi.Pipeline.Name, outputCommit.ID))
+		require.NoError(t, finishNamedOutputs(c, nil, namedCommits))
+		fis, err = c.ListFile("models", namedCommits["models"].ID, "/")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(fis))
+		return nil
+	}))
+}
+
+// putTree writes 'tree' as an output hashtree, as merge does
+func putTree(t *testing.T, c *client.APIClient, tree *hashtree.Ordered) *pfs.Object {
+	objW, err := c.PutObjectAsync(nil)
+	require.NoError(t, err)
+	w := hashtree.NewWriter(objW)
+	require.NoError(t, w.Copy(hashtree.NewReader(bytes.NewReader(serializeTree(t, tree)), nil)))
+	require.NoError(t, objW.Close())
+	object, err := objW.Object()
+	require.NoError(t, err)
+	indexData, err := w.Index()
+	require.NoError(t, err)
+	require.NoError(t, writeIndex(c, object, indexData))
+	return object
+}
+
+func mustInspectCommit(t *testing.T, c *client.APIClient, commit *pfs.Commit) *pfs.CommitInfo {
+	ci, err := c.InspectCommit(commit.Repo.Name, commit.ID)
+	require.NoError(t, err)
+	return ci
+}
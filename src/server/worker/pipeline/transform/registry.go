@@ -18,6 +18,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
@@ -26,6 +27,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 	"github.com/pachyderm/pachyderm/src/server/pkg/work"
 	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
 	"github.com/pachyderm/pachyderm/src/server/worker/common"
@@ -51,6 +53,10 @@ func jobArtifactRecoveredObject(jobID string) string {
 	return path.Join(jobArtifactPrefix(jobID), "recovered")
 }
 
+func jobArtifactPendingOutput(jobID string) string {
+	return path.Join(jobArtifactPrefix(jobID), "pending-output")
+}
+
 type pendingJob struct {
 	driver          driver.Driver
 	commitInfo      *pfs.CommitInfo
@@ -60,6 +66,9 @@ type pendingJob struct {
 	ji              *pps.JobInfo
 	jdit            chain.JobDatumIterator
 	taskMaster      *work.Master
+	// releaseSlot gives up the job's slot in the registry's limiter. It may be
+	// called more than once, but only releases the slot the first time.
+	releaseSlot func()
 
 	// These are filled in when the RUNNING phase completes, but may be re-fetched
 	// from object storage.
@@ -129,6 +138,20 @@ func finishJob(
 	jobInfo.State = state
 	jobInfo.Reason = reason
 
+	// Persist the job's logs so far, so they're complete once it's finished
+	logs.FlushLogs(pipelineInfo)
+
+	// The named outputs are finished in the same transaction as the output
+	// commit, so that no downstream job sees one without the others. If they
+	// can't be committed, the job fails and they're left empty.
+	namedRequests, err := namedOutputRequests(pachClient, trees, jobInfo.NamedOutputCommits)
+	if err != nil {
+		failNamedOutputs(jobInfo, err)
+		datums, trees, size = nil, nil, 0
+		if namedRequests, err = namedOutputRequests(pachClient, nil, jobInfo.NamedOutputCommits); err != nil {
+			return err
+		}
+	}
 	if _, err := pachClient.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
 		if pipelineInfo.S3Out {
 			if err := builder.FinishCommit(jobInfo.OutputCommit.Repo.Name, jobInfo.OutputCommit.ID); err != nil {
@@ -155,6 +178,12 @@ func finishJob(
 			}); err != nil {
 				return err
 			}
+
+			for _, request := range namedRequests {
+				if _, err := builder.PfsAPIClient.FinishCommit(pachClient.Ctx(), request); err != nil {
+					return err
+				}
+			}
 		}
 
 		return writeJobInfo(&builder.APIClient, jobInfo)
@@ -172,6 +201,20 @@ func finishJob(
 	return nil
 }
 
+// failNamedOutputs fails the job 'jobInfo' (if it would otherwise succeed)
+// because its named outputs couldn't be committed
+func failNamedOutputs(jobInfo *pps.JobInfo, err error) {
+	reason := fmt.Sprintf("could not commit named outputs: %v", err)
+	if jobInfo.State == pps.JobState_JOB_SUCCESS {
+		jobInfo.State = pps.JobState_JOB_FAILURE
+		jobInfo.Reason = reason
+	} else if jobInfo.Reason == "" {
+		jobInfo.Reason = reason
+	} else {
+		jobInfo.Reason += "; " + reason
+	}
+}
+
 // recoverFinishedJob performs job and output commit updates outside of a
 // transaction in an attempt to get everything in a consistent state if they
 // were modified non-transactionally elsewhere.
@@ -218,6 +261,12 @@ func recoverFinishedJob(
 		}
 	}
 
+	if err := finishNamedOutputs(pachClient, trees, jobInfo.NamedOutputCommits); err != nil {
+		failNamedOutputs(jobInfo, err)
+		if err := finishNamedOutputs(pachClient, nil, jobInfo.NamedOutputCommits); err != nil {
+			return err
+		}
+	}
 	if err := writeJobInfo(pachClient, jobInfo); err != nil {
 		if !ppsserver.IsJobFinishedErr(err) {
 			return err
@@ -227,7 +276,8 @@ func recoverFinishedJob(
 }
 
 // succeedJob will move a job to the successful state and propagate to any
-// dependent jobs in the jobChain.
+// dependent jobs in the jobChain, or, if the pipeline requires approval, hold
+// the job in the awaiting approval state.
 func (reg *registry) succeedJob(
 	pj *pendingJob,
 	trees []*pfs.Object,
@@ -240,6 +290,22 @@ func (reg *registry) succeedJob(
 		return err
 	}
 
+	output := &PendingOutput{
+		Datums:         datums,
+		Trees:          trees,
+		SizeBytes:      size,
+		StatsTrees:     statsTrees,
+		StatsSizeBytes: statsSize,
+	}
+	if reg.driver.PipelineInfo().Approval != nil {
+		return reg.awaitApproval(pj, output)
+	}
+	return reg.finishSucceededJob(pj, output, "")
+}
+
+// finishSucceededJob finishes the output commits of a successful job with
+// 'output', and moves the job to the successful (or egressing) state.
+func (reg *registry) finishSucceededJob(pj *pendingJob, output *PendingOutput, reason string) error {
 	var newState pps.JobState
 	if pj.ji.Egress == nil {
 		pj.logger.Logf("job successful, closing commits")
@@ -250,7 +316,8 @@ func (reg *registry) succeedJob(
 	}
 
 	// Use the registry's driver so that the job's supervision goroutine cannot cancel us
-	if err := finishJob(reg.driver.PipelineInfo(), reg.driver.PachClient(), pj.ji, newState, "", datums, trees, size, statsTrees, statsSize); err != nil {
+	if err := finishJob(reg.driver.PipelineInfo(), reg.driver.PachClient(), pj.ji, newState, reason,
+		output.Datums, output.Trees, output.SizeBytes, output.StatsTrees, output.StatsSizeBytes); err != nil {
 		return err
 	}
 
@@ -426,7 +493,7 @@ func (reg *registry) sendDatumTasks(ctx context.Context, pj *pendingJob, numDatu
 			return err
 		}
 
-		taskData, err := serializeDatumData(&DatumData{DatumsObject: objectName, OutputCommit: pj.ji.OutputCommit, JobID: pj.ji.Job.ID})
+		taskData, err := serializeDatumData(&DatumData{DatumsObject: objectName, OutputCommit: pj.ji.OutputCommit, JobID: pj.ji.Job.ID, Parameters: pj.ji.Parameters})
 		if err != nil {
 			return err
 		}
@@ -576,6 +643,7 @@ func (reg *registry) getParentCommitInfo(commitInfo *pfs.CommitInfo) (*pfs.Commi
 func (reg *registry) ensureJob(
 	commitInfo *pfs.CommitInfo,
 	statsCommit *pfs.Commit,
+	namedCommits map[string]*pfs.Commit,
 ) (*pps.JobInfo, error) {
 	pachClient := reg.driver.PachClient()
 
@@ -587,9 +655,14 @@ func (reg *registry) ensureJob(
 	if len(jobInfos) > 1 {
 		return nil, errors.Errorf("multiple jobs found for commit: %s/%s", commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
 	} else if len(jobInfos) < 1 {
-		job, err := pachClient.CreateJob(reg.driver.PipelineInfo().Pipeline.Name, commitInfo.Commit, statsCommit)
+		job, err := pachClient.PpsAPIClient.CreateJob(pachClient.Ctx(), &pps.CreateJobRequest{
+			Pipeline:           reg.driver.PipelineInfo().Pipeline,
+			OutputCommit:       commitInfo.Commit,
+			StatsCommit:        statsCommit,
+			NamedOutputCommits: namedCommits,
+		})
 		if err != nil {
-			return nil, err
+			return nil, grpcutil.ScrubGRPC(err)
 		}
 		reg.logger.Logf("created new job %q for output commit %q", job.ID, commitInfo.Commit.ID)
 		// get jobInfo to look up spec commit, pipeline version, etc (if this
@@ -604,7 +677,7 @@ func (reg *registry) ensureJob(
 	return pachClient.InspectJob(jobInfos[0].Job.ID, false)
 }
 
-func (reg *registry) startJob(commitInfo *pfs.CommitInfo, statsCommit *pfs.Commit) error {
+func (reg *registry) startJob(commitInfo *pfs.CommitInfo, statsCommit *pfs.Commit, namedCommits map[string]*pfs.Commit) error {
 	if err := reg.initializeJobChain(commitInfo); err != nil {
 		return err
 	}
@@ -619,7 +692,7 @@ func (reg *registry) startJob(commitInfo *pfs.CommitInfo, statsCommit *pfs.Commi
 		}
 	}()
 
-	jobInfo, err := reg.ensureJob(commitInfo, statsCommit)
+	jobInfo, err := reg.ensureJob(commitInfo, statsCommit, namedCommits)
 	if err != nil {
 		return err
 	}
@@ -645,6 +718,8 @@ func (reg *registry) startJob(commitInfo *pfs.CommitInfo, statsCommit *pfs.Commi
 		ji:              jobInfo,
 		cancel:          cancel,
 	}
+	var releaseOnce sync.Once
+	pj.releaseSlot = func() { releaseOnce.Do(reg.limiter.Release) }
 
 	switch {
 	case ppsutil.IsTerminal(jobInfo.State):
@@ -813,7 +888,7 @@ func (reg *registry) startJob(commitInfo *pfs.CommitInfo, statsCommit *pfs.Commi
 	})
 
 	go func() {
-		defer reg.limiter.Release()
+		defer pj.releaseSlot()
 
 		// Make sure the job has been removed from the job chain, ignore any errors
 		defer reg.jobChain.Fail(pj)
@@ -894,6 +969,10 @@ func (reg *registry) processJob(pj *pendingJob) error {
 		return pj.logger.LogStep("merging job hashtrees", func() error {
 			return reg.processJobMerging(pj)
 		})
+	case state == pps.JobState_JOB_AWAITING_APPROVAL:
+		return pj.logger.LogStep("waiting for job approval", func() error {
+			return reg.processJobAwaitingApproval(pj)
+		})
 	case state == pps.JobState_JOB_EGRESSING:
 		return errutil.ErrBreak
 	}
@@ -931,6 +1010,12 @@ func (reg *registry) processJobStarting(pj *pendingJob) error {
 	return nil
 }
 
+// Hash fulfills the chain.DatumHasher interface for pendingJob, so that the
+// datums of jobs run with parameters are hashed with the job's salt
+func (pj *pendingJob) Hash(inputs []*common.Input) string {
+	return common.HashDatum(pj.driver.PipelineInfo().Pipeline.Name, ppsutil.JobSalt(pj.driver.PipelineInfo().Salt, pj.ji.Parameters), inputs)
+}
+
 // Iterator fulfills the chain.JobData interface for pendingJob
 func (pj *pendingJob) Iterator() (datum.Iterator, error) {
 	var dit datum.Iterator
@@ -1366,6 +1451,139 @@ func (reg *registry) processJobEgress(pj *pendingJob) error {
 	return pj.writeJobInfo()
 }
 
+// awaitApproval holds the output commits of a successful job open, storing
+// 'output' until the job is approved. The job stays in the job chain, so later
+// jobs that depend on its datums wait behind it.
+func (reg *registry) awaitApproval(pj *pendingJob, output *PendingOutput) error {
+	pj.logger.Logf("job successful, awaiting approval")
+	if err := pj.storePendingOutput(output); err != nil {
+		return err
+	}
+	pj.ji.State = pps.JobState_JOB_AWAITING_APPROVAL
+	pj.ji.Reason = ""
+	return pj.writeJobInfo()
+}
+
+func (reg *registry) processJobAwaitingApproval(pj *pendingJob) error {
+	output, err := pj.loadPendingOutput()
+	if err != nil {
+		return err
+	}
+
+	// The job doesn't need its slot in the limiter while it waits, which could
+	// take a long time, so other jobs can run in the meantime. It doesn't take
+	// the slot back afterwards: later jobs that took it may be waiting on this
+	// job in the job chain, so waiting for the slot could deadlock.
+	pj.releaseSlot()
+	approval, err := reg.waitForApproval(pj)
+	if err != nil {
+		return err
+	}
+	if approval == nil {
+		return reg.failJob(pj, "approval timed out", output.StatsTrees, output.StatsSizeBytes)
+	}
+	if !approval.Approved {
+		return reg.failJob(pj, ppsutil.ApprovalReason(approval), output.StatsTrees, output.StatsSizeBytes)
+	}
+
+	// If the job was picked up after a restart, its datums haven't been
+	// iterated, which the job chain requires before it can succeed.
+	for {
+		numDatums, err := pj.jdit.NextBatch(pj.driver.PachClient().Ctx())
+		if err != nil {
+			return err
+		}
+		if numDatums == 0 {
+			break
+		}
+		for i := int64(0); i < numDatums; i++ {
+			pj.jdit.NextDatum()
+		}
+	}
+	return reg.finishSucceededJob(pj, output, ppsutil.ApprovalReason(approval))
+}
+
+// waitForApproval blocks until the job is approved or rejected, and returns
+// the approval, or nil if the pipeline's approval timeout passes first.
+func (reg *registry) waitForApproval(pj *pendingJob) (*pps.JobApproval, error) {
+	ctx := pj.driver.PachClient().Ctx()
+	if timeout := reg.driver.PipelineInfo().Approval.Timeout; timeout != nil {
+		d, err := types.DurationFromProto(timeout)
+		if err != nil {
+			return nil, err
+		}
+		since := ppsutil.AwaitingApprovalSince(pj.ji.Transitions)
+		if since.IsZero() {
+			since = time.Now()
+		}
+		pj.logger.Logf("rejecting job if it isn't approved by %v", since.Add(d))
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, since.Add(d))
+		defer cancel()
+	}
+
+	var approval *pps.JobApproval
+	if err := pj.driver.Jobs().ReadOnly(ctx).WatchOneF(pj.ji.Job.ID, func(e *watch.Event) error {
+		if e.Type != watch.EventPut {
+			return nil // superviseJob handles deleted jobs
+		}
+		var jobID string
+		jobPtr := &pps.EtcdJobInfo{}
+		if err := e.Unmarshal(&jobID, jobPtr); err != nil {
+			return err
+		}
+		if jobPtr.Approval != nil {
+			approval = jobPtr.Approval
+			return errutil.ErrBreak
+		}
+		return nil
+	}); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && pj.driver.PachClient().Ctx().Err() == nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if approval == nil {
+		return nil, errors.New("job watch ended before the job was approved")
+	}
+	pj.logger.Logf("job %s", ppsutil.ApprovalReason(approval))
+	return approval, nil
+}
+
+func (pj *pendingJob) storePendingOutput(output *PendingOutput) (retErr error) {
+	writer, err := pj.driver.PachClient().DirectObjWriter(jobArtifactPendingOutput(pj.ji.Job.ID))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := writer.Close(); err != nil && retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+
+	pbw := pbutil.NewWriter(writer)
+	_, err = pbw.Write(output)
+	return err
+}
+
+func (pj *pendingJob) loadPendingOutput() (_ *PendingOutput, retErr error) {
+	reader, err := pj.driver.PachClient().DirectObjReader(jobArtifactPendingOutput(pj.ji.Job.ID))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := reader.Close(); err != nil && retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+
+	output := &PendingOutput{}
+	if err := pbutil.NewReader(reader).Read(output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
 func failedInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) ([]string, error) {
 	var failed []string
 	var vistErr error
@@ -1396,6 +1614,12 @@ func failedInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) ([]string,
 		if input.Git != nil && input.Git.Commit != "" {
 			blockCommit(input.Git.Name, client.NewCommit(input.Git.Name, input.Git.Commit))
 		}
+		if input.Window != nil && input.Window.Commit != "" {
+			blockCommit(input.Window.Name, client.NewCommit(input.Window.Repo, input.Window.Commit))
+		}
+		if input.ObjectStore != nil && input.ObjectStore.Commit != "" {
+			blockCommit(input.ObjectStore.Name, client.NewCommit(input.ObjectStore.Repo, input.ObjectStore.Commit))
+		}
 	})
 	return failed, vistErr
 }
@@ -15,20 +15,11 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/worker/logs"
 )
 
-func getStatsCommit(pipelineInfo *pps.PipelineInfo, commitInfo *pfs.CommitInfo) *pfs.Commit {
-	for _, commitRange := range commitInfo.Subvenance {
-		if commitRange.Lower.Repo.Name == pipelineInfo.Pipeline.Name && commitRange.Upper.Repo.Name == pipelineInfo.Pipeline.Name {
-			return commitRange.Lower
-		}
-	}
-	return nil
-}
-
 // forEachCommit listens for each READY output commit in the pipeline, and calls
 // the given callback once for each such commit, synchronously.
 func forEachCommit(
 	driver driver.Driver,
-	cb func(*pfs.CommitInfo, *pfs.Commit) error,
+	cb func(*pfs.CommitInfo, *pfs.Commit, map[string]*pfs.Commit) error,
 ) error {
 	pachClient := driver.PachClient()
 	pi := driver.PipelineInfo()
@@ -40,7 +31,15 @@ func forEachCommit(
 		"",
 		pfs.CommitState_READY,
 		func(ci *pfs.CommitInfo) error {
-			statsCommit := getStatsCommit(pi, ci)
+			// Commits on the named output branches are created alongside the
+			// output commits, and are finished by the output commits' jobs
+			if isNamedOutputBranch(pi, ci.Branch) {
+				return nil
+			}
+			statsCommit, namedCommits, err := getOutputCommits(pachClient, pi, ci)
+			if err != nil {
+				return err
+			}
 			// TODO: ensure ci and statsCommit are in a consistent state
 			if ci.Finished == nil {
 				// Inspect the commit and check again if it has been finished (it may have
@@ -48,7 +47,7 @@ func forEachCommit(
 				if ci, err := pachClient.InspectCommit(ci.Commit.Repo.Name, ci.Commit.ID); err != nil {
 					return err
 				} else if ci.Finished == nil {
-					return cb(ci, statsCommit)
+					return cb(ci, statsCommit, namedCommits)
 				} else {
 					// Make sure the stats commit has been finished as the output commit has.
 					if statsCommit != nil {
@@ -59,6 +58,10 @@ func forEachCommit(
 							return err
 						}
 					}
+					// Likewise for the named output commits
+					if err := finishNamedOutputs(pachClient, ci.Trees, namedCommits); err != nil {
+						return err
+					}
 
 					// Make sure that the job has been correctly finished as the commit(s) have.
 					ji, err := pachClient.InspectJobOutputCommit(ci.Commit.Repo.Name, ci.Commit.ID, false)
@@ -101,7 +104,7 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 	// TODO: goroutine linearly waiting on jobs in the registry and cleaning up
 	// after them, bubbling up errors, canceling
 
-	return forEachCommit(driver, func(commitInfo *pfs.CommitInfo, statsCommit *pfs.Commit) error {
-		return reg.startJob(commitInfo, statsCommit)
+	return forEachCommit(driver, func(commitInfo *pfs.CommitInfo, statsCommit *pfs.Commit, namedCommits map[string]*pfs.Commit) error {
+		return reg.startJob(commitInfo, statsCommit, namedCommits)
 	})
 }
@@ -439,9 +439,10 @@ func (m *DatumStats) GetFailedDatumID() string {
 
 type DatumData struct {
 	// Inputs
-	JobID        string      `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	DatumsObject string      `protobuf:"bytes,8,opt,name=datums_object,json=datumsObject,proto3" json:"datums_object,omitempty"`
-	OutputCommit *pfs.Commit `protobuf:"bytes,3,opt,name=output_commit,json=outputCommit,proto3" json:"output_commit,omitempty"`
+	JobID        string             `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	DatumsObject string             `protobuf:"bytes,8,opt,name=datums_object,json=datumsObject,proto3" json:"datums_object,omitempty"`
+	OutputCommit *pfs.Commit        `protobuf:"bytes,3,opt,name=output_commit,json=outputCommit,proto3" json:"output_commit,omitempty"`
+	Parameters   *pps.RunParameters `protobuf:"bytes,9,opt,name=parameters,proto3" json:"parameters,omitempty"`
 	// Outputs
 	Stats                 *DatumStats   `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
 	ChunkHashtree         *HashtreeInfo `protobuf:"bytes,5,opt,name=chunk_hashtree,json=chunkHashtree,proto3" json:"chunk_hashtree,omitempty"`
@@ -506,6 +507,13 @@ func (m *DatumData) GetOutputCommit() *pfs.Commit {
 	return nil
 }
 
+func (m *DatumData) GetParameters() *pps.RunParameters {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
 func (m *DatumData) GetStats() *DatumStats {
 	if m != nil {
 		return m.Stats
@@ -631,6 +639,89 @@ func (m *MergeData) GetTreeSize() uint64 {
 	return 0
 }
 
+// PendingOutput is the message contained in the object generated by the
+// registry when a job of a pipeline that requires approval moves to the
+// 'awaiting approval' state. It holds the outputs that the job's commits are
+// finished with if the job is approved.
+type PendingOutput struct {
+	Datums               *pfs.Object   `protobuf:"bytes,1,opt,name=datums,proto3" json:"datums,omitempty"`
+	Trees                []*pfs.Object `protobuf:"bytes,2,rep,name=trees,proto3" json:"trees,omitempty"`
+	SizeBytes            uint64        `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	StatsTrees           []*pfs.Object `protobuf:"bytes,4,rep,name=stats_trees,json=statsTrees,proto3" json:"stats_trees,omitempty"`
+	StatsSizeBytes       uint64        `protobuf:"varint,5,opt,name=stats_size_bytes,json=statsSizeBytes,proto3" json:"stats_size_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PendingOutput) Reset()         { *m = PendingOutput{} }
+func (m *PendingOutput) String() string { return proto.CompactTextString(m) }
+func (*PendingOutput) ProtoMessage()    {}
+func (*PendingOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21583a759eb7fa97, []int{9}
+}
+func (m *PendingOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOutput.Merge(m, src)
+}
+func (m *PendingOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingOutput proto.InternalMessageInfo
+
+func (m *PendingOutput) GetDatums() *pfs.Object {
+	if m != nil {
+		return m.Datums
+	}
+	return nil
+}
+
+func (m *PendingOutput) GetTrees() []*pfs.Object {
+	if m != nil {
+		return m.Trees
+	}
+	return nil
+}
+
+func (m *PendingOutput) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *PendingOutput) GetStatsTrees() []*pfs.Object {
+	if m != nil {
+		return m.StatsTrees
+	}
+	return nil
+}
+
+func (m *PendingOutput) GetStatsSizeBytes() uint64 {
+	if m != nil {
+		return m.StatsSizeBytes
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DatumInputs)(nil), "pachyderm.worker.pipeline.transform.DatumInputs")
 	proto.RegisterType((*DatumInputsList)(nil), "pachyderm.worker.pipeline.transform.DatumInputsList")
@@ -641,6 +732,7 @@ func init() {
 	proto.RegisterType((*DatumStats)(nil), "pachyderm.worker.pipeline.transform.DatumStats")
 	proto.RegisterType((*DatumData)(nil), "pachyderm.worker.pipeline.transform.DatumData")
 	proto.RegisterType((*MergeData)(nil), "pachyderm.worker.pipeline.transform.MergeData")
+	proto.RegisterType((*PendingOutput)(nil), "pachyderm.worker.pipeline.transform.PendingOutput")
 }
 
 func init() {
@@ -648,55 +740,61 @@ func init() {
 }
 
 var fileDescriptor_21583a759eb7fa97 = []byte{
-	// 760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xd1, 0x4e, 0xdb, 0x4a,
-	0x10, 0x55, 0x12, 0x62, 0xf0, 0x26, 0x26, 0x97, 0x15, 0xdc, 0x1b, 0x71, 0x25, 0xc2, 0x35, 0x42,
-	0x02, 0xe9, 0xca, 0x86, 0x5c, 0x09, 0xe9, 0xbe, 0x42, 0x5a, 0x11, 0xd4, 0x0a, 0xea, 0xbc, 0x54,
-	0xed, 0x83, 0xe5, 0xd8, 0x9b, 0xd8, 0x84, 0x78, 0xad, 0x5d, 0x87, 0xb6, 0xfc, 0x43, 0x3f, 0xa2,
-	0x5f, 0xd1, 0x5f, 0xe8, 0x63, 0xbf, 0x00, 0x55, 0xf9, 0x92, 0x6a, 0x67, 0xd6, 0xc1, 0xe9, 0x4b,
-	0x23, 0x1e, 0x2c, 0xef, 0x9c, 0x3d, 0x73, 0x66, 0x76, 0x66, 0xc7, 0x26, 0x27, 0x92, 0x89, 0x7b,
-	0x26, 0xdc, 0x0f, 0x5c, 0x4c, 0x98, 0x70, 0xb3, 0x24, 0x63, 0x77, 0x49, 0xca, 0xdc, 0x5c, 0x04,
-	0xa9, 0x1c, 0x71, 0x31, 0x7d, 0x5a, 0x39, 0x99, 0xe0, 0x39, 0xa7, 0x07, 0x59, 0x10, 0xc6, 0x9f,
-	0x22, 0x26, 0xa6, 0x0e, 0x3a, 0x39, 0x85, 0x93, 0xb3, 0xa0, 0xee, 0x6e, 0x8f, 0xf9, 0x98, 0x03,
-	0xdf, 0x55, 0x2b, 0x74, 0xdd, 0xdd, 0x0e, 0xef, 0x12, 0x96, 0xe6, 0x6e, 0x36, 0x92, 0xea, 0xf9,
-	0x15, 0xcd, 0xa4, 0x7a, 0x34, 0xfa, 0xcf, 0x72, 0x62, 0x21, 0x9f, 0x4e, 0x79, 0xaa, 0x5f, 0x48,
-	0xb1, 0xaf, 0x48, 0xa3, 0x17, 0xe4, 0xb3, 0x69, 0x3f, 0xcd, 0x66, 0xb9, 0xa4, 0x87, 0xc4, 0x48,
-	0x60, 0xd5, 0xae, 0xec, 0xd7, 0x8e, 0x1a, 0x5d, 0xcb, 0xd1, 0x6c, 0xd8, 0xf7, 0xf4, 0x26, 0xdd,
-	0x26, 0xf5, 0x24, 0x8d, 0xd8, 0xc7, 0x76, 0x75, 0xbf, 0x72, 0x54, 0xf3, 0xd0, 0xb0, 0xdf, 0x93,
-	0x56, 0x49, 0xeb, 0x55, 0x22, 0x73, 0x7a, 0x49, 0x8c, 0x48, 0x41, 0x85, 0xde, 0x89, 0xb3, 0xc2,
-	0xc9, 0x9d, 0x92, 0x8a, 0xa7, 0xfd, 0x95, 0xf8, 0x65, 0x20, 0xe3, 0x5c, 0x30, 0x76, 0x3d, 0xbc,
-	0x65, 0x61, 0x2e, 0xe9, 0x01, 0xb1, 0xc2, 0x78, 0x96, 0x4e, 0x7c, 0x8e, 0x00, 0xc4, 0x30, 0xbd,
-	0x26, 0x80, 0x25, 0x92, 0xcc, 0x83, 0x5c, 0x2e, 0x48, 0x55, 0x24, 0x01, 0xa8, 0x49, 0xf6, 0x31,
-	0x69, 0x79, 0x2c, 0xe4, 0xf7, 0x4c, 0xb0, 0x08, 0x82, 0x4b, 0xfa, 0x27, 0x31, 0xe2, 0x40, 0xc6,
-	0xac, 0x50, 0xd5, 0x96, 0x7d, 0x4a, 0x76, 0x96, 0xa9, 0x45, 0xa0, 0x36, 0x59, 0x5f, 0xce, 0xa3,
-	0x30, 0xed, 0x94, 0x34, 0x8b, 0xd4, 0xfb, 0xe9, 0x88, 0x2b, 0x66, 0x10, 0x45, 0x82, 0x49, 0xc5,
-	0xac, 0x28, 0xa6, 0x36, 0xe9, 0xbf, 0x84, 0xc8, 0xd9, 0x30, 0x0f, 0xe4, 0xc4, 0x4f, 0x22, 0x28,
-	0xae, 0x79, 0x6e, 0xcd, 0x1f, 0x3b, 0xe6, 0x00, 0xd1, 0x7e, 0xcf, 0x33, 0x35, 0xa1, 0x1f, 0xa9,
-	0x14, 0x31, 0x44, 0xbb, 0x06, 0x32, 0xda, 0xb2, 0xbf, 0x54, 0x09, 0x81, 0xd4, 0x06, 0xea, 0x8c,
-	0xf4, 0x8c, 0x58, 0x99, 0xe0, 0x21, 0x93, 0xd2, 0x87, 0x43, 0x43, 0xd0, 0x46, 0x77, 0xcb, 0x51,
-	0x17, 0xe5, 0x06, 0x77, 0x80, 0xe9, 0x35, 0xb3, 0x92, 0x45, 0x8f, 0xc9, 0x1f, 0x58, 0x7b, 0x5f,
-	0xc3, 0x2c, 0xd2, 0xfd, 0x6e, 0x21, 0x7e, 0x53, 0xc0, 0xf4, 0x90, 0x6c, 0x6a, 0xaa, 0x9c, 0x24,
-	0x59, 0xc6, 0x22, 0xc8, 0xa8, 0xe6, 0x59, 0x88, 0x0e, 0x10, 0x54, 0xbd, 0xd0, 0xb4, 0x51, 0x90,
-	0xdc, 0xb1, 0xa8, 0x5d, 0x07, 0x56, 0x13, 0xc1, 0x97, 0x80, 0x95, 0xc2, 0x8a, 0xa2, 0xce, 0x6d,
-	0xa3, 0x1c, 0x76, 0x51, 0x7e, 0xfa, 0x3f, 0x69, 0xa1, 0x90, 0x0f, 0x3b, 0xaa, 0x66, 0x1b, 0x50,
-	0xb3, 0xad, 0xf9, 0x63, 0xc7, 0x42, 0x3d, 0xbc, 0x4b, 0x3d, 0xcf, 0x1a, 0x95, 0xcc, 0xc8, 0xfe,
-	0x5a, 0x23, 0x26, 0xac, 0x7b, 0x41, 0x1e, 0xd0, 0x7d, 0x62, 0xdc, 0xf2, 0xa1, 0xf2, 0x87, 0x86,
-	0x9c, 0x9b, 0xf3, 0xc7, 0x4e, 0xfd, 0x8a, 0x0f, 0xfb, 0x3d, 0xaf, 0x7e, 0xcb, 0x87, 0xfd, 0x72,
-	0xea, 0xba, 0xe4, 0x10, 0xa8, 0x48, 0x1d, 0xef, 0x00, 0x3d, 0x21, 0x16, 0x9f, 0xe5, 0xd9, 0x2c,
-	0xf7, 0xd5, 0xd4, 0x24, 0xd8, 0x97, 0x46, 0xb7, 0xe1, 0xa8, 0x41, 0xbd, 0x00, 0xc8, 0x6b, 0x22,
-	0x03, 0x2d, 0xfa, 0x82, 0xd4, 0xb1, 0x27, 0x6b, 0xc0, 0x74, 0x57, 0x1f, 0x0f, 0xec, 0x18, 0x7a,
-	0xd3, 0xb7, 0x64, 0x13, 0x27, 0x21, 0xd6, 0xf7, 0x0c, 0x2a, 0xdb, 0xe8, 0x9e, 0xae, 0xa4, 0x57,
-	0xbe, 0x9c, 0x1e, 0x8e, 0x54, 0x01, 0x29, 0x65, 0x1c, 0x9f, 0x85, 0xb2, 0xf1, 0x6c, 0x65, 0x10,
-	0x5a, 0x28, 0x9f, 0x91, 0xbf, 0x16, 0x0d, 0xf6, 0x97, 0x6b, 0xbb, 0x0e, 0xb5, 0xdd, 0x11, 0xcb,
-	0x23, 0x89, 0x45, 0xb6, 0x3f, 0x57, 0x89, 0xf9, 0x9a, 0x89, 0x31, 0x5b, 0xb1, 0x73, 0xd7, 0xc4,
-	0x2c, 0x72, 0xc7, 0xe1, 0x7f, 0x56, 0xf2, 0x4f, 0x1a, 0xf4, 0x80, 0x18, 0x59, 0x20, 0x58, 0xba,
-	0xdc, 0x5e, 0xcc, 0xce, 0xd3, 0x5b, 0xea, 0x0b, 0x29, 0xe3, 0x40, 0x44, 0xd0, 0xd8, 0x9a, 0x87,
-	0x06, 0xa0, 0xd0, 0x6e, 0xd5, 0x9e, 0x8d, 0xa2, 0x7b, 0x1d, 0xb2, 0x56, 0xaa, 0xec, 0x92, 0x1c,
-	0x6c, 0xd0, 0xbf, 0x89, 0xa9, 0xde, 0xbe, 0x4c, 0x1e, 0x18, 0x14, 0x67, 0xcd, 0xdb, 0x50, 0xc0,
-	0x20, 0x79, 0x60, 0xe7, 0x6f, 0xbe, 0xcd, 0xf7, 0x2a, 0xdf, 0xe7, 0x7b, 0x95, 0x1f, 0xf3, 0xbd,
-	0xca, 0xbb, 0x8b, 0x71, 0x92, 0xc7, 0xb3, 0xa1, 0xfa, 0x6c, 0xbb, 0x8b, 0x43, 0x96, 0x56, 0x52,
-	0x84, 0xee, 0xef, 0x7e, 0x57, 0x43, 0x03, 0xfe, 0x0d, 0xff, 0xfd, 0x0c, 0x00, 0x00, 0xff, 0xff,
-	0x6f, 0x96, 0xe4, 0xe7, 0xd9, 0x06, 0x00, 0x00,
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x86, 0xac, 0x9f, 0x98, 0x23, 0x31, 0x4e, 0x16, 0x4e, 0x2b, 0xa4, 0xa8, 0xed, 0xd0, 0x08,
+	0xe0, 0x00, 0x01, 0xe5, 0xa8, 0x40, 0x80, 0x5e, 0x1d, 0xb5, 0x88, 0x82, 0x16, 0x76, 0x57, 0x3d,
+	0x14, 0xed, 0x81, 0xa0, 0xc4, 0x95, 0x44, 0xdb, 0xe2, 0x12, 0xbb, 0xcb, 0xb4, 0xc9, 0x3b, 0xf4,
+	0x21, 0xfa, 0x36, 0x3d, 0x15, 0x7d, 0x02, 0xa3, 0xd0, 0xb5, 0x2f, 0x51, 0xec, 0xcc, 0x92, 0x21,
+	0x9b, 0x43, 0x0c, 0x1f, 0x04, 0xed, 0x7e, 0xf3, 0xed, 0x37, 0xc3, 0xf9, 0x66, 0x49, 0x38, 0xd5,
+	0x42, 0xbd, 0x15, 0x6a, 0xf4, 0xab, 0x54, 0x57, 0x42, 0x8d, 0xf2, 0x34, 0x17, 0xd7, 0x69, 0x26,
+	0x46, 0x46, 0xc5, 0x99, 0x5e, 0x4a, 0xb5, 0xf9, 0xb0, 0x0a, 0x73, 0x25, 0x8d, 0x64, 0xc7, 0x79,
+	0xbc, 0x58, 0xbf, 0x4b, 0x84, 0xda, 0x84, 0x74, 0x28, 0x2c, 0x0f, 0x85, 0x15, 0xf5, 0xf1, 0xfe,
+	0x4a, 0xae, 0x24, 0xf2, 0x47, 0x76, 0x45, 0x47, 0x1f, 0xef, 0x2f, 0xae, 0x53, 0x91, 0x99, 0x51,
+	0xbe, 0xd4, 0xf6, 0xf7, 0x7f, 0x34, 0xd7, 0xf6, 0xe7, 0xd0, 0x27, 0xcd, 0xc2, 0x16, 0x72, 0xb3,
+	0x91, 0x99, 0xfb, 0x23, 0x4a, 0xf0, 0x06, 0xfa, 0x93, 0xd8, 0x14, 0x9b, 0x69, 0x96, 0x17, 0x46,
+	0xb3, 0xa7, 0xd0, 0x4b, 0x71, 0x35, 0x6c, 0x1d, 0xb5, 0x4f, 0xfa, 0x63, 0x3f, 0x74, 0x6c, 0x8c,
+	0x73, 0x17, 0x64, 0xfb, 0xd0, 0x4d, 0xb3, 0x44, 0xfc, 0x36, 0xdc, 0x39, 0x6a, 0x9d, 0xb4, 0x39,
+	0x6d, 0x82, 0x5f, 0x60, 0xaf, 0xa6, 0xf5, 0x5d, 0xaa, 0x0d, 0x7b, 0x0d, 0xbd, 0xc4, 0x42, 0xa5,
+	0xde, 0x69, 0x78, 0x8b, 0x27, 0x0f, 0x6b, 0x2a, 0xdc, 0x9d, 0xb7, 0xe2, 0xaf, 0x63, 0xbd, 0x36,
+	0x4a, 0x88, 0xf3, 0xf9, 0xa5, 0x58, 0x18, 0xcd, 0x8e, 0xc1, 0x5f, 0xac, 0x8b, 0xec, 0x2a, 0x92,
+	0x04, 0x60, 0x0e, 0x8f, 0x0f, 0x10, 0xac, 0x91, 0xb4, 0x89, 0x8d, 0xae, 0x48, 0x3b, 0x44, 0x42,
+	0xd0, 0x91, 0x82, 0x67, 0xb0, 0xc7, 0xc5, 0x42, 0xbe, 0x15, 0x4a, 0x24, 0x98, 0x5c, 0xb3, 0xcf,
+	0xa0, 0xb7, 0x8e, 0xf5, 0x5a, 0x94, 0xaa, 0x6e, 0x17, 0xbc, 0x80, 0x47, 0x4d, 0x6a, 0x99, 0x68,
+	0x08, 0xf7, 0x9a, 0x75, 0x94, 0xdb, 0x20, 0x83, 0x41, 0x59, 0xfa, 0x34, 0x5b, 0x4a, 0xcb, 0x8c,
+	0x93, 0x44, 0x09, 0x6d, 0x99, 0x2d, 0xcb, 0x74, 0x5b, 0xf6, 0x1c, 0x40, 0x17, 0x73, 0x13, 0xeb,
+	0xab, 0x28, 0x4d, 0xb0, 0xb9, 0xde, 0x99, 0xbf, 0xbd, 0x39, 0xf4, 0x66, 0x84, 0x4e, 0x27, 0xdc,
+	0x73, 0x84, 0x69, 0x62, 0x4b, 0xa4, 0x14, 0xc3, 0x36, 0xca, 0xb8, 0x5d, 0xf0, 0xc7, 0x0e, 0x00,
+	0x96, 0x36, 0xb3, 0xcf, 0xc8, 0x5e, 0x82, 0x9f, 0x2b, 0xb9, 0x10, 0x5a, 0x47, 0xf8, 0xd0, 0x98,
+	0xb4, 0x3f, 0x7e, 0x18, 0xda, 0x41, 0xb9, 0xa0, 0x08, 0x32, 0xf9, 0x20, 0xaf, 0xed, 0xd8, 0x33,
+	0x78, 0x40, 0xbd, 0x8f, 0x1c, 0x2c, 0x12, 0xe7, 0xf7, 0x1e, 0xe1, 0x17, 0x25, 0xcc, 0x9e, 0xc2,
+	0x7d, 0x47, 0xd5, 0x57, 0x69, 0x9e, 0x8b, 0x04, 0x2b, 0x6a, 0x73, 0x9f, 0xd0, 0x19, 0x81, 0xd6,
+	0x0b, 0x47, 0x5b, 0xc6, 0xe9, 0xb5, 0x48, 0x86, 0x5d, 0x64, 0x0d, 0x08, 0xfc, 0x16, 0xb1, 0x5a,
+	0x5a, 0x55, 0xf6, 0x79, 0xd8, 0xab, 0xa7, 0xad, 0xda, 0xcf, 0xbe, 0x86, 0x3d, 0x12, 0x8a, 0x30,
+	0x62, 0x7b, 0xb6, 0x8b, 0x3d, 0x7b, 0xb8, 0xbd, 0x39, 0xf4, 0x49, 0x8f, 0x66, 0x69, 0xc2, 0xfd,
+	0x65, 0x6d, 0x9b, 0x04, 0xff, 0xb6, 0xc1, 0xc3, 0xf5, 0x24, 0x36, 0x31, 0x3b, 0x82, 0xde, 0xa5,
+	0x9c, 0xdb, 0xf3, 0x68, 0xc8, 0x99, 0xb7, 0xbd, 0x39, 0xec, 0xbe, 0x91, 0xf3, 0xe9, 0x84, 0x77,
+	0x2f, 0xe5, 0x7c, 0x5a, 0x2f, 0xdd, 0xb5, 0x1c, 0x13, 0x95, 0xa5, 0xd3, 0x0c, 0xb0, 0x53, 0xf0,
+	0x65, 0x61, 0xf2, 0xc2, 0x44, 0xf6, 0xd6, 0xa4, 0xe4, 0x4b, 0x7f, 0xdc, 0x0f, 0xed, 0x45, 0x7d,
+	0x85, 0x10, 0x1f, 0x10, 0x83, 0x76, 0x6c, 0x0c, 0x90, 0xc7, 0x2a, 0xde, 0x08, 0x23, 0x94, 0x1e,
+	0x7a, 0x48, 0x67, 0x68, 0x0c, 0x2f, 0xb2, 0x8b, 0x2a, 0xc2, 0x6b, 0x2c, 0xf6, 0x0d, 0x74, 0xc9,
+	0xc7, 0x0e, 0xd2, 0x47, 0xb7, 0xbf, 0x52, 0xe4, 0x32, 0x9d, 0x66, 0x3f, 0xc1, 0x7d, 0xba, 0x3d,
+	0x6b, 0x37, 0x9b, 0xe8, 0x46, 0x7f, 0xfc, 0xe2, 0x56, 0x7a, 0xf5, 0x81, 0xe6, 0x74, 0x0d, 0x4b,
+	0xc8, 0x2a, 0xd3, 0x95, 0xab, 0x94, 0x7b, 0x77, 0x56, 0x46, 0xa1, 0x4a, 0xf9, 0x25, 0x7c, 0x5e,
+	0x0d, 0x45, 0xd4, 0xf4, 0xe3, 0x1e, 0xfa, 0xf1, 0x48, 0x35, 0xaf, 0x31, 0x19, 0x13, 0xfc, 0xbe,
+	0x03, 0xde, 0xf7, 0x42, 0xad, 0xc4, 0x2d, 0xdd, 0x3e, 0x07, 0xaf, 0xac, 0x9d, 0x5e, 0x18, 0x77,
+	0x2a, 0xfe, 0x83, 0x06, 0x3b, 0x86, 0x5e, 0x1e, 0x2b, 0x91, 0x35, 0x47, 0x82, 0xaa, 0xe3, 0x2e,
+	0x64, 0xdf, 0xaa, 0x7a, 0x1d, 0xab, 0x04, 0x8d, 0x6d, 0x73, 0xda, 0x20, 0x8a, 0x76, 0x5b, 0x7b,
+	0x76, 0x4b, 0xf7, 0x0e, 0xa1, 0x53, 0xeb, 0x6c, 0x43, 0x0e, 0x03, 0xec, 0x0b, 0xf0, 0xec, 0x7f,
+	0xa4, 0xd3, 0xf7, 0x02, 0x9b, 0xd3, 0xe1, 0xbb, 0x16, 0x98, 0xa5, 0xef, 0x45, 0xf0, 0x57, 0x0b,
+	0xfc, 0x0b, 0x91, 0x25, 0x69, 0xb6, 0x3a, 0xc7, 0x71, 0xb4, 0x05, 0x56, 0x2f, 0xea, 0x8f, 0x0b,
+	0xa4, 0x10, 0x7b, 0x02, 0xdd, 0x7a, 0x4b, 0x1a, 0x1c, 0x8a, 0xb0, 0x2f, 0x01, 0x6c, 0xc6, 0x68,
+	0xfe, 0xce, 0x08, 0x8d, 0x0f, 0xdb, 0xe1, 0x9e, 0x45, 0xce, 0x2c, 0xc0, 0x9e, 0x43, 0x9f, 0x46,
+	0x83, 0x74, 0x3a, 0x1f, 0xeb, 0x00, 0xc6, 0x7f, 0x44, 0xb1, 0x13, 0x78, 0x40, 0xec, 0x9a, 0x64,
+	0x17, 0x25, 0x69, 0xc0, 0x66, 0xa5, 0xee, 0xd9, 0x0f, 0x7f, 0x6e, 0x0f, 0x5a, 0x7f, 0x6f, 0x0f,
+	0x5a, 0xff, 0x6c, 0x0f, 0x5a, 0x3f, 0xbf, 0x5a, 0xa5, 0x66, 0x5d, 0xcc, 0xed, 0xb7, 0x6b, 0x54,
+	0xb9, 0x56, 0x5b, 0x69, 0xb5, 0x18, 0x7d, 0xea, 0x9b, 0x3d, 0xef, 0xe1, 0x07, 0xf2, 0xab, 0xff,
+	0x06, 0x00, 0x04, 0x57, 0x7e, 0x7a, 0xde, 0x07, 0x00, 0x00,
 }
 
 func (m *DatumInputs) Marshal() (dAtA []byte, err error) {
@@ -1041,6 +1139,18 @@ func (m *DatumData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Parameters != nil {
+		{
+			size, err := m.Parameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransform(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DatumsObject) > 0 {
 		i -= len(m.DatumsObject)
 		copy(dAtA[i:], m.DatumsObject)
@@ -1205,6 +1315,83 @@ func (m *MergeData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StatsSizeBytes != 0 {
+		i = encodeVarintTransform(dAtA, i, uint64(m.StatsSizeBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.StatsTrees) > 0 {
+		for iNdEx := len(m.StatsTrees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StatsTrees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransform(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintTransform(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Trees) > 0 {
+		for iNdEx := len(m.Trees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransform(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Datums != nil {
+		{
+			size, err := m.Datums.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransform(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTransform(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTransform(v)
 	base := offset
@@ -1405,6 +1592,10 @@ func (m *DatumData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTransform(uint64(l))
 	}
+	if m.Parameters != nil {
+		l = m.Parameters.Size()
+		n += 1 + l + sovTransform(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1450,6 +1641,40 @@ func (m *MergeData) Size() (n int) {
 	return n
 }
 
+func (m *PendingOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Datums != nil {
+		l = m.Datums.Size()
+		n += 1 + l + sovTransform(uint64(l))
+	}
+	if len(m.Trees) > 0 {
+		for _, e := range m.Trees {
+			l = e.Size()
+			n += 1 + l + sovTransform(uint64(l))
+		}
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovTransform(uint64(m.SizeBytes))
+	}
+	if len(m.StatsTrees) > 0 {
+		for _, e := range m.StatsTrees {
+			l = e.Size()
+			n += 1 + l + sovTransform(uint64(l))
+		}
+	}
+	if m.StatsSizeBytes != 0 {
+		n += 1 + sovTransform(uint64(m.StatsSizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovTransform(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2558,6 +2783,42 @@ func (m *DatumData) Unmarshal(dAtA []byte) error {
 			}
 			m.DatumsObject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransform
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransform
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parameters == nil {
+				m.Parameters = &pps.RunParameters{}
+			}
+			if err := m.Parameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransform(dAtA[iNdEx:])
@@ -2833,6 +3094,202 @@ func (m *MergeData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransform
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransform
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransform
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datums == nil {
+				m.Datums = &pfs.Object{}
+			}
+			if err := m.Datums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransform
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransform
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trees = append(m.Trees, &pfs.Object{})
+			if err := m.Trees[len(m.Trees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsTrees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransform
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransform
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatsTrees = append(m.StatsTrees, &pfs.Object{})
+			if err := m.StatsTrees[len(m.StatsTrees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsSizeBytes", wireType)
+			}
+			m.StatsSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatsSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransform(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransform
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTransform
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTransform(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -308,7 +308,7 @@ func handleDatumTask(driver driver.Driver, logger logs.TaggedLogger, data *Datum
 						logger = logger.WithJob(jobID).WithData(inputs)
 
 						// subStats is still valid even on an error, merge those in before proceeding
-						subStats, subRecovered, err := processDatum(driver, logger, index, inputs, data.OutputCommit, datumCache, statsCache, status)
+						subStats, subRecovered, err := processDatum(driver, logger, index, inputs, data.OutputCommit, data.Parameters, datumCache, statsCache, status)
 
 						statsMutex.Lock()
 						defer statsMutex.Unlock()
@@ -381,13 +381,14 @@ func processDatum(
 	datumIndex int64,
 	inputs []*common.Input,
 	outputCommit *pfs.Commit,
+	parameters *pps.RunParameters,
 	datumCache *hashtree.MergeCache,
 	datumStatsCache *hashtree.MergeCache,
 	status *Status,
 ) (_ *DatumStats, _ []string, retErr error) {
 	recoveredDatums := []string{}
 	stats := &DatumStats{}
-	tag := common.HashDatum(driver.PipelineInfo().Pipeline.Name, driver.PipelineInfo().Salt, inputs)
+	tag := common.HashDatum(driver.PipelineInfo().Pipeline.Name, ppsutil.JobSalt(driver.PipelineInfo().Salt, parameters), inputs)
 	datumID := common.DatumID(inputs)
 
 	if _, err := driver.PachClient().InspectTag(driver.PachClient().Ctx(), client.NewTag(tag)); err == nil {
@@ -444,12 +445,44 @@ func processDatum(
 		}()
 	}
 
+	// If this pipeline memoizes its datums, reuse the output of an identical
+	// datum processed by any pipeline
+	memoKey := driver.MemoKey(inputs)
+	if parameters != nil {
+		// The memoization cache isn't keyed by run parameters
+		memoKey = ""
+	}
+	if memoKey != "" {
+		memoized, err := reuseMemoizedDatum(driver, logger, memoKey, tag)
+		if err != nil {
+			return stats, recoveredDatums, err
+		}
+		if memoized != nil {
+			if err := datumCache.Put(uuid.NewWithoutDashes(), bytes.NewReader(memoized)); err != nil {
+				return stats, recoveredDatums, err
+			}
+			if outputTree != nil {
+				// The datum's stats are written when we return
+				stats.ProcessStats = &pps.ProcessStats{}
+				if err := putMemoizedOutput(outputTree, memoized); err != nil {
+					return stats, recoveredDatums, err
+				}
+			}
+			if err := driver.WriteRecord(outputCommit, inputs, parameters, tag); err != nil {
+				return stats, recoveredDatums, err
+			}
+			stats.DatumsSkipped++
+			return stats, recoveredDatums, nil
+		}
+	}
+
 	var failures int64
+	var datumHashtree []byte
 	if err := backoff.RetryUntilCancel(driver.PachClient().Ctx(), func() error {
 		var err error
 
 		// WithData will download the inputs for this datum
-		stats.ProcessStats, err = driver.WithData(inputs, inputTree, logger, func(dir string, processStats *pps.ProcessStats) error {
+		stats.ProcessStats, err = driver.WithData(inputs, parameters, inputTree, logger, func(dir string, processStats *pps.ProcessStats) error {
 
 			// WithActiveData acquires a mutex so that we don't run this section concurrently
 			if err := driver.WithActiveData(inputs, dir, func() error {
@@ -459,7 +492,7 @@ func processDatum(
 				driver := driver.WithContext(ctx)
 
 				return status.withDatum(inputs, cancel, func() error {
-					env := driver.UserCodeEnv(logger.JobID(), outputCommit, inputs)
+					env := driver.UserCodeEnv(logger.JobID(), outputCommit, inputs, parameters)
 					if err := driver.RunUserCode(logger, env, processStats, driver.PipelineInfo().DatumTimeout); err != nil {
 						if driver.PipelineInfo().Transform.ErrCmd != nil && failures == driver.PipelineInfo().DatumTries-1 {
 							if err = driver.RunUserErrorHandlingCode(logger, env, processStats, driver.PipelineInfo().DatumTimeout); err != nil {
@@ -483,6 +516,10 @@ func processDatum(
 			if err != nil {
 				return err
 			}
+			datumHashtree = hashtreeBytes
+			if err := driver.WriteRecord(outputCommit, inputs, parameters, tag); err != nil {
+				return err
+			}
 
 			// Cache datum hashtree locally
 			return datumCache.Put(uuid.NewWithoutDashes(), bytes.NewReader(hashtreeBytes))
@@ -526,6 +563,9 @@ func processDatum(
 		stats.DatumsFailed++
 	} else {
 		stats.DatumsProcessed++
+		if memoKey != "" && datumHashtree != nil {
+			memoizeDatum(driver, logger, memoKey, datumHashtree, stats.ProcessStats.GetUploadBytes())
+		}
 	}
 	return stats, recoveredDatums, nil
 }
@@ -771,15 +811,15 @@ func merge(driver driver.Driver, parent io.Reader, cache *hashtree.MergeCache, s
 		if err != nil {
 			return errors.EnsureStack(err)
 		}
-		return writeIndex(driver, tree, indexData)
+		return writeIndex(driver.PachClient(), tree, indexData)
 	}(); err != nil {
 		return nil, 0, err
 	}
 	return tree, size, nil
 }
 
-func writeIndex(driver driver.Driver, tree *pfs.Object, indexData []byte) (retErr error) {
-	info, err := driver.PachClient().InspectObject(tree.Hash)
+func writeIndex(pachClient *client.APIClient, tree *pfs.Object, indexData []byte) (retErr error) {
+	info, err := pachClient.InspectObject(tree.Hash)
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
@@ -787,7 +827,7 @@ func writeIndex(driver driver.Driver, tree *pfs.Object, indexData []byte) (retEr
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
-	indexWriter, err := driver.PachClient().DirectObjWriter(path + hashtree.IndexPath)
+	indexWriter, err := pachClient.DirectObjWriter(path + hashtree.IndexPath)
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
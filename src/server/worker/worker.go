@@ -31,6 +31,14 @@ import (
 
 const (
 	masterLockPath = "_master_worker_lock"
+
+	// setupTries is how many times a worker runs its pipeline's setup_cmd before
+	// giving up and failing the pipeline
+	setupTries = 3
+
+	// logPruneInterval is how often the master deletes the persisted logs of
+	// jobs that have outlived the pipeline's log retention
+	logPruneInterval = 10 * time.Minute
 )
 
 // The Worker object represents
@@ -38,6 +46,19 @@ type Worker struct {
 	APIServer *server.APIServer // Provides rpcs for other nodes in the cluster
 	driver    driver.Driver     // Provides common functions used by worker code
 	status    *transform.Status // An interface for inspecting and canceling the actively running task
+
+	taskWorker *work.Worker // Claims and processes the subtasks created by the master
+
+	// masterCtx is canceled when the worker drains, which stops the master
+	// goroutine (releasing the master lock, if this worker holds it).
+	// masterDone is closed once the master goroutine has returned.
+	masterCtx    context.Context
+	cancelMaster context.CancelFunc
+	masterDone   chan struct{}
+
+	// setupDone is closed once the pipeline's setup_cmd (if any) has succeeded.
+	// Teardown only runs the teardown_cmd after that.
+	setupDone chan struct{}
 }
 
 // NewWorker constructs a Worker object that provides all worker functionality:
@@ -56,6 +77,7 @@ func NewWorker(
 	rootPath string,
 ) (*Worker, error) {
 	stats.InitPrometheus()
+	logs.PersistLogs(pachClient, pipelineInfo)
 
 	hasDocker := true
 	if _, err := os.Stat("/var/run/docker.sock"); err != nil {
@@ -94,24 +116,98 @@ func NewWorker(
 			if len(image.Config.Entrypoint) == 0 {
 				ppsutil.FailPipeline(pachClient.Ctx(), etcdClient, driver.Pipelines(),
 					pipelineInfo.Pipeline.Name,
-					"nothing to run: no transform.cmd and no entrypoint")
+					"nothing to run: no transform.cmd and no entrypoint", ppsutil.ActorWorker)
 			}
 			pipelineInfo.Transform.Cmd = image.Config.Entrypoint
 		}
 	}
 
 	worker := &Worker{
-		driver: driver,
-		status: &transform.Status{},
+		driver:     driver,
+		status:     &transform.Status{},
+		taskWorker: driver.NewTaskWorker(),
+		masterDone: make(chan struct{}),
+		setupDone:  make(chan struct{}),
 	}
+	worker.masterCtx, worker.cancelMaster = context.WithCancel(driver.PachClient().Ctx())
 
 	worker.APIServer = server.NewAPIServer(driver, worker.status, workerName)
 
-	go worker.master(etcdClient, etcdPrefix)
-	go worker.worker()
+	// The worker doesn't direct jobs or process datums (both of which may run
+	// user code) until the pipeline's setup_cmd has succeeded. If it keeps
+	// failing, the pipeline has been failed and the worker exits.
+	go func() {
+		defer close(worker.masterDone)
+		if err := worker.setup(etcdClient); err != nil {
+			os.Exit(1)
+		}
+		close(worker.setupDone)
+		go worker.worker()
+		worker.master(etcdClient, etcdPrefix)
+	}()
 	return worker, nil
 }
 
+// setup runs the pipeline's setup_cmd (if any), retrying it if it fails. If it
+// keeps failing, the pipeline is failed.
+func (w *Worker) setup(etcdClient *etcd.Client) error {
+	pipelineInfo := w.driver.PipelineInfo()
+	if len(pipelineInfo.Transform.SetupCmd) == 0 {
+		return nil
+	}
+	logger := logs.NewStatlessLogger(pipelineInfo)
+	tries := 0
+	err := backoff.RetryNotify(func() error {
+		tries++
+		return w.driver.RunUserSetupCode(logger)
+	}, backoff.NewExponentialBackOff(), func(err error, d time.Duration) error {
+		if tries >= setupTries {
+			return err
+		}
+		logger.Logf("setup failed, retrying in %v: %v", d, err)
+		return nil
+	})
+	if err != nil {
+		logger.Logf("setup failed %d times, failing pipeline: %v", tries, err)
+		if err := ppsutil.FailPipeline(w.driver.PachClient().Ctx(), etcdClient, w.driver.Pipelines(),
+			pipelineInfo.Pipeline.Name, fmt.Sprintf("setup_cmd failed: %v", err), ppsutil.ActorWorker); err != nil {
+			logger.Logf("could not fail pipeline: %v", err)
+		}
+	}
+	return err
+}
+
+// Teardown runs the pipeline's teardown_cmd (if any), unless the worker's
+// setup_cmd hasn't succeeded. It's called when the worker's pod is being
+// terminated, after the worker has drained.
+func (w *Worker) Teardown() error {
+	select {
+	case <-w.setupDone:
+	default:
+		return nil
+	}
+	return w.driver.RunUserTeardownCode(logs.NewStatlessLogger(w.driver.PipelineInfo()))
+}
+
+// Drain stops this worker from claiming new datums, and waits for the datums
+// it is already processing to finish (or for 'ctx' to be done). Then, if this
+// worker is the master, it stops directing jobs and releases the master lock,
+// so that another worker takes over right away rather than once the lock
+// expires. It's called when the worker's pod is being terminated, e.g. when an
+// autoscaling pipeline scales down.
+func (w *Worker) Drain(ctx context.Context) error {
+	if err := w.taskWorker.Drain(ctx); err != nil {
+		return err
+	}
+	w.cancelMaster()
+	select {
+	case <-w.masterDone:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (w *Worker) worker() {
 	ctx := w.driver.PachClient().Ctx()
 	logger := logs.NewStatlessLogger(w.driver.PipelineInfo())
@@ -139,7 +235,7 @@ func (w *Worker) worker() {
 
 		// Run any worker tasks that the master creates
 		eg.Go(func() error {
-			return driver.NewTaskWorker().Run(
+			return w.taskWorker.Run(
 				ctx,
 				func(ctx context.Context, subtask *work.Task) error {
 					driver := w.driver.WithContext(ctx)
@@ -176,18 +272,29 @@ func (w *Worker) master(etcdClient *etcd.Client, etcdPrefix string) {
 	// to restart.
 	b.InitialInterval = 10 * time.Second
 	backoff.RetryNotify(func() error {
-		// We use pachClient.Ctx here because it contains auth information.
-		ctx, cancel := context.WithCancel(w.driver.PachClient().Ctx())
+		// We use masterCtx here because it contains auth information (it's
+		// derived from pachClient.Ctx), and it's canceled when the worker drains.
+		ctx, cancel := context.WithCancel(w.masterCtx)
 		defer cancel() // make sure that everything this loop might spawn gets cleaned up
 		ctx, err := masterLock.Lock(ctx)
 		if err != nil {
 			return err
 		}
-		defer masterLock.Unlock(ctx)
+		defer func() {
+			// Unlock with a fresh context, as 'ctx' has likely been canceled
+			unlockCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			masterLock.Unlock(unlockCtx)
+		}()
+		go pruneLogs(w.driver.PachClient().WithCtx(ctx), pipelineInfo, logger)
 
 		// Create a new driver that uses a new cancelable pachClient
 		return runSpawner(w.driver.WithContext(ctx), logger)
 	}, b, func(err error, d time.Duration) error {
+		if w.masterCtx.Err() != nil {
+			logger.Logf("master: worker is draining, giving up the master lock")
+			return w.masterCtx.Err()
+		}
 		if auth.IsErrNotAuthorized(err) {
 			logger.Logf("failing %q due to auth rejection", pipelineInfo.Pipeline.Name)
 			return ppsutil.FailPipeline(
@@ -196,6 +303,7 @@ func (w *Worker) master(etcdClient *etcd.Client, etcdPrefix string) {
 				w.driver.Pipelines(),
 				pipelineInfo.Pipeline.Name,
 				"worker master could not access output repo to watch for new commits",
+				ppsutil.ActorWorker,
 			)
 		}
 		logger.Logf("master: error running the master process, retrying in %v: %v", d, err)
@@ -203,6 +311,28 @@ func (w *Worker) master(etcdClient *etcd.Client, etcdPrefix string) {
 	})
 }
 
+// pruneLogs periodically deletes the persisted logs of the pipeline's jobs
+// that have outlived its log retention, until the context of 'pachClient' is
+// done
+func pruneLogs(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, logger logs.TaggedLogger) {
+	if pipelineInfo.PersistLogs == nil || pipelineInfo.PersistLogs.Retention == nil {
+		return
+	}
+	ticker := time.NewTicker(logPruneInterval)
+	defer ticker.Stop()
+	for {
+		if err := logs.PruneLogs(pachClient, pipelineInfo); err != nil {
+			// Errf, as the master logger isn't safe to use concurrently
+			logger.Errf("master: could not prune persisted logs: %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-pachClient.Ctx().Done():
+			return
+		}
+	}
+}
+
 type spawnerFunc func(driver.Driver, logs.TaggedLogger) error
 
 // Run runs the spawner for a given pipeline.  This switches between several